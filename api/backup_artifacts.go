@@ -0,0 +1,368 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// importedBackupAnnotation marks the backups imported from a backup storage.
+	importedBackupAnnotation = "everest.percona.com/imported-backup"
+	// importedBackupClusterSuffix is appended to the database cluster name of the imported backups.
+	// No database cluster can have such a name, so the operator does not reconcile an imported backup
+	// once the source database cluster is created again.
+	importedBackupClusterSuffix = ".imported"
+
+	xtrabackupSSTInfoSuffix = ".sst_info"
+	pbmMetadataSuffix       = ".pbm.json"
+	pgBackRestManifest      = "backup.manifest"
+	pgBackRestBackupDir     = "backup/db"
+	pgBackRestLabelLayout   = "20060102-150405"
+)
+
+var (
+	errUnknownBackupArtifact  = errors.New("backup not found in the backup storage")
+	errBackupArtifactImported = errors.New("backup is already imported")
+)
+
+// storageObject is an object stored in the bucket of a backup storage.
+type storageObject struct {
+	key          string
+	lastModified time.Time
+	size         int64
+}
+
+// ListBackupArtifacts lists the backups stored in the specified backup storage.
+func (e *EverestServer) ListBackupArtifacts(ctx echo.Context, name string) error {
+	c := ctx.Request().Context()
+	storage, err := e.kubeClient.GetBackupStorage(c, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Backup storage is not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed getting backup storage")})
+	}
+	artifacts, err := e.backupArtifacts(c, storage)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString(fmt.Sprintf("Could not list the backups in the %s backup storage", name)),
+		})
+	}
+	return ctx.JSON(http.StatusOK, artifacts)
+}
+
+// ImportBackupArtifacts creates DatabaseClusterBackup objects for the selected backups of the backup storage.
+func (e *EverestServer) ImportBackupArtifacts(ctx echo.Context, name string) error { //nolint:funlen
+	var params BackupArtifactImport
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	namespaces, err := e.kubeClient.GetDBNamespaces(c, e.kubeClient.Namespace())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Failed getting watched namespaces"),
+		})
+	}
+	if err := validateAllowedNamespaces([]string{params.Namespace}, namespaces); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	storage, err := e.kubeClient.GetBackupStorage(c, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Backup storage is not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed getting backup storage")})
+	}
+	if len(storage.Spec.AllowedNamespaces) > 0 && !slices.Contains(storage.Spec.AllowedNamespaces, params.Namespace) {
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString(fmt.Sprintf("The backup storage %s is not allowed in the %s namespace", name, params.Namespace)),
+		})
+	}
+
+	artifacts, err := e.backupArtifacts(c, storage)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString(fmt.Sprintf("Could not list the backups in the %s backup storage", name)),
+		})
+	}
+	selected, err := selectBackupArtifacts(artifacts, params.Destinations, params.Namespace)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	result := make([]BackupArtifact, 0, len(selected))
+	for _, a := range selected {
+		backup, err := e.importBackupArtifact(c, params.Namespace, name, a)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{
+				Message: pointer.ToString(fmt.Sprintf("Failed importing backup %s", a.Destination)),
+			})
+		}
+		a.Orphaned = false
+		a.DatabaseClusterBackupNamespace = &backup.Namespace
+		a.DatabaseClusterBackupName = &backup.Name
+		result = append(result, a)
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// backupArtifacts lists the backups of the backup storage and flags the ones without DatabaseClusterBackup.
+func (e *EverestServer) backupArtifacts(ctx context.Context, storage *everestv1alpha1.BackupStorage) ([]BackupArtifact, error) {
+	objects, err := e.listStorageObjects(ctx, storage, "")
+	if err != nil {
+		return nil, err
+	}
+	namespaces, err := e.kubeClient.GetDBNamespaces(ctx, e.kubeClient.Namespace())
+	if err != nil {
+		return nil, err
+	}
+	var backups []everestv1alpha1.DatabaseClusterBackup
+	for _, namespace := range namespaces {
+		list, err := e.kubeClient.ListDatabaseClusterBackups(ctx, namespace, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		backups = append(backups, list.Items...)
+	}
+
+	artifacts := backupArtifacts(objects, storage)
+	flagOrphanedBackupArtifacts(artifacts, backups, storage.Name)
+	return artifacts, nil
+}
+
+// listStorageObjects lists the objects with the key prefix in the bucket of the backup storage.
+func (e *EverestServer) listStorageObjects(ctx context.Context, storage *everestv1alpha1.BackupStorage, prefix string) ([]storageObject, error) {
+	client, err := e.newStorageClient(ctx, storage)
+	if err != nil {
+		return nil, err
+	}
+	return client.list(ctx, prefix)
+}
+
+// backupArtifacts recognizes the backups among the objects of the backup storage.
+// The operators store the backups of a database cluster under the <name>/<uid> prefix:
+//   - xtrabackup (PXC) writes <prefix>/<backup>.sst_info/ next to <prefix>/<backup>/,
+//   - PBM (PSMDB) writes <prefix>/<timestamp>.pbm.json next to <prefix>/<timestamp>/,
+//   - pgBackRest (PostgreSQL) writes <prefix>/backup/db/<label>/backup.manifest.
+func backupArtifacts(objects []storageObject, storage *everestv1alpha1.BackupStorage) []BackupArtifact {
+	found := make(map[string]*BackupArtifact)
+	var keys []string
+	for _, o := range objects {
+		parts := strings.Split(o.key, "/")
+		if len(parts) < 3 || parts[0] == "" || parts[1] == "" {
+			continue
+		}
+		a := &BackupArtifact{
+			DatabaseClusterName: parts[0],
+			DatabaseClusterUID:  pointer.ToString(parts[1]),
+		}
+		var key string
+		switch {
+		case len(parts) >= 4 && strings.HasSuffix(parts[2], xtrabackupSSTInfoSuffix):
+			a.Name = strings.TrimSuffix(parts[2], xtrabackupSSTInfoSuffix)
+			a.EngineType = string(everestv1alpha1.DatabaseEnginePXC)
+			key = strings.Join(append(parts[:2:2], a.Name), "/")
+		case len(parts) == 3 && strings.HasSuffix(parts[2], pbmMetadataSuffix):
+			a.Name = strings.TrimSuffix(parts[2], pbmMetadataSuffix)
+			a.EngineType = string(everestv1alpha1.DatabaseEnginePSMDB)
+			if t, err := time.Parse(time.RFC3339, a.Name); err == nil {
+				a.CreatedAt = &t
+			}
+			key = strings.Join(append(parts[:2:2], a.Name), "/")
+		case len(parts) == 6 && strings.Join(parts[2:4], "/") == pgBackRestBackupDir && parts[5] == pgBackRestManifest:
+			a.Name = parts[4]
+			a.EngineType = string(everestv1alpha1.DatabaseEnginePostgresql)
+			if len(a.Name) >= len(pgBackRestLabelLayout) {
+				if t, err := time.Parse(pgBackRestLabelLayout, a.Name[:len(pgBackRestLabelLayout)]); err == nil {
+					a.CreatedAt = &t
+				}
+			}
+			key = strings.Join(parts[:5], "/")
+		default:
+			continue
+		}
+		if _, ok := found[key]; ok {
+			continue
+		}
+		a.Destination = storageDestination(storage, key)
+		found[key] = a
+		keys = append(keys, key)
+	}
+
+	// The size of a backup is the total size of the objects named after it.
+	// The oldest object gives the creation time unless the name of the backup has it.
+	modified := make(map[string]time.Time, len(keys))
+	for _, o := range objects {
+		for _, key := range backupArtifactKeys(o.key) {
+			a, ok := found[key]
+			if !ok {
+				continue
+			}
+			a.SizeBytes = pointer.ToInt64(pointer.GetInt64(a.SizeBytes) + o.size)
+			if t, ok := modified[key]; !ok || o.lastModified.Before(t) {
+				modified[key] = o.lastModified
+			}
+		}
+	}
+
+	result := make([]BackupArtifact, 0, len(keys))
+	for _, key := range keys {
+		a := found[key]
+		if t, ok := modified[key]; ok && a.CreatedAt == nil {
+			a.CreatedAt = &t
+		}
+		result = append(result, *a)
+	}
+	slices.SortStableFunc(result, func(a, b BackupArtifact) int {
+		return pointer.GetTime(b.CreatedAt).Compare(pointer.GetTime(a.CreatedAt))
+	})
+	return result
+}
+
+// backupArtifactKeys returns the keys of the backups the object may be named after, i.e. its key
+// starts with the key of the backup followed by "/" or ".". The keys of the backups have
+// 3 segments, or 5 segments for pgBackRest.
+func backupArtifactKeys(objectKey string) []string {
+	var keys []string
+	parts := strings.Split(objectKey, "/")
+	for _, n := range []int{3, 5} {
+		if len(parts) < n {
+			break
+		}
+		prefix := strings.Join(parts[:n-1], "/") + "/"
+		last := parts[n-1]
+		if len(parts) > n {
+			keys = append(keys, prefix+last)
+		}
+		for i := range last {
+			if last[i] == '.' {
+				keys = append(keys, prefix+last[:i])
+			}
+		}
+	}
+	return keys
+}
+
+// flagOrphanedBackupArtifacts links the backups to the DatabaseClusterBackup objects referring to them
+// and flags the others as orphaned.
+func flagOrphanedBackupArtifacts(artifacts []BackupArtifact, backups []everestv1alpha1.DatabaseClusterBackup, storageName string) {
+	for i := range artifacts {
+		artifacts[i].Orphaned = true
+		for _, b := range backups {
+			if b.Spec.BackupStorageName != storageName || b.Status.Destination == nil ||
+				strings.TrimSuffix(*b.Status.Destination, "/") != artifacts[i].Destination {
+				continue
+			}
+			artifacts[i].Orphaned = false
+			artifacts[i].DatabaseClusterBackupNamespace = pointer.ToString(b.Namespace)
+			artifacts[i].DatabaseClusterBackupName = pointer.ToString(b.Name)
+			break
+		}
+	}
+}
+
+// selectBackupArtifacts returns the backups with the destinations which can be imported in the namespace.
+func selectBackupArtifacts(artifacts []BackupArtifact, destinations []string, namespace string) ([]BackupArtifact, error) {
+	selected := make([]BackupArtifact, 0, len(destinations))
+	for _, destination := range destinations {
+		i := slices.IndexFunc(artifacts, func(a BackupArtifact) bool {
+			return a.Destination == strings.TrimSuffix(destination, "/")
+		})
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %s", errUnknownBackupArtifact, destination)
+		}
+		a := artifacts[i]
+		if pointer.GetString(a.DatabaseClusterBackupNamespace) == namespace {
+			return nil, fmt.Errorf("%w: %s is referred by %s", errBackupArtifactImported, destination, pointer.GetString(a.DatabaseClusterBackupName))
+		}
+		if !slices.ContainsFunc(selected, func(s BackupArtifact) bool { return s.Destination == a.Destination }) {
+			selected = append(selected, a)
+		}
+	}
+	return selected, nil
+}
+
+// importedBackup returns the DatabaseClusterBackup referring to the backup found in the backup storage.
+func importedBackup(a BackupArtifact, namespace, storageName string) *everestv1alpha1.DatabaseClusterBackup {
+	sum := sha256.Sum256([]byte(a.Destination))
+	return &everestv1alpha1.DatabaseClusterBackup{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: databaseClusterAPIVersion,
+			Kind:       "DatabaseClusterBackup",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-imported-%x", a.DatabaseClusterName, sum[:4]),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"clusterName": a.DatabaseClusterName,
+				fmt.Sprintf(backupStorageLabelTmpl, storageName): "used",
+			},
+			Annotations: map[string]string{
+				importedBackupAnnotation:   a.Destination,
+				backupEngineTypeAnnotation: string(a.EngineType),
+			},
+		},
+		Spec: everestv1alpha1.DatabaseClusterBackupSpec{
+			DBClusterName:     a.DatabaseClusterName + importedBackupClusterSuffix,
+			BackupStorageName: storageName,
+		},
+	}
+}
+
+// importBackupArtifact creates the DatabaseClusterBackup and sets its status so the backup can be restored.
+// The operator does not take a backup since no database cluster has the name of the backup.
+func (e *EverestServer) importBackupArtifact(
+	ctx context.Context,
+	namespace, storageName string,
+	a BackupArtifact,
+) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	backup, err := e.kubeClient.CreateDatabaseClusterBackup(ctx, namespace, importedBackup(a, namespace, storageName))
+	if err != nil {
+		return nil, err
+	}
+	createdAt := metav1.Now()
+	if a.CreatedAt != nil {
+		createdAt = metav1.NewTime(*a.CreatedAt)
+	}
+	backup.Status = everestv1alpha1.DatabaseClusterBackupStatus{
+		CreatedAt:   &createdAt,
+		CompletedAt: &createdAt,
+		State:       successState(everestv1alpha1.EngineType(a.EngineType)),
+		Destination: pointer.ToString(a.Destination),
+	}
+	return e.kubeClient.UpdateDatabaseClusterBackupStatus(ctx, backup)
+}
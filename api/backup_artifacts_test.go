@@ -0,0 +1,162 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBackupArtifacts(t *testing.T) {
+	t.Parallel()
+	modified := time.Date(2024, 1, 2, 10, 5, 0, 0, time.UTC)
+	objects := []storageObject{
+		{key: "mysql/uid-1/mysql-full.md5", lastModified: modified, size: 10},
+		{key: "mysql/uid-1/mysql-full.sst_info/sst_info.00000000000000000000", lastModified: modified, size: 20},
+		{key: "mysql/uid-1/mysql-full/xtrabackup_checkpoints.00000000000000000000", lastModified: modified, size: 30},
+		{key: "mongo/uid-2/2024-01-03T10:00:00Z.pbm.json", lastModified: modified, size: 5},
+		{key: "mongo/uid-2/2024-01-03T10:00:00Z/rs0/oplog", lastModified: modified, size: 100},
+		{key: "mongo/uid-2/pbmPitr/rs0/20240103/oplog", lastModified: modified, size: 1000},
+		{key: "pg/uid-3/backup/db/20240101-100000F/backup.manifest", lastModified: modified, size: 1},
+		{key: "pg/uid-3/backup/db/20240101-100000F/pg_data/base.gz", lastModified: modified, size: 50},
+		{key: "pg/uid-3/backup/db/backup.history/2024/20240101-100000F.manifest.gz", lastModified: modified, size: 1},
+		{key: "unrelated.txt", lastModified: modified, size: 1},
+	}
+	storage := &everestv1alpha1.BackupStorage{
+		ObjectMeta: metav1.ObjectMeta{Name: "s3"},
+		Spec:       everestv1alpha1.BackupStorageSpec{Type: everestv1alpha1.BackupStorageTypeS3, Bucket: "bucket"},
+	}
+
+	artifacts := backupArtifacts(objects, storage)
+	require.Len(t, artifacts, 3)
+	assert.Equal(t, BackupArtifact{
+		Name:                "2024-01-03T10:00:00Z",
+		EngineType:          "psmdb",
+		Destination:         "s3://bucket/mongo/uid-2/2024-01-03T10:00:00Z",
+		DatabaseClusterName: "mongo",
+		DatabaseClusterUID:  pointer.ToString("uid-2"),
+		CreatedAt:           pointer.ToTime(time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC)),
+		SizeBytes:           pointer.ToInt64(105),
+	}, artifacts[0])
+	assert.Equal(t, "mysql-full", artifacts[1].Name)
+	assert.Equal(t, "pxc", artifacts[1].EngineType)
+	assert.Equal(t, "s3://bucket/mysql/uid-1/mysql-full", artifacts[1].Destination)
+	assert.Equal(t, pointer.ToInt64(60), artifacts[1].SizeBytes)
+	assert.Equal(t, &modified, artifacts[1].CreatedAt)
+	assert.Equal(t, "20240101-100000F", artifacts[2].Name)
+	assert.Equal(t, "postgresql", artifacts[2].EngineType)
+	assert.Equal(t, "s3://bucket/pg/uid-3/backup/db/20240101-100000F", artifacts[2].Destination)
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), *artifacts[2].CreatedAt)
+	assert.Equal(t, pointer.ToInt64(51), artifacts[2].SizeBytes)
+
+	storage.Spec.Type = everestv1alpha1.BackupStorageTypeAzure
+	artifacts = backupArtifacts(objects[:3], storage)
+	require.Len(t, artifacts, 1)
+	assert.Equal(t, "azure://bucket/mysql/uid-1/mysql-full", artifacts[0].Destination)
+}
+
+func TestBackupArtifactKeys(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		objectKey string
+		keys      []string
+	}{
+		{objectKey: "mysql/uid-1/mysql-full.md5", keys: []string{"mysql/uid-1/mysql-full"}},
+		{
+			objectKey: "mysql/uid-1/mysql-full.sst_info/sst_info.00000000000000000000",
+			keys:      []string{"mysql/uid-1/mysql-full.sst_info", "mysql/uid-1/mysql-full"},
+		},
+		{objectKey: "mongo/uid-2/2024-01-03T10:00:00Z.pbm.json", keys: []string{"mongo/uid-2/2024-01-03T10:00:00Z", "mongo/uid-2/2024-01-03T10:00:00Z.pbm"}},
+		{
+			objectKey: "pg/uid-3/backup/db/20240101-100000F/pg_data/base.gz",
+			keys:      []string{"pg/uid-3/backup", "pg/uid-3/backup/db/20240101-100000F"},
+		},
+		{objectKey: "pg/uid-3/archive", keys: nil},
+		{objectKey: "unrelated.txt", keys: nil},
+	}
+	for _, tc := range cases {
+		assert.Equal(t, tc.keys, backupArtifactKeys(tc.objectKey), tc.objectKey)
+	}
+}
+
+func TestFlagOrphanedBackupArtifacts(t *testing.T) {
+	t.Parallel()
+	artifacts := []BackupArtifact{
+		{Destination: "s3://bucket/mysql/uid-1/full"},
+		{Destination: "s3://bucket/mysql/uid-1/incr"},
+	}
+	backup := func(name, storage, destination string) everestv1alpha1.DatabaseClusterBackup {
+		return everestv1alpha1.DatabaseClusterBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+			Spec:       everestv1alpha1.DatabaseClusterBackupSpec{BackupStorageName: storage},
+			Status:     everestv1alpha1.DatabaseClusterBackupStatus{Destination: pointer.ToString(destination)},
+		}
+	}
+	flagOrphanedBackupArtifacts(artifacts, []everestv1alpha1.DatabaseClusterBackup{
+		backup("full", "s3", "s3://bucket/mysql/uid-1/full/"),
+		backup("incr", "other", "s3://bucket/mysql/uid-1/incr"),
+	}, "s3")
+
+	assert.False(t, artifacts[0].Orphaned)
+	assert.Equal(t, pointer.ToString("full"), artifacts[0].DatabaseClusterBackupName)
+	assert.True(t, artifacts[1].Orphaned)
+	assert.Nil(t, artifacts[1].DatabaseClusterBackupName)
+}
+
+func TestSelectBackupArtifacts(t *testing.T) {
+	t.Parallel()
+	artifacts := []BackupArtifact{
+		{Destination: "s3://bucket/mysql/uid-1/full", Orphaned: true},
+		{
+			Destination:                    "s3://bucket/mysql/uid-1/incr",
+			DatabaseClusterBackupNamespace: pointer.ToString("production"),
+			DatabaseClusterBackupName:      pointer.ToString("incr"),
+		},
+	}
+
+	selected, err := selectBackupArtifacts(artifacts, []string{
+		"s3://bucket/mysql/uid-1/full", "s3://bucket/mysql/uid-1/full/", "s3://bucket/mysql/uid-1/incr",
+	}, "staging")
+	require.NoError(t, err)
+	assert.Len(t, selected, 2)
+
+	_, err = selectBackupArtifacts(artifacts, []string{"s3://bucket/mysql/uid-1/incr"}, "production")
+	require.ErrorIs(t, err, errBackupArtifactImported)
+	_, err = selectBackupArtifacts(artifacts, []string{"s3://bucket/unknown"}, "staging")
+	require.ErrorIs(t, err, errUnknownBackupArtifact)
+}
+
+func TestImportedBackup(t *testing.T) {
+	t.Parallel()
+	backup := importedBackup(BackupArtifact{
+		Destination:         "s3://bucket/mysql/uid-1/full",
+		DatabaseClusterName: "mysql",
+	}, "staging", "s3")
+	assert.Regexp(t, `^mysql-imported-[0-9a-f]{8}$`, backup.Name)
+	assert.Equal(t, "staging", backup.Namespace)
+	assert.Equal(t, "s3://bucket/mysql/uid-1/full", backup.Annotations[importedBackupAnnotation])
+	assert.Equal(t, map[string]string{"clusterName": "mysql", "backupStorage-s3": "used"}, backup.Labels)
+	assert.Equal(t, everestv1alpha1.DatabaseClusterBackupSpec{DBClusterName: "mysql.imported", BackupStorageName: "s3"}, backup.Spec)
+	assert.Equal(t, "mysql", backupClusterName(backup))
+
+	copied := copiedBackup(backup, everestv1alpha1.DatabaseEnginePXC, "", &everestv1alpha1.BackupStorage{}, "mysql/uid-1/full")
+	assert.Equal(t, "mysql.copy", copied.Spec.DBClusterName)
+}
@@ -0,0 +1,580 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// backupCopiesAnnotation holds the copies of a backup by the name of their backup storage.
+	backupCopiesAnnotation = "everest.percona.com/backup-copies"
+	// copiedFromAnnotation holds the backup a backup is copied from.
+	copiedFromAnnotation = "everest.percona.com/copied-from"
+	// copiedBackupClusterSuffix is appended to the database cluster name of the copies of backups.
+	// No database cluster can have such a name, so the operator does not take a new backup for a copy.
+	// The copies are still listed with the backups of the database cluster by its label.
+	copiedBackupClusterSuffix = ".copy"
+	// backupEngineTypeAnnotation holds the engine type of the backups which may outlive their database cluster.
+	backupEngineTypeAnnotation = "everest.percona.com/engine-type"
+	// backupEngineVersionAnnotation holds the engine version of the backups which may outlive their database cluster.
+	backupEngineVersionAnnotation = "everest.percona.com/engine-version"
+	backupStorageLabelTmpl        = "backupStorage-%s"
+
+	backupCopyStateRunning   = "running"
+	backupCopyStateSucceeded = "succeeded"
+	backupCopyStateFailed    = "failed"
+
+	backupCopyTimeout     = 6 * time.Hour
+	backupCopySaveTimeout = time.Minute
+)
+
+var (
+	errBackupCopyRunning           = errors.New("the backup is already being copied to the backup storage")
+	errBackupCopyExists            = errors.New("the backup is already copied to the backup storage")
+	errBackupCopySameStorage       = errors.New("the backup is already stored in the backup storage")
+	errBackupCopyOfCopy            = errors.New("copies of backups can't be copied")
+	errBackupCopyNotSucceeded      = errors.New("only successful backups can be copied")
+	errBackupCopyStorageNotAllowed = errors.New("the backup storage is not allowed in the namespace of the backup")
+	errInvalidBackupDestination    = errors.New("invalid backup destination")
+)
+
+// ListDatabaseClusterBackupCopies lists the copies of the specified backup.
+func (e *EverestServer) ListDatabaseClusterBackupCopies(ctx echo.Context, namespace, name string) error {
+	backup, err := e.kubeClient.GetDatabaseClusterBackup(ctx.Request().Context(), namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Backup is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the backup")})
+	}
+
+	copies, err := backupCopies(backup)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not parse the backup copies")})
+	}
+	result := make([]BackupCopy, 0, len(copies))
+	for _, c := range copies {
+		result = append(result, c)
+	}
+	slices.SortFunc(result, func(a, b BackupCopy) int {
+		return strings.Compare(a.BackupStorageName, b.BackupStorageName)
+	})
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// CopyDatabaseClusterBackup starts copying the specified backup to another backup storage.
+func (e *EverestServer) CopyDatabaseClusterBackup(ctx echo.Context, namespace, name string) error {
+	params := &BackupCopyParams{}
+	if err := e.getBodyFromContext(ctx, params); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get BackupCopyParams from the request body"),
+		})
+	}
+
+	reqCtx := ctx.Request().Context()
+	backup, err := e.kubeClient.GetDatabaseClusterBackup(reqCtx, namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Backup is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the backup")})
+	}
+
+	c, engineType, err := e.startBackupCopy(reqCtx, backup, params.BackupStorageName, time.Now())
+	if err != nil {
+		e.l.Error(err)
+		switch {
+		case errors.Is(err, errBackupCopyRunning), errors.Is(err, errBackupCopyExists):
+			return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString(err.Error())})
+		case k8serrors.IsNotFound(err), errors.Is(err, errBackupCopySameStorage), errors.Is(err, errBackupCopyOfCopy),
+			errors.Is(err, errBackupCopyNotSucceeded), errors.Is(err, errBackupCopyStorageNotAllowed):
+			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not start the backup copy")})
+		}
+	}
+
+	// The copy outlives the request.
+	go func() {
+		cCtx, cancel := context.WithTimeout(context.WithoutCancel(reqCtx), backupCopyTimeout)
+		defer cancel()
+		e.runBackupCopy(cCtx, backup, engineType, c)
+	}()
+
+	return ctx.JSON(http.StatusAccepted, c)
+}
+
+// backupCopies returns the copies of the backup by the name of their backup storage.
+func backupCopies(backup *everestv1alpha1.DatabaseClusterBackup) (map[string]BackupCopy, error) {
+	copies := make(map[string]BackupCopy)
+	v, ok := backup.Annotations[backupCopiesAnnotation]
+	if !ok {
+		return copies, nil
+	}
+	if err := json.Unmarshal([]byte(v), &copies); err != nil {
+		return nil, err
+	}
+	return copies, nil
+}
+
+// backupCopyRunning returns true if the copy is in progress.
+// A copy which has not finished in time is considered abandoned.
+func backupCopyRunning(c BackupCopy, now time.Time) bool {
+	return c.State == backupCopyStateRunning && now.Before(c.StartedAt.Add(backupCopyTimeout))
+}
+
+// startBackupCopy records the copy of the backup to the backup storage as running.
+// It returns the engine type of the database cluster of the backup with the copy.
+func (e *EverestServer) startBackupCopy(
+	ctx context.Context,
+	backup *everestv1alpha1.DatabaseClusterBackup,
+	storageName string,
+	now time.Time,
+) (*BackupCopy, everestv1alpha1.EngineType, error) {
+	if _, ok := backup.Annotations[copiedFromAnnotation]; ok {
+		return nil, "", errBackupCopyOfCopy
+	}
+	if storageName == backup.Spec.BackupStorageName {
+		return nil, "", errBackupCopySameStorage
+	}
+	db, err := e.kubeClient.GetDatabaseCluster(ctx, backup.Namespace, backupClusterName(backup))
+	if err != nil {
+		return nil, "", err
+	}
+	if !successStatus(backup.Status.State, db.Spec.Engine.Type) || backup.Status.Destination == nil {
+		return nil, "", errBackupCopyNotSucceeded
+	}
+	storage, err := e.kubeClient.GetBackupStorage(ctx, storageName)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(storage.Spec.AllowedNamespaces) > 0 && !slices.Contains(storage.Spec.AllowedNamespaces, backup.Namespace) {
+		return nil, "", fmt.Errorf("%w: %s", errBackupCopyStorageNotAllowed, storageName)
+	}
+
+	c := &BackupCopy{
+		BackupStorageName: storageName,
+		State:             backupCopyStateRunning,
+		StartedAt:         now.UTC(),
+	}
+	err = e.updateBackupCopies(ctx, backup.Namespace, backup.Name, func(copies map[string]BackupCopy) error {
+		if current, ok := copies[storageName]; ok {
+			if err := e.checkBackupCopyConflict(ctx, backup.Namespace, current, now); err != nil {
+				return err
+			}
+		}
+		copies[storageName] = *c
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return c, db.Spec.Engine.Type, nil
+}
+
+// checkBackupCopyConflict checks the current copy of a backup doesn't prevent copying it again.
+// A successful copy is made again only if its database cluster backup is deleted.
+func (e *EverestServer) checkBackupCopyConflict(ctx context.Context, namespace string, current BackupCopy, now time.Time) error {
+	if backupCopyRunning(current, now) {
+		return errBackupCopyRunning
+	}
+	if current.State != backupCopyStateSucceeded || current.DatabaseClusterBackupName == nil {
+		return nil
+	}
+	_, err := e.kubeClient.GetDatabaseClusterBackup(ctx, namespace, *current.DatabaseClusterBackupName)
+	if err == nil {
+		return errBackupCopyExists
+	}
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// updateBackupCopies stores the copies of the backup changed by update.
+func (e *EverestServer) updateBackupCopies(
+	ctx context.Context,
+	namespace, name string,
+	update func(copies map[string]BackupCopy) error,
+) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		backup, err := e.kubeClient.GetDatabaseClusterBackup(ctx, namespace, name)
+		if err != nil {
+			return err
+		}
+		copies, err := backupCopies(backup)
+		if err != nil {
+			return err
+		}
+		if err := update(copies); err != nil {
+			return err
+		}
+		data, err := json.Marshal(copies)
+		if err != nil {
+			return err
+		}
+		if backup.Annotations == nil {
+			backup.Annotations = make(map[string]string)
+		}
+		backup.Annotations[backupCopiesAnnotation] = string(data)
+		_, err = e.kubeClient.UpdateDatabaseClusterBackup(ctx, backup)
+		return err
+	})
+}
+
+// runBackupCopy copies the backup, registers the copy and records the result on the backup.
+func (e *EverestServer) runBackupCopy(
+	ctx context.Context,
+	backup *everestv1alpha1.DatabaseClusterBackup,
+	engineType everestv1alpha1.EngineType,
+	c *BackupCopy,
+) {
+	e.l.Infow("Copying backup", "namespace", backup.Namespace, "backup", backup.Name, "backupStorage", c.BackupStorageName)
+
+	err := e.copyBackup(ctx, backup, engineType, c)
+
+	// The result must not be lost when the copy times out.
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), backupCopySaveTimeout)
+	defer cancel()
+	c.FinishedAt = pointer.ToTime(time.Now().UTC())
+	c.State = backupCopyStateSucceeded
+	if err != nil {
+		c.State = backupCopyStateFailed
+		c.Message = pointer.ToString(err.Error())
+		e.l.Error(errors.Join(err, fmt.Errorf("failed to copy backup %s/%s to %s", backup.Namespace, backup.Name, c.BackupStorageName)))
+	}
+	err = e.updateBackupCopies(saveCtx, backup.Namespace, backup.Name, func(copies map[string]BackupCopy) error {
+		copies[c.BackupStorageName] = *c
+		return nil
+	})
+	if err != nil {
+		e.l.Error(errors.Join(err, fmt.Errorf("failed to save backup %s/%s copy", backup.Namespace, backup.Name)))
+	}
+}
+
+// copyBackup copies the objects of the backup to the backup storage of the copy
+// and creates the DatabaseClusterBackup of the copy.
+// The objects keep their keys, so the copy is found where the operators look for the backups of the database cluster.
+// The objects already stored with the same size are not copied again.
+func (e *EverestServer) copyBackup(
+	ctx context.Context,
+	backup *everestv1alpha1.DatabaseClusterBackup,
+	engineType everestv1alpha1.EngineType,
+	c *BackupCopy,
+) error {
+	_, key, err := splitBackupDestination(pointer.GetString(backup.Status.Destination))
+	if err != nil {
+		return err
+	}
+	source, err := e.kubeClient.GetBackupStorage(ctx, backup.Spec.BackupStorageName)
+	if err != nil {
+		return fmt.Errorf("could not get the backup storage of the backup: %w", err)
+	}
+	target, err := e.kubeClient.GetBackupStorage(ctx, c.BackupStorageName)
+	if err != nil {
+		return fmt.Errorf("could not get the backup storage of the copy: %w", err)
+	}
+	sourceClient, err := e.newStorageClient(ctx, source)
+	if err != nil {
+		return fmt.Errorf("could not access the backup storage %s: %w", source.Name, err)
+	}
+	targetClient, err := e.newStorageClient(ctx, target)
+	if err != nil {
+		return fmt.Errorf("could not access the backup storage %s: %w", target.Name, err)
+	}
+
+	prefix := backupClusterPrefix(key)
+	objects, err := sourceClient.list(ctx, prefix)
+	if err != nil {
+		return fmt.Errorf("could not list the objects of the backup: %w", err)
+	}
+	objects = backupCopyObjects(engineType, key, objects)
+	if len(objects) == 0 {
+		return fmt.Errorf("no objects of the backup found in the backup storage %s", source.Name)
+	}
+	stored, err := targetClient.list(ctx, prefix)
+	if err != nil {
+		return fmt.Errorf("could not list the objects of the backup storage %s: %w", target.Name, err)
+	}
+	if err := copyStorageObjects(ctx, sourceClient, targetClient, objects, stored, c); err != nil {
+		return err
+	}
+
+	copied, err := e.registerBackupCopy(ctx, backup, engineType, target, key)
+	if err != nil {
+		return fmt.Errorf("could not register the copy: %w", err)
+	}
+	c.DatabaseClusterBackupName = pointer.ToString(copied.Name)
+	c.Destination = copied.Status.Destination
+	return nil
+}
+
+// copyStorageObjects copies the objects which are not stored in the target yet and counts them in the copy.
+func copyStorageObjects(ctx context.Context, source, target storageClient, objects, stored []storageObject, c *BackupCopy) error {
+	sizes := make(map[string]int64, len(stored))
+	for _, o := range stored {
+		sizes[o.key] = o.size
+	}
+	c.ObjectsCopied = pointer.ToInt64(0)
+	c.BytesCopied = pointer.ToInt64(0)
+	for _, o := range objects {
+		if size, ok := sizes[o.key]; ok && size == o.size {
+			continue
+		}
+		if err := copyStorageObject(ctx, source, target, o.key); err != nil {
+			return fmt.Errorf("could not copy %s: %w", o.key, err)
+		}
+		*c.ObjectsCopied++
+		*c.BytesCopied += o.size
+	}
+	return nil
+}
+
+func copyStorageObject(ctx context.Context, source, target storageClient, key string) error {
+	body, err := source.read(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer body.Close() //nolint:errcheck
+	return target.write(ctx, key, body)
+}
+
+// registerBackupCopy creates the DatabaseClusterBackup of the copy and sets its status so the copy can be restored.
+// The DatabaseClusterBackup of an earlier copy is reused.
+func (e *EverestServer) registerBackupCopy(
+	ctx context.Context,
+	backup *everestv1alpha1.DatabaseClusterBackup,
+	engineType everestv1alpha1.EngineType,
+	storage *everestv1alpha1.BackupStorage,
+	key string,
+) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	engineVersion, err := e.backupEngineVersion(ctx, backup)
+	if err != nil {
+		return nil, err
+	}
+	copied := copiedBackup(backup, engineType, engineVersion, storage, key)
+	status := copied.Status
+	created, err := e.kubeClient.CreateDatabaseClusterBackup(ctx, backup.Namespace, copied)
+	if err != nil {
+		if !k8serrors.IsAlreadyExists(err) {
+			return nil, err
+		}
+		if created, err = e.kubeClient.GetDatabaseClusterBackup(ctx, backup.Namespace, copied.Name); err != nil {
+			return nil, err
+		}
+	}
+	created.Status = status
+	return e.kubeClient.UpdateDatabaseClusterBackupStatus(ctx, created)
+}
+
+// copiedBackup returns the DatabaseClusterBackup of the copy of the backup stored under the key in the backup storage.
+// The engine version is recorded on the copy unless it is unknown.
+func copiedBackup(
+	backup *everestv1alpha1.DatabaseClusterBackup,
+	engineType everestv1alpha1.EngineType,
+	engineVersion string,
+	storage *everestv1alpha1.BackupStorage,
+	key string,
+) *everestv1alpha1.DatabaseClusterBackup {
+	sum := sha256.Sum256([]byte(backup.Namespace + "/" + backup.Name + "/" + storage.Name))
+	status := everestv1alpha1.DatabaseClusterBackupStatus{
+		CreatedAt:   backup.Status.CreatedAt,
+		CompletedAt: backup.Status.CompletedAt,
+		State:       successState(engineType),
+		Destination: pointer.ToString(storageDestination(storage, key)),
+	}
+	if status.CreatedAt == nil {
+		status.CreatedAt = &backup.CreationTimestamp
+	}
+	copied := &everestv1alpha1.DatabaseClusterBackup{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: databaseClusterAPIVersion,
+			Kind:       "DatabaseClusterBackup",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-copy-%x", backupClusterName(backup), sum[:4]),
+			Namespace: backup.Namespace,
+			Labels: map[string]string{
+				"clusterName": backupClusterName(backup),
+				fmt.Sprintf(backupStorageLabelTmpl, storage.Name): "used",
+			},
+			Annotations: map[string]string{
+				copiedFromAnnotation:       backup.Namespace + "/" + backup.Name,
+				backupEngineTypeAnnotation: string(engineType),
+			},
+		},
+		Spec: everestv1alpha1.DatabaseClusterBackupSpec{
+			DBClusterName:     backupClusterName(backup) + copiedBackupClusterSuffix,
+			BackupStorageName: storage.Name,
+		},
+		Status: status,
+	}
+	if engineVersion != "" {
+		copied.Annotations[backupEngineVersionAnnotation] = engineVersion
+	}
+	return copied
+}
+
+// deleteBackupCopyObjects deletes the objects of the copy of the backup from its backup storage.
+func (e *EverestServer) deleteBackupCopyObjects(
+	ctx context.Context,
+	backup *everestv1alpha1.DatabaseClusterBackup,
+	engineType everestv1alpha1.EngineType,
+) error {
+	_, key, err := splitBackupDestination(pointer.GetString(backup.Status.Destination))
+	if err != nil {
+		return err
+	}
+	storage, err := e.kubeClient.GetBackupStorage(ctx, backup.Spec.BackupStorageName)
+	if err != nil {
+		return err
+	}
+	client, err := e.newStorageClient(ctx, storage)
+	if err != nil {
+		return err
+	}
+	return deleteBackupCopyObjectsFrom(ctx, client, engineType, key)
+}
+
+// deleteBackupCopyObjectsFrom deletes the objects of the copied backup with the key.
+// The copies of pgBackRest backups share the repository of the database cluster, so their objects are kept.
+func deleteBackupCopyObjectsFrom(ctx context.Context, client storageClient, engineType everestv1alpha1.EngineType, key string) error {
+	if engineType == everestv1alpha1.DatabaseEnginePostgresql {
+		return nil
+	}
+	objects, err := client.list(ctx, key)
+	if err != nil {
+		return err
+	}
+	for _, o := range backupCopyObjects(engineType, key, objects) {
+		if err := client.delete(ctx, o.key); err != nil {
+			return fmt.Errorf("could not delete %s: %w", o.key, err)
+		}
+	}
+	return nil
+}
+
+// splitBackupDestination returns the bucket and the key of the backup destination.
+func splitBackupDestination(destination string) (string, string, error) {
+	rest, ok := strings.CutPrefix(destination, "s3://")
+	if !ok {
+		rest, ok = strings.CutPrefix(destination, "azure://")
+	}
+	if !ok {
+		return "", "", fmt.Errorf("%w: %s", errInvalidBackupDestination, destination)
+	}
+	bucket, key, _ := strings.Cut(rest, "/")
+	key = strings.TrimSuffix(key, "/")
+	if bucket == "" || strings.Count(key, "/") < 2 {
+		return "", "", fmt.Errorf("%w: %s", errInvalidBackupDestination, destination)
+	}
+	return bucket, key, nil
+}
+
+// storageDestination returns the destination of the key in the bucket of the backup storage.
+func storageDestination(storage *everestv1alpha1.BackupStorage, key string) string {
+	scheme := "s3://"
+	if storage.Spec.Type == everestv1alpha1.BackupStorageTypeAzure {
+		scheme = "azure://"
+	}
+	return scheme + storage.Spec.Bucket + "/" + key
+}
+
+// backupClusterPrefix returns the <name>/<uid>/ prefix of the database cluster the backup key is stored under.
+func backupClusterPrefix(key string) string {
+	parts := strings.SplitN(key, "/", 3)
+	return parts[0] + "/" + parts[1] + "/"
+}
+
+// backupCopyObjects returns the objects which make up the backup with the key.
+// pgBackRest backups may depend on earlier ones and need the WAL to be consistent,
+// so the whole repository of the database cluster is copied for them.
+func backupCopyObjects(engineType everestv1alpha1.EngineType, key string, objects []storageObject) []storageObject {
+	prefixes := []string{key + "/", key + "."}
+	if engineType == everestv1alpha1.DatabaseEnginePostgresql {
+		prefix := backupClusterPrefix(key)
+		prefixes = []string{prefix + pgBackRestBackupDir + "/", prefix + pgBackRestArchiveDir + "/"}
+	}
+	result := make([]storageObject, 0, len(objects))
+	for _, o := range objects {
+		if slices.ContainsFunc(prefixes, func(p string) bool { return strings.HasPrefix(o.key, p) }) {
+			result = append(result, o)
+		}
+	}
+	return result
+}
+
+// backupClusterName returns the name of the database cluster the backup or its original is taken of.
+func backupClusterName(backup *everestv1alpha1.DatabaseClusterBackup) string {
+	name := strings.TrimSuffix(backup.Spec.DBClusterName, copiedBackupClusterSuffix)
+	return strings.TrimSuffix(name, importedBackupClusterSuffix)
+}
+
+// backupEngineType returns the engine type recorded on the backup, or an empty string if it is unknown.
+func backupEngineType(backup *everestv1alpha1.DatabaseClusterBackup) everestv1alpha1.EngineType {
+	return everestv1alpha1.EngineType(backup.Annotations[backupEngineTypeAnnotation])
+}
+
+// backupEngineVersion returns the engine version recorded on the backup or, if it has none, the one of its
+// database cluster. It returns an empty string if the version is unknown.
+func (e *EverestServer) backupEngineVersion(ctx context.Context, backup *everestv1alpha1.DatabaseClusterBackup) (string, error) {
+	if v := backup.Annotations[backupEngineVersionAnnotation]; v != "" {
+		return v, nil
+	}
+	db, err := e.kubeClient.GetDatabaseCluster(ctx, backup.Namespace, backupClusterName(backup))
+	if k8serrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return db.Spec.Engine.Version, nil
+}
+
+// backupSource returns the source restoring the backup from its backup storage.
+// The operator expects the key of the backup for PXC and the full destination for the other engines.
+func backupSource(engineType everestv1alpha1.EngineType, backup *everestv1alpha1.DatabaseClusterBackup) *everestv1alpha1.BackupSource {
+	path := pointer.GetString(backup.Status.Destination)
+	if engineType == everestv1alpha1.DatabaseEnginePXC {
+		if _, key, err := splitBackupDestination(path); err == nil {
+			path = key
+		}
+	}
+	return &everestv1alpha1.BackupSource{
+		Path:              path,
+		BackupStorageName: backup.Spec.BackupStorageName,
+	}
+}
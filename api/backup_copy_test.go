@@ -0,0 +1,201 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// memoryStorageClient is a storageClient keeping the objects in memory.
+type memoryStorageClient struct {
+	objects map[string][]byte
+}
+
+func (c *memoryStorageClient) list(_ context.Context, prefix string) ([]storageObject, error) {
+	var result []storageObject
+	for key, data := range c.objects {
+		if strings.HasPrefix(key, prefix) {
+			result = append(result, storageObject{key: key, size: int64(len(data))})
+		}
+	}
+	return result, nil
+}
+
+func (c *memoryStorageClient) read(_ context.Context, key string) (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(c.objects[key])), nil
+}
+
+func (c *memoryStorageClient) write(_ context.Context, key string, body io.Reader) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	c.objects[key] = data
+	return nil
+}
+
+func (c *memoryStorageClient) delete(_ context.Context, key string) error {
+	delete(c.objects, key)
+	return nil
+}
+
+func TestSplitBackupDestination(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		destination string
+		bucket      string
+		key         string
+		err         bool
+	}{
+		{destination: "s3://bucket/db/uid/db-backup", bucket: "bucket", key: "db/uid/db-backup"},
+		{destination: "azure://container/db/uid/2024-03-20T12:00:00Z/", bucket: "container", key: "db/uid/2024-03-20T12:00:00Z"},
+		{destination: "s3://bucket/db/uid/backup/db/20240320-120000F", bucket: "bucket", key: "db/uid/backup/db/20240320-120000F"},
+		{destination: "gs://bucket/db/uid/db-backup", err: true},
+		{destination: "s3://bucket/db-backup", err: true},
+		{destination: "", err: true},
+	}
+	for _, tc := range cases {
+		bucket, key, err := splitBackupDestination(tc.destination)
+		if tc.err {
+			assert.ErrorIs(t, err, errInvalidBackupDestination, tc.destination)
+			continue
+		}
+		require.NoError(t, err, tc.destination)
+		assert.Equal(t, tc.bucket, bucket)
+		assert.Equal(t, tc.key, key)
+	}
+}
+
+func TestBackupCopyObjects(t *testing.T) {
+	t.Parallel()
+	objects := []storageObject{
+		{key: "db/uid/db-backup/xtrabackup.stream"},
+		{key: "db/uid/db-backup.sst_info/sst_info.00000000000000000000"},
+		{key: "db/uid/db-backup-2/xtrabackup.stream"},
+		{key: "db/uid/pitr/binlog_1710936000_0123"},
+		{key: "db/uid/backup/db/20240320-120000F/backup.manifest"},
+		{key: "db/uid/backup/db/backup.info"},
+		{key: "db/uid/archive/db/16-1/000000010000000000000001.gz"},
+		{key: "other/uid/backup/db/backup.info"},
+	}
+	keys := func(objects []storageObject) []string {
+		result := make([]string, 0, len(objects))
+		for _, o := range objects {
+			result = append(result, o.key)
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{
+		"db/uid/db-backup/xtrabackup.stream",
+		"db/uid/db-backup.sst_info/sst_info.00000000000000000000",
+	}, keys(backupCopyObjects(everestv1alpha1.DatabaseEnginePXC, "db/uid/db-backup", objects)))
+	assert.Equal(t, []string{
+		"db/uid/backup/db/20240320-120000F/backup.manifest",
+		"db/uid/backup/db/backup.info",
+		"db/uid/archive/db/16-1/000000010000000000000001.gz",
+	}, keys(backupCopyObjects(everestv1alpha1.DatabaseEnginePostgresql, "db/uid/backup/db/20240320-120000F", objects)))
+}
+
+func TestCopyStorageObjects(t *testing.T) {
+	t.Parallel()
+	source := &memoryStorageClient{objects: map[string][]byte{
+		"db/uid/db-backup/full":  []byte("full backup"),
+		"db/uid/db-backup/delta": []byte("delta"),
+		"db/uid/db-backup.json":  []byte("{}"),
+	}}
+	target := &memoryStorageClient{objects: map[string][]byte{
+		"db/uid/db-backup/full":  []byte("full backup"),
+		"db/uid/db-backup/delta": []byte("old"),
+	}}
+	objects, err := source.list(context.Background(), "db/uid/")
+	require.NoError(t, err)
+	stored, err := target.list(context.Background(), "db/uid/")
+	require.NoError(t, err)
+
+	c := &BackupCopy{}
+	require.NoError(t, copyStorageObjects(context.Background(), source, target, objects, stored, c))
+	assert.Equal(t, source.objects, target.objects)
+	assert.Equal(t, pointer.ToInt64(2), c.ObjectsCopied)
+	assert.Equal(t, pointer.ToInt64(7), c.BytesCopied)
+}
+
+func TestCopiedBackup(t *testing.T) {
+	t.Parallel()
+	createdAt := metav1.NewTime(time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC))
+	completedAt := metav1.NewTime(createdAt.Add(10 * time.Minute))
+	backup := &everestv1alpha1.DatabaseClusterBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-backup", Namespace: "prod"},
+		Spec:       everestv1alpha1.DatabaseClusterBackupSpec{DBClusterName: "db", BackupStorageName: "s3"},
+		Status: everestv1alpha1.DatabaseClusterBackupStatus{
+			CreatedAt:   &createdAt,
+			CompletedAt: &completedAt,
+			State:       "Succeeded",
+			Destination: pointer.ToString("s3://bucket/db/uid/db-backup"),
+		},
+	}
+	storage := &everestv1alpha1.BackupStorage{
+		ObjectMeta: metav1.ObjectMeta{Name: "azure-dr"},
+		Spec:       everestv1alpha1.BackupStorageSpec{Type: everestv1alpha1.BackupStorageTypeAzure, Bucket: "container"},
+	}
+
+	copied := copiedBackup(backup, everestv1alpha1.DatabaseEnginePXC, "8.0.35-27", storage, "db/uid/db-backup")
+	assert.True(t, strings.HasPrefix(copied.Name, "db-copy-"))
+	assert.Equal(t, copied.Name, copiedBackup(backup, everestv1alpha1.DatabaseEnginePXC, "", storage, "db/uid/db-backup").Name)
+	assert.Equal(t, "prod", copied.Namespace)
+	assert.Equal(t, map[string]string{"clusterName": "db", "backupStorage-azure-dr": "used"}, copied.Labels)
+	assert.Equal(t, "prod/db-backup", copied.Annotations[copiedFromAnnotation])
+	assert.Equal(t, everestv1alpha1.DatabaseEnginePXC, backupEngineType(copied))
+	assert.Equal(t, "8.0.35-27", copied.Annotations[backupEngineVersionAnnotation])
+	assert.Equal(t, everestv1alpha1.DatabaseClusterBackupSpec{DBClusterName: "db.copy", BackupStorageName: "azure-dr"}, copied.Spec)
+	assert.Equal(t, "db", backupClusterName(copied))
+	assert.Equal(t, everestv1alpha1.DatabaseClusterBackupStatus{
+		CreatedAt:   &createdAt,
+		CompletedAt: &completedAt,
+		State:       successState(everestv1alpha1.DatabaseEnginePXC),
+		Destination: pointer.ToString("azure://container/db/uid/db-backup"),
+	}, copied.Status)
+
+	assert.Equal(t, &everestv1alpha1.BackupSource{Path: "db/uid/db-backup", BackupStorageName: "azure-dr"},
+		backupSource(everestv1alpha1.DatabaseEnginePXC, copied))
+	assert.Equal(t, &everestv1alpha1.BackupSource{Path: "azure://container/db/uid/db-backup", BackupStorageName: "azure-dr"},
+		backupSource(everestv1alpha1.DatabaseEnginePSMDB, copied))
+}
+
+func TestDeleteBackupCopyObjectsFrom(t *testing.T) {
+	t.Parallel()
+	client := &memoryStorageClient{objects: map[string][]byte{
+		"db/uid/db-backup/xtrabackup.stream":   []byte("data"),
+		"db/uid/db-backup.sst_info":            []byte("info"),
+		"db/uid/db-backup-2/xtrabackup.stream": []byte("other"),
+	}}
+	require.NoError(t, deleteBackupCopyObjectsFrom(context.Background(), client, everestv1alpha1.DatabaseEnginePXC, "db/uid/db-backup"))
+	assert.Equal(t, map[string][]byte{"db/uid/db-backup-2/xtrabackup.stream": []byte("other")}, client.objects)
+
+	client.objects["db/uid/backup/db/20240320-120000F/backup.manifest"] = []byte("manifest")
+	require.NoError(t, deleteBackupCopyObjectsFrom(context.Background(), client, everestv1alpha1.DatabaseEnginePostgresql, "db/uid/backup/db/20240320-120000F"))
+	assert.Len(t, client.objects, 2)
+}
@@ -0,0 +1,306 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// backupReplicationConfigMapName is the name of the config map in the Everest namespace
+	// which stores the backup replication policies of database clusters.
+	backupReplicationConfigMapName = "everest-backup-replication"
+	backupReplicationInterval      = 5 * time.Minute
+	// backupReplicationRetryAfter is the time after which a failed copy is retried.
+	backupReplicationRetryAfter = time.Hour
+	// backupReplicationConcurrency is the maximum number of backup copies made by the job at the same time.
+	backupReplicationConcurrency = 4
+)
+
+var errBackupReplicationNoStorages = errors.New("at least one backup storage should be set when the backup replication policy is enabled")
+
+// GetDatabaseClusterBackupReplication returns the backup replication policy of the specified database cluster.
+func (e *EverestServer) GetDatabaseClusterBackupReplication(ctx echo.Context, namespace, name string) error {
+	if _, err := e.kubeClient.GetDatabaseCluster(ctx.Request().Context(), namespace, name); err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	policy, err := e.backupReplicationPolicy(ctx.Request().Context(), namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the backup replication policy")})
+	}
+	if policy == nil {
+		policy = &BackupReplicationPolicy{BackupStorageNames: []string{}}
+	}
+
+	return ctx.JSON(http.StatusOK, policy)
+}
+
+// UpdateDatabaseClusterBackupReplication sets the backup replication policy of the specified database cluster.
+func (e *EverestServer) UpdateDatabaseClusterBackupReplication(ctx echo.Context, namespace, name string) error {
+	policy := &BackupReplicationPolicy{}
+	if err := e.getBodyFromContext(ctx, policy); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get BackupReplicationPolicy from the request body"),
+		})
+	}
+	if err := validateBackupReplicationPolicy(policy); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	reqCtx := ctx.Request().Context()
+	if _, err := e.kubeClient.GetDatabaseCluster(reqCtx, namespace, name); err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+	for _, storageName := range policy.BackupStorageNames {
+		if err := e.checkBackupStorageAllowed(reqCtx, storageName, namespace); err != nil {
+			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		}
+	}
+
+	previous, err := e.backupReplicationPolicy(reqCtx, namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the backup replication policy")})
+	}
+	setBackupReplicationEnabledAt(policy, previous, time.Now())
+	data, err := json.Marshal(policy)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the backup replication policy")})
+	}
+	if err := e.kubeClient.SetConfigMapEntry(reqCtx, backupReplicationConfigMapName, dbClusterEntryKey(namespace, name), string(data)); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the backup replication policy")})
+	}
+
+	return ctx.JSON(http.StatusOK, policy)
+}
+
+// DeleteDatabaseClusterBackupReplication deletes the backup replication policy of the specified database cluster.
+func (e *EverestServer) DeleteDatabaseClusterBackupReplication(ctx echo.Context, namespace, name string) error {
+	err := e.kubeClient.DeleteConfigMapEntry(ctx.Request().Context(), backupReplicationConfigMapName, dbClusterEntryKey(namespace, name))
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete the backup replication policy")})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// backupReplicationPolicy returns the backup replication policy of the database cluster or nil if it has none.
+func (e *EverestServer) backupReplicationPolicy(ctx context.Context, namespace, name string) (*BackupReplicationPolicy, error) {
+	data, err := e.kubeClient.GetConfigMapData(ctx, backupReplicationConfigMapName)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := data[dbClusterEntryKey(namespace, name)]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+	policy := &BackupReplicationPolicy{}
+	if err := json.Unmarshal([]byte(v), policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// validateBackupReplicationPolicy checks the policy and removes the duplicate backup storages.
+func validateBackupReplicationPolicy(policy *BackupReplicationPolicy) error {
+	slices.Sort(policy.BackupStorageNames)
+	policy.BackupStorageNames = slices.Compact(policy.BackupStorageNames)
+	if policy.BackupStorageNames == nil {
+		policy.BackupStorageNames = []string{}
+	}
+	if policy.Enabled && len(policy.BackupStorageNames) == 0 {
+		return errBackupReplicationNoStorages
+	}
+	return nil
+}
+
+// setBackupReplicationEnabledAt keeps the time the policy was enabled at, so the backups
+// completed before are not copied.
+func setBackupReplicationEnabledAt(policy, previous *BackupReplicationPolicy, now time.Time) {
+	switch {
+	case !policy.Enabled:
+		policy.EnabledAt = nil
+	case previous != nil && previous.Enabled && previous.EnabledAt != nil:
+		policy.EnabledAt = previous.EnabledAt
+	default:
+		policy.EnabledAt = pointer.ToTime(now.UTC())
+	}
+}
+
+// RunBackupReplicationJob runs background job copying the new backups according to the backup replication policies.
+func (e *EverestServer) RunBackupReplicationJob(ctx context.Context) {
+	e.l.Debug("Starting backup replication job.")
+
+	ticker := time.NewTicker(backupReplicationInterval)
+	defer ticker.Stop()
+
+	// The copies run in the background, so a long copy does not delay the others.
+	workers := make(chan struct{}, backupReplicationConcurrency)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.replicateBackups(ctx, workers)
+		}
+	}
+}
+
+func (e *EverestServer) replicateBackups(ctx context.Context, workers chan struct{}) {
+	data, err := e.kubeClient.GetConfigMapData(ctx, backupReplicationConfigMapName)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("failed to get backup replication policies")))
+		return
+	}
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		namespace, name, ok := parseDBClusterEntryKey(key)
+		if !ok {
+			continue
+		}
+		policy := &BackupReplicationPolicy{}
+		if err := json.Unmarshal([]byte(data[key]), policy); err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("failed to parse backup replication policy of %s/%s", namespace, name)))
+			continue
+		}
+		if !policy.Enabled {
+			continue
+		}
+		if err := e.replicateDatabaseClusterBackups(ctx, namespace, name, policy, workers); err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("failed to replicate backups of %s/%s", namespace, name)))
+		}
+	}
+}
+
+// replicateDatabaseClusterBackups starts the copies of the backups of the database cluster to the backup storages
+// of the policy. The copies take a worker each, the ones left without a worker are started by the next runs.
+func (e *EverestServer) replicateDatabaseClusterBackups(
+	ctx context.Context,
+	namespace, name string,
+	policy *BackupReplicationPolicy,
+	workers chan struct{},
+) error {
+	db, err := e.kubeClient.GetDatabaseCluster(ctx, namespace, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	backups, err := e.kubeClient.ListDatabaseClusterBackups(ctx, namespace, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("clusterName=%s", name),
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := range backups.Items {
+		backup := &backups.Items[i]
+		targets, err := backupReplicationTargets(policy, backup, db.Spec.Engine.Type, time.Now())
+		if err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("failed to get backup %s/%s copies", namespace, backup.Name)))
+			continue
+		}
+		for _, storageName := range targets {
+			select {
+			case workers <- struct{}{}:
+			default:
+				return nil
+			}
+			c, engineType, err := e.startBackupCopy(ctx, backup, storageName, time.Now())
+			if err != nil {
+				<-workers
+				if !errors.Is(err, errBackupCopyRunning) && !errors.Is(err, errBackupCopyExists) {
+					e.l.Error(errors.Join(err, fmt.Errorf("failed to start backup %s/%s copy to %s", namespace, backup.Name, storageName)))
+				}
+				continue
+			}
+			go func() {
+				defer func() { <-workers }()
+				cCtx, cancel := context.WithTimeout(ctx, backupCopyTimeout)
+				defer cancel()
+				e.runBackupCopy(cCtx, backup, engineType, c)
+			}()
+		}
+	}
+	return nil
+}
+
+// backupReplicationTargets returns the backup storages the backup should be copied to by the policy.
+// Only the successful backups completed since the policy is enabled are copied.
+// A failed copy is retried after a while.
+func backupReplicationTargets(
+	policy *BackupReplicationPolicy,
+	backup *everestv1alpha1.DatabaseClusterBackup,
+	engineType everestv1alpha1.EngineType,
+	now time.Time,
+) ([]string, error) {
+	if _, ok := backup.Annotations[copiedFromAnnotation]; ok {
+		return nil, nil
+	}
+	if !successStatus(backup.Status.State, engineType) || backup.Status.CompletedAt == nil ||
+		policy.EnabledAt == nil || backup.Status.CompletedAt.Time.Before(*policy.EnabledAt) {
+		return nil, nil
+	}
+	copies, err := backupCopies(backup)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []string
+	for _, storageName := range policy.BackupStorageNames {
+		if storageName == backup.Spec.BackupStorageName {
+			continue
+		}
+		c, ok := copies[storageName]
+		if ok && (c.State != backupCopyStateFailed || c.FinishedAt == nil || now.Before(c.FinishedAt.Add(backupReplicationRetryAfter))) {
+			continue
+		}
+		targets = append(targets, storageName)
+	}
+	return targets, nil
+}
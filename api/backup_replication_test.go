@@ -0,0 +1,130 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateBackupReplicationPolicy(t *testing.T) {
+	t.Parallel()
+	policy := &BackupReplicationPolicy{Enabled: true, BackupStorageNames: []string{"minio", "azure", "minio"}}
+	require.NoError(t, validateBackupReplicationPolicy(policy))
+	assert.Equal(t, []string{"azure", "minio"}, policy.BackupStorageNames)
+
+	require.ErrorIs(t, validateBackupReplicationPolicy(&BackupReplicationPolicy{Enabled: true}), errBackupReplicationNoStorages)
+	policy = &BackupReplicationPolicy{}
+	require.NoError(t, validateBackupReplicationPolicy(policy))
+	assert.Equal(t, []string{}, policy.BackupStorageNames)
+}
+
+func TestSetBackupReplicationEnabledAt(t *testing.T) {
+	t.Parallel()
+	enabledAt := time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+	now := enabledAt.Add(24 * time.Hour)
+
+	policy := &BackupReplicationPolicy{Enabled: true}
+	setBackupReplicationEnabledAt(policy, nil, now)
+	assert.Equal(t, pointer.ToTime(now), policy.EnabledAt)
+
+	policy = &BackupReplicationPolicy{Enabled: true}
+	setBackupReplicationEnabledAt(policy, &BackupReplicationPolicy{Enabled: true, EnabledAt: &enabledAt}, now)
+	assert.Equal(t, &enabledAt, policy.EnabledAt)
+
+	policy = &BackupReplicationPolicy{Enabled: true}
+	setBackupReplicationEnabledAt(policy, &BackupReplicationPolicy{EnabledAt: &enabledAt}, now)
+	assert.Equal(t, pointer.ToTime(now), policy.EnabledAt)
+
+	policy = &BackupReplicationPolicy{EnabledAt: &enabledAt}
+	setBackupReplicationEnabledAt(policy, nil, now)
+	assert.Nil(t, policy.EnabledAt)
+}
+
+func TestBackupReplicationTargets(t *testing.T) {
+	t.Parallel()
+	enabledAt := time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+	now := enabledAt.Add(24 * time.Hour)
+	policy := &BackupReplicationPolicy{Enabled: true, BackupStorageNames: []string{"azure", "minio", "s3"}, EnabledAt: &enabledAt}
+
+	backup := func(state string, completedAt time.Time, copies map[string]BackupCopy) *everestv1alpha1.DatabaseClusterBackup {
+		b := &everestv1alpha1.DatabaseClusterBackup{
+			Spec: everestv1alpha1.DatabaseClusterBackupSpec{DBClusterName: "db", BackupStorageName: "s3"},
+			Status: everestv1alpha1.DatabaseClusterBackupStatus{
+				State:       everestv1alpha1.BackupState(state),
+				CompletedAt: &metav1.Time{Time: completedAt},
+			},
+		}
+		if copies != nil {
+			data, err := json.Marshal(copies)
+			require.NoError(t, err)
+			b.Annotations = map[string]string{backupCopiesAnnotation: string(data)}
+		}
+		return b
+	}
+
+	cases := []struct {
+		name    string
+		backup  *everestv1alpha1.DatabaseClusterBackup
+		targets []string
+	}{
+		{
+			name:    "new backup",
+			backup:  backup("Succeeded", now.Add(-time.Hour), nil),
+			targets: []string{"azure", "minio"},
+		},
+		{
+			name:   "completed before the policy is enabled",
+			backup: backup("Succeeded", enabledAt.Add(-time.Hour), nil),
+		},
+		{
+			name:   "failed backup",
+			backup: backup("Failed", now.Add(-time.Hour), nil),
+		},
+		{
+			name: "copied and recently failed copies",
+			backup: backup("Succeeded", now.Add(-time.Hour), map[string]BackupCopy{
+				"azure": {State: backupCopyStateSucceeded},
+				"minio": {State: backupCopyStateFailed, FinishedAt: pointer.ToTime(now.Add(-time.Minute))},
+			}),
+		},
+		{
+			name: "failed copy retried",
+			backup: backup("Succeeded", now.Add(-time.Hour), map[string]BackupCopy{
+				"azure": {State: backupCopyStateRunning},
+				"minio": {State: backupCopyStateFailed, FinishedAt: pointer.ToTime(now.Add(-2 * time.Hour))},
+			}),
+			targets: []string{"minio"},
+		},
+	}
+	for _, tc := range cases {
+		targets, err := backupReplicationTargets(policy, tc.backup, everestv1alpha1.DatabaseEnginePXC, now)
+		require.NoError(t, err, tc.name)
+		assert.Equal(t, tc.targets, targets, tc.name)
+	}
+
+	copied := backup("Succeeded", now.Add(-time.Hour), nil)
+	copied.Annotations = map[string]string{copiedFromAnnotation: "prod/db-backup"}
+	targets, err := backupReplicationTargets(policy, copied, everestv1alpha1.DatabaseEnginePXC, now)
+	require.NoError(t, err)
+	assert.Empty(t, targets)
+}
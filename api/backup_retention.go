@@ -0,0 +1,541 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// backupRetentionConfigMapName is the name of the config map in the Everest namespace
+	// which stores the backup retention policies of database clusters and the defaults of namespaces.
+	backupRetentionConfigMapName = "everest-backup-retention"
+	backupRetentionInterval      = time.Hour
+)
+
+var errBackupRetentionNoRules = errors.New("at least one of 'keepLast', 'keepDays', 'keepDaily', 'keepWeekly' or 'keepMonthly' should be set when the backup retention policy is enabled")
+
+// GetDatabaseClusterBackupRetention returns the backup retention policy in effect for the specified database cluster.
+func (e *EverestServer) GetDatabaseClusterBackupRetention(ctx echo.Context, namespace, name string) error {
+	if _, err := e.kubeClient.GetDatabaseCluster(ctx.Request().Context(), namespace, name); err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	retention, err := e.databaseClusterBackupRetention(ctx.Request().Context(), namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the backup retention policy")})
+	}
+
+	return ctx.JSON(http.StatusOK, retention)
+}
+
+// UpdateDatabaseClusterBackupRetention sets the backup retention policy of the specified database cluster.
+func (e *EverestServer) UpdateDatabaseClusterBackupRetention(ctx echo.Context, namespace, name string) error {
+	policy := &BackupRetentionPolicy{}
+	if err := e.getBodyFromContext(ctx, policy); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get BackupRetentionPolicy from the request body"),
+		})
+	}
+	if err := validateBackupRetentionPolicy(policy); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	if _, err := e.kubeClient.GetDatabaseCluster(ctx.Request().Context(), namespace, name); err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	if err := e.saveBackupRetentionPolicy(ctx.Request().Context(), dbClusterEntryKey(namespace, name), policy); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the backup retention policy")})
+	}
+
+	return ctx.JSON(http.StatusOK, DatabaseClusterBackupRetention{Source: Cluster, Policy: *policy})
+}
+
+// DeleteDatabaseClusterBackupRetention deletes the backup retention policy of the specified database cluster.
+func (e *EverestServer) DeleteDatabaseClusterBackupRetention(ctx echo.Context, namespace, name string) error {
+	err := e.kubeClient.DeleteConfigMapEntry(ctx.Request().Context(), backupRetentionConfigMapName, dbClusterEntryKey(namespace, name))
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete the backup retention policy")})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// PreviewDatabaseClusterBackupRetention shows which backups of the specified database cluster
+// the backup retention policy in effect deletes.
+func (e *EverestServer) PreviewDatabaseClusterBackupRetention(ctx echo.Context, namespace, name string) error {
+	db, err := e.kubeClient.GetDatabaseCluster(ctx.Request().Context(), namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	retention, err := e.databaseClusterBackupRetention(ctx.Request().Context(), namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the backup retention policy")})
+	}
+
+	decisions, err := e.backupRetentionDecisions(ctx.Request().Context(), db, &retention.Policy, time.Now())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not evaluate the backup retention policy")})
+	}
+
+	return ctx.JSON(http.StatusOK, BackupRetentionPreview{
+		Source:  retention.Source,
+		Policy:  retention.Policy,
+		Backups: decisions,
+	})
+}
+
+// GetNamespaceBackupRetention returns the default backup retention policy of the specified namespace.
+func (e *EverestServer) GetNamespaceBackupRetention(ctx echo.Context, namespace string) error {
+	data, err := e.kubeClient.GetConfigMapData(ctx.Request().Context(), backupRetentionConfigMapName)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the backup retention policy")})
+	}
+
+	policy := &BackupRetentionPolicy{}
+	if v, ok := data[namespace]; ok {
+		if err := json.Unmarshal([]byte(v), policy); err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not parse the backup retention policy")})
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, policy)
+}
+
+// UpdateNamespaceBackupRetention sets the default backup retention policy of the specified namespace.
+func (e *EverestServer) UpdateNamespaceBackupRetention(ctx echo.Context, namespace string) error {
+	policy := &BackupRetentionPolicy{}
+	if err := e.getBodyFromContext(ctx, policy); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get BackupRetentionPolicy from the request body"),
+		})
+	}
+	if err := validateBackupRetentionPolicy(policy); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	namespaces, err := e.kubeClient.GetDBNamespaces(ctx.Request().Context(), e.kubeClient.Namespace())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Failed getting watched namespaces"),
+		})
+	}
+	if err := validateAllowedNamespaces([]string{namespace}, namespaces); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	if err := e.saveBackupRetentionPolicy(ctx.Request().Context(), namespace, policy); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the backup retention policy")})
+	}
+
+	return ctx.JSON(http.StatusOK, policy)
+}
+
+// DeleteNamespaceBackupRetention deletes the default backup retention policy of the specified namespace.
+func (e *EverestServer) DeleteNamespaceBackupRetention(ctx echo.Context, namespace string) error {
+	if err := e.kubeClient.DeleteConfigMapEntry(ctx.Request().Context(), backupRetentionConfigMapName, namespace); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete the backup retention policy")})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (e *EverestServer) saveBackupRetentionPolicy(ctx context.Context, key string, policy *BackupRetentionPolicy) error {
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	return e.kubeClient.SetConfigMapEntry(ctx, backupRetentionConfigMapName, key, string(data))
+}
+
+// databaseClusterBackupRetention returns the backup retention policy in effect for the database cluster.
+func (e *EverestServer) databaseClusterBackupRetention(
+	ctx context.Context,
+	namespace, name string,
+) (*DatabaseClusterBackupRetention, error) {
+	data, err := e.kubeClient.GetConfigMapData(ctx, backupRetentionConfigMapName)
+	if err != nil {
+		return nil, err
+	}
+	return backupRetentionPolicyFor(data, namespace, name)
+}
+
+// backupRetentionPolicyFor resolves the policy of the database cluster from the stored policies.
+// The policy of the database cluster takes precedence over the default of the namespace.
+func backupRetentionPolicyFor(data map[string]string, namespace, name string) (*DatabaseClusterBackupRetention, error) {
+	for _, c := range []struct {
+		key    string
+		source SettingSource
+	}{
+		{key: dbClusterEntryKey(namespace, name), source: Cluster},
+		{key: namespace, source: Namespace},
+	} {
+		v, ok := data[c.key]
+		if !ok {
+			continue
+		}
+		r := &DatabaseClusterBackupRetention{Source: c.source}
+		if err := json.Unmarshal([]byte(v), &r.Policy); err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+	return &DatabaseClusterBackupRetention{Source: None}, nil
+}
+
+func validateBackupRetentionPolicy(policy *BackupRetentionPolicy) error {
+	if !policy.Enabled {
+		return nil
+	}
+	for _, keep := range []*int{policy.KeepLast, policy.KeepDays, policy.KeepDaily, policy.KeepWeekly, policy.KeepMonthly} {
+		if pointer.GetInt(keep) > 0 {
+			return nil
+		}
+	}
+	return errBackupRetentionNoRules
+}
+
+// backupRetentionDecisions evaluates the policy against the backups of the database cluster.
+func (e *EverestServer) backupRetentionDecisions(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+	policy *BackupRetentionPolicy,
+	now time.Time,
+) ([]BackupRetentionDecision, error) {
+	backups, err := e.kubeClient.ListDatabaseClusterBackups(ctx, db.Namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	restores, err := e.kubeClient.ListDatabaseClusterRestores(ctx, db.Namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return newBackupRetention(db, policy, restores.Items, now).decisions(backups.Items), nil
+}
+
+// backupRetention applies a backup retention policy to the backups of a database cluster.
+type backupRetention struct {
+	policy     *BackupRetentionPolicy
+	cluster    string
+	engineType everestv1alpha1.EngineType
+	pitr       bool
+	// restoring holds the names of the backups used by the running restores.
+	restoring map[string]struct{}
+	now       time.Time
+}
+
+func newBackupRetention(
+	db *everestv1alpha1.DatabaseCluster,
+	policy *BackupRetentionPolicy,
+	restores []everestv1alpha1.DatabaseClusterRestore,
+	now time.Time,
+) backupRetention {
+	r := backupRetention{
+		policy:     policy,
+		cluster:    db.Name,
+		engineType: db.Spec.Engine.Type,
+		pitr:       db.Spec.Backup.Enabled && db.Spec.Backup.PITR.Enabled,
+		restoring:  make(map[string]struct{}),
+		now:        now,
+	}
+	for _, restore := range restores {
+		if restore.Spec.DataSource.DBClusterBackupName == "" || restore.IsComplete(r.engineType) {
+			continue
+		}
+		r.restoring[restore.Spec.DataSource.DBClusterBackupName] = struct{}{}
+	}
+	return r
+}
+
+// decisions returns the decisions about the backups of the database cluster and their copies, the latest first.
+// The copies are kept by the same rules, counted separately in each backup storage, since they outlive the originals.
+// The backups of other database clusters are ignored.
+func (r backupRetention) decisions(backups []everestv1alpha1.DatabaseClusterBackup) []BackupRetentionDecision {
+	own := make([]everestv1alpha1.DatabaseClusterBackup, 0, len(backups))
+	for _, b := range backups {
+		if b.Spec.DBClusterName == r.cluster || b.Spec.DBClusterName == r.cluster+copiedBackupClusterSuffix {
+			own = append(own, b)
+		}
+	}
+	slices.SortStableFunc(own, func(a, b everestv1alpha1.DatabaseClusterBackup) int {
+		return backupCreatedAt(b).Compare(backupCreatedAt(a))
+	})
+
+	// The backups of the database cluster are grouped under the empty name, the copies by their backup storage.
+	groups := make(map[string][]everestv1alpha1.DatabaseClusterBackup)
+	for _, b := range own {
+		group := ""
+		if b.Spec.DBClusterName != r.cluster {
+			group = b.Spec.BackupStorageName
+		}
+		groups[group] = append(groups[group], b)
+	}
+	byName := make(map[string]BackupRetentionDecision, len(own))
+	for group, groupBackups := range groups {
+		for _, d := range r.groupDecisions(groupBackups, group != "") {
+			byName[d.Name] = d
+		}
+	}
+	decisions := make([]BackupRetentionDecision, 0, len(own))
+	for _, b := range own {
+		decisions = append(decisions, byName[b.Name])
+	}
+	return decisions
+}
+
+// groupDecisions returns the decisions about the backups of a group, the latest first.
+// The point-in-time recovery starts from the backups of the database cluster, not from their copies.
+func (r backupRetention) groupDecisions(own []everestv1alpha1.DatabaseClusterBackup, copies bool) []BackupRetentionDecision { //nolint:cyclop
+	var (
+		keepLast    = pointer.GetInt(r.policy.KeepLast)
+		keepDays    = pointer.GetInt(r.policy.KeepDays)
+		successful  int
+		daily       = newRetentionLadder(pointer.GetInt(r.policy.KeepDaily), "day", layoutPeriod("2006-01-02"))
+		weekly      = newRetentionLadder(pointer.GetInt(r.policy.KeepWeekly), "week", isoWeek)
+		monthly     = newRetentionLadder(pointer.GetInt(r.policy.KeepMonthly), "month", layoutPeriod("2006-01"))
+		decisions   = make([]BackupRetentionDecision, 0, len(own))
+		keptDaysAgo = r.now.AddDate(0, 0, -keepDays)
+	)
+	for _, b := range own {
+		createdAt := backupCreatedAt(b)
+		var reasons []string
+		if _, ok := r.restoring[b.Name]; ok {
+			reasons = append(reasons, "used by a running restore")
+		}
+		if b.Annotations[finalBackupAnnotation] == "true" {
+			reasons = append(reasons, "final backup of the database cluster")
+		}
+		if _, ok := b.Annotations[preRestoreBackupForAnnotation]; ok {
+			reasons = append(reasons, "taken before a restore of the database cluster")
+		}
+		if keepDays > 0 && createdAt.After(keptDaysAgo) {
+			reasons = append(reasons, fmt.Sprintf("taken in the last %d days", keepDays))
+		}
+
+		switch {
+		case successStatus(b.Status.State, r.engineType):
+			successful++
+			if successful == 1 {
+				reasons = append(reasons, "the latest successful backup")
+				if r.pitr && !copies {
+					// The point-in-time recovery window starts with the latest successful backup.
+					reasons = append(reasons, "needed for the point-in-time recovery")
+				}
+			}
+			if successful <= keepLast {
+				reasons = append(reasons, fmt.Sprintf("one of the last %d successful backups", keepLast))
+			}
+			for _, ladder := range []*retentionLadder{daily, weekly, monthly} {
+				if reason, ok := ladder.keep(createdAt); ok {
+					reasons = append(reasons, reason)
+				}
+			}
+		case failedStatus(b.Status.State, r.engineType):
+		default:
+			reasons = append(reasons, "the backup is not finished")
+		}
+
+		d := BackupRetentionDecision{
+			Name:    b.Name,
+			Delete:  len(reasons) == 0,
+			Reasons: &reasons,
+		}
+		if !createdAt.IsZero() {
+			d.CreatedAt = pointer.ToTime(createdAt.UTC())
+		}
+		if b.Status.State != "" {
+			d.State = pointer.ToString(string(b.Status.State))
+		}
+		decisions = append(decisions, d)
+	}
+	return decisions
+}
+
+// backupCreatedAt returns the time the backup was taken at.
+func backupCreatedAt(b everestv1alpha1.DatabaseClusterBackup) time.Time {
+	if b.Status.CreatedAt != nil {
+		return b.Status.CreatedAt.Time
+	}
+	return b.CreationTimestamp.Time
+}
+
+// retentionLadder keeps the latest backup of each of the latest periods with backups.
+type retentionLadder struct {
+	periods int
+	name    string
+	period  func(time.Time) string
+	seen    map[string]struct{}
+}
+
+func newRetentionLadder(periods int, name string, period func(time.Time) string) *retentionLadder {
+	return &retentionLadder{periods: periods, name: name, period: period, seen: make(map[string]struct{})}
+}
+
+// keep must be called for the successful backups, the latest first.
+func (l *retentionLadder) keep(t time.Time) (string, bool) {
+	if len(l.seen) >= l.periods {
+		return "", false
+	}
+	period := l.period(t.UTC())
+	if _, ok := l.seen[period]; ok {
+		return "", false
+	}
+	l.seen[period] = struct{}{}
+	return fmt.Sprintf("the latest backup of the %s %s", l.name, period), true
+}
+
+func layoutPeriod(layout string) func(time.Time) string {
+	return func(t time.Time) string { return t.Format(layout) }
+}
+
+func isoWeek(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// RunBackupRetentionJob runs background job deleting the backups expired according to the backup retention policies.
+func (e *EverestServer) RunBackupRetentionJob(ctx context.Context) {
+	e.l.Debug("Starting backup retention job.")
+
+	ticker := time.NewTicker(backupRetentionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			e.applyBackupRetentionPolicies(ctx, now)
+		}
+	}
+}
+
+func (e *EverestServer) applyBackupRetentionPolicies(ctx context.Context, now time.Time) {
+	policies, err := e.kubeClient.GetConfigMapData(ctx, backupRetentionConfigMapName)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("failed to get backup retention policies")))
+		return
+	}
+	if len(policies) == 0 {
+		return
+	}
+
+	namespaces, err := e.kubeClient.GetDBNamespaces(ctx, e.kubeClient.Namespace())
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("failed to get watched namespaces")))
+		return
+	}
+	for _, namespace := range namespaces {
+		clusters, err := e.kubeClient.ListDatabaseClusters(ctx, namespace)
+		if err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("failed to list database clusters in %s", namespace)))
+			continue
+		}
+		for i := range clusters.Items {
+			db := &clusters.Items[i]
+			retention, err := backupRetentionPolicyFor(policies, namespace, db.Name)
+			if err != nil {
+				e.l.Error(errors.Join(err, fmt.Errorf("failed to parse backup retention policy of %s/%s", namespace, db.Name)))
+				continue
+			}
+			if !retention.Policy.Enabled || !db.DeletionTimestamp.IsZero() {
+				continue
+			}
+			e.deleteExpiredBackups(ctx, db, &retention.Policy, now)
+		}
+	}
+}
+
+func (e *EverestServer) deleteExpiredBackups(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+	policy *BackupRetentionPolicy,
+	now time.Time,
+) {
+	decisions, err := e.backupRetentionDecisions(ctx, db, policy, now)
+	if err != nil {
+		e.l.Error(errors.Join(err, fmt.Errorf("failed to evaluate backup retention policy of %s/%s", db.Namespace, db.Name)))
+		return
+	}
+	for _, d := range decisions {
+		if !d.Delete {
+			continue
+		}
+		if err := e.deleteExpiredBackupCopyObjects(ctx, db, d.Name); err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("failed to delete the files of expired backup copy %s/%s", db.Namespace, d.Name)))
+			continue
+		}
+		if err := e.kubeClient.DeleteDatabaseClusterBackup(ctx, db.Namespace, d.Name); err != nil && !k8serrors.IsNotFound(err) {
+			e.l.Error(errors.Join(err, fmt.Errorf("failed to delete expired backup %s/%s", db.Namespace, d.Name)))
+			continue
+		}
+		e.l.Infow("Expired backup deleted", "namespace", db.Namespace, "databaseCluster", db.Name, "backup", d.Name)
+	}
+}
+
+// deleteExpiredBackupCopyObjects deletes the files of the backup if it is a copy.
+// The operator deletes the files of the other backups with their DatabaseClusterBackup.
+func (e *EverestServer) deleteExpiredBackupCopyObjects(ctx context.Context, db *everestv1alpha1.DatabaseCluster, name string) error {
+	backup, err := e.kubeClient.GetDatabaseClusterBackup(ctx, db.Namespace, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if _, ok := backup.Annotations[copiedFromAnnotation]; !ok {
+		return nil
+	}
+	return e.deleteBackupCopyObjects(ctx, backup, db.Spec.Engine.Type)
+}
@@ -0,0 +1,201 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBackupRetentionDecisions(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+	backup := func(name, state string, age time.Duration) everestv1alpha1.DatabaseClusterBackup {
+		b := everestv1alpha1.DatabaseClusterBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       everestv1alpha1.DatabaseClusterBackupSpec{DBClusterName: "db"},
+		}
+		b.Status.State = everestv1alpha1.BackupState(state)
+		if state != "" {
+			b.Status.CreatedAt = &metav1.Time{Time: now.Add(-age)}
+		}
+		return b
+	}
+	backups := []everestv1alpha1.DatabaseClusterBackup{
+		backup("old", "Succeeded", 40*24*time.Hour),
+		backup("latest", "Succeeded", time.Hour),
+		backup("yesterday-late", "Succeeded", 13*time.Hour),
+		backup("yesterday-early", "Succeeded", 20*time.Hour),
+		backup("failed", "Failed", 2*time.Hour),
+		backup("running", "", 0),
+		backup("week-ago", "Succeeded", 7*24*time.Hour),
+	}
+	other := backup("other", "Succeeded", 50*24*time.Hour)
+	other.Spec.DBClusterName = "other-db"
+	backups = append(backups, other)
+
+	deleted := func(decisions []BackupRetentionDecision) []string {
+		var names []string
+		for _, d := range decisions {
+			if d.Delete {
+				names = append(names, d.Name)
+			}
+		}
+		return names
+	}
+
+	cases := []struct {
+		name     string
+		policy   BackupRetentionPolicy
+		restores []everestv1alpha1.DatabaseClusterRestore
+		deleted  []string
+	}{
+		{
+			name:    "keep last",
+			policy:  BackupRetentionPolicy{Enabled: true, KeepLast: pointer.ToInt(2)},
+			deleted: []string{"failed", "yesterday-early", "week-ago", "old"},
+		},
+		{
+			name:    "keep days",
+			policy:  BackupRetentionPolicy{Enabled: true, KeepDays: pointer.ToInt(1)},
+			deleted: []string{"week-ago", "old"},
+		},
+		{
+			name:    "keep daily",
+			policy:  BackupRetentionPolicy{Enabled: true, KeepDaily: pointer.ToInt(2)},
+			deleted: []string{"failed", "yesterday-early", "week-ago", "old"},
+		},
+		{
+			name:    "keep weekly and monthly",
+			policy:  BackupRetentionPolicy{Enabled: true, KeepWeekly: pointer.ToInt(2), KeepMonthly: pointer.ToInt(2)},
+			deleted: []string{"failed", "yesterday-late", "yesterday-early"},
+		},
+		{
+			name:   "running restore",
+			policy: BackupRetentionPolicy{Enabled: true, KeepLast: pointer.ToInt(1)},
+			restores: []everestv1alpha1.DatabaseClusterRestore{{
+				Spec: everestv1alpha1.DatabaseClusterRestoreSpec{
+					DataSource: everestv1alpha1.DataSource{DBClusterBackupName: "old"},
+				},
+				Status: everestv1alpha1.DatabaseClusterRestoreStatus{State: "Restoring"},
+			}},
+			deleted: []string{"failed", "yesterday-late", "yesterday-early", "week-ago"},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			db := &everestv1alpha1.DatabaseCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "db"},
+				Spec: everestv1alpha1.DatabaseClusterSpec{
+					Engine: everestv1alpha1.Engine{Type: everestv1alpha1.DatabaseEnginePXC},
+				},
+			}
+			decisions := newBackupRetention(db, &tc.policy, tc.restores, now).decisions(backups)
+			assert.Equal(t, tc.deleted, deleted(decisions))
+		})
+	}
+
+	t.Run("latest successful backup", func(t *testing.T) {
+		t.Parallel()
+		db := &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "db"},
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{Type: everestv1alpha1.DatabaseEnginePXC},
+				Backup: everestv1alpha1.Backup{Enabled: true, PITR: everestv1alpha1.PITRSpec{Enabled: true}},
+			},
+		}
+		policy := &BackupRetentionPolicy{Enabled: true, KeepDays: pointer.ToInt(1)}
+		decisions := newBackupRetention(db, policy, nil, now).decisions(backups[:1])
+		require.Len(t, decisions, 1)
+		assert.False(t, decisions[0].Delete)
+		assert.Equal(t, []string{"the latest successful backup", "needed for the point-in-time recovery"}, *decisions[0].Reasons)
+	})
+
+	t.Run("pre-restore backup", func(t *testing.T) {
+		t.Parallel()
+		db := &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "db"},
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{Type: everestv1alpha1.DatabaseEnginePXC},
+			},
+		}
+		preRestore := backup("pre-restore", "Succeeded", 40*24*time.Hour)
+		preRestore.Annotations = map[string]string{preRestoreBackupForAnnotation: "restore"}
+		policy := &BackupRetentionPolicy{Enabled: true, KeepLast: pointer.ToInt(1)}
+		decisions := newBackupRetention(db, policy, nil, now).decisions([]everestv1alpha1.DatabaseClusterBackup{backups[1], preRestore})
+		require.Len(t, decisions, 2)
+		assert.Equal(t, "pre-restore", decisions[1].Name)
+		assert.False(t, decisions[1].Delete)
+		assert.Equal(t, []string{"taken before a restore of the database cluster"}, *decisions[1].Reasons)
+	})
+}
+
+func TestBackupRetentionDecisionsOfCopies(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+	backup := func(name, clusterName, storage string, age time.Duration) everestv1alpha1.DatabaseClusterBackup {
+		return everestv1alpha1.DatabaseClusterBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       everestv1alpha1.DatabaseClusterBackupSpec{DBClusterName: clusterName, BackupStorageName: storage},
+			Status: everestv1alpha1.DatabaseClusterBackupStatus{
+				State:     "Succeeded",
+				CreatedAt: &metav1.Time{Time: now.Add(-age)},
+			},
+		}
+	}
+	backups := []everestv1alpha1.DatabaseClusterBackup{
+		backup("today", "db", "s3", time.Hour),
+		backup("yesterday", "db", "s3", 24*time.Hour),
+		// the original of the copy is already deleted
+		backup("week-ago-copy", "db.copy", "azure", 7*24*time.Hour),
+		backup("yesterday-copy", "db.copy", "azure", 24*time.Hour),
+		backup("imported", "db.imported", "s3", 30*24*time.Hour),
+	}
+	db := &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "db"},
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{Type: everestv1alpha1.DatabaseEnginePXC},
+			Backup: everestv1alpha1.Backup{Enabled: true, PITR: everestv1alpha1.PITRSpec{Enabled: true}},
+		},
+	}
+	policy := &BackupRetentionPolicy{Enabled: true, KeepLast: pointer.ToInt(1)}
+	decisions := newBackupRetention(db, policy, nil, now).decisions(backups)
+
+	names := make([]string, 0, len(decisions))
+	for _, d := range decisions {
+		names = append(names, d.Name)
+	}
+	assert.Equal(t, []string{"today", "yesterday", "yesterday-copy", "week-ago-copy"}, names)
+	assert.False(t, decisions[0].Delete)
+	assert.True(t, decisions[1].Delete)
+	assert.False(t, decisions[2].Delete)
+	assert.Equal(t, []string{"the latest successful backup", "one of the last 1 successful backups"}, *decisions[2].Reasons)
+	assert.True(t, decisions[3].Delete)
+}
+
+func TestValidateBackupRetentionPolicy(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, validateBackupRetentionPolicy(&BackupRetentionPolicy{}))
+	require.NoError(t, validateBackupRetentionPolicy(&BackupRetentionPolicy{Enabled: true, KeepMonthly: pointer.ToInt(6)}))
+	require.ErrorIs(t, validateBackupRetentionPolicy(&BackupRetentionPolicy{Enabled: true, KeepLast: pointer.ToInt(0)}), errBackupRetentionNoRules)
+}
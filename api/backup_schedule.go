@@ -0,0 +1,193 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/robfig/cron/v3"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// minBackupScheduleInterval is the minimum time between two runs of a backup schedule.
+	minBackupScheduleInterval = time.Hour
+	// backupScheduleCheckPeriod is the period the runs of the backup schedules are checked over.
+	// Four weeks cover the schedules depending on the day of the week.
+	backupScheduleCheckPeriod = 4 * 7 * 24 * time.Hour
+	// backupScheduleCompareRuns is the number of runs compared to detect the duplicated schedules.
+	backupScheduleCompareRuns = 50
+
+	defaultBackupScheduleRuns = 5
+)
+
+var (
+	errInvalidBackupSchedule       = errors.New("invalid backup schedule")
+	errBackupScheduleTooFrequent   = fmt.Errorf("backup schedules should not run more often than every %s", minBackupScheduleInterval)
+	errDuplicateBackupScheduleName = errors.New("backup schedule names should be unique")
+	errDuplicateBackupSchedule     = errors.New("backup schedules should not run at the same time on the same backup storage")
+
+	// backupScheduleReference is the time the runs of the backup schedules are checked from.
+	// It starts on Monday, so the schedules are checked over whole weeks.
+	backupScheduleReference = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// validateBackupSchedules checks the cron expressions of the backup schedules
+// and rejects the enabled schedules running too often or duplicating another one.
+// The schedules existing unchanged are not rejected for running too often or duplicating
+// one another, so the database clusters created before the rules were enforced can still be updated.
+func validateBackupSchedules(schedules, existing []everestv1alpha1.BackupSchedule) error {
+	parsed := make([]cron.Schedule, len(schedules))
+	unchanged := make([]bool, len(schedules))
+	for i, s := range schedules {
+		sched, err := cron.ParseStandard(s.Schedule)
+		if err != nil {
+			return fmt.Errorf("%w '%s': %w", errInvalidBackupSchedule, s.Name, err)
+		}
+		parsed[i] = sched
+		unchanged[i] = slices.ContainsFunc(existing, func(e everestv1alpha1.BackupSchedule) bool {
+			return e.Name == s.Name && e.Enabled == s.Enabled && e.Schedule == s.Schedule && e.BackupStorageName == s.BackupStorageName
+		})
+		for _, prev := range schedules[:i] {
+			if prev.Name == s.Name {
+				return fmt.Errorf("%w: '%s'", errDuplicateBackupScheduleName, s.Name)
+			}
+		}
+	}
+
+	for i, s := range schedules {
+		if !s.Enabled {
+			continue
+		}
+		if !unchanged[i] && shortestScheduleInterval(parsed[i], backupScheduleReference, backupScheduleCheckPeriod) < minBackupScheduleInterval {
+			return fmt.Errorf("%w: '%s'", errBackupScheduleTooFrequent, s.Name)
+		}
+		for j, prev := range schedules[:i] {
+			if unchanged[i] && unchanged[j] {
+				continue
+			}
+			if prev.Enabled && prev.BackupStorageName == s.BackupStorageName && sameScheduleRuns(parsed[j], parsed[i]) {
+				return fmt.Errorf("%w: '%s' and '%s'", errDuplicateBackupSchedule, prev.Name, s.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// shortestScheduleInterval returns the shortest time between two runs of the schedule in the period.
+func shortestScheduleInterval(sched cron.Schedule, from time.Time, period time.Duration) time.Duration {
+	shortest := period
+	prev := sched.Next(from)
+	for next := sched.Next(prev); !next.IsZero() && next.Sub(from) <= period; next = sched.Next(next) {
+		if d := next.Sub(prev); d < shortest {
+			shortest = d
+		}
+		if shortest < minBackupScheduleInterval {
+			break
+		}
+		prev = next
+	}
+	return shortest
+}
+
+// sameScheduleRuns reports whether the schedules run at the same times, e.g. "@daily" and "0 0 * * *".
+func sameScheduleRuns(a, b cron.Schedule) bool {
+	nextA, nextB := backupScheduleReference, backupScheduleReference
+	for i := 0; i < backupScheduleCompareRuns; i++ {
+		nextA, nextB = a.Next(nextA), b.Next(nextB)
+		if !nextA.Equal(nextB) {
+			return false
+		}
+	}
+	return true
+}
+
+// backupScheduleRuns returns the next runs of the backup schedule after the time.
+func backupScheduleRuns(s everestv1alpha1.BackupSchedule, from time.Time, count int, loc *time.Location) BackupScheduleRuns {
+	result := BackupScheduleRuns{
+		Name:              s.Name,
+		Schedule:          s.Schedule,
+		Enabled:           s.Enabled,
+		BackupStorageName: pointer.ToString(s.BackupStorageName),
+		NextRuns:          []BackupScheduleRun{},
+	}
+	sched, err := cron.ParseStandard(s.Schedule)
+	if err != nil {
+		result.Error = pointer.ToString(err.Error())
+		return result
+	}
+	if !s.Enabled {
+		return result
+	}
+	// The operators run the schedules in UTC.
+	next := from.UTC()
+	for i := 0; i < count; i++ {
+		next = sched.Next(next)
+		if next.IsZero() {
+			break
+		}
+		result.NextRuns = append(result.NextRuns, BackupScheduleRun{Utc: next, Local: next.In(loc)})
+	}
+	return result
+}
+
+// PreviewDatabaseClusterBackupSchedules returns the next runs of the backup schedules of the specified database cluster.
+func (e *EverestServer) PreviewDatabaseClusterBackupSchedules(
+	ctx echo.Context,
+	namespace, name string,
+	params PreviewDatabaseClusterBackupSchedulesParams,
+) error {
+	loc := time.UTC
+	if params.Timezone != nil && *params.Timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(*params.Timezone)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, Error{
+				Message: pointer.ToString(fmt.Sprintf("invalid 'timezone': %s", err)),
+			})
+		}
+	}
+	count := defaultBackupScheduleRuns
+	if params.Count != nil {
+		count = *params.Count
+	}
+
+	db, err := e.kubeClient.GetDatabaseCluster(ctx.Request().Context(), namespace, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed getting database cluster")})
+	}
+
+	now := time.Now()
+	result := BackupSchedulePreview{
+		Timezone:  loc.String(),
+		Schedules: make([]BackupScheduleRuns, 0, len(db.Spec.Backup.Schedules)),
+	}
+	for _, s := range db.Spec.Backup.Schedules {
+		result.Schedules = append(result.Schedules, backupScheduleRuns(s, now, count, loc))
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,133 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"testing"
+	"time"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBackupSchedules(t *testing.T) {
+	t.Parallel()
+	schedule := func(name, cron, storage string) everestv1alpha1.BackupSchedule {
+		return everestv1alpha1.BackupSchedule{Enabled: true, Name: name, Schedule: cron, BackupStorageName: storage}
+	}
+	cases := []struct {
+		name      string
+		schedules []everestv1alpha1.BackupSchedule
+		existing  []everestv1alpha1.BackupSchedule
+		err       error
+	}{
+		{
+			name:      "daily and weekly",
+			schedules: []everestv1alpha1.BackupSchedule{schedule("daily", "0 3 * * *", "s3"), schedule("weekly", "0 4 * * 0", "s3")},
+		},
+		{
+			name:      "invalid cron expression",
+			schedules: []everestv1alpha1.BackupSchedule{schedule("daily", "0 25 * * *", "s3")},
+			err:       errInvalidBackupSchedule,
+		},
+		{
+			name:      "every minute",
+			schedules: []everestv1alpha1.BackupSchedule{schedule("often", "* * * * *", "s3")},
+			err:       errBackupScheduleTooFrequent,
+		},
+		{
+			name:      "twice within an hour on weekends",
+			schedules: []everestv1alpha1.BackupSchedule{schedule("weekend", "0,30 12 * * 6", "s3")},
+			err:       errBackupScheduleTooFrequent,
+		},
+		{
+			name:      "disabled frequent schedule",
+			schedules: []everestv1alpha1.BackupSchedule{{Name: "often", Schedule: "* * * * *"}},
+		},
+		{
+			name:      "duplicated name",
+			schedules: []everestv1alpha1.BackupSchedule{schedule("daily", "0 3 * * *", "s3"), schedule("daily", "0 4 * * *", "s3")},
+			err:       errDuplicateBackupScheduleName,
+		},
+		{
+			name:      "same runs on the same storage",
+			schedules: []everestv1alpha1.BackupSchedule{schedule("a", "@daily", "s3"), schedule("b", "0 0 * * *", "s3")},
+			err:       errDuplicateBackupSchedule,
+		},
+		{
+			name:      "same runs on different storages",
+			schedules: []everestv1alpha1.BackupSchedule{schedule("a", "@daily", "s3"), schedule("b", "0 0 * * *", "azure")},
+		},
+		{
+			name:      "existing frequent schedule",
+			schedules: []everestv1alpha1.BackupSchedule{schedule("often", "*/30 * * * *", "s3"), schedule("daily", "0 3 * * *", "s3")},
+			existing:  []everestv1alpha1.BackupSchedule{{Enabled: true, Name: "often", Schedule: "*/30 * * * *", BackupStorageName: "s3", RetentionCopies: 3}},
+		},
+		{
+			name:      "changed frequent schedule",
+			schedules: []everestv1alpha1.BackupSchedule{schedule("often", "*/15 * * * *", "s3")},
+			existing:  []everestv1alpha1.BackupSchedule{schedule("often", "*/30 * * * *", "s3")},
+			err:       errBackupScheduleTooFrequent,
+		},
+		{
+			name:      "existing same runs on the same storage",
+			schedules: []everestv1alpha1.BackupSchedule{schedule("a", "@daily", "s3"), schedule("b", "0 0 * * *", "s3")},
+			existing:  []everestv1alpha1.BackupSchedule{schedule("a", "@daily", "s3"), schedule("b", "0 0 * * *", "s3")},
+		},
+		{
+			name:      "new schedule with the runs of an existing one",
+			schedules: []everestv1alpha1.BackupSchedule{schedule("a", "@daily", "s3"), schedule("b", "0 0 * * *", "s3")},
+			existing:  []everestv1alpha1.BackupSchedule{schedule("a", "@daily", "s3")},
+			err:       errDuplicateBackupSchedule,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateBackupSchedules(tc.schedules, tc.existing)
+			if tc.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestBackupScheduleRuns(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2024, 3, 30, 12, 0, 0, 0, time.UTC)
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	runs := backupScheduleRuns(everestv1alpha1.BackupSchedule{
+		Enabled: true, Name: "daily", Schedule: "0 3 * * *", BackupStorageName: "s3",
+	}, from, 2, berlin)
+	require.Len(t, runs.NextRuns, 2)
+	assert.Equal(t, time.Date(2024, 3, 31, 3, 0, 0, 0, time.UTC), runs.NextRuns[0].Utc)
+	// daylight saving time starts on March 31
+	assert.Equal(t, "2024-03-31T05:00:00+02:00", runs.NextRuns[0].Local.Format(time.RFC3339))
+	assert.Equal(t, "2024-04-01T05:00:00+02:00", runs.NextRuns[1].Local.Format(time.RFC3339))
+
+	runs = backupScheduleRuns(everestv1alpha1.BackupSchedule{Name: "disabled", Schedule: "0 3 * * *"}, from, 2, berlin)
+	assert.Empty(t, runs.NextRuns)
+	assert.Nil(t, runs.Error)
+
+	runs = backupScheduleRuns(everestv1alpha1.BackupSchedule{Enabled: true, Name: "invalid", Schedule: "daily"}, from, 2, berlin)
+	assert.Empty(t, runs.NextRuns)
+	assert.NotNil(t, runs.Error)
+}
@@ -0,0 +1,315 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"golang.org/x/sync/errgroup"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultBackupStatsDays = 30
+	// backupStatsConcurrency is the maximum number of database clusters whose backup storages are read at the same time.
+	backupStatsConcurrency = 8
+)
+
+// backupStatsRecord is a backup counted in the statistics.
+type backupStatsRecord struct {
+	entry     BackupStatsEntry
+	succeeded bool
+	failed    bool
+}
+
+// GetDatabaseClusterBackupStats returns the backup statistics of the specified database cluster.
+func (e *EverestServer) GetDatabaseClusterBackupStats(
+	ctx echo.Context,
+	namespace, name string,
+	params GetDatabaseClusterBackupStatsParams,
+) error {
+	reqCtx := ctx.Request().Context()
+	db, err := e.kubeClient.GetDatabaseCluster(reqCtx, namespace, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed getting database cluster")})
+	}
+	backups, err := e.kubeClient.ListDatabaseClusterBackups(reqCtx, namespace, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("clusterName=%s", name),
+	})
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed listing database cluster backups")})
+	}
+
+	now := time.Now().UTC()
+	stats, _ := e.databaseClusterBackupStats(reqCtx, db, backups.Items, backupStatsWindowStart(now, params.Days), now)
+	return ctx.JSON(http.StatusOK, stats)
+}
+
+// GetNamespaceBackupStats returns the backup statistics of the database clusters in the specified namespace.
+func (e *EverestServer) GetNamespaceBackupStats(ctx echo.Context, namespace string, params GetNamespaceBackupStatsParams) error {
+	reqCtx := ctx.Request().Context()
+	namespaces, err := e.kubeClient.GetDBNamespaces(reqCtx, e.kubeClient.Namespace())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Failed getting watched namespaces"),
+		})
+	}
+	if err := validateAllowedNamespaces([]string{namespace}, namespaces); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	clusters, err := e.kubeClient.ListDatabaseClusters(reqCtx, namespace)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not list the database clusters of the namespace"),
+		})
+	}
+	backups, err := e.kubeClient.ListDatabaseClusterBackups(reqCtx, namespace, metav1.ListOptions{})
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed listing database cluster backups")})
+	}
+
+	now := time.Now().UTC()
+	from := backupStatsWindowStart(now, params.Days)
+	result := NamespaceBackupStats{
+		Namespace:        namespace,
+		DatabaseClusters: make([]BackupStats, 0, len(clusters.Items)),
+	}
+	// The statistics of a database cluster do not fail, the backup storages which can't be read are reported in them.
+	clusterStats := make([]BackupStats, len(clusters.Items))
+	clusterRecords := make([][]backupStatsRecord, len(clusters.Items))
+	var g errgroup.Group
+	g.SetLimit(backupStatsConcurrency)
+	for i := range clusters.Items {
+		i, db := i, &clusters.Items[i]
+		clusterBackups := slices.DeleteFunc(slices.Clone(backups.Items), func(b everestv1alpha1.DatabaseClusterBackup) bool {
+			return backupClusterName(&b) != db.Name
+		})
+		g.Go(func() error {
+			clusterStats[i], clusterRecords[i] = e.databaseClusterBackupStats(reqCtx, db, clusterBackups, from, now)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	var records []backupStatsRecord
+	var storages [][]BackupStorageUsage
+	for i, stats := range clusterStats {
+		result.DatabaseClusters = append(result.DatabaseClusters, stats)
+		records = append(records, clusterRecords[i]...)
+		storages = append(storages, stats.Storages)
+	}
+	result.Total = backupStats(records, from, now)
+	result.Total.Backups = nil
+	result.Total.Storages = sumBackupStorageUsage(storages)
+	return ctx.JSON(http.StatusOK, result)
+}
+
+func backupStatsWindowStart(now time.Time, days *int) time.Time {
+	d := defaultBackupStatsDays
+	if days != nil {
+		d = *days
+	}
+	return now.Add(-time.Duration(d) * 24 * time.Hour)
+}
+
+// databaseClusterBackupStats returns the backup statistics of the database cluster and the backups counted in them.
+// The sizes are read from the backup storages, so a storage which can't be read is reported
+// in the statistics instead of failing them.
+func (e *EverestServer) databaseClusterBackupStats(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+	backups []everestv1alpha1.DatabaseClusterBackup,
+	from, now time.Time,
+) (BackupStats, []backupStatsRecord) {
+	storageNames := backupStorageNames(db)
+	for _, b := range backups {
+		storageNames = append(storageNames, b.Spec.BackupStorageName)
+	}
+	slices.Sort(storageNames)
+	storageNames = slices.Compact(storageNames)
+
+	sizes := make(map[string]int64)
+	usage := make([]BackupStorageUsage, 0, len(storageNames))
+	for _, name := range storageNames {
+		u, err := e.backupStorageUsage(ctx, db, backups, name, sizes)
+		if err != nil {
+			e.l.Error(fmt.Errorf("could not read the backups of %s/%s in the %s backup storage: %w", db.Namespace, db.Name, name, err))
+			u.Error = pointer.ToString(err.Error())
+		}
+		usage = append(usage, u)
+	}
+
+	records := make([]backupStatsRecord, 0, len(backups))
+	for _, b := range backups {
+		r := newBackupStatsRecord(b, db.Spec.Engine.Type)
+		if size, ok := sizes[b.Name]; ok {
+			r.entry.SizeBytes = pointer.ToInt64(size)
+		}
+		records = append(records, r)
+	}
+
+	stats := backupStats(records, from, now)
+	stats.DatabaseClusterName = pointer.ToString(db.Name)
+	stats.Storages = usage
+	return stats, records
+}
+
+// backupStorageUsage returns the bytes stored by the database cluster in the backup storage
+// and sets the sizes of the backups found in it.
+func (e *EverestServer) backupStorageUsage(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+	backups []everestv1alpha1.DatabaseClusterBackup,
+	storageName string,
+	sizes map[string]int64,
+) (BackupStorageUsage, error) {
+	usage := BackupStorageUsage{Name: storageName}
+	storage, err := e.kubeClient.GetBackupStorage(ctx, storageName)
+	if err != nil {
+		return usage, err
+	}
+	objects, err := e.listStorageObjects(ctx, storage, fmt.Sprintf("%s/%s/", db.Name, db.UID))
+	if err != nil {
+		return usage, err
+	}
+	for _, o := range objects {
+		usage.BytesStored += o.size
+	}
+
+	artifacts := backupArtifacts(objects, storage)
+	flagOrphanedBackupArtifacts(artifacts, backups, storageName)
+	for _, a := range artifacts {
+		if a.DatabaseClusterBackupName != nil && a.SizeBytes != nil {
+			sizes[*a.DatabaseClusterBackupName] = *a.SizeBytes
+		}
+	}
+	return usage, nil
+}
+
+func newBackupStatsRecord(b everestv1alpha1.DatabaseClusterBackup, engineType everestv1alpha1.EngineType) backupStatsRecord {
+	createdAt := b.CreationTimestamp.Time
+	if b.Status.CreatedAt != nil {
+		createdAt = b.Status.CreatedAt.Time
+	}
+	r := backupStatsRecord{
+		entry: BackupStatsEntry{
+			Name:              b.Name,
+			State:             string(b.Status.State),
+			BackupStorageName: b.Spec.BackupStorageName,
+			CreatedAt:         pointer.ToTime(createdAt.UTC()),
+		},
+		succeeded: successStatus(b.Status.State, engineType),
+		failed:    failedStatus(b.Status.State, engineType),
+	}
+	if b.Status.CompletedAt != nil && (r.succeeded || r.failed) {
+		r.entry.CompletedAt = pointer.ToTime(b.Status.CompletedAt.UTC())
+		r.entry.DurationSeconds = pointer.ToInt64(int64(b.Status.CompletedAt.Sub(createdAt).Seconds()))
+	}
+	return r
+}
+
+// backupStats returns the statistics of the backups created from the time on.
+// The last successful backup is looked for among all the backups.
+func backupStats(records []backupStatsRecord, from, now time.Time) BackupStats {
+	stats := BackupStats{
+		WindowStart: from,
+		WindowEnd:   now,
+		Storages:    []BackupStorageUsage{},
+	}
+	backups := make([]BackupStatsEntry, 0, len(records))
+	var durations, durationCount, lastDuration int64
+	var lastCompletedAt time.Time
+	for _, r := range records {
+		if r.succeeded && r.entry.CompletedAt != nil &&
+			(stats.LastSuccessfulBackupAt == nil || r.entry.CompletedAt.After(*stats.LastSuccessfulBackupAt)) {
+			stats.LastSuccessfulBackupAt = r.entry.CompletedAt
+		}
+		if r.entry.CreatedAt.Before(from) {
+			continue
+		}
+		backups = append(backups, r.entry)
+		switch {
+		case r.succeeded:
+			stats.Succeeded++
+		case r.failed:
+			stats.Failed++
+		default:
+			stats.InProgress++
+		}
+		if !r.succeeded || r.entry.DurationSeconds == nil {
+			continue
+		}
+		durations += *r.entry.DurationSeconds
+		durationCount++
+		if r.entry.CompletedAt.After(lastCompletedAt) {
+			lastCompletedAt = *r.entry.CompletedAt
+			lastDuration = *r.entry.DurationSeconds
+		}
+	}
+
+	if durationCount > 0 {
+		stats.AverageDurationSeconds = pointer.ToInt64(durations / durationCount)
+		stats.LastDurationSeconds = pointer.ToInt64(lastDuration)
+	}
+	if stats.LastSuccessfulBackupAt != nil {
+		stats.SecondsSinceLastSuccessfulBackup = pointer.ToInt64(int64(now.Sub(*stats.LastSuccessfulBackupAt).Seconds()))
+	}
+	slices.SortStableFunc(backups, func(a, b BackupStatsEntry) int {
+		return b.CreatedAt.Compare(*a.CreatedAt)
+	})
+	stats.Backups = &backups
+	return stats
+}
+
+// sumBackupStorageUsage returns the bytes stored in every backup storage by all the database clusters.
+func sumBackupStorageUsage(usages [][]BackupStorageUsage) []BackupStorageUsage {
+	result := []BackupStorageUsage{}
+	for _, clusterUsage := range usages {
+		for _, u := range clusterUsage {
+			i := slices.IndexFunc(result, func(r BackupStorageUsage) bool { return r.Name == u.Name })
+			if i < 0 {
+				result = append(result, BackupStorageUsage{Name: u.Name})
+				i = len(result) - 1
+			}
+			result[i].BytesStored += u.BytesStored
+			if u.Error != nil {
+				result[i].Error = u.Error
+			}
+		}
+	}
+	slices.SortFunc(result, func(a, b BackupStorageUsage) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return result
+}
@@ -0,0 +1,95 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBackupStats(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC)
+	from := backupStatsWindowStart(now, pointer.ToInt(7))
+	require.Equal(t, time.Date(2024, 3, 24, 12, 0, 0, 0, time.UTC), from)
+
+	backup := func(name, state string, created time.Time, duration time.Duration) everestv1alpha1.DatabaseClusterBackup {
+		b := everestv1alpha1.DatabaseClusterBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created.Add(-time.Minute))},
+			Spec:       everestv1alpha1.DatabaseClusterBackupSpec{BackupStorageName: "s3"},
+			Status: everestv1alpha1.DatabaseClusterBackupStatus{
+				State:     everestv1alpha1.BackupState(state),
+				CreatedAt: &metav1.Time{Time: created},
+			},
+		}
+		if duration > 0 {
+			b.Status.CompletedAt = &metav1.Time{Time: created.Add(duration)}
+		}
+		return b
+	}
+	backups := []everestv1alpha1.DatabaseClusterBackup{
+		backup("old", "Succeeded", now.Add(-10*24*time.Hour), time.Hour),
+		backup("first", "Succeeded", now.Add(-3*24*time.Hour), 10*time.Minute),
+		backup("failed", "Failed", now.Add(-2*24*time.Hour), 5*time.Minute),
+		backup("second", "Succeeded", now.Add(-24*time.Hour), 20*time.Minute),
+		backup("running", "Running", now.Add(-time.Hour), 0),
+	}
+	records := make([]backupStatsRecord, 0, len(backups))
+	for _, b := range backups {
+		records = append(records, newBackupStatsRecord(b, everestv1alpha1.DatabaseEnginePXC))
+	}
+
+	stats := backupStats(records, from, now)
+	assert.Equal(t, 2, stats.Succeeded)
+	assert.Equal(t, 1, stats.Failed)
+	assert.Equal(t, 1, stats.InProgress)
+	assert.Equal(t, pointer.ToInt64(900), stats.AverageDurationSeconds)
+	assert.Equal(t, pointer.ToInt64(1200), stats.LastDurationSeconds)
+	assert.Equal(t, pointer.ToTime(now.Add(-24*time.Hour+20*time.Minute)), stats.LastSuccessfulBackupAt)
+	assert.Equal(t, pointer.ToInt64(int64((24*time.Hour - 20*time.Minute).Seconds())), stats.SecondsSinceLastSuccessfulBackup)
+	require.NotNil(t, stats.Backups)
+	names := make([]string, 0, len(*stats.Backups))
+	for _, b := range *stats.Backups {
+		names = append(names, b.Name)
+	}
+	assert.Equal(t, []string{"running", "second", "failed", "first"}, names)
+	assert.Equal(t, pointer.ToInt64(300), (*stats.Backups)[2].DurationSeconds)
+	assert.Nil(t, (*stats.Backups)[0].DurationSeconds)
+
+	// The last successful backup is reported even before the window.
+	stats = backupStats(records[:1], from, now)
+	assert.Zero(t, stats.Succeeded)
+	assert.Nil(t, stats.AverageDurationSeconds)
+	assert.Equal(t, pointer.ToInt64(int64((10*24*time.Hour - time.Hour).Seconds())), stats.SecondsSinceLastSuccessfulBackup)
+}
+
+func TestSumBackupStorageUsage(t *testing.T) {
+	t.Parallel()
+	usage := sumBackupStorageUsage([][]BackupStorageUsage{
+		{{Name: "s3", BytesStored: 100}, {Name: "azure", BytesStored: 10}},
+		{{Name: "s3", BytesStored: 50}, {Name: "minio", Error: pointer.ToString("access denied")}},
+	})
+	assert.Equal(t, []BackupStorageUsage{
+		{Name: "azure", BytesStored: 10},
+		{Name: "minio", Error: pointer.ToString("access denied")},
+		{Name: "s3", BytesStored: 150},
+	}, usage)
+}
@@ -0,0 +1,635 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/percona/percona-everest-backend/pkg/enginecheck"
+)
+
+const (
+	// backupVerificationConfigMapName is the name of the config map in the Everest namespace
+	// which stores the backup verification settings.
+	backupVerificationConfigMapName = "everest-backup-verification"
+	backupVerificationSettingsKey   = "settings"
+
+	// backupVerificationAnnotation holds the last verification of a backup.
+	backupVerificationAnnotation = "everest.percona.com/backup-verification"
+	// backupVerificationLabel marks the temporary database clusters of the verifications.
+	backupVerificationLabel = "everest.percona.com/backup-verification"
+	// verifiedBackupAnnotation holds the backup restored to a temporary database cluster.
+	verifiedBackupAnnotation = "everest.percona.com/verified-backup"
+
+	backupVerificationInterval     = time.Minute
+	backupVerificationPollInterval = 30 * time.Second
+	backupVerificationTimeout      = 2 * time.Hour
+	backupVerificationCleanTimeout = time.Minute
+	backupVerificationCPU          = "1"
+	backupVerificationMemory       = "2G"
+)
+
+var (
+	errBackupVerificationNoSchedule = errors.New("'schedule' should be specified when the backup verification is enabled")
+	errBackupVerificationNoSandbox  = errors.New("the sandbox namespace of the backup verification is not configured")
+	errBackupVerificationRunning    = errors.New("the backup is already being verified")
+	errBackupNotSucceeded           = errors.New("only successful backups can be verified")
+)
+
+// GetBackupVerificationSettings returns the backup verification settings.
+func (e *EverestServer) GetBackupVerificationSettings(ctx echo.Context) error {
+	settings, err := e.backupVerificationSettings(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the backup verification settings")})
+	}
+	if settings == nil {
+		settings = &BackupVerificationSettings{}
+	}
+
+	return ctx.JSON(http.StatusOK, settings)
+}
+
+// UpdateBackupVerificationSettings sets the backup verification settings.
+func (e *EverestServer) UpdateBackupVerificationSettings(ctx echo.Context) error {
+	settings := &BackupVerificationSettings{}
+	if err := e.getBodyFromContext(ctx, settings); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get BackupVerificationSettings from the request body"),
+		})
+	}
+	if err := validateBackupVerificationSettings(settings); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	namespaces, err := e.kubeClient.GetDBNamespaces(ctx.Request().Context(), e.kubeClient.Namespace())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Failed getting watched namespaces"),
+		})
+	}
+	if err := validateAllowedNamespaces([]string{settings.SandboxNamespace}, namespaces); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	data, err := json.Marshal(settings)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the backup verification settings")})
+	}
+	err = e.kubeClient.SetConfigMapEntry(ctx.Request().Context(), backupVerificationConfigMapName, backupVerificationSettingsKey, string(data))
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the backup verification settings")})
+	}
+
+	return ctx.JSON(http.StatusOK, settings)
+}
+
+// GetDatabaseClusterBackupVerification returns the last verification of the specified backup.
+func (e *EverestServer) GetDatabaseClusterBackupVerification(ctx echo.Context, namespace, name string) error {
+	backup, err := e.kubeClient.GetDatabaseClusterBackup(ctx.Request().Context(), namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Backup is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the backup")})
+	}
+
+	verification, err := backupVerification(backup)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not parse the backup verification")})
+	}
+	if verification == nil {
+		return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("The backup was never verified")})
+	}
+
+	return ctx.JSON(http.StatusOK, verification)
+}
+
+// VerifyDatabaseClusterBackup starts the verification of the specified backup.
+func (e *EverestServer) VerifyDatabaseClusterBackup(ctx echo.Context, namespace, name string) error {
+	reqCtx := ctx.Request().Context()
+	settings, err := e.backupVerificationSettings(reqCtx)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the backup verification settings")})
+	}
+	if settings == nil || settings.SandboxNamespace == "" {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(errBackupVerificationNoSandbox.Error())})
+	}
+
+	backup, err := e.kubeClient.GetDatabaseClusterBackup(reqCtx, namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Backup is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the backup")})
+	}
+
+	verification, err := e.startBackupVerification(reqCtx, backup, settings.SandboxNamespace, time.Now())
+	if err != nil {
+		e.l.Error(err)
+		switch {
+		case errors.Is(err, errBackupVerificationRunning):
+			return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString(err.Error())})
+		case k8serrors.IsNotFound(err), errors.Is(err, errBackupNotSucceeded):
+			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not start the backup verification")})
+		}
+	}
+
+	// The verification outlives the request.
+	go func() {
+		vCtx, cancel := context.WithTimeout(context.WithoutCancel(reqCtx), backupVerificationTimeout)
+		defer cancel()
+		e.runBackupVerification(vCtx, backup, verification)
+	}()
+
+	return ctx.JSON(http.StatusAccepted, verification)
+}
+
+func (e *EverestServer) backupVerificationSettings(ctx context.Context) (*BackupVerificationSettings, error) {
+	data, err := e.kubeClient.GetConfigMapData(ctx, backupVerificationConfigMapName)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := data[backupVerificationSettingsKey]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+	settings := &BackupVerificationSettings{}
+	if err := json.Unmarshal([]byte(v), settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func validateBackupVerificationSettings(settings *BackupVerificationSettings) error {
+	schedule := strings.TrimSpace(pointer.GetString(settings.Schedule))
+	if schedule == "" {
+		if settings.Enabled {
+			return errBackupVerificationNoSchedule
+		}
+		return nil
+	}
+	if _, err := cron.ParseStandard(schedule); err != nil {
+		return fmt.Errorf("invalid 'schedule': %w", err)
+	}
+	return nil
+}
+
+// backupVerification returns the last verification of the backup or nil if it was never verified.
+func backupVerification(backup *everestv1alpha1.DatabaseClusterBackup) (*BackupVerification, error) {
+	v, ok := backup.Annotations[backupVerificationAnnotation]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+	verification := &BackupVerification{}
+	if err := json.Unmarshal([]byte(v), verification); err != nil {
+		return nil, err
+	}
+	return verification, nil
+}
+
+// verificationRunning returns true if the verification is in progress.
+// A verification which has not finished in time is considered abandoned.
+func verificationRunning(verification *BackupVerification, now time.Time) bool {
+	return verification != nil && verification.FinishedAt == nil &&
+		now.Before(verification.StartedAt.Add(backupVerificationTimeout))
+}
+
+// startBackupVerification records the verification of the backup as running.
+func (e *EverestServer) startBackupVerification(
+	ctx context.Context,
+	backup *everestv1alpha1.DatabaseClusterBackup,
+	sandboxNamespace string,
+	now time.Time,
+) (*BackupVerification, error) {
+	db, err := e.kubeClient.GetDatabaseCluster(ctx, backup.Namespace, backupClusterName(backup))
+	if err != nil {
+		return nil, err
+	}
+	if !successStatus(backup.Status.State, db.Spec.Engine.Type) {
+		return nil, errBackupNotSucceeded
+	}
+
+	verification := &BackupVerification{
+		StartedAt:           now.UTC(),
+		SandboxNamespace:    sandboxNamespace,
+		DatabaseClusterName: backupVerificationClusterName(backup),
+	}
+	err = e.updateBackupVerification(ctx, backup.Namespace, backup.Name, func(current *BackupVerification) error {
+		if verificationRunning(current, now) {
+			return errBackupVerificationRunning
+		}
+		return nil
+	}, verification)
+	if err != nil {
+		return nil, err
+	}
+	return verification, nil
+}
+
+// updateBackupVerification stores the verification on the backup if check allows it.
+func (e *EverestServer) updateBackupVerification(
+	ctx context.Context,
+	namespace, name string,
+	check func(current *BackupVerification) error,
+	verification *BackupVerification,
+) error {
+	data, err := json.Marshal(verification)
+	if err != nil {
+		return err
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		backup, err := e.kubeClient.GetDatabaseClusterBackup(ctx, namespace, name)
+		if err != nil {
+			return err
+		}
+		if check != nil {
+			current, err := backupVerification(backup)
+			if err != nil {
+				return err
+			}
+			if err := check(current); err != nil {
+				return err
+			}
+		}
+		if backup.Annotations == nil {
+			backup.Annotations = make(map[string]string)
+		}
+		backup.Annotations[backupVerificationAnnotation] = string(data)
+		_, err = e.kubeClient.UpdateDatabaseClusterBackup(ctx, backup)
+		return err
+	})
+}
+
+// runBackupVerification restores the backup to a temporary database cluster, checks it,
+// records the result on the backup and deletes the temporary database cluster.
+func (e *EverestServer) runBackupVerification(
+	ctx context.Context,
+	backup *everestv1alpha1.DatabaseClusterBackup,
+	verification *BackupVerification,
+) {
+	e.l.Infow("Verifying backup", "namespace", backup.Namespace, "backup", backup.Name,
+		"sandboxNamespace", verification.SandboxNamespace, "databaseCluster", verification.DatabaseClusterName)
+
+	err := e.restoreAndCheckBackup(ctx, backup, verification)
+
+	// The cleanup and the result must not be lost when the verification times out.
+	cleanCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), backupVerificationCleanTimeout)
+	defer cancel()
+	e.deleteVerificationCluster(cleanCtx, verification.SandboxNamespace, verification.DatabaseClusterName)
+
+	finishedAt := time.Now().UTC()
+	verification.FinishedAt = &finishedAt
+	verification.DurationSeconds = pointer.ToInt64(int64(finishedAt.Sub(verification.StartedAt).Seconds()))
+	verification.Passed = pointer.ToBool(err == nil)
+	if err != nil {
+		verification.Message = pointer.ToString(err.Error())
+		e.l.Error(errors.Join(err, fmt.Errorf("backup %s/%s verification failed", backup.Namespace, backup.Name)))
+	}
+	if err := e.updateBackupVerification(cleanCtx, backup.Namespace, backup.Name, nil, verification); err != nil {
+		e.l.Error(errors.Join(err, fmt.Errorf("failed to save backup %s/%s verification", backup.Namespace, backup.Name)))
+	}
+}
+
+func (e *EverestServer) restoreAndCheckBackup(
+	ctx context.Context,
+	backup *everestv1alpha1.DatabaseClusterBackup,
+	verification *BackupVerification,
+) error {
+	source, err := e.kubeClient.GetDatabaseCluster(ctx, backup.Namespace, backupClusterName(backup))
+	if err != nil {
+		return fmt.Errorf("could not get the database cluster of the backup: %w", err)
+	}
+	if err := e.checkBackupStorageAllowed(ctx, backup.Spec.BackupStorageName, verification.SandboxNamespace); err != nil {
+		return err
+	}
+
+	db := verificationCluster(source, backup, verification.SandboxNamespace, verification.DatabaseClusterName)
+	// Leftovers of an abandoned verification of the backup are removed first.
+	e.deleteVerificationCluster(ctx, db.Namespace, db.Name)
+	// The restored data contains the users of the source database cluster, so the temporary one needs the same credentials.
+	secret, err := e.kubeClient.GetSecret(ctx, source.Namespace, source.Spec.Engine.UserSecretsName)
+	if err != nil {
+		return fmt.Errorf("could not get the credentials of the database cluster: %w", err)
+	}
+	_, err = e.kubeClient.CreateSecret(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      db.Spec.Engine.UserSecretsName,
+			Namespace: db.Namespace,
+			Labels:    map[string]string{backupVerificationLabel: "true"},
+		},
+		Type: secret.Type,
+		Data: secret.Data,
+	})
+	if err != nil {
+		return fmt.Errorf("could not create the credentials of the temporary database cluster: %w", err)
+	}
+	if _, err := e.kubeClient.CreateDatabaseCluster(ctx, db); err != nil {
+		return fmt.Errorf("could not create the temporary database cluster: %w", err)
+	}
+
+	db, err = e.waitForDatabaseClusterReady(ctx, db.Namespace, db.Name, backupVerificationPollInterval)
+	if err != nil {
+		return err
+	}
+	creds, ok := databaseClusterCredentials(db.Spec.Engine.Type, secret.Data)
+	if !ok {
+		return fmt.Errorf("unsupported engine type %s", db.Spec.Engine.Type)
+	}
+	return checkVerificationCluster(ctx, db, enginecheck.Credentials{
+		Username: pointer.GetString(creds.Username),
+		Password: pointer.GetString(creds.Password),
+	})
+}
+
+// checkBackupStorageAllowed checks the backup storage can be used in the namespace.
+func (e *EverestServer) checkBackupStorageAllowed(ctx context.Context, storageName, namespace string) error {
+	storage, err := e.kubeClient.GetBackupStorage(ctx, storageName)
+	if err != nil {
+		return fmt.Errorf("could not get the backup storage %s: %w", storageName, err)
+	}
+	if len(storage.Spec.AllowedNamespaces) > 0 && !slices.Contains(storage.Spec.AllowedNamespaces, namespace) {
+		return fmt.Errorf("the backup storage %s is not allowed in the %s namespace", storageName, namespace)
+	}
+	return nil
+}
+
+// waitForDatabaseClusterReady polls the database cluster until it is ready or the context is done.
+func (e *EverestServer) waitForDatabaseClusterReady(
+	ctx context.Context,
+	namespace, name string,
+	interval time.Duration,
+) (*everestv1alpha1.DatabaseCluster, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var state everestv1alpha1.AppState
+	for {
+		db, err := e.kubeClient.GetDatabaseCluster(ctx, namespace, name)
+		switch {
+		case err != nil && !k8serrors.IsNotFound(err):
+			return nil, err
+		case err != nil:
+			// The database cluster may not be visible yet right after it is created.
+		case db.Status.Status == everestv1alpha1.AppStateReady:
+			return db, nil
+		default:
+			state = db.Status.Status
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("the temporary database cluster did not become ready in time, last state %q: %w", state, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkVerificationCluster logs in to the restored database cluster with the credentials of the
+// source database cluster and checks it lists the databases.
+func checkVerificationCluster(ctx context.Context, db *everestv1alpha1.DatabaseCluster, creds enginecheck.Credentials) error {
+	if db.Status.Ready < db.Status.Size {
+		return fmt.Errorf("only %d of %d pods of the temporary database cluster are ready", db.Status.Ready, db.Status.Size)
+	}
+	if db.Status.Hostname == "" || db.Status.Port == 0 {
+		return errors.New("the temporary database cluster has no endpoint")
+	}
+	address := net.JoinHostPort(db.Status.Hostname, strconv.Itoa(int(db.Status.Port)))
+	databases, err := enginecheck.ListDatabases(ctx, db.Spec.Engine.Type, address, creds)
+	if err != nil {
+		return fmt.Errorf("sanity check of the temporary database cluster failed: %w", err)
+	}
+	if len(databases) == 0 {
+		return errors.New("sanity check of the temporary database cluster failed: no databases are restored")
+	}
+	return nil
+}
+
+func (e *EverestServer) deleteVerificationCluster(ctx context.Context, namespace, name string) {
+	if err := e.kubeClient.DeleteDatabaseCluster(ctx, namespace, name); err != nil && !k8serrors.IsNotFound(err) {
+		e.l.Error(errors.Join(err, fmt.Errorf("failed to delete temporary database cluster %s/%s", namespace, name)))
+	}
+	if err := e.kubeClient.DeleteSecret(ctx, namespace, databaseClusterSecretName(name)); err != nil && !k8serrors.IsNotFound(err) {
+		e.l.Error(errors.Join(err, fmt.Errorf("failed to delete credentials of temporary database cluster %s/%s", namespace, name)))
+	}
+}
+
+// backupVerificationClusterName returns the name of the temporary database cluster of the backup.
+// It is derived from the backup so a backup can't be verified twice at the same time.
+func backupVerificationClusterName(backup *everestv1alpha1.DatabaseClusterBackup) string {
+	sum := sha256.Sum256([]byte(backup.Namespace + "/" + backup.Name))
+	return "verify-" + hex.EncodeToString(sum[:])[:10]
+}
+
+// databaseClusterSecretName returns the name of the credentials secret the operator would generate for the database cluster.
+func databaseClusterSecretName(clusterName string) string {
+	return "everest-secrets-" + clusterName
+}
+
+// verificationCluster returns the minimally sized database cluster restored from the backup in the sandbox namespace.
+func verificationCluster(
+	source *everestv1alpha1.DatabaseCluster,
+	backup *everestv1alpha1.DatabaseClusterBackup,
+	namespace, name string,
+) *everestv1alpha1.DatabaseCluster {
+	dataSource := &everestv1alpha1.DataSource{DBClusterBackupName: backup.Name}
+	if backup.Namespace != namespace {
+		// The backup is not visible in other namespaces, so it is restored from its backup storage.
+		dataSource = &everestv1alpha1.DataSource{
+			BackupSource: &everestv1alpha1.BackupSource{
+				Path:              pointer.GetString(backup.Status.Destination),
+				BackupStorageName: backup.Spec.BackupStorageName,
+			},
+		}
+	}
+
+	db := &everestv1alpha1.DatabaseCluster{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: databaseClusterAPIVersion,
+			Kind:       "DatabaseCluster",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      map[string]string{backupVerificationLabel: "true"},
+			Annotations: map[string]string{verifiedBackupAnnotation: backup.Namespace + "/" + backup.Name},
+		},
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			// A single node is enough to check the backup.
+			AllowUnsafeConfiguration: true,
+			Engine: everestv1alpha1.Engine{
+				Type:     source.Spec.Engine.Type,
+				Version:  source.Spec.Engine.Version,
+				Replicas: 1,
+				// The storage should fit the restored data.
+				Storage: source.Spec.Engine.Storage,
+				Resources: everestv1alpha1.Resources{
+					CPU:    resource.MustParse(backupVerificationCPU),
+					Memory: resource.MustParse(backupVerificationMemory),
+				},
+				Config:          source.Spec.Engine.Config,
+				UserSecretsName: databaseClusterSecretName(name),
+			},
+			DataSource: dataSource,
+		},
+	}
+	if source.Spec.Proxy.Type != "" {
+		db.Spec.Proxy = everestv1alpha1.Proxy{Type: source.Spec.Proxy.Type, Replicas: pointer.ToInt32(1)}
+	}
+	return db
+}
+
+// RunBackupVerificationJob runs background job verifying the latest successful backups on the schedule.
+func (e *EverestServer) RunBackupVerificationJob(ctx context.Context) {
+	e.l.Debug("Starting backup verification job.")
+
+	ticker := time.NewTicker(backupVerificationInterval)
+	defer ticker.Stop()
+
+	// running is taken by the verification run in progress. The runs go on in the background,
+	// so a long run doesn't delay the schedule, and they don't overlap.
+	running := make(chan struct{}, 1)
+	last := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			e.verifyBackups(ctx, last, now, running)
+			last = now
+		}
+	}
+}
+
+func (e *EverestServer) verifyBackups(ctx context.Context, from, to time.Time, running chan struct{}) {
+	settings, err := e.backupVerificationSettings(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("failed to get backup verification settings")))
+		return
+	}
+	if settings == nil || !settings.Enabled {
+		return
+	}
+	sched, err := cron.ParseStandard(pointer.GetString(settings.Schedule))
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("failed to parse backup verification schedule")))
+		return
+	}
+	if lastActivation(sched, from.UTC(), to.UTC()).IsZero() {
+		return
+	}
+	select {
+	case running <- struct{}{}:
+	default:
+		e.l.Info("Skipping the scheduled backup verification, the previous one is still in progress")
+		return
+	}
+
+	backups, err := e.unverifiedBackups(ctx, settings.SandboxNamespace)
+	if err != nil {
+		<-running
+		e.l.Error(errors.Join(err, errors.New("failed to list backups to verify")))
+		return
+	}
+	go func() {
+		defer func() { <-running }()
+		e.verifyBackupsOneByOne(ctx, backups, settings.SandboxNamespace)
+	}()
+}
+
+// verifyBackupsOneByOne verifies the backups one by one to limit the resources used by the temporary database clusters.
+func (e *EverestServer) verifyBackupsOneByOne(ctx context.Context, backups []*everestv1alpha1.DatabaseClusterBackup, sandboxNamespace string) {
+	for _, backup := range backups {
+		verification, err := e.startBackupVerification(ctx, backup, sandboxNamespace, time.Now())
+		if err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("failed to start backup %s/%s verification", backup.Namespace, backup.Name)))
+			continue
+		}
+		vCtx, cancel := context.WithTimeout(ctx, backupVerificationTimeout)
+		e.runBackupVerification(vCtx, backup, verification)
+		cancel()
+	}
+}
+
+// unverifiedBackups returns the latest successful backup of every database cluster if it was never verified.
+func (e *EverestServer) unverifiedBackups(ctx context.Context, sandboxNamespace string) ([]*everestv1alpha1.DatabaseClusterBackup, error) {
+	namespaces, err := e.kubeClient.GetDBNamespaces(ctx, e.kubeClient.Namespace())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*everestv1alpha1.DatabaseClusterBackup
+	for _, namespace := range namespaces {
+		if namespace == sandboxNamespace {
+			continue
+		}
+		clusters, err := e.kubeClient.ListDatabaseClusters(ctx, namespace)
+		if err != nil {
+			return nil, err
+		}
+		backups, err := e.kubeClient.ListDatabaseClusterBackups(ctx, namespace, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, db := range clusters.Items {
+			own := make([]everestv1alpha1.DatabaseClusterBackup, 0, len(backups.Items))
+			for _, b := range backups.Items {
+				if b.Spec.DBClusterName == db.Name {
+					own = append(own, b)
+				}
+			}
+			latest := latestSuccessfulBackup(own, db.Spec.Engine.Type)
+			if latest == nil {
+				continue
+			}
+			if _, ok := latest.Annotations[backupVerificationAnnotation]; ok {
+				continue
+			}
+			result = append(result, latest)
+		}
+	}
+	return result, nil
+}
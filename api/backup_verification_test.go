@@ -0,0 +1,90 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestVerificationCluster(t *testing.T) {
+	t.Parallel()
+	source := &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "mysql", Namespace: "production"},
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{
+				Type:            everestv1alpha1.DatabaseEnginePXC,
+				Version:         "8.0.35-27.1",
+				Replicas:        3,
+				Storage:         everestv1alpha1.Storage{Size: resource.MustParse("100Gi"), Class: pointer.ToString("gp3")},
+				Resources:       everestv1alpha1.Resources{CPU: resource.MustParse("8"), Memory: resource.MustParse("32G")},
+				UserSecretsName: "everest-secrets-mysql",
+			},
+			Proxy: everestv1alpha1.Proxy{Type: everestv1alpha1.ProxyTypeHAProxy, Replicas: pointer.ToInt32(3)},
+		},
+	}
+	backup := &everestv1alpha1.DatabaseClusterBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "mysql-daily", Namespace: "production"},
+		Spec:       everestv1alpha1.DatabaseClusterBackupSpec{DBClusterName: "mysql", BackupStorageName: "s3"},
+		Status:     everestv1alpha1.DatabaseClusterBackupStatus{Destination: pointer.ToString("s3://bucket/mysql-daily")},
+	}
+	name := backupVerificationClusterName(backup)
+	assert.Regexp(t, `^verify-[0-9a-f]{10}$`, name)
+
+	db := verificationCluster(source, backup, "production", name)
+	assert.Equal(t, &everestv1alpha1.DataSource{DBClusterBackupName: "mysql-daily"}, db.Spec.DataSource)
+	assert.Equal(t, int32(1), db.Spec.Engine.Replicas)
+	assert.Equal(t, source.Spec.Engine.Storage, db.Spec.Engine.Storage)
+	assert.Equal(t, resource.MustParse(backupVerificationCPU), db.Spec.Engine.Resources.CPU)
+	assert.Equal(t, "everest-secrets-"+name, db.Spec.Engine.UserSecretsName)
+	assert.Equal(t, pointer.ToInt32(1), db.Spec.Proxy.Replicas)
+	assert.True(t, db.Spec.AllowUnsafeConfiguration)
+	assert.Equal(t, "true", db.Labels[backupVerificationLabel])
+
+	db = verificationCluster(source, backup, "sandbox", name)
+	assert.Equal(t, "sandbox", db.Namespace)
+	assert.Equal(t, &everestv1alpha1.DataSource{
+		BackupSource: &everestv1alpha1.BackupSource{Path: "s3://bucket/mysql-daily", BackupStorageName: "s3"},
+	}, db.Spec.DataSource)
+}
+
+func TestVerificationRunning(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+	assert.False(t, verificationRunning(nil, now))
+	assert.True(t, verificationRunning(&BackupVerification{StartedAt: now.Add(-time.Hour)}, now))
+	assert.False(t, verificationRunning(&BackupVerification{StartedAt: now.Add(-time.Hour), FinishedAt: &now}, now))
+	assert.False(t, verificationRunning(&BackupVerification{StartedAt: now.Add(-backupVerificationTimeout)}, now))
+}
+
+func TestValidateBackupVerificationSettings(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, validateBackupVerificationSettings(&BackupVerificationSettings{SandboxNamespace: "sandbox"}))
+	require.NoError(t, validateBackupVerificationSettings(&BackupVerificationSettings{
+		Enabled: true, SandboxNamespace: "sandbox", Schedule: pointer.ToString("0 3 * * 0"),
+	}))
+	require.ErrorIs(t, validateBackupVerificationSettings(&BackupVerificationSettings{Enabled: true, SandboxNamespace: "sandbox"}),
+		errBackupVerificationNoSchedule)
+	require.Error(t, validateBackupVerificationSettings(&BackupVerificationSettings{
+		SandboxNamespace: "sandbox", Schedule: pointer.ToString("every night"),
+	}))
+}
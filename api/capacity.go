@@ -0,0 +1,102 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const forceParam = "force"
+
+var errInsufficientResources = errors.New("not enough available resources in the Kubernetes cluster")
+
+// checkClusterCapacity checks the Kubernetes cluster has enough available resources for the database cluster.
+// For updates only the resources requested on top of the old database cluster are checked.
+// If force is set, a warning header is added to the response instead of returning an error.
+func (e *EverestServer) checkClusterCapacity(
+	ctx echo.Context,
+	dbc *DatabaseCluster,
+	oldDB *everestv1alpha1.DatabaseCluster,
+	force *bool,
+) error {
+	db := &everestv1alpha1.DatabaseCluster{}
+	if err := roundTrip(dbc, db); err != nil {
+		return err
+	}
+	requested := databaseClusterUsage(db)
+	if oldDB != nil {
+		requested.sub(databaseClusterUsage(oldDB))
+	}
+	if requested.cpu.Sign() <= 0 && requested.memory.Sign() <= 0 && requested.storage.Sign() <= 0 {
+		return nil
+	}
+
+	res, err := e.getKubernetesClusterResources(ctx)
+	if err != nil {
+		// The available resources are an estimation anyway, so we do not block
+		// the request if they cannot be calculated.
+		e.l.Error(errors.Join(err, errors.New("could not check the available resources")))
+		return nil
+	}
+
+	err = checkCapacity(requested, res)
+	if err == nil {
+		return nil
+	}
+	if !pointer.GetBool(force) {
+		return fmt.Errorf("%w. Set the %s parameter to proceed anyway", err, forceParam)
+	}
+	addWarningHeader(ctx, err.Error())
+	return nil
+}
+
+// checkCapacity checks the requested resources fit into the available resources of the Kubernetes cluster.
+// Resources with unknown capacity are not checked.
+func checkCapacity(requested resourceUsage, res *KubernetesClusterResources) error {
+	var missing []string
+	if res.Capacity.CpuMillis != nil && requested.cpu.Sign() > 0 {
+		available := pointer.GetUint64(res.Available.CpuMillis)
+		if uint64(requested.cpu.MilliValue()) > available {
+			missing = append(missing, fmt.Sprintf("requested CPU %s, available %s",
+				requested.cpu.String(), resource.NewMilliQuantity(int64(available), resource.DecimalSI).String()))
+		}
+	}
+	if res.Capacity.MemoryBytes != nil && requested.memory.Sign() > 0 {
+		available := pointer.GetUint64(res.Available.MemoryBytes)
+		if uint64(requested.memory.Value()) > available {
+			missing = append(missing, fmt.Sprintf("requested memory %s, available %s",
+				requested.memory.String(), resource.NewQuantity(int64(available), resource.DecimalSI).String()))
+		}
+	}
+	if res.Capacity.DiskSize != nil && requested.storage.Sign() > 0 {
+		available := pointer.GetUint64(res.Available.DiskSize)
+		if uint64(requested.storage.Value()) > available {
+			missing = append(missing, fmt.Sprintf("requested storage %s, available %s",
+				requested.storage.String(), resource.NewQuantity(int64(available), resource.DecimalSI).String()))
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", errInsufficientResources, strings.Join(missing, "; "))
+}
@@ -0,0 +1,90 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestCheckCapacity(t *testing.T) {
+	t.Parallel()
+	res := &KubernetesClusterResources{
+		Capacity: ResourcesCapacity{
+			CpuMillis:   pointer.ToUint64(8000),
+			MemoryBytes: pointer.ToUint64(16000000000),
+		},
+		Available: ResourcesAvailable{
+			CpuMillis:   pointer.ToUint64(2000),
+			MemoryBytes: pointer.ToUint64(4000000000),
+		},
+	}
+	cases := []struct {
+		name      string
+		requested resourceUsage
+		err       error
+	}{
+		{
+			name: "fits",
+			requested: resourceUsage{
+				cpu:     resource.MustParse("2"),
+				memory:  resource.MustParse("4G"),
+				storage: resource.MustParse("100G"),
+			},
+			err: nil,
+		},
+		{
+			name: "not enough cpu",
+			requested: resourceUsage{
+				cpu:    resource.MustParse("3"),
+				memory: resource.MustParse("1G"),
+			},
+			err: errors.New("not enough available resources in the Kubernetes cluster: requested CPU 3, available 2"),
+		},
+		{
+			name: "not enough cpu and memory",
+			requested: resourceUsage{
+				cpu:    resource.MustParse("2500m"),
+				memory: resource.MustParse("6G"),
+			},
+			err: errors.New("not enough available resources in the Kubernetes cluster: requested CPU 2500m, available 2; requested memory 6G, available 4G"),
+		},
+		{
+			name: "scale down is not checked",
+			requested: resourceUsage{
+				cpu: resource.MustParse("-3"),
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := checkCapacity(tc.requested, res)
+			if tc.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, errInsufficientResources)
+			assert.Equal(t, tc.err.Error(), err.Error())
+		})
+	}
+}
@@ -27,7 +27,11 @@ func (e *EverestServer) GetKubernetesClusterInfo(ctx echo.Context) error {
 	}
 	classNames := storageClasses(storagesList)
 
-	return ctx.JSON(http.StatusOK, &KubernetesClusterInfo{ClusterType: string(clusterType), StorageClassNames: classNames})
+	return ctx.JSON(http.StatusOK, &KubernetesClusterInfo{
+		ClusterType:       string(clusterType),
+		StorageClassNames: classNames,
+		StorageClasses:    storageClassInfos(storagesList),
+	})
 }
 
 func storageClasses(storagesList *storagev1.StorageClassList) []string {
@@ -43,3 +47,35 @@ func storageClasses(storagesList *storagev1.StorageClassList) []string {
 	}
 	return classNames
 }
+
+// storageClassInfos returns the capabilities of the storage classes with the default one first like storageClasses.
+func storageClassInfos(storagesList *storagev1.StorageClassList) []StorageClassInfo {
+	infos := make([]StorageClassInfo, len(storagesList.Items))
+	for i := range storagesList.Items {
+		storageClass := &storagesList.Items[i]
+		infos[i] = StorageClassInfo{
+			Name:                 storageClass.Name,
+			Default:              isDefaultStorageClass(storageClass),
+			AllowVolumeExpansion: pointer.GetBool(storageClass.AllowVolumeExpansion),
+		}
+		if infos[i].Default && i != 0 {
+			infos[0], infos[i] = infos[i], infos[0]
+		}
+	}
+	return infos
+}
+
+// defaultStorageClass returns the name of the default storage class or an empty string if there is none.
+func defaultStorageClass(storagesList *storagev1.StorageClassList) string {
+	for i := range storagesList.Items {
+		if isDefaultStorageClass(&storagesList.Items[i]) {
+			return storagesList.Items[i].Name
+		}
+	}
+	return ""
+}
+
+func isDefaultStorageClass(storageClass *storagev1.StorageClass) bool {
+	_, ok := storageClass.Annotations[annotationStorageClassDefault]
+	return ok
+}
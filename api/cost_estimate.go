@@ -0,0 +1,337 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/percona/percona-everest-backend/pkg/convertors"
+)
+
+const (
+	// priceTableConfigMapName is the name of the config map in the Everest namespace
+	// which stores the price table used to estimate the cost of database clusters.
+	priceTableConfigMapName = "everest-price-table"
+	priceTableKey           = "priceTable"
+
+	defaultCurrency = "USD"
+	hoursPerMonth   = 730
+	bytesPerGiB     = 1 << 30
+)
+
+var errPriceTableNotConfigured = errors.New("the price table is not configured")
+
+// GetPriceTable returns the price table used to estimate the cost of database clusters.
+func (e *EverestServer) GetPriceTable(ctx echo.Context) error {
+	prices, err := e.getPriceTable(ctx.Request().Context())
+	if err != nil {
+		return e.costErrorResponse(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, prices)
+}
+
+// UpdatePriceTable sets the price table used to estimate the cost of database clusters.
+func (e *EverestServer) UpdatePriceTable(ctx echo.Context) error {
+	prices := &PriceTable{}
+	if err := e.getBodyFromContext(ctx, prices); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get PriceTable from the request body"),
+		})
+	}
+	if err := validatePriceTable(prices); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if prices.Currency == nil {
+		prices.Currency = pointer.ToString(defaultCurrency)
+	}
+	if prices.StorageClassGiBMonth == nil {
+		prices.StorageClassGiBMonth = map[string]float64{}
+	}
+
+	data, err := json.Marshal(prices)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the price table")})
+	}
+	if err := e.kubeClient.SetConfigMapEntry(ctx.Request().Context(), priceTableConfigMapName, priceTableKey, string(data)); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the price table")})
+	}
+
+	return ctx.JSON(http.StatusOK, prices)
+}
+
+// EstimateDatabaseClusterCost estimates the monthly cost of the proposed database cluster.
+func (e *EverestServer) EstimateDatabaseClusterCost(ctx echo.Context) error {
+	dbc := &DatabaseCluster{}
+	if err := e.getBodyFromContext(ctx, dbc); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get DatabaseCluster from the request body"),
+		})
+	}
+	if dbc.Spec == nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(".spec cannot be empty")})
+	}
+	db := &everestv1alpha1.DatabaseCluster{}
+	if err := roundTrip(dbc, db); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Could not parse the database cluster")})
+	}
+
+	estimator, err := e.costEstimator(ctx.Request().Context())
+	if err != nil {
+		return e.costErrorResponse(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, estimator.databaseCluster(db))
+}
+
+// ListNamespaceCostEstimates estimates the monthly cost of the database clusters of all database namespaces.
+func (e *EverestServer) ListNamespaceCostEstimates(ctx echo.Context) error {
+	namespaces, err := e.kubeClient.GetDBNamespaces(ctx.Request().Context(), e.kubeClient.Namespace())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Failed getting watched namespaces"),
+		})
+	}
+	estimator, err := e.costEstimator(ctx.Request().Context())
+	if err != nil {
+		return e.costErrorResponse(ctx, err)
+	}
+
+	result := make(NamespaceCostEstimateList, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		clusters, err := e.kubeClient.ListDatabaseClusters(ctx.Request().Context(), namespace)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{
+				Message: pointer.ToString(fmt.Sprintf("Could not list the database clusters of namespace %s", namespace)),
+			})
+		}
+		result = append(result, estimator.namespace(namespace, clusters.Items))
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// GetNamespaceCostEstimate estimates the monthly cost of the database clusters of the specified namespace.
+func (e *EverestServer) GetNamespaceCostEstimate(ctx echo.Context, namespace string) error {
+	namespaces, err := e.kubeClient.GetDBNamespaces(ctx.Request().Context(), e.kubeClient.Namespace())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Failed getting watched namespaces"),
+		})
+	}
+	if err := validateAllowedNamespaces([]string{namespace}, namespaces); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	estimator, err := e.costEstimator(ctx.Request().Context())
+	if err != nil {
+		return e.costErrorResponse(ctx, err)
+	}
+
+	clusters, err := e.kubeClient.ListDatabaseClusters(ctx.Request().Context(), namespace)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not list the database clusters of the namespace"),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, estimator.namespace(namespace, clusters.Items))
+}
+
+func (e *EverestServer) costErrorResponse(ctx echo.Context, err error) error {
+	if errors.Is(err, errPriceTableNotConfigured) {
+		return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString(err.Error())})
+	}
+	e.l.Error(err)
+	return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the price table")})
+}
+
+func (e *EverestServer) getPriceTable(ctx context.Context) (*PriceTable, error) {
+	data, err := e.kubeClient.GetConfigMapData(ctx, priceTableConfigMapName)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := data[priceTableKey]
+	if !ok {
+		return nil, errPriceTableNotConfigured
+	}
+	prices := &PriceTable{}
+	if err := json.Unmarshal([]byte(v), prices); err != nil {
+		return nil, errors.Join(err, errors.New("could not parse the price table"))
+	}
+	return prices, nil
+}
+
+func (e *EverestServer) costEstimator(ctx context.Context) (*costEstimator, error) {
+	prices, err := e.getPriceTable(ctx)
+	if err != nil {
+		return nil, err
+	}
+	storageClasses, err := e.kubeClient.GetStorageClasses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &costEstimator{prices: prices, defaultStorageClass: defaultStorageClass(storageClasses)}, nil
+}
+
+func validatePriceTable(prices *PriceTable) error {
+	fields := map[string]float64{
+		"cpuHour":               prices.CpuHour,
+		"memoryGiBHour":         prices.MemoryGiBHour,
+		"backupStorageGiBMonth": pointer.GetFloat64(prices.BackupStorageGiBMonth),
+	}
+	for class, price := range prices.StorageClassGiBMonth {
+		fields[fmt.Sprintf("storageClassGiBMonth.%s", class)] = price
+	}
+	for field, price := range fields {
+		if price < 0 || math.IsNaN(price) || math.IsInf(price, 0) {
+			return fmt.Errorf("'%s' should be a non-negative number", field)
+		}
+	}
+	return nil
+}
+
+// costEstimator estimates the monthly cost of database clusters with the price table.
+type costEstimator struct {
+	prices              *PriceTable
+	defaultStorageClass string
+}
+
+func (c *costEstimator) currency() string {
+	if c.prices.Currency == nil {
+		return defaultCurrency
+	}
+	return *c.prices.Currency
+}
+
+// namespace estimates the monthly cost of the database clusters of the namespace.
+func (c *costEstimator) namespace(namespace string, dbs []everestv1alpha1.DatabaseCluster) NamespaceCostEstimate {
+	result := NamespaceCostEstimate{
+		Namespace: namespace,
+		Currency:  c.currency(),
+		Clusters:  make([]DatabaseClusterCostEstimate, 0, len(dbs)),
+	}
+	for _, db := range dbs {
+		db := db
+		estimate := c.databaseCluster(&db)
+		result.Clusters = append(result.Clusters, estimate)
+		result.Monthly.Cpu += estimate.Monthly.Cpu
+		result.Monthly.Memory += estimate.Monthly.Memory
+		result.Monthly.Storage += estimate.Monthly.Storage
+		result.Monthly.BackupStorage += estimate.Monthly.BackupStorage
+	}
+	result.Monthly = roundCost(result.Monthly)
+	return result
+}
+
+// databaseCluster estimates the monthly cost of the database cluster including its proxies and backups.
+func (c *costEstimator) databaseCluster(db *everestv1alpha1.DatabaseCluster) DatabaseClusterCostEstimate {
+	var warnings []string
+	cpu := func(q resource.Quantity, replicas int32) float64 {
+		millis, err := convertors.StrToMilliCPU(q.String())
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("could not parse CPU '%s': %s", q.String(), err))
+		}
+		return float64(millis) / 1000 * float64(replicas)
+	}
+	gib := func(q resource.Quantity, replicas int32) float64 {
+		bytes, err := convertors.StrToBytes(q.String())
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("could not parse size '%s': %s", q.String(), err))
+		}
+		return float64(bytes) / bytesPerGiB * float64(replicas)
+	}
+
+	engine := db.Spec.Engine
+	cost := CostBreakdown{}
+	// Paused database clusters keep their storage only.
+	if !db.Spec.Paused {
+		cpus := cpu(engine.Resources.CPU, engine.Replicas)
+		memory := gib(engine.Resources.Memory, engine.Replicas)
+		if proxy := db.Spec.Proxy; proxy.Replicas != nil {
+			cpus += cpu(proxy.Resources.CPU, *proxy.Replicas)
+			memory += gib(proxy.Resources.Memory, *proxy.Replicas)
+		}
+		cost.Cpu = cpus * c.prices.CpuHour * hoursPerMonth
+		cost.Memory = memory * c.prices.MemoryGiBHour * hoursPerMonth
+	}
+
+	storageClass := pointer.GetString(engine.Storage.Class)
+	if storageClass == "" {
+		storageClass = c.defaultStorageClass
+	}
+	if price, ok := c.prices.StorageClassGiBMonth[storageClass]; ok {
+		cost.Storage = gib(engine.Storage.Size, engine.Replicas) * price
+	} else {
+		warnings = append(warnings, fmt.Sprintf("storage class '%s' has no price, its storage is not included", storageClass))
+	}
+
+	if db.Spec.Backup.Enabled && c.prices.BackupStorageGiBMonth != nil {
+		// Every retained backup is assumed to be as large as the storage of one node.
+		var copies int32
+		for _, schedule := range db.Spec.Backup.Schedules {
+			if !schedule.Enabled {
+				continue
+			}
+			if schedule.RetentionCopies == 0 {
+				warnings = append(warnings, fmt.Sprintf("backup schedule '%s' retains all backups, one copy is included", schedule.Name))
+			}
+			copies += max(schedule.RetentionCopies, 1)
+		}
+		cost.BackupStorage = gib(engine.Storage.Size, copies) * *c.prices.BackupStorageGiBMonth
+	}
+
+	result := DatabaseClusterCostEstimate{
+		Name:     db.Name,
+		Currency: c.currency(),
+		Monthly:  roundCost(cost),
+	}
+	if len(warnings) != 0 {
+		result.Warnings = &warnings
+	}
+	return result
+}
+
+// roundCost rounds the costs to cents and sums them up.
+func roundCost(cost CostBreakdown) CostBreakdown {
+	round := func(v float64) float64 {
+		return math.Round(v*100) / 100 //nolint:gomnd
+	}
+	cost.Cpu = round(cost.Cpu)
+	cost.Memory = round(cost.Memory)
+	cost.Storage = round(cost.Storage)
+	cost.BackupStorage = round(cost.BackupStorage)
+	cost.Total = round(cost.Cpu + cost.Memory + cost.Storage + cost.BackupStorage)
+	return cost
+}
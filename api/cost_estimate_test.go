@@ -0,0 +1,104 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCostEstimator(t *testing.T) {
+	t.Parallel()
+	estimator := &costEstimator{
+		prices: &PriceTable{
+			Currency:              pointer.ToString("EUR"),
+			CpuHour:               0.04,
+			MemoryGiBHour:         0.005,
+			StorageClassGiBMonth:  map[string]float64{"standard": 0.1},
+			BackupStorageGiBMonth: pointer.ToFloat64(0.02),
+		},
+		defaultStorageClass: "standard",
+	}
+	db := everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "mysql"},
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{
+				Type:     everestv1alpha1.DatabaseEnginePXC,
+				Replicas: 3,
+				Resources: everestv1alpha1.Resources{
+					CPU:    resource.MustParse("1"),
+					Memory: resource.MustParse("2Gi"),
+				},
+				Storage: everestv1alpha1.Storage{Size: resource.MustParse("10Gi")},
+			},
+			Proxy: everestv1alpha1.Proxy{
+				Replicas: pointer.ToInt32(2),
+				Resources: everestv1alpha1.Resources{
+					CPU:    resource.MustParse("500m"),
+					Memory: resource.MustParse("1Gi"),
+				},
+			},
+			Backup: everestv1alpha1.Backup{
+				Enabled: true,
+				Schedules: []everestv1alpha1.BackupSchedule{
+					{Name: "daily", Enabled: true, RetentionCopies: 7},
+					{Name: "weekly", Enabled: true},
+					{Name: "hourly", RetentionCopies: 24},
+				},
+			},
+		},
+	}
+
+	estimate := estimator.databaseCluster(&db)
+	assert.Equal(t, DatabaseClusterCostEstimate{
+		Name:     "mysql",
+		Currency: "EUR",
+		Monthly: CostBreakdown{
+			Cpu:           116.8,
+			Memory:        29.2,
+			Storage:       3,
+			BackupStorage: 1.6,
+			Total:         150.6,
+		},
+		Warnings: &[]string{"backup schedule 'weekly' retains all backups, one copy is included"},
+	}, estimate)
+
+	paused := *db.DeepCopy()
+	paused.Name = "paused"
+	paused.Spec.Paused = true
+	paused.Spec.Backup.Enabled = false
+	paused.Spec.Engine.Storage.Class = pointer.ToString("fast")
+
+	namespace := estimator.namespace("production", []everestv1alpha1.DatabaseCluster{db, paused})
+	require.Len(t, namespace.Clusters, 2)
+	assert.Equal(t, CostBreakdown{}, namespace.Clusters[1].Monthly)
+	assert.Equal(t, &[]string{"storage class 'fast' has no price, its storage is not included"}, namespace.Clusters[1].Warnings)
+	assert.Equal(t, estimate.Monthly, namespace.Monthly)
+}
+
+func TestValidatePriceTable(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, validatePriceTable(&PriceTable{CpuHour: 0.04, StorageClassGiBMonth: map[string]float64{"standard": 0}}))
+	require.EqualError(t, validatePriceTable(&PriceTable{MemoryGiBHour: -1}), "'memoryGiBHour' should be a non-negative number")
+	require.EqualError(t,
+		validatePriceTable(&PriceTable{StorageClassGiBMonth: map[string]float64{"gp3": -0.08}}),
+		"'storageClassGiBMonth.gp3' should be a non-negative number")
+}
@@ -17,7 +17,9 @@
 package api
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"slices"
 	"time"
@@ -33,7 +35,7 @@ const (
 )
 
 // CreateDatabaseCluster creates a new db cluster inside the given k8s cluster.
-func (e *EverestServer) CreateDatabaseCluster(ctx echo.Context, namespace string) error {
+func (e *EverestServer) CreateDatabaseCluster(ctx echo.Context, namespace string, params CreateDatabaseClusterParams) error {
 	dbc := &DatabaseCluster{}
 	if err := e.getBodyFromContext(ctx, dbc); err != nil {
 		e.l.Error(err)
@@ -42,10 +44,17 @@ func (e *EverestServer) CreateDatabaseCluster(ctx echo.Context, namespace string
 		})
 	}
 
-	if err := e.validateDatabaseClusterCR(ctx, namespace, dbc); err != nil {
+	if err := e.validateDatabaseClusterCR(ctx, namespace, dbc, nil); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := e.enforceNamespaceQuota(ctx.Request().Context(), namespace, dbc); err != nil {
+		return e.quotaErrorResponse(ctx, err)
+	}
+	if err := e.checkClusterCapacity(ctx, dbc, nil, params.Force); err != nil {
 		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
 	}
 
+	removeQueryParams(ctx.Request(), forceParam)
 	return e.proxyKubernetes(ctx, namespace, databaseClusterKind, "")
 }
 
@@ -55,8 +64,51 @@ func (e *EverestServer) ListDatabaseClusters(ctx echo.Context, namespace string)
 }
 
 // DeleteDatabaseCluster deletes a database cluster on the specified kubernetes cluster.
-func (e *EverestServer) DeleteDatabaseCluster(ctx echo.Context, namespace, name string) error {
-	return e.proxyKubernetes(ctx, namespace, databaseClusterKind, name)
+func (e *EverestServer) DeleteDatabaseCluster(ctx echo.Context, namespace, name string, params DeleteDatabaseClusterParams) error {
+	dp, err := e.databaseClusterDeletionProtection(ctx.Request().Context(), namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the deletion protection")})
+	}
+	if err := checkDeletionAllowed(dp, name, params.ConfirmName); err != nil {
+		if errors.Is(err, errDeletionProtected) {
+			return ctx.JSON(http.StatusLocked, Error{Message: pointer.ToString(err.Error())})
+		}
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	if pointer.GetBool(params.FinalBackup) {
+		return e.deleteWithFinalBackup(ctx, namespace, name, pointer.GetString(params.FinalBackupStorageName))
+	}
+
+	removeQueryParams(ctx.Request(), confirmNameParam, finalBackupParam, finalBackupStorageNameParam)
+	if err := e.proxyKubernetes(ctx, namespace, databaseClusterKind, name); err != nil {
+		return err
+	}
+	if ctx.Response().Status < http.StatusMultipleChoices {
+		if err := e.deleteDatabaseClusterSettings(ctx.Request().Context(), namespace, name); err != nil {
+			e.l.Error(errors.Join(err, errors.New("could not delete the settings of the deleted database cluster")))
+		}
+	}
+	return nil
+}
+
+// deleteDatabaseClusterSettings deletes the per database cluster settings, so the background
+// jobs stop acting on the deleted database cluster and a new one with the same name does not
+// inherit them.
+func (e *EverestServer) deleteDatabaseClusterSettings(ctx context.Context, namespace, name string) error {
+	var errs []error
+	for _, cm := range []string{
+		deletionProtectionConfigMapName,
+		powerSchedulesConfigMapName,
+		backupRetentionConfigMapName,
+		backupReplicationConfigMapName,
+	} {
+		if err := e.kubeClient.DeleteConfigMapEntry(ctx, cm, dbClusterEntryKey(namespace, name)); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete the entry of %s: %w", cm, err))
+		}
+	}
+	return errors.Join(errs...)
 }
 
 // GetDatabaseCluster retrieves the specified database cluster on the specified kubernetes cluster.
@@ -65,7 +117,7 @@ func (e *EverestServer) GetDatabaseCluster(ctx echo.Context, namespace, name str
 }
 
 // UpdateDatabaseCluster replaces the specified database cluster on the specified kubernetes cluster.
-func (e *EverestServer) UpdateDatabaseCluster(ctx echo.Context, namespace, name string) error {
+func (e *EverestServer) UpdateDatabaseCluster(ctx echo.Context, namespace, name string, params UpdateDatabaseClusterParams) error {
 	dbc := &DatabaseCluster{}
 	if err := e.getBodyFromContext(ctx, dbc); err != nil {
 		e.l.Error(err)
@@ -74,18 +126,27 @@ func (e *EverestServer) UpdateDatabaseCluster(ctx echo.Context, namespace, name
 		})
 	}
 
-	if err := e.validateDatabaseClusterCR(ctx, namespace, dbc); err != nil {
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
-	}
-
 	oldDB, err := e.kubeClient.GetDatabaseCluster(ctx.Request().Context(), namespace, name)
 	if err != nil {
 		return errors.Join(err, errors.New("could not get old Database Cluster"))
 	}
+	if err := e.validateDatabaseClusterCR(ctx, namespace, dbc, oldDB); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
 	if err := validateDatabaseClusterOnUpdate(dbc, oldDB); err != nil {
 		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
 	}
+	if err := e.validateStorageResize(ctx.Request().Context(), dbc, oldDB); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := e.enforceNamespaceQuota(ctx.Request().Context(), namespace, dbc); err != nil {
+		return e.quotaErrorResponse(ctx, err)
+	}
+	if err := e.checkClusterCapacity(ctx, dbc, oldDB, params.Force); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
 
+	removeQueryParams(ctx.Request(), forceParam)
 	return e.proxyKubernetes(ctx, namespace, databaseClusterKind, name)
 }
 
@@ -101,22 +162,31 @@ func (e *EverestServer) GetDatabaseClusterCredentials(ctx echo.Context, namespac
 		e.l.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
 	}
+	response, ok := databaseClusterCredentials(databaseCluster.Spec.Engine.Type, secret.Data)
+	if !ok {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Unsupported database engine")})
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// databaseClusterCredentials returns the credentials of the admin user stored in the user secret of the database cluster.
+func databaseClusterCredentials(engineType everestv1alpha1.EngineType, data map[string][]byte) (*DatabaseClusterCredential, bool) {
 	response := &DatabaseClusterCredential{}
-	switch databaseCluster.Spec.Engine.Type {
+	switch engineType {
 	case everestv1alpha1.DatabaseEnginePXC:
 		response.Username = pointer.ToString("root")
-		response.Password = pointer.ToString(string(secret.Data["root"]))
+		response.Password = pointer.ToString(string(data["root"]))
 	case everestv1alpha1.DatabaseEnginePSMDB:
-		response.Username = pointer.ToString(string(secret.Data["MONGODB_DATABASE_ADMIN_USER"]))
-		response.Password = pointer.ToString(string(secret.Data["MONGODB_DATABASE_ADMIN_PASSWORD"]))
+		response.Username = pointer.ToString(string(data["MONGODB_DATABASE_ADMIN_USER"]))
+		response.Password = pointer.ToString(string(data["MONGODB_DATABASE_ADMIN_PASSWORD"]))
 	case everestv1alpha1.DatabaseEnginePostgresql:
 		response.Username = pointer.ToString("postgres")
-		response.Password = pointer.ToString(string(secret.Data["password"]))
+		response.Password = pointer.ToString(string(data["password"]))
 	default:
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Unsupported database engine")})
+		return nil, false
 	}
-
-	return ctx.JSON(http.StatusOK, response)
+	return response, true
 }
 
 // GetDatabaseClusterPitr returns the point-in-time recovery related information for the specified database cluster.
@@ -149,20 +219,45 @@ func (e *EverestServer) GetDatabaseClusterPitr(ctx echo.Context, namespace, name
 	}
 
 	latestBackup := latestSuccessfulBackup(backups.Items, databaseCluster.Spec.Engine.Type)
+	ranges, err := e.databaseClusterPitrRanges(ctx.Request().Context(), databaseCluster, backups.Items)
+	if err != nil {
+		// the ranges are unknown, so the recoverable dates are estimated from the latest backup
+		e.l.Error(errors.Join(err, errors.New("could not list the point-in-time recovery logs")))
+		estimatePitr(response, databaseCluster, latestBackup)
+		return ctx.JSON(http.StatusOK, response)
+	}
+	response.Ranges = &ranges
+	if len(ranges) > 0 {
+		latest := ranges[len(ranges)-1]
+		response.EarliestDate = &latest.EarliestDate
+		response.LatestDate = &latest.LatestDate
+	}
 
-	uploadInterval := getDefaultUploadInterval(databaseCluster.Spec.Engine.Type)
-	now := time.Now()
-	// delete nanoseconds since they're not accepted by restoration
-	now = now.Truncate(time.Duration(now.Nanosecond()) * time.Nanosecond)
-	// heuristic: latest restorable date is now minus uploadInterval
-	latest := now.Truncate(time.Duration(uploadInterval) * time.Second).UTC()
-	response.LatestDate = &latest
-	earliest := latestBackup.Status.CreatedAt.UTC()
+	if latestBackup != nil {
+		response.LatestBackupName = &latestBackup.Name
+		// the logs are missing after the latest backup if no range covers it
+		completedAt := backupCompletedAt(*latestBackup)
+		gaps := latestBackup.Status.Gaps || !slices.ContainsFunc(ranges, func(r DatabaseClusterPitrRange) bool {
+			return r.BackupName == latestBackup.Name || pitrRangeContains([]DatabaseClusterPitrRange{r}, completedAt)
+		})
+		response.Gaps = &gaps
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// estimatePitr fills the point-in-time recovery information which does not depend on the stored logs.
+// The latest recoverable date is estimated to be the last upload of the logs.
+func estimatePitr(response *DatabaseClusterPitr, db *everestv1alpha1.DatabaseCluster, latestBackup *everestv1alpha1.DatabaseClusterBackup) {
+	if latestBackup == nil {
+		return
+	}
+	latest := time.Now().Truncate(pitrUploadInterval(db)).UTC()
+	earliest := backupCompletedAt(*latestBackup).UTC()
 	response.EarliestDate = &earliest
+	response.LatestDate = &latest
 	response.LatestBackupName = &latestBackup.Name
 	response.Gaps = &latestBackup.Status.Gaps
-
-	return ctx.JSON(http.StatusOK, response)
 }
 
 func latestSuccessfulBackup(backups []everestv1alpha1.DatabaseClusterBackup, engineType everestv1alpha1.EngineType) *everestv1alpha1.DatabaseClusterBackup {
@@ -189,16 +284,32 @@ func sortFunc(a, b everestv1alpha1.DatabaseClusterBackup) int {
 }
 
 func successStatus(state everestv1alpha1.BackupState, engineType everestv1alpha1.EngineType) bool {
-	var successState string
+	return state == successState(engineType)
+}
+
+func successState(engineType everestv1alpha1.EngineType) everestv1alpha1.BackupState {
+	switch engineType {
+	case everestv1alpha1.DatabaseEnginePXC:
+		return "Succeeded"
+	case everestv1alpha1.DatabaseEnginePSMDB:
+		return "ready"
+	case everestv1alpha1.DatabaseEnginePostgresql:
+		return "Succeeded"
+	}
+	return ""
+}
+
+func failedStatus(state everestv1alpha1.BackupState, engineType everestv1alpha1.EngineType) bool {
+	var failedState string
 	switch engineType {
 	case everestv1alpha1.DatabaseEnginePXC:
-		successState = "Succeeded"
+		failedState = "Failed"
 	case everestv1alpha1.DatabaseEnginePSMDB:
-		successState = "ready"
+		failedState = "error"
 	case everestv1alpha1.DatabaseEnginePostgresql:
-		successState = "Succeeded"
+		failedState = "Failed"
 	}
-	return string(state) == successState
+	return string(state) == failedState
 }
 
 func getDefaultUploadInterval(engineType everestv1alpha1.EngineType) int {
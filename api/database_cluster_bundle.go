@@ -0,0 +1,292 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	databaseClusterBundleVersion = "v1"
+	lastAppliedAnnotation        = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+var (
+	errUnsupportedBundleVersion = fmt.Errorf("unsupported bundle version. Only %s is supported", databaseClusterBundleVersion)
+	errBundleSecretName         = errors.New("credentials.secretName should match spec.engine.userSecretsName of the database cluster")
+)
+
+// ExportDatabaseCluster returns a portable bundle with the definition of the specified database cluster.
+func (e *EverestServer) ExportDatabaseCluster(
+	ctx echo.Context,
+	namespace, name string,
+	params ExportDatabaseClusterParams,
+) error {
+	reqCtx := ctx.Request().Context()
+	db, err := e.kubeClient.GetDatabaseCluster(reqCtx, namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	bundle, err := exportDatabaseCluster(db)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not export database cluster")})
+	}
+	if pointer.GetBool(params.IncludeCredentials) {
+		secret, err := e.kubeClient.GetSecret(reqCtx, namespace, db.Spec.Engine.UserSecretsName)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{
+				Message: pointer.ToString("Could not get the credentials of the database cluster"),
+			})
+		}
+		bundle.Credentials = &DatabaseClusterBundleCredentials{SecretName: secret.Name, Data: make(map[string]string, len(secret.Data))}
+		for k, v := range secret.Data {
+			bundle.Credentials.Data[k] = string(v)
+		}
+	}
+	if bundle.PowerSchedule, err = e.getPowerSchedule(reqCtx, namespace, name); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get power schedules")})
+	}
+
+	if params.Format != nil && *params.Format == Yaml {
+		data, err := yaml.Marshal(bundle)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not export database cluster")})
+		}
+		ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", name+".yaml"))
+		return ctx.Blob(http.StatusOK, "application/yaml", data)
+	}
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", name+".json"))
+	return ctx.JSON(http.StatusOK, bundle)
+}
+
+// ImportDatabaseCluster creates a database cluster from an exported bundle.
+func (e *EverestServer) ImportDatabaseCluster( //nolint:cyclop
+	ctx echo.Context,
+	namespace string,
+	params ImportDatabaseClusterParams,
+) error {
+	bundle, err := readDatabaseClusterBundle(ctx.Request())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get DatabaseClusterBundle from the request body"),
+		})
+	}
+	dbc, err := importedDatabaseCluster(bundle, namespace)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := e.validateDatabaseClusterCR(ctx, namespace, dbc, nil); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if bundle.PowerSchedule != nil {
+		if err := validatePowerSchedule(bundle.PowerSchedule); err != nil {
+			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		}
+	}
+	if err := e.enforceNamespaceQuota(ctx.Request().Context(), namespace, dbc); err != nil {
+		return e.quotaErrorResponse(ctx, err)
+	}
+	if err := e.checkClusterCapacity(ctx, dbc, nil, params.Force); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	reqCtx := ctx.Request().Context()
+	// The secret is created first, so the operator does not generate new credentials.
+	if bundle.Credentials != nil {
+		if _, err := e.kubeClient.CreateSecret(reqCtx, bundleSecret(bundle.Credentials, namespace)); err != nil {
+			e.l.Error(err)
+			if k8serrors.IsAlreadyExists(err) {
+				return ctx.JSON(http.StatusConflict, Error{
+					Message: pointer.ToString(fmt.Sprintf("Secret %s already exists", bundle.Credentials.SecretName)),
+				})
+			}
+			return ctx.JSON(http.StatusInternalServerError, Error{
+				Message: pointer.ToString("Could not create the credentials secret"),
+			})
+		}
+	}
+
+	created, err := e.createDatabaseClusterCR(reqCtx, dbc)
+	if err != nil {
+		if bundle.Credentials != nil {
+			if err := e.kubeClient.DeleteSecret(reqCtx, namespace, bundle.Credentials.SecretName); err != nil {
+				e.l.Error(errors.Join(err, errors.New("could not clean up the credentials secret")))
+			}
+		}
+		return e.createDatabaseClusterErrorResponse(ctx, err)
+	}
+
+	if bundle.PowerSchedule != nil {
+		bundle.PowerSchedule.Status = nil
+		if err := e.savePowerSchedule(reqCtx, namespace, created.Name, bundle.PowerSchedule); err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{
+				Message: pointer.ToString("The database cluster is created but its power schedule could not be saved"),
+			})
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, created)
+}
+
+// getPowerSchedule returns the power schedule of the database cluster or nil if it has none.
+func (e *EverestServer) getPowerSchedule(ctx context.Context, namespace, name string) (*PowerSchedule, error) {
+	schedules, err := e.kubeClient.GetConfigMapData(ctx, powerSchedulesConfigMapName)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := schedules[dbClusterEntryKey(namespace, name)]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+	ps := &PowerSchedule{}
+	if err := json.Unmarshal([]byte(data), ps); err != nil {
+		return nil, err
+	}
+	ps.Status = nil
+	return ps, nil
+}
+
+// exportDatabaseCluster returns the bundle of the database cluster without the status,
+// the namespace and the other fields specific to the Kubernetes cluster it runs in.
+func exportDatabaseCluster(db *everestv1alpha1.DatabaseCluster) (*DatabaseClusterBundle, error) {
+	bundle := &DatabaseClusterBundle{
+		Version: databaseClusterBundleVersion,
+		DatabaseCluster: DatabaseCluster{
+			ApiVersion: pointer.ToString(databaseClusterAPIVersion),
+			Kind:       pointer.ToString(databaseClusterKindName),
+			Metadata:   pointer.To(portableMetadata(db.Name, db.Labels, db.Annotations)),
+		},
+	}
+
+	spec := db.Spec.DeepCopy()
+	// The data source refers to backups of the original installation
+	// and is only used when the database cluster is created.
+	spec.DataSource = nil
+	if err := roundTrip(spec, &bundle.DatabaseCluster.Spec); err != nil {
+		return nil, err
+	}
+
+	refs := &DatabaseClusterBundleReferences{}
+	storages := backupStorageNames(db)
+	slices.Sort(storages)
+	if storages = slices.Compact(storages); len(storages) > 0 {
+		refs.BackupStorages = &storages
+	}
+	if spec.Monitoring != nil && spec.Monitoring.MonitoringConfigName != "" {
+		refs.MonitoringConfig = pointer.ToString(spec.Monitoring.MonitoringConfigName)
+	}
+	if refs.BackupStorages != nil || refs.MonitoringConfig != nil {
+		bundle.References = refs
+	}
+	return bundle, nil
+}
+
+// importedDatabaseCluster returns the database cluster of the bundle to be created in the namespace.
+func importedDatabaseCluster(bundle *DatabaseClusterBundle, namespace string) (*DatabaseCluster, error) {
+	if bundle.Version != databaseClusterBundleVersion {
+		return nil, errUnsupportedBundleVersion
+	}
+	dbc := bundle.DatabaseCluster
+	if dbc.Metadata == nil {
+		return nil, errDBCEmptyMetadata
+	}
+	meta := &metav1.ObjectMeta{}
+	if err := roundTrip(dbc.Metadata, meta); err != nil {
+		return nil, errors.Join(err, errors.New("invalid databaseCluster's metadata"))
+	}
+	md := portableMetadata(meta.Name, meta.Labels, meta.Annotations)
+	md["namespace"] = namespace
+	dbc.Metadata = &md
+	dbc.ApiVersion = pointer.ToString(databaseClusterAPIVersion)
+	dbc.Kind = pointer.ToString(databaseClusterKindName)
+	dbc.Status = nil
+
+	if bundle.Credentials != nil && (dbc.Spec == nil || dbc.Spec.Engine.UserSecretsName == nil ||
+		*dbc.Spec.Engine.UserSecretsName != bundle.Credentials.SecretName) {
+		return nil, errBundleSecretName
+	}
+	return &dbc, nil
+}
+
+func portableMetadata(name string, labels, annotations map[string]string) map[string]interface{} {
+	md := map[string]interface{}{"name": name}
+	if len(labels) > 0 {
+		md["labels"] = labels
+	}
+	annotations = maps.Clone(annotations)
+	delete(annotations, lastAppliedAnnotation)
+	if len(annotations) > 0 {
+		md["annotations"] = annotations
+	}
+	return md
+}
+
+func bundleSecret(c *DatabaseClusterBundleCredentials, namespace string) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: c.SecretName, Namespace: namespace},
+		Type:       corev1.SecretTypeOpaque,
+		Data:       make(map[string][]byte, len(c.Data)),
+	}
+	for k, v := range c.Data {
+		secret.Data[k] = []byte(v)
+	}
+	return secret
+}
+
+// readDatabaseClusterBundle reads the bundle from the request body in JSON or YAML.
+func readDatabaseClusterBundle(req *http.Request) (*DatabaseClusterBundle, error) {
+	// GetBody creates a copy of the body since the original one is read by the validation middleware.
+	reader, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	bundle := &DatabaseClusterBundle{}
+	// JSON is a subset of YAML, so both are parsed the same way.
+	if err := yaml.Unmarshal(data, bundle); err != nil {
+		return nil, errors.Join(err, errors.New("could not decode body"))
+	}
+	return bundle, nil
+}
@@ -0,0 +1,89 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func TestExportImportDatabaseCluster(t *testing.T) {
+	t.Parallel()
+	db := &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "mysql",
+			Namespace:       "production",
+			ResourceVersion: "12345",
+			Labels:          map[string]string{"team": "payments"},
+			Annotations:     map[string]string{lastAppliedAnnotation: "{}"},
+		},
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{
+				Type:            everestv1alpha1.DatabaseEnginePXC,
+				Replicas:        3,
+				UserSecretsName: "everest-secrets-mysql",
+			},
+			Backup: everestv1alpha1.Backup{
+				Enabled: true,
+				Schedules: []everestv1alpha1.BackupSchedule{
+					{Name: "daily", BackupStorageName: "s3"},
+					{Name: "hourly", BackupStorageName: "s3"},
+				},
+				PITR: everestv1alpha1.PITRSpec{Enabled: true, BackupStorageName: pointer.ToString("s3-pitr")},
+			},
+			Monitoring: &everestv1alpha1.Monitoring{MonitoringConfigName: "pmm"},
+			DataSource: &everestv1alpha1.DataSource{DBClusterBackupName: "backup-1"},
+		},
+		Status: everestv1alpha1.DatabaseClusterStatus{Status: everestv1alpha1.AppStateReady},
+	}
+
+	bundle, err := exportDatabaseCluster(db)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":   "mysql",
+		"labels": map[string]string{"team": "payments"},
+	}, *bundle.DatabaseCluster.Metadata)
+	assert.Nil(t, bundle.DatabaseCluster.Status)
+	assert.Nil(t, bundle.DatabaseCluster.Spec.DataSource)
+	assert.Equal(t, []string{"s3", "s3-pitr"}, *bundle.References.BackupStorages)
+	assert.Equal(t, "pmm", *bundle.References.MonitoringConfig)
+
+	// The bundle is imported from the exported YAML.
+	bundle.Credentials = &DatabaseClusterBundleCredentials{SecretName: "everest-secrets-mysql", Data: map[string]string{"root": "secret"}}
+	data, err := yaml.Marshal(bundle)
+	require.NoError(t, err)
+	imported := &DatabaseClusterBundle{}
+	require.NoError(t, yaml.Unmarshal(data, imported))
+
+	dbc, err := importedDatabaseCluster(imported, "staging")
+	require.NoError(t, err)
+	assert.Equal(t, "staging", (*dbc.Metadata)["namespace"])
+	assert.Equal(t, "mysql", (*dbc.Metadata)["name"])
+	assert.Equal(t, int32(3), *dbc.Spec.Engine.Replicas)
+
+	imported.Credentials.SecretName = "other"
+	_, err = importedDatabaseCluster(imported, "staging")
+	require.ErrorIs(t, err, errBundleSecretName)
+
+	imported.Version = "v2"
+	_, err = importedDatabaseCluster(imported, "staging")
+	require.ErrorIs(t, err, errUnsupportedBundleVersion)
+}
@@ -0,0 +1,219 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// upstreamKinds are the kinds of the objects the Everest operator creates for
+// database clusters, backups and restores of every engine. They have the same
+// names as the Everest objects.
+var upstreamKinds = map[everestv1alpha1.EngineType]struct{ cluster, backup, restore string }{ //nolint:gochecknoglobals
+	everestv1alpha1.DatabaseEnginePXC: {
+		cluster: "PerconaXtraDBCluster", backup: "PerconaXtraDBClusterBackup", restore: "PerconaXtraDBClusterRestore",
+	},
+	everestv1alpha1.DatabaseEnginePSMDB: {
+		cluster: "PerconaServerMongoDB", backup: "PerconaServerMongoDBBackup", restore: "PerconaServerMongoDBRestore",
+	},
+	everestv1alpha1.DatabaseEnginePostgresql: {
+		cluster: "PerconaPGCluster", backup: "PerconaPGBackup", restore: "PerconaPGRestore",
+	},
+}
+
+// eventObject identifies an object the events are reported for.
+type eventObject struct {
+	kind string
+	name string
+}
+
+// GetDatabaseClusterEvents returns the timeline of the events of the specified database cluster and its components.
+func (e *EverestServer) GetDatabaseClusterEvents(ctx echo.Context, namespace, name string) error { //nolint:funlen
+	reqCtx := ctx.Request().Context()
+	db, err := e.kubeClient.GetDatabaseCluster(reqCtx, namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	objects := map[eventObject]struct{}{{kind: "DatabaseCluster", name: db.Name}: {}}
+	upstream := upstreamKinds[db.Spec.Engine.Type]
+	objects[eventObject{kind: upstream.cluster, name: db.Name}] = struct{}{}
+
+	options := metav1.ListOptions{LabelSelector: fmt.Sprintf("clusterName=%s", db.Name)}
+	backups, err := e.kubeClient.ListDatabaseClusterBackups(reqCtx, namespace, options)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster backups")})
+	}
+	for _, b := range backups.Items {
+		objects[eventObject{kind: "DatabaseClusterBackup", name: b.Name}] = struct{}{}
+		objects[eventObject{kind: upstream.backup, name: b.Name}] = struct{}{}
+	}
+	restores, err := e.kubeClient.ListDatabaseClusterRestores(reqCtx, namespace, options)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster restores")})
+	}
+	for _, r := range restores.Items {
+		objects[eventObject{kind: "DatabaseClusterRestore", name: r.Name}] = struct{}{}
+		objects[eventObject{kind: upstream.restore, name: r.Name}] = struct{}{}
+	}
+
+	selector := databaseClusterLabelSelector(db)
+	pods, err := e.kubeClient.GetPods(reqCtx, namespace, selector)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster pods")})
+	}
+	for _, p := range pods.Items {
+		objects[eventObject{kind: "Pod", name: p.Name}] = struct{}{}
+	}
+	pvcs, err := e.kubeClient.GetPersistentVolumeClaims(reqCtx, namespace, selector)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not get database cluster persistent volume claims"),
+		})
+	}
+	for _, pvc := range pvcs.Items {
+		objects[eventObject{kind: "PersistentVolumeClaim", name: pvc.Name}] = struct{}{}
+	}
+
+	// Events can't be filtered by several objects at once,
+	// so all the events of the namespace are filtered here.
+	events, err := e.kubeClient.ListEvents(reqCtx, namespace, metav1.ListOptions{})
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get events")})
+	}
+
+	return ctx.JSON(http.StatusOK, eventsTimeline(events.Items, objects))
+}
+
+// eventsTimeline returns the events of the objects ordered by time.
+// Repeated events of the same object with the same reason and message are merged into one.
+func eventsTimeline(events []corev1.Event, objects map[eventObject]struct{}) DatabaseClusterEventList {
+	type eventKey struct {
+		object  eventObject
+		reason  string
+		message string
+	}
+	merged := make(map[eventKey]*DatabaseClusterEvent)
+	for i := range events {
+		ev := &events[i]
+		obj := eventObject{kind: ev.InvolvedObject.Kind, name: ev.InvolvedObject.Name}
+		if _, ok := objects[obj]; !ok {
+			continue
+		}
+
+		first, last := eventTimes(ev)
+		count := max(ev.Count, 1)
+		if ev.Series != nil {
+			count = max(ev.Series.Count, count)
+		}
+		key := eventKey{object: obj, reason: ev.Reason, message: ev.Message}
+		if m, ok := merged[key]; ok {
+			m.Count += count
+			if first.Before(m.FirstTime) {
+				m.FirstTime = first
+			}
+			if last.After(m.Time) {
+				m.Time = last
+			}
+			if ev.Type == corev1.EventTypeWarning {
+				m.Severity = Warning
+			}
+			continue
+		}
+
+		item := &DatabaseClusterEvent{
+			Time:      last,
+			FirstTime: first,
+			Severity:  Info,
+			Reason:    ev.Reason,
+			Message:   ev.Message,
+			Count:     count,
+			Object:    DatabaseClusterEventObject{Kind: obj.kind, Name: obj.name},
+		}
+		if ev.Type == corev1.EventTypeWarning {
+			item.Severity = Warning
+		}
+		if src := eventSource(ev); src != "" {
+			item.Source = pointer.ToString(src)
+		}
+		merged[key] = item
+	}
+
+	timeline := make(DatabaseClusterEventList, 0, len(merged))
+	for _, m := range merged {
+		timeline = append(timeline, *m)
+	}
+	sort.Slice(timeline, func(i, j int) bool {
+		if !timeline[i].Time.Equal(timeline[j].Time) {
+			return timeline[i].Time.Before(timeline[j].Time)
+		}
+		if timeline[i].Object != timeline[j].Object {
+			if timeline[i].Object.Kind != timeline[j].Object.Kind {
+				return timeline[i].Object.Kind < timeline[j].Object.Kind
+			}
+			return timeline[i].Object.Name < timeline[j].Object.Name
+		}
+		return timeline[i].Reason < timeline[j].Reason
+	})
+	return timeline
+}
+
+// eventTimes returns the times of the first and the last occurrence of the event.
+// Events reported with the events.k8s.io API only have the event time set.
+func eventTimes(ev *corev1.Event) (time.Time, time.Time) {
+	first := ev.FirstTimestamp.Time
+	if first.IsZero() {
+		first = ev.EventTime.Time
+	}
+	if first.IsZero() {
+		first = ev.CreationTimestamp.Time
+	}
+
+	last := ev.LastTimestamp.Time
+	if ev.Series != nil && ev.Series.LastObservedTime.After(last) {
+		last = ev.Series.LastObservedTime.Time
+	}
+	if last.IsZero() {
+		last = first
+	}
+	return first.UTC(), last.UTC()
+}
+
+func eventSource(ev *corev1.Event) string {
+	if ev.Source.Component != "" {
+		return ev.Source.Component
+	}
+	return ev.ReportingController
+}
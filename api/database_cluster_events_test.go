@@ -0,0 +1,83 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEventsTimeline(t *testing.T) {
+	t.Parallel()
+	event := func(kind, name, typ, reason string, first, last int64, count int32) corev1.Event {
+		return corev1.Event{
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Name: name},
+			Type:           typ,
+			Reason:         reason,
+			Message:        reason + " message",
+			FirstTimestamp: metav1.Unix(first, 0),
+			LastTimestamp:  metav1.Unix(last, 0),
+			Count:          count,
+			Source:         corev1.EventSource{Component: "test"},
+		}
+	}
+	events := []corev1.Event{
+		event("PersistentVolumeClaim", "datadir-db-pxc-0", corev1.EventTypeWarning, "ProvisioningFailed", 20, 50, 3),
+		event("DatabaseCluster", "db", corev1.EventTypeNormal, "Created", 10, 10, 1),
+		event("Pod", "other-pxc-0", corev1.EventTypeWarning, "FailedScheduling", 15, 15, 1),
+		event("PersistentVolumeClaim", "datadir-db-pxc-0", corev1.EventTypeWarning, "ProvisioningFailed", 5, 30, 2),
+		{
+			InvolvedObject:      corev1.ObjectReference{Kind: "Pod", Name: "db-pxc-0"},
+			Type:                corev1.EventTypeWarning,
+			Reason:              "BackOff",
+			Message:             "Back-off pulling image",
+			EventTime:           metav1.NewMicroTime(time.Unix(40, 0)),
+			Series:              &corev1.EventSeries{Count: 4, LastObservedTime: metav1.NewMicroTime(time.Unix(60, 0))},
+			ReportingController: "kubelet",
+		},
+	}
+	objects := map[eventObject]struct{}{
+		{kind: "DatabaseCluster", name: "db"}:                        {},
+		{kind: "Pod", name: "db-pxc-0"}:                              {},
+		{kind: "PersistentVolumeClaim", name: "datadir-db-pxc-0"}:    {},
+		{kind: "PerconaXtraDBClusterBackup", name: "db-backup-1234"}: {},
+	}
+
+	timeline := eventsTimeline(events, objects)
+	require.Len(t, timeline, 3)
+
+	assert.Equal(t, "Created", timeline[0].Reason)
+	assert.Equal(t, Info, timeline[0].Severity)
+	assert.Equal(t, time.Unix(10, 0).UTC(), timeline[0].Time)
+
+	assert.Equal(t, "ProvisioningFailed", timeline[1].Reason)
+	assert.Equal(t, Warning, timeline[1].Severity)
+	assert.Equal(t, int32(5), timeline[1].Count)
+	assert.Equal(t, time.Unix(5, 0).UTC(), timeline[1].FirstTime)
+	assert.Equal(t, time.Unix(50, 0).UTC(), timeline[1].Time)
+	assert.Equal(t, DatabaseClusterEventObject{Kind: "PersistentVolumeClaim", Name: "datadir-db-pxc-0"}, timeline[1].Object)
+
+	assert.Equal(t, "BackOff", timeline[2].Reason)
+	assert.Equal(t, int32(4), timeline[2].Count)
+	assert.Equal(t, time.Unix(40, 0).UTC(), timeline[2].FirstTime)
+	assert.Equal(t, time.Unix(60, 0).UTC(), timeline[2].Time)
+	assert.Equal(t, "kubelet", pointer.GetString(timeline[2].Source))
+}
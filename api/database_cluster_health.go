@@ -0,0 +1,248 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Labels set by the Percona operators for PXC and PSMDB.
+	instanceLabel  = "app.kubernetes.io/instance"
+	componentLabel = "app.kubernetes.io/component"
+
+	// Labels set by the Percona operator for PostgreSQL.
+	pgClusterLabel     = "postgres-operator.crunchydata.com/cluster"
+	pgRoleLabel        = "postgres-operator.crunchydata.com/role"
+	pgInstanceSetLabel = "postgres-operator.crunchydata.com/instance-set"
+	pgBackrestLabel    = "postgres-operator.crunchydata.com/pgbackrest"
+)
+
+// GetDatabaseClusterHealth returns the health of the specified database cluster and its pods and volumes.
+func (e *EverestServer) GetDatabaseClusterHealth(ctx echo.Context, namespace, name string) error {
+	db, err := e.kubeClient.GetDatabaseCluster(ctx.Request().Context(), namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	selector := databaseClusterLabelSelector(db)
+	pods, err := e.kubeClient.GetPods(ctx.Request().Context(), namespace, selector)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster pods")})
+	}
+	pvcs, err := e.kubeClient.GetPersistentVolumeClaims(ctx.Request().Context(), namespace, selector)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not get database cluster persistent volume claims"),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, databaseClusterHealth(db, pods.Items, pvcs.Items))
+}
+
+// databaseClusterLabelSelector returns the selector of the pods and volumes
+// the operator creates for the database cluster.
+func databaseClusterLabelSelector(db *everestv1alpha1.DatabaseCluster) *metav1.LabelSelector {
+	if db.Spec.Engine.Type == everestv1alpha1.DatabaseEnginePostgresql {
+		return &metav1.LabelSelector{MatchLabels: map[string]string{pgClusterLabel: db.Name}}
+	}
+	return &metav1.LabelSelector{MatchLabels: map[string]string{instanceLabel: db.Name}}
+}
+
+func podRole(pod *corev1.Pod) DatabaseClusterPodHealthRole {
+	switch pod.Labels[componentLabel] {
+	case "pxc", "mongod", "cfg", "arbiter", "nonvoting":
+		return Engine
+	case "haproxy", "proxysql", "mongos":
+		return Proxy
+	case "backup", "pitr":
+		return Backup
+	}
+
+	switch {
+	case pod.Labels[pgRoleLabel] == "pgbouncer":
+		return Proxy
+	case pod.Labels[pgInstanceSetLabel] != "":
+		return Engine
+	case pod.Labels[pgBackrestLabel] != "":
+		return Backup
+	}
+	return Other
+}
+
+func podReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func podHealth(pod *corev1.Pod) DatabaseClusterPodHealth {
+	h := DatabaseClusterPodHealth{
+		Name:  pod.Name,
+		Role:  podRole(pod),
+		Phase: string(pod.Status.Phase),
+		Ready: podReady(pod),
+	}
+	if pod.Spec.NodeName != "" {
+		h.Node = pointer.ToString(pod.Spec.NodeName)
+	}
+
+	var lastFinished metav1.Time
+	for _, cs := range pod.Status.ContainerStatuses {
+		h.Restarts += cs.RestartCount
+		t := cs.LastTerminationState.Terminated
+		if t != nil && !t.FinishedAt.Before(&lastFinished) {
+			lastFinished = t.FinishedAt
+			h.LastTerminationReason = pointer.ToString(t.Reason)
+		}
+	}
+	return h
+}
+
+// databaseClusterHealth rolls up the health of the database cluster from its pods and volumes.
+func databaseClusterHealth( //nolint:cyclop
+	db *everestv1alpha1.DatabaseCluster,
+	pods []corev1.Pod,
+	pvcs []corev1.PersistentVolumeClaim,
+) *DatabaseClusterHealth {
+	health := &DatabaseClusterHealth{
+		Status:  Healthy,
+		Reasons: []string{},
+		Pods:    make([]DatabaseClusterPodHealth, 0, len(pods)),
+		Volumes: make([]DatabaseClusterVolumeHealth, 0, len(pvcs)),
+	}
+	degraded, unhealthy := false, false
+
+	var engineTotal, engineReady, proxyTotal, proxyReady int
+	for i := range pods {
+		pod := &pods[i]
+		h := podHealth(pod)
+		health.Pods = append(health.Pods, h)
+
+		switch h.Role {
+		case Engine:
+			engineTotal++
+			if h.Ready {
+				engineReady++
+			}
+		case Proxy:
+			proxyTotal++
+			if h.Ready {
+				proxyReady++
+			}
+		default:
+			continue
+		}
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.State.Waiting != nil && cs.State.Waiting.Reason != "" && cs.State.Waiting.Reason != "ContainerCreating" {
+				degraded = true
+				health.Reasons = append(health.Reasons,
+					fmt.Sprintf("container %s of pod %s is waiting: %s", cs.Name, pod.Name, cs.State.Waiting.Reason))
+			}
+		}
+	}
+
+	for i := range pvcs {
+		pvc := &pvcs[i]
+		v := DatabaseClusterVolumeHealth{
+			Name:         pvc.Name,
+			Phase:        string(pvc.Status.Phase),
+			StorageClass: pvc.Spec.StorageClassName,
+		}
+		c, ok := pvc.Status.Capacity[corev1.ResourceStorage]
+		if ok {
+			v.Capacity = pointer.ToString(c.String())
+		}
+		if r, requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; requested && (!ok || r.Cmp(c) != 0) {
+			v.RequestedCapacity = pointer.ToString(r.String())
+		}
+		var message string
+		v.ResizeStatus, message = volumeResize(pvc)
+		if message != "" {
+			v.ResizeMessage = pointer.ToString(message)
+		}
+		if v.ResizeStatus != nil && *v.ResizeStatus == ResizeFailed {
+			degraded = true
+			health.Reasons = append(health.Reasons, fmt.Sprintf("resize of persistent volume claim %s failed", pvc.Name))
+		}
+		health.Volumes = append(health.Volumes, v)
+
+		switch pvc.Status.Phase {
+		case corev1.ClaimBound:
+		case corev1.ClaimLost:
+			unhealthy = true
+			health.Reasons = append(health.Reasons, fmt.Sprintf("persistent volume claim %s is lost", pvc.Name))
+		default:
+			degraded = true
+			health.Reasons = append(health.Reasons, fmt.Sprintf("persistent volume claim %s is not bound", pvc.Name))
+		}
+	}
+
+	sort.Slice(health.Pods, func(i, j int) bool { return health.Pods[i].Name < health.Pods[j].Name })
+	sort.Slice(health.Volumes, func(i, j int) bool { return health.Volumes[i].Name < health.Volumes[j].Name })
+
+	if db.Spec.Paused {
+		health.Status = Paused
+		return health
+	}
+
+	expectedEngine := max(int(db.Spec.Engine.Replicas), engineTotal)
+	switch {
+	case engineReady == 0:
+		unhealthy = true
+		health.Reasons = append(health.Reasons, "no engine pods are ready")
+	case engineReady < expectedEngine:
+		degraded = true
+		health.Reasons = append(health.Reasons, fmt.Sprintf("%d of %d engine pods are ready", engineReady, expectedEngine))
+	}
+
+	expectedProxy := proxyTotal
+	// Mongos pods exist only for sharded PSMDB clusters, so the expected
+	// number of proxies is known only for the other engines.
+	if db.Spec.Proxy.Replicas != nil && db.Spec.Engine.Type != everestv1alpha1.DatabaseEnginePSMDB {
+		expectedProxy = max(int(*db.Spec.Proxy.Replicas), proxyTotal)
+	}
+	if proxyReady < expectedProxy {
+		degraded = true
+		health.Reasons = append(health.Reasons, fmt.Sprintf("%d of %d proxy pods are ready", proxyReady, expectedProxy))
+	}
+
+	switch {
+	case unhealthy:
+		health.Status = Unhealthy
+	case degraded:
+		health.Status = Degraded
+	}
+	return health
+}
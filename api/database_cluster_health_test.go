@@ -0,0 +1,173 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testPod(name, component string, ready bool, waiting string) corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{componentLabel: component},
+		},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+	if waiting != "" {
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+			Name:         component,
+			RestartCount: 5,
+			State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: waiting}},
+			LastTerminationState: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"},
+			},
+		}}
+	}
+	return pod
+}
+
+func testPVC(name string, phase corev1.PersistentVolumeClaimPhase) corev1.PersistentVolumeClaim {
+	return corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: phase},
+	}
+}
+
+func TestDatabaseClusterHealth(t *testing.T) {
+	t.Parallel()
+	db := &everestv1alpha1.DatabaseCluster{
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{Type: everestv1alpha1.DatabaseEnginePXC, Replicas: 3},
+			Proxy:  everestv1alpha1.Proxy{Replicas: pointer.ToInt32(1)},
+		},
+	}
+	paused := db.DeepCopy()
+	paused.Spec.Paused = true
+
+	cases := []struct {
+		name    string
+		db      *everestv1alpha1.DatabaseCluster
+		pods    []corev1.Pod
+		pvcs    []corev1.PersistentVolumeClaim
+		status  DatabaseClusterHealthStatus
+		reasons []string
+	}{
+		{
+			name: "healthy",
+			db:   db,
+			pods: []corev1.Pod{
+				testPod("db-pxc-0", "pxc", true, ""),
+				testPod("db-pxc-1", "pxc", true, ""),
+				testPod("db-pxc-2", "pxc", true, ""),
+				testPod("db-haproxy-0", "haproxy", true, ""),
+			},
+			pvcs:    []corev1.PersistentVolumeClaim{testPVC("datadir-db-pxc-0", corev1.ClaimBound)},
+			status:  Healthy,
+			reasons: []string{},
+		},
+		{
+			name: "crash looping engine pod",
+			db:   db,
+			pods: []corev1.Pod{
+				testPod("db-pxc-0", "pxc", true, ""),
+				testPod("db-pxc-1", "pxc", true, ""),
+				testPod("db-pxc-2", "pxc", false, "CrashLoopBackOff"),
+				testPod("db-haproxy-0", "haproxy", true, ""),
+			},
+			status: Degraded,
+			reasons: []string{
+				"container pxc of pod db-pxc-2 is waiting: CrashLoopBackOff",
+				"2 of 3 engine pods are ready",
+			},
+		},
+		{
+			name:   "unbound volume and no ready pods",
+			db:     db,
+			pods:   []corev1.Pod{testPod("db-pxc-0", "pxc", false, "")},
+			pvcs:   []corev1.PersistentVolumeClaim{testPVC("datadir-db-pxc-0", corev1.ClaimPending)},
+			status: Unhealthy,
+			reasons: []string{
+				"persistent volume claim datadir-db-pxc-0 is not bound",
+				"no engine pods are ready",
+				"0 of 1 proxy pods are ready",
+			},
+		},
+		{
+			name:    "paused",
+			db:      paused,
+			status:  Paused,
+			reasons: []string{},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			health := databaseClusterHealth(tc.db, tc.pods, tc.pvcs)
+			assert.Equal(t, tc.status, health.Status)
+			assert.Equal(t, tc.reasons, health.Reasons)
+			assert.Len(t, health.Pods, len(tc.pods))
+		})
+	}
+}
+
+func TestPodHealth(t *testing.T) {
+	t.Parallel()
+	h := podHealth(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "db-instance1-abcd-0",
+			Labels: map[string]string{pgInstanceSetLabel: "instance1"},
+		},
+		Spec: corev1.PodSpec{NodeName: "node-1"},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					RestartCount: 1,
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+						Reason:     "Error",
+						FinishedAt: metav1.Unix(100, 0),
+					}},
+				},
+				{
+					RestartCount: 2,
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+						Reason:     "OOMKilled",
+						FinishedAt: metav1.Unix(200, 0),
+					}},
+				},
+			},
+		},
+	})
+	assert.Equal(t, Engine, h.Role)
+	assert.False(t, h.Ready)
+	assert.Equal(t, int32(3), h.Restarts)
+	assert.Equal(t, "OOMKilled", pointer.GetString(h.LastTerminationReason))
+	assert.Equal(t, "node-1", pointer.GetString(h.Node))
+}
@@ -0,0 +1,179 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"golang.org/x/sync/errgroup"
+)
+
+// listNamespacesConcurrency is the maximum number of namespaces listed at the same time.
+const listNamespacesConcurrency = 8
+
+// ListAllDatabaseClusters lists the database clusters of all database namespaces.
+func (e *EverestServer) ListAllDatabaseClusters(ctx echo.Context, params ListAllDatabaseClustersParams) error {
+	namespaces, err := e.kubeClient.GetDBNamespaces(ctx.Request().Context(), e.kubeClient.Namespace())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Failed getting watched namespaces"),
+		})
+	}
+	if params.Namespace != nil {
+		if err := validateAllowedNamespaces([]string{*params.Namespace}, namespaces); err != nil {
+			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		}
+		namespaces = []string{*params.Namespace}
+	}
+
+	filter := databaseClusterFilter{params: params}
+	if params.StorageClass != nil {
+		storageClasses, err := e.kubeClient.GetStorageClasses(ctx.Request().Context())
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed getting storage classes")})
+		}
+		filter.defaultStorageClass = defaultStorageClass(storageClasses)
+	}
+
+	items, err := e.listDatabaseClusters(ctx.Request().Context(), namespaces)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list the database clusters")})
+	}
+
+	list := &everestv1alpha1.DatabaseClusterList{Items: make([]everestv1alpha1.DatabaseCluster, 0, len(items))}
+	list.APIVersion = databaseClusterAPIVersion
+	list.Kind = "DatabaseClusterList"
+	for i := range items {
+		if filter.matches(&items[i]) {
+			list.Items = append(list.Items, items[i])
+		}
+	}
+	slices.SortFunc(list.Items, func(a, b everestv1alpha1.DatabaseCluster) int {
+		if c := strings.Compare(a.Namespace, b.Namespace); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	result := &DatabaseClusterList{}
+	if err := roundTrip(list, result); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list the database clusters")})
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// listDatabaseClusters lists the database clusters of the namespaces concurrently.
+func (e *EverestServer) listDatabaseClusters(ctx context.Context, namespaces []string) ([]everestv1alpha1.DatabaseCluster, error) {
+	lists := make([][]everestv1alpha1.DatabaseCluster, len(namespaces))
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(listNamespacesConcurrency)
+	for i, namespace := range namespaces {
+		i, namespace := i, namespace
+		g.Go(func() error {
+			list, err := e.kubeClient.ListDatabaseClusters(gCtx, namespace)
+			if err != nil {
+				return err
+			}
+			lists[i] = list.Items
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	var items []everestv1alpha1.DatabaseCluster
+	for _, l := range lists {
+		items = append(items, l...)
+	}
+	return items, nil
+}
+
+// databaseClusterFilter matches database clusters against the search and the filters of the request.
+type databaseClusterFilter struct {
+	params              ListAllDatabaseClustersParams
+	defaultStorageClass string
+}
+
+func (f databaseClusterFilter) matches(db *everestv1alpha1.DatabaseCluster) bool { //nolint:cyclop
+	p := f.params
+	if p.Search != nil && !searchDatabaseCluster(db, *p.Search) {
+		return false
+	}
+	if p.Engine != nil && string(db.Spec.Engine.Type) != *p.Engine {
+		return false
+	}
+	if p.Version != nil && db.Spec.Engine.Version != *p.Version {
+		return false
+	}
+	if p.Status != nil && string(db.Status.Status) != *p.Status {
+		return false
+	}
+	if p.StorageClass != nil {
+		storageClass := pointer.GetString(db.Spec.Engine.Storage.Class)
+		if storageClass == "" {
+			storageClass = f.defaultStorageClass
+		}
+		if storageClass != *p.StorageClass {
+			return false
+		}
+	}
+	if p.BackupStorage != nil && !slices.Contains(backupStorageNames(db), *p.BackupStorage) {
+		return false
+	}
+	if p.MonitoringInstance != nil {
+		if db.Spec.Monitoring == nil || db.Spec.Monitoring.MonitoringConfigName != *p.MonitoringInstance {
+			return false
+		}
+	}
+	return true
+}
+
+// searchDatabaseCluster returns true if the name, a label key or a label value of the database cluster
+// contains the text, ignoring the case. Labels are also matched as key=value.
+func searchDatabaseCluster(db *everestv1alpha1.DatabaseCluster, text string) bool {
+	text = strings.ToLower(strings.TrimSpace(text))
+	if strings.Contains(strings.ToLower(db.Name), text) {
+		return true
+	}
+	for k, v := range db.Labels {
+		if strings.Contains(strings.ToLower(k+"="+v), text) {
+			return true
+		}
+	}
+	return false
+}
+
+// backupStorageNames returns the backup storages used by the backup schedules and the point-in-time recovery.
+func backupStorageNames(db *everestv1alpha1.DatabaseCluster) []string {
+	names := make([]string, 0, len(db.Spec.Backup.Schedules)+1)
+	for _, schedule := range db.Spec.Backup.Schedules {
+		names = append(names, schedule.BackupStorageName)
+	}
+	if db.Spec.Backup.PITR.BackupStorageName != nil {
+		names = append(names, *db.Spec.Backup.PITR.BackupStorageName)
+	}
+	return names
+}
@@ -0,0 +1,81 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDatabaseClusterFilter(t *testing.T) {
+	t.Parallel()
+	db := &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "Payments-DB",
+			Namespace: "production",
+			Labels:    map[string]string{"team": "billing"},
+		},
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{Type: everestv1alpha1.DatabaseEnginePXC, Version: "8.0.35-27.1"},
+			Backup: everestv1alpha1.Backup{
+				Schedules: []everestv1alpha1.BackupSchedule{{Name: "daily", BackupStorageName: "s3"}},
+				PITR:      everestv1alpha1.PITRSpec{BackupStorageName: pointer.ToString("s3-pitr")},
+			},
+			Monitoring: &everestv1alpha1.Monitoring{MonitoringConfigName: "pmm"},
+		},
+		Status: everestv1alpha1.DatabaseClusterStatus{Status: everestv1alpha1.AppStateReady},
+	}
+
+	cases := []struct {
+		name    string
+		params  ListAllDatabaseClustersParams
+		matches bool
+	}{
+		{name: "no filters", matches: true},
+		{name: "search name", params: ListAllDatabaseClustersParams{Search: pointer.ToString("payments")}, matches: true},
+		{name: "search label", params: ListAllDatabaseClustersParams{Search: pointer.ToString("team=bill")}, matches: true},
+		{name: "search no match", params: ListAllDatabaseClustersParams{Search: pointer.ToString("orders")}},
+		{name: "engine", params: ListAllDatabaseClustersParams{Engine: pointer.ToString("pxc")}, matches: true},
+		{name: "other engine", params: ListAllDatabaseClustersParams{Engine: pointer.ToString("psmdb")}},
+		{name: "version", params: ListAllDatabaseClustersParams{Version: pointer.ToString("8.0.35-27.1")}, matches: true},
+		{name: "status", params: ListAllDatabaseClustersParams{Status: pointer.ToString("initializing")}},
+		{name: "default storage class", params: ListAllDatabaseClustersParams{StorageClass: pointer.ToString("gp3")}, matches: true},
+		{name: "other storage class", params: ListAllDatabaseClustersParams{StorageClass: pointer.ToString("gp2")}},
+		{name: "pitr backup storage", params: ListAllDatabaseClustersParams{BackupStorage: pointer.ToString("s3-pitr")}, matches: true},
+		{name: "other backup storage", params: ListAllDatabaseClustersParams{BackupStorage: pointer.ToString("azure")}},
+		{name: "monitoring instance", params: ListAllDatabaseClustersParams{MonitoringInstance: pointer.ToString("pmm")}, matches: true},
+		{
+			name: "combined",
+			params: ListAllDatabaseClustersParams{
+				Search: pointer.ToString("payments"),
+				Engine: pointer.ToString("pxc"),
+				Status: pointer.ToString("ready"),
+			},
+			matches: true,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			filter := databaseClusterFilter{params: tc.params, defaultStorageClass: "gp3"}
+			assert.Equal(t, tc.matches, filter.matches(db))
+		})
+	}
+}
@@ -0,0 +1,199 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// GetDatabaseClusterLogs streams the logs of the containers of the specified database cluster.
+func (e *EverestServer) GetDatabaseClusterLogs( //nolint:funlen
+	ctx echo.Context,
+	namespace, name string,
+	params GetDatabaseClusterLogsParams,
+) error {
+	reqCtx := ctx.Request().Context()
+	namespaces, err := e.kubeClient.GetDBNamespaces(reqCtx, e.kubeClient.Namespace())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Failed getting watched namespaces"),
+		})
+	}
+	if err := validateAllowedNamespaces([]string{namespace}, namespaces); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	db, err := e.kubeClient.GetDatabaseCluster(reqCtx, namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	pods, err := e.kubeClient.GetPods(reqCtx, namespace, databaseClusterLabelSelector(db))
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster pods")})
+	}
+	targets := logTargets(pods.Items, params.Pod, params.Container)
+	if len(targets) == 0 && (params.Pod != nil || params.Container != nil) {
+		return ctx.JSON(http.StatusNotFound, Error{
+			Message: pointer.ToString("The pod or container is not found in the database cluster"),
+		})
+	}
+
+	opts := corev1.PodLogOptions{
+		Follow:       pointer.GetBool(params.Follow),
+		Previous:     pointer.GetBool(params.Previous),
+		TailLines:    params.TailLines,
+		SinceSeconds: params.SinceSeconds,
+	}
+
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, echo.MIMETextPlainCharsetUTF8)
+	res.WriteHeader(http.StatusOK)
+	w := &logWriter{w: res, flush: opts.Follow}
+
+	// Without follow the logs of every container are returned one after
+	// another. With follow all the streams are read at the same time since
+	// none of them ends until the client disconnects.
+	if !opts.Follow {
+		for _, t := range targets {
+			e.streamContainerLogs(reqCtx, w, namespace, t, opts)
+		}
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	for _, t := range targets {
+		wg.Add(1)
+		go func(t logTarget) {
+			defer wg.Done()
+			e.streamContainerLogs(reqCtx, w, namespace, t, opts)
+		}(t)
+	}
+	wg.Wait()
+	return nil
+}
+
+func (e *EverestServer) streamContainerLogs(
+	ctx context.Context,
+	w *logWriter,
+	namespace string,
+	t logTarget,
+	opts corev1.PodLogOptions,
+) {
+	opts.Container = t.container
+	stream, err := e.kubeClient.GetPodLogs(ctx, namespace, t.pod, &opts)
+	if err != nil {
+		e.l.Error(err)
+		// The response status is already sent, so the error is reported in the stream.
+		_ = w.writeLine(t.prefix(), "could not get logs: "+err.Error())
+		return
+	}
+	defer stream.Close() //nolint:errcheck
+
+	if err := copyLogLines(w, t.prefix(), stream); err != nil && ctx.Err() == nil {
+		e.l.Error(errors.Join(err, errors.New("could not stream logs")))
+	}
+}
+
+// logTarget is a container of a pod to get the logs from.
+type logTarget struct {
+	pod       string
+	container string
+}
+
+func (t logTarget) prefix() string {
+	return "[" + t.pod + "/" + t.container + "] "
+}
+
+// logTargets returns the containers of the pods matching the optional pod and container filters
+// ordered by the pod name.
+func logTargets(pods []corev1.Pod, pod, container *string) []logTarget {
+	sorted := make([]corev1.Pod, len(pods))
+	copy(sorted, pods)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	targets := make([]logTarget, 0, len(sorted))
+	for _, p := range sorted {
+		if pod != nil && p.Name != *pod {
+			continue
+		}
+		for _, c := range p.Spec.Containers {
+			if container != nil && c.Name != *container {
+				continue
+			}
+			targets = append(targets, logTarget{pod: p.Name, container: c.Name})
+		}
+	}
+	return targets
+}
+
+// logWriter writes the log lines of several containers to the response
+// making sure lines of different containers are not mixed up.
+type logWriter struct {
+	mu    sync.Mutex
+	w     io.Writer
+	flush bool
+}
+
+func (lw *logWriter) writeLine(prefix, line string) error {
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+
+	if _, err := io.WriteString(lw.w, prefix+line+"\n"); err != nil {
+		return err
+	}
+	if f, ok := lw.w.(http.Flusher); ok && lw.flush {
+		f.Flush()
+	}
+	return nil
+}
+
+// copyLogLines copies the log lines from r to w adding the prefix to every line.
+func copyLogLines(w *logWriter, prefix string, r io.Reader) error {
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadString('\n')
+		if line != "" {
+			if werr := w.writeLine(prefix, strings.TrimSuffix(line, "\n")); werr != nil {
+				return werr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLogTargets(t *testing.T) {
+	t.Parallel()
+	pod := func(name string, containers ...string) corev1.Pod {
+		p := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		for _, c := range containers {
+			p.Spec.Containers = append(p.Spec.Containers, corev1.Container{Name: c})
+		}
+		return p
+	}
+	pods := []corev1.Pod{
+		pod("db-pxc-1", "pxc", "logs"),
+		pod("db-haproxy-0", "haproxy"),
+		pod("db-pxc-0", "pxc", "logs"),
+	}
+
+	cases := []struct {
+		name      string
+		pod       *string
+		container *string
+		targets   []logTarget
+	}{
+		{
+			name: "all containers",
+			targets: []logTarget{
+				{pod: "db-haproxy-0", container: "haproxy"},
+				{pod: "db-pxc-0", container: "pxc"},
+				{pod: "db-pxc-0", container: "logs"},
+				{pod: "db-pxc-1", container: "pxc"},
+				{pod: "db-pxc-1", container: "logs"},
+			},
+		},
+		{
+			name:      "container",
+			container: pointer.ToString("pxc"),
+			targets: []logTarget{
+				{pod: "db-pxc-0", container: "pxc"},
+				{pod: "db-pxc-1", container: "pxc"},
+			},
+		},
+		{
+			name:    "pod",
+			pod:     pointer.ToString("db-pxc-1"),
+			targets: []logTarget{{pod: "db-pxc-1", container: "pxc"}, {pod: "db-pxc-1", container: "logs"}},
+		},
+		{
+			name:      "unknown container",
+			pod:       pointer.ToString("db-haproxy-0"),
+			container: pointer.ToString("pxc"),
+			targets:   []logTarget{},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.targets, logTargets(pods, tc.pod, tc.container))
+		})
+	}
+}
+
+func TestCopyLogLines(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	w := &logWriter{w: &buf}
+
+	err := copyLogLines(w, "[db-pxc-0/pxc] ", strings.NewReader("first\nsecond\n"))
+	require.NoError(t, err)
+	err = copyLogLines(w, "[db-pxc-1/pxc] ", strings.NewReader("no trailing newline"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "[db-pxc-0/pxc] first\n[db-pxc-0/pxc] second\n[db-pxc-1/pxc] no trailing newline\n", buf.String())
+}
@@ -17,14 +17,17 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/AlekSi/pointer"
 	"github.com/labstack/echo/v4"
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 const (
@@ -59,7 +62,7 @@ func (e *EverestServer) CreateDatabaseClusterRestore(ctx echo.Context, namespace
 			Message: pointer.ToString("Could not get DatabaseClusterRestore from the request body"),
 		})
 	}
-	if err := validateDatabaseClusterRestore(ctx.Request().Context(), namespace, restore, e.kubeClient); err != nil {
+	if err := validateDatabaseClusterRestore(ctx.Request().Context(), namespace, restore, e.kubeClient, e.backupPitrRanges); err != nil {
 		e.l.Error(err)
 		return ctx.JSON(http.StatusBadRequest, Error{
 			Message: pointer.ToString(err.Error()),
@@ -79,7 +82,76 @@ func (e *EverestServer) CreateDatabaseClusterRestore(ctx echo.Context, namespace
 		})
 	}
 
-	return e.proxyKubernetes(ctx, namespace, databaseClusterRestoreKind, "")
+	reqCtx := ctx.Request().Context()
+	backup, err := e.kubeClient.GetDatabaseClusterBackup(reqCtx, namespace, pointer.GetString(restore.Spec.DataSource.DbClusterBackupName))
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString(err.Error()),
+		})
+	}
+	r := &everestv1alpha1.DatabaseClusterRestore{}
+	if err := roundTrip(restore, r); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get DatabaseClusterRestore from the request body"),
+		})
+	}
+	r.Namespace = namespace
+	// The pre-restore backup is not taken for a restore which can't be created anyway.
+	if r.Name != "" {
+		_, err := e.kubeClient.GetDatabaseClusterRestore(reqCtx, namespace, r.Name)
+		if err == nil {
+			return ctx.JSON(http.StatusConflict, Error{
+				Message: pointer.ToString(fmt.Sprintf("Restore %s already exists", r.Name)),
+			})
+		}
+		if !k8serrors.IsNotFound(err) {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the restore")})
+		}
+	}
+
+	_, copied := backup.Annotations[copiedFromAnnotation]
+	if copied {
+		if r.Annotations == nil {
+			r.Annotations = make(map[string]string)
+		}
+		// The operator can't restore copies of backups by their name, so they are restored from their backup storage.
+		r.Annotations[restoredFromAnnotation] = backup.Namespace + "/" + backup.Name
+		r.Spec.DataSource.DBClusterBackupName = ""
+		r.Spec.DataSource.BackupSource = backupSource(dbCluster.Spec.Engine.Type, backup)
+	}
+
+	preRestoreBackup, err := e.startPreRestoreBackup(reqCtx, dbCluster, r, backup, time.Now())
+	if errors.Is(err, errPreRestoreBackupNotReady) {
+		// The database clusters which are not ready are often restored to repair them, so the restore is not refused.
+		addWarningHeader(ctx, err.Error())
+		err = nil
+	}
+	if err != nil {
+		return e.preRestoreBackupErrorResponse(ctx, err)
+	}
+
+	if preRestoreBackup != nil {
+		e.goRunPreRestoreBackup(reqCtx, dbCluster, r, *preRestoreBackup)
+		return ctx.JSON(http.StatusAccepted, preRestoreBackup)
+	}
+	if !copied {
+		return e.proxyKubernetes(ctx, namespace, databaseClusterRestoreKind, "")
+	}
+
+	created, err := e.kubeClient.CreateDatabaseClusterRestore(reqCtx, r)
+	if err != nil {
+		e.l.Error(err)
+		if k8serrors.IsAlreadyExists(err) {
+			return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString(err.Error())})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not create the restore"),
+		})
+	}
+	return ctx.JSON(http.StatusCreated, created)
 }
 
 // DeleteDatabaseClusterRestore Delete the specified cluster restore on the specified kubernetes cluster.
@@ -101,7 +173,7 @@ func (e *EverestServer) UpdateDatabaseClusterRestore(ctx echo.Context, namespace
 			Message: pointer.ToString("Could not get DatabaseClusterRestore from the request body"),
 		})
 	}
-	if err := validateDatabaseClusterRestore(ctx.Request().Context(), namespace, restore, e.kubeClient); err != nil {
+	if err := validateDatabaseClusterRestore(ctx.Request().Context(), namespace, restore, e.kubeClient, e.backupPitrRanges); err != nil {
 		e.l.Error(err)
 		return ctx.JSON(http.StatusBadRequest, Error{
 			Message: pointer.ToString(err.Error()),
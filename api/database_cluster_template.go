@@ -0,0 +1,365 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// databaseClusterTemplatesConfigMapName is the name of the config map in
+	// the Everest namespace which stores the database cluster templates.
+	databaseClusterTemplatesConfigMapName = "everest-database-cluster-templates"
+
+	databaseClusterAPIVersion = "everest.percona.com/v1alpha1"
+	databaseClusterKindName   = "DatabaseCluster"
+)
+
+var (
+	errTemplateNotFound      = errors.New("template not found")
+	errTemplateNameChanged   = errors.New("template name cannot be changed")
+	errTemplateNoClusterName = errors.New("databaseCluster's metadata.name should be specified")
+)
+
+// ListDatabaseClusterTemplates lists the database cluster templates.
+func (e *EverestServer) ListDatabaseClusterTemplates(ctx echo.Context, params ListDatabaseClusterTemplatesParams) error {
+	templates, err := e.listDatabaseClusterTemplates(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not list database cluster templates"),
+		})
+	}
+
+	result := make(DatabaseClusterTemplateList, 0, len(templates))
+	for _, t := range templates {
+		if params.Namespace != nil && !templateAllowedIn(&t, *params.Namespace) {
+			continue
+		}
+		result = append(result, t)
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// CreateDatabaseClusterTemplate creates a new database cluster template.
+func (e *EverestServer) CreateDatabaseClusterTemplate(ctx echo.Context) error {
+	t := &DatabaseClusterTemplate{}
+	if err := e.getBodyFromContext(ctx, t); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get DatabaseClusterTemplate from the request body"),
+		})
+	}
+	if err := e.validateDatabaseClusterTemplate(ctx.Request().Context(), t); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	_, err := e.getDatabaseClusterTemplate(ctx.Request().Context(), t.Name)
+	if err == nil {
+		return ctx.JSON(http.StatusConflict, Error{
+			Message: pointer.ToString(fmt.Sprintf("Template %s already exists", t.Name)),
+		})
+	}
+	if !errors.Is(err, errTemplateNotFound) {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not get database cluster template"),
+		})
+	}
+
+	if err := e.saveDatabaseClusterTemplate(ctx.Request().Context(), t); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not save database cluster template"),
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, t)
+}
+
+// GetDatabaseClusterTemplate returns the specified database cluster template.
+func (e *EverestServer) GetDatabaseClusterTemplate(ctx echo.Context, name string) error {
+	t, err := e.getDatabaseClusterTemplate(ctx.Request().Context(), name)
+	if err != nil {
+		return e.templateErrorResponse(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, t)
+}
+
+// UpdateDatabaseClusterTemplate replaces the specified database cluster template.
+func (e *EverestServer) UpdateDatabaseClusterTemplate(ctx echo.Context, name string) error {
+	t := &DatabaseClusterTemplate{}
+	if err := e.getBodyFromContext(ctx, t); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get DatabaseClusterTemplate from the request body"),
+		})
+	}
+	if t.Name != name {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(errTemplateNameChanged.Error())})
+	}
+	if err := e.validateDatabaseClusterTemplate(ctx.Request().Context(), t); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	if _, err := e.getDatabaseClusterTemplate(ctx.Request().Context(), name); err != nil {
+		return e.templateErrorResponse(ctx, err)
+	}
+
+	if err := e.saveDatabaseClusterTemplate(ctx.Request().Context(), t); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not save database cluster template"),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, t)
+}
+
+// DeleteDatabaseClusterTemplate deletes the specified database cluster template.
+func (e *EverestServer) DeleteDatabaseClusterTemplate(ctx echo.Context, name string) error {
+	if _, err := e.getDatabaseClusterTemplate(ctx.Request().Context(), name); err != nil {
+		return e.templateErrorResponse(ctx, err)
+	}
+
+	if err := e.kubeClient.DeleteConfigMapEntry(ctx.Request().Context(), databaseClusterTemplatesConfigMapName, name); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not delete database cluster template"),
+		})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// CreateDatabaseClusterFromTemplate creates a new db cluster from the template merged with the provided overrides.
+func (e *EverestServer) CreateDatabaseClusterFromTemplate(
+	ctx echo.Context,
+	namespace string,
+	params CreateDatabaseClusterFromTemplateParams,
+) error {
+	req := &DatabaseClusterFromTemplateParams{}
+	if err := e.getBodyFromContext(ctx, req); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get DatabaseClusterFromTemplateParams from the request body"),
+		})
+	}
+
+	t, err := e.getDatabaseClusterTemplate(ctx.Request().Context(), req.TemplateName)
+	if err != nil {
+		return e.templateErrorResponse(ctx, err)
+	}
+	if !templateAllowedIn(t, namespace) {
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString(fmt.Sprintf("template %s is not allowed for namespace %s", t.Name, namespace)),
+		})
+	}
+
+	dbc, err := databaseClusterFromTemplate(t, req.DatabaseCluster, namespace)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := e.validateDatabaseClusterCR(ctx, namespace, dbc, nil); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := e.enforceNamespaceQuota(ctx.Request().Context(), namespace, dbc); err != nil {
+		return e.quotaErrorResponse(ctx, err)
+	}
+	if err := e.checkClusterCapacity(ctx, dbc, nil, params.Force); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	created, err := e.createDatabaseClusterCR(ctx.Request().Context(), dbc)
+	if err != nil {
+		return e.createDatabaseClusterErrorResponse(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, created)
+}
+
+// createDatabaseClusterCR creates the database cluster in Kubernetes directly instead of proxying the request.
+func (e *EverestServer) createDatabaseClusterCR(ctx context.Context, dbc *DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
+	db := &everestv1alpha1.DatabaseCluster{}
+	if err := roundTrip(dbc, db); err != nil {
+		return nil, errors.Join(err, errors.New("could not convert the database cluster"))
+	}
+	return e.kubeClient.CreateDatabaseCluster(ctx, db)
+}
+
+func (e *EverestServer) createDatabaseClusterErrorResponse(ctx echo.Context, err error) error {
+	e.l.Error(err)
+	switch {
+	case k8serrors.IsAlreadyExists(err):
+		return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString(err.Error())})
+	case k8serrors.IsInvalid(err):
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	return ctx.JSON(http.StatusInternalServerError, Error{
+		Message: pointer.ToString("Could not create the database cluster"),
+	})
+}
+
+func (e *EverestServer) templateErrorResponse(ctx echo.Context, err error) error {
+	if errors.Is(err, errTemplateNotFound) {
+		return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString(err.Error())})
+	}
+	e.l.Error(err)
+	return ctx.JSON(http.StatusInternalServerError, Error{
+		Message: pointer.ToString("Could not get database cluster template"),
+	})
+}
+
+func (e *EverestServer) validateDatabaseClusterTemplate(ctx context.Context, t *DatabaseClusterTemplate) error {
+	if err := validateRFC1035(t.Name, "name"); err != nil {
+		return err
+	}
+	if t.AllowedNamespaces != nil {
+		namespaces, err := e.kubeClient.GetDBNamespaces(ctx, e.kubeClient.Namespace())
+		if err != nil {
+			return errors.Join(err, errors.New("failed getting watched namespaces"))
+		}
+		if err := validateAllowedNamespaces(*t.AllowedNamespaces, namespaces); err != nil {
+			return err
+		}
+	}
+
+	// Make sure the partial spec has the structure of a database cluster spec.
+	if err := roundTrip(map[string]interface{}{"spec": t.Spec}, &DatabaseCluster{}); err != nil {
+		return errors.Join(err, errors.New("invalid template spec"))
+	}
+	return nil
+}
+
+func (e *EverestServer) listDatabaseClusterTemplates(ctx context.Context) ([]DatabaseClusterTemplate, error) {
+	data, err := e.kubeClient.GetConfigMapData(ctx, databaseClusterTemplatesConfigMapName)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]DatabaseClusterTemplate, 0, len(data))
+	for name, value := range data {
+		t := DatabaseClusterTemplate{}
+		if err := json.Unmarshal([]byte(value), &t); err != nil {
+			return nil, errors.Join(err, fmt.Errorf("could not parse template %s", name))
+		}
+		templates = append(templates, t)
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+	return templates, nil
+}
+
+func (e *EverestServer) getDatabaseClusterTemplate(ctx context.Context, name string) (*DatabaseClusterTemplate, error) {
+	data, err := e.kubeClient.GetConfigMapData(ctx, databaseClusterTemplatesConfigMapName)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := data[name]
+	if !ok {
+		return nil, errTemplateNotFound
+	}
+
+	t := &DatabaseClusterTemplate{}
+	if err := json.Unmarshal([]byte(value), t); err != nil {
+		return nil, errors.Join(err, fmt.Errorf("could not parse template %s", name))
+	}
+	return t, nil
+}
+
+func (e *EverestServer) saveDatabaseClusterTemplate(ctx context.Context, t *DatabaseClusterTemplate) error {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	return e.kubeClient.SetConfigMapEntry(ctx, databaseClusterTemplatesConfigMapName, t.Name, string(data))
+}
+
+func templateAllowedIn(t *DatabaseClusterTemplate, namespace string) bool {
+	if t.AllowedNamespaces == nil || len(*t.AllowedNamespaces) == 0 {
+		return true
+	}
+	return slices.Contains(*t.AllowedNamespaces, namespace)
+}
+
+// databaseClusterFromTemplate merges the overrides on top of the template spec.
+func databaseClusterFromTemplate(t *DatabaseClusterTemplate, overrides map[string]interface{}, namespace string) (*DatabaseCluster, error) {
+	// Round trip the template spec to get a deep copy of it.
+	spec := map[string]interface{}{}
+	if err := roundTrip(t.Spec, &spec); err != nil {
+		return nil, err
+	}
+	merged := mergeObjects(map[string]interface{}{"spec": spec}, overrides)
+
+	metadata, _ := merged["metadata"].(map[string]interface{})
+	if name, _ := metadata["name"].(string); name == "" {
+		return nil, errTemplateNoClusterName
+	}
+	metadata["namespace"] = namespace
+	merged["apiVersion"] = databaseClusterAPIVersion
+	merged["kind"] = databaseClusterKindName
+
+	dbc := &DatabaseCluster{}
+	if err := roundTrip(merged, dbc); err != nil {
+		return nil, errors.Join(err, errors.New("invalid database cluster"))
+	}
+	return dbc, nil
+}
+
+// mergeObjects merges the patch into the target following JSON merge patch (RFC 7386) semantics:
+// nested objects are merged, null values remove the key and any other value replaces the target one.
+func mergeObjects(target, patch map[string]interface{}) map[string]interface{} {
+	if target == nil {
+		target = map[string]interface{}{}
+	}
+	for key, value := range patch {
+		if value == nil {
+			delete(target, key)
+			continue
+		}
+		patchObj, ok := value.(map[string]interface{})
+		if !ok {
+			target[key] = value
+			continue
+		}
+		targetObj, _ := target[key].(map[string]interface{})
+		target[key] = mergeObjects(targetObj, patchObj)
+	}
+	return target
+}
+
+// roundTrip converts between types sharing the JSON representation.
+func roundTrip(from, to any) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, to)
+}
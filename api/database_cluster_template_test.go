@@ -0,0 +1,99 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeObjects(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		target   []byte
+		patch    []byte
+		expected []byte
+	}{
+		{
+			name:     "nested objects are merged",
+			target:   []byte(`{"engine": {"type": "pxc", "replicas": 1}}`),
+			patch:    []byte(`{"engine": {"replicas": 3}}`),
+			expected: []byte(`{"engine": {"type": "pxc", "replicas": 3}}`),
+		},
+		{
+			name:     "lists are replaced",
+			target:   []byte(`{"backup": {"schedules": [{"name": "a"}, {"name": "b"}]}}`),
+			patch:    []byte(`{"backup": {"schedules": [{"name": "c"}]}}`),
+			expected: []byte(`{"backup": {"schedules": [{"name": "c"}]}}`),
+		},
+		{
+			name:     "null removes the key",
+			target:   []byte(`{"proxy": {"type": "haproxy"}, "engine": {"type": "pxc"}}`),
+			patch:    []byte(`{"proxy": null}`),
+			expected: []byte(`{"engine": {"type": "pxc"}}`),
+		},
+		{
+			name:     "missing keys are added",
+			target:   []byte(`{}`),
+			patch:    []byte(`{"monitoring": {"monitoringConfigName": "pmm"}}`),
+			expected: []byte(`{"monitoring": {"monitoringConfigName": "pmm"}}`),
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var target, patch, expected map[string]interface{}
+			require.NoError(t, json.Unmarshal(tc.target, &target))
+			require.NoError(t, json.Unmarshal(tc.patch, &patch))
+			require.NoError(t, json.Unmarshal(tc.expected, &expected))
+			assert.Equal(t, expected, mergeObjects(target, patch))
+		})
+	}
+}
+
+func TestDatabaseClusterFromTemplate(t *testing.T) {
+	t.Parallel()
+	template := &DatabaseClusterTemplate{}
+	err := json.Unmarshal([]byte(`{"name": "small-mysql", "spec": {"engine": {"type": "pxc", "replicas": 1, "storage": {"size": "25G"}}}}`), template)
+	require.NoError(t, err)
+
+	t.Run("overrides are applied", func(t *testing.T) {
+		t.Parallel()
+		overrides := map[string]interface{}{}
+		err := json.Unmarshal([]byte(`{"metadata": {"name": "db", "namespace": "other"}, "spec": {"engine": {"replicas": 3}}}`), &overrides)
+		require.NoError(t, err)
+
+		dbc, err := databaseClusterFromTemplate(template, overrides, "ns")
+		require.NoError(t, err)
+		assert.Equal(t, "db", (*dbc.Metadata)["name"])
+		assert.Equal(t, "ns", (*dbc.Metadata)["namespace"])
+		assert.Equal(t, databaseClusterKindName, *dbc.Kind)
+		assert.Equal(t, DatabaseClusterSpecEngineType("pxc"), dbc.Spec.Engine.Type)
+		assert.Equal(t, int32(3), *dbc.Spec.Engine.Replicas)
+		// The template itself is not modified.
+		assert.Equal(t, float64(1), template.Spec["engine"].(map[string]interface{})["replicas"]) //nolint:forcetypeassert
+	})
+
+	t.Run("errTemplateNoClusterName", func(t *testing.T) {
+		t.Parallel()
+		_, err := databaseClusterFromTemplate(template, map[string]interface{}{}, "ns")
+		assert.Equal(t, errTemplateNoClusterName, err)
+	})
+}
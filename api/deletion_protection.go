@@ -0,0 +1,276 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// deletionProtectionConfigMapName is the name of the config map in the Everest namespace
+	// which stores the deletion protection of database clusters and the defaults of namespaces.
+	deletionProtectionConfigMapName = "everest-deletion-protection"
+
+	confirmNameParam = "confirmName"
+)
+
+var (
+	errDeletionProtected            = errors.New("database cluster is protected from deletion. Remove the deletion protection first")
+	errDeletionConfirmationRequired = fmt.Errorf("database cluster deletion requires confirmation. Set the %s parameter to the database cluster name", confirmNameParam)
+	errConfirmNameMismatch          = fmt.Errorf("the %s parameter does not match the database cluster name", confirmNameParam)
+	errDeletionProtectionRemoval    = errors.New("deletion protection can't be weakened with an update. Use the unprotect action instead")
+	errDeletionProtectionReason     = errors.New("a reason is required to weaken the deletion protection")
+)
+
+// GetDatabaseClusterDeletionProtection returns the deletion protection in effect for the specified database cluster.
+func (e *EverestServer) GetDatabaseClusterDeletionProtection(ctx echo.Context, namespace, name string) error {
+	if _, err := e.kubeClient.GetDatabaseCluster(ctx.Request().Context(), namespace, name); err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	dp, err := e.databaseClusterDeletionProtection(ctx.Request().Context(), namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the deletion protection")})
+	}
+
+	return ctx.JSON(http.StatusOK, dp)
+}
+
+// UpdateDatabaseClusterDeletionProtection sets the deletion protection of the specified database cluster.
+func (e *EverestServer) UpdateDatabaseClusterDeletionProtection(ctx echo.Context, namespace, name string) error {
+	protection := &DeletionProtection{}
+	if err := e.getBodyFromContext(ctx, protection); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get DeletionProtection from the request body"),
+		})
+	}
+
+	if _, err := e.kubeClient.GetDatabaseCluster(ctx.Request().Context(), namespace, name); err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	current, err := e.databaseClusterDeletionProtection(ctx.Request().Context(), namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the deletion protection")})
+	}
+	if weakensDeletionProtection(&current.Protection, protection) {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(errDeletionProtectionRemoval.Error())})
+	}
+
+	protection.UpdatedAt = pointer.ToTime(time.Now().UTC())
+	if err := e.saveDeletionProtection(ctx.Request().Context(), dbClusterEntryKey(namespace, name), protection); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the deletion protection")})
+	}
+
+	return ctx.JSON(http.StatusOK, DatabaseClusterDeletionProtection{Source: Cluster, Protection: *protection})
+}
+
+// UnprotectDatabaseCluster removes the deletion protection of the specified database cluster.
+func (e *EverestServer) UnprotectDatabaseCluster(ctx echo.Context, namespace, name string) error {
+	removal := &DeletionProtectionRemoval{}
+	if err := e.getBodyFromContext(ctx, removal); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get DeletionProtectionRemoval from the request body"),
+		})
+	}
+	reason := strings.TrimSpace(removal.Reason)
+	if reason == "" {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(errDeletionProtectionReason.Error())})
+	}
+
+	if _, err := e.kubeClient.GetDatabaseCluster(ctx.Request().Context(), namespace, name); err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	// The protection is stored as disabled rather than deleted,
+	// so the namespace default does not apply to the database cluster anymore.
+	protection := &DeletionProtection{
+		Enabled:   false,
+		Reason:    pointer.ToString(reason),
+		UpdatedAt: pointer.ToTime(time.Now().UTC()),
+	}
+	if err := e.saveDeletionProtection(ctx.Request().Context(), dbClusterEntryKey(namespace, name), protection); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the deletion protection")})
+	}
+	e.l.Infow("Deletion protection of database cluster removed",
+		"namespace", namespace, "name", name, "reason", reason, "remoteAddr", ctx.RealIP())
+
+	return ctx.JSON(http.StatusOK, DatabaseClusterDeletionProtection{Source: Cluster, Protection: *protection})
+}
+
+// GetNamespaceDeletionProtection returns the default deletion protection of the specified namespace.
+func (e *EverestServer) GetNamespaceDeletionProtection(ctx echo.Context, namespace string) error {
+	protection, err := e.getDeletionProtection(ctx.Request().Context(), namespace)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the deletion protection")})
+	}
+	if protection == nil {
+		protection = &DeletionProtection{}
+	}
+
+	return ctx.JSON(http.StatusOK, protection)
+}
+
+// UpdateNamespaceDeletionProtection sets the default deletion protection of the specified namespace.
+func (e *EverestServer) UpdateNamespaceDeletionProtection(ctx echo.Context, namespace string) error {
+	protection := &DeletionProtection{}
+	if err := e.getBodyFromContext(ctx, protection); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get DeletionProtection from the request body"),
+		})
+	}
+
+	namespaces, err := e.kubeClient.GetDBNamespaces(ctx.Request().Context(), e.kubeClient.Namespace())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Failed getting watched namespaces"),
+		})
+	}
+	if err := validateAllowedNamespaces([]string{namespace}, namespaces); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	current, err := e.getDeletionProtection(ctx.Request().Context(), namespace)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the deletion protection")})
+	}
+	weakened := current != nil && weakensDeletionProtection(current, protection)
+	if weakened && strings.TrimSpace(pointer.GetString(protection.Reason)) == "" {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(errDeletionProtectionReason.Error())})
+	}
+
+	protection.UpdatedAt = pointer.ToTime(time.Now().UTC())
+	if err := e.saveDeletionProtection(ctx.Request().Context(), namespace, protection); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the deletion protection")})
+	}
+	if weakened {
+		e.l.Infow("Default deletion protection of namespace weakened",
+			"namespace", namespace, "reason", pointer.GetString(protection.Reason), "remoteAddr", ctx.RealIP())
+	}
+
+	return ctx.JSON(http.StatusOK, protection)
+}
+
+// databaseClusterDeletionProtection returns the deletion protection in effect for the database cluster.
+// The setting of the database cluster takes precedence over the default of the namespace.
+func (e *EverestServer) databaseClusterDeletionProtection(
+	ctx context.Context,
+	namespace, name string,
+) (*DatabaseClusterDeletionProtection, error) {
+	data, err := e.kubeClient.GetConfigMapData(ctx, deletionProtectionConfigMapName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range []struct {
+		key    string
+		source SettingSource
+	}{
+		{key: dbClusterEntryKey(namespace, name), source: Cluster},
+		{key: namespace, source: Namespace},
+	} {
+		v, ok := data[c.key]
+		if !ok {
+			continue
+		}
+		dp := &DatabaseClusterDeletionProtection{Source: c.source}
+		if err := json.Unmarshal([]byte(v), &dp.Protection); err != nil {
+			return nil, err
+		}
+		return dp, nil
+	}
+	return &DatabaseClusterDeletionProtection{Source: None}, nil
+}
+
+// getDeletionProtection returns the deletion protection stored under the key or nil if there is none.
+func (e *EverestServer) getDeletionProtection(ctx context.Context, key string) (*DeletionProtection, error) {
+	data, err := e.kubeClient.GetConfigMapData(ctx, deletionProtectionConfigMapName)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := data[key]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+	dp := &DeletionProtection{}
+	if err := json.Unmarshal([]byte(v), dp); err != nil {
+		return nil, err
+	}
+	return dp, nil
+}
+
+func (e *EverestServer) saveDeletionProtection(ctx context.Context, key string, dp *DeletionProtection) error {
+	data, err := json.Marshal(dp)
+	if err != nil {
+		return err
+	}
+	return e.kubeClient.SetConfigMapEntry(ctx, deletionProtectionConfigMapName, key, string(data))
+}
+
+// weakensDeletionProtection returns true if the updated protection allows deletions the current one does not.
+func weakensDeletionProtection(current, updated *DeletionProtection) bool {
+	if updated.Enabled {
+		return false
+	}
+	return current.Enabled || (pointer.GetBool(current.RequireConfirmation) && !pointer.GetBool(updated.RequireConfirmation))
+}
+
+// checkDeletionAllowed checks the database cluster can be deleted with the provided confirmation.
+func checkDeletionAllowed(dp *DatabaseClusterDeletionProtection, name string, confirmName *string) error {
+	if confirmName != nil && *confirmName != name {
+		return errConfirmNameMismatch
+	}
+	if dp.Protection.Enabled {
+		return errDeletionProtected
+	}
+	if pointer.GetBool(dp.Protection.RequireConfirmation) && confirmName == nil {
+		return errDeletionConfirmationRequired
+	}
+	return nil
+}
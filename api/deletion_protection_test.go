@@ -0,0 +1,90 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDeletionAllowed(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name        string
+		protection  DeletionProtection
+		confirmName *string
+		err         error
+	}{
+		{
+			name: "not protected",
+		},
+		{
+			name:       "protected",
+			protection: DeletionProtection{Enabled: true},
+			err:        errDeletionProtected,
+		},
+		{
+			name:        "protected with confirmation",
+			protection:  DeletionProtection{Enabled: true},
+			confirmName: pointer.ToString("db"),
+			err:         errDeletionProtected,
+		},
+		{
+			name:       "confirmation required",
+			protection: DeletionProtection{RequireConfirmation: pointer.ToBool(true)},
+			err:        errDeletionConfirmationRequired,
+		},
+		{
+			name:        "confirmed",
+			protection:  DeletionProtection{RequireConfirmation: pointer.ToBool(true)},
+			confirmName: pointer.ToString("db"),
+		},
+		{
+			name:        "wrong confirmation",
+			confirmName: pointer.ToString("other-db"),
+			err:         errConfirmNameMismatch,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			dp := &DatabaseClusterDeletionProtection{Source: Cluster, Protection: tc.protection}
+			err := checkDeletionAllowed(dp, "db", tc.confirmName)
+			if tc.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestWeakensDeletionProtection(t *testing.T) {
+	t.Parallel()
+	enabled := &DeletionProtection{Enabled: true}
+	confirm := &DeletionProtection{RequireConfirmation: pointer.ToBool(true)}
+	none := &DeletionProtection{}
+
+	assert.True(t, weakensDeletionProtection(enabled, confirm))
+	assert.True(t, weakensDeletionProtection(enabled, none))
+	assert.True(t, weakensDeletionProtection(confirm, none))
+	assert.False(t, weakensDeletionProtection(confirm, enabled))
+	assert.False(t, weakensDeletionProtection(none, confirm))
+	assert.False(t, weakensDeletionProtection(enabled, enabled))
+}
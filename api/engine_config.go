@@ -0,0 +1,500 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"gopkg.in/yaml.v3"
+)
+
+var errInvalidConfig = errors.New("invalid configuration")
+
+type paramKind int
+
+const (
+	paramString paramKind = iota
+	paramInt
+	paramFloat
+	paramBool
+	paramEnum
+	// paramSize is an amount of memory with an optional unit.
+	paramSize
+	// paramDuration is a time interval with an optional unit.
+	paramDuration
+)
+
+// paramSpec describes the values accepted by a configuration parameter.
+type paramSpec struct {
+	kind paramKind
+	// min and max limit the value. Sizes are limited in bytes and durations in milliseconds.
+	// Zero max means there is no upper limit.
+	min, max float64
+	// unit is the size in bytes or the duration in milliseconds of a value
+	// specified without a unit.
+	unit float64
+	// values are the accepted values of an enum parameter.
+	values []string
+}
+
+// configIssue is a problem found in a configuration at the specific line.
+type configIssue struct {
+	line int
+	msg  string
+}
+
+// configEntry is a parameter set in a configuration.
+type configEntry struct {
+	line    int
+	section string
+	name    string
+	value   string
+	// noValue is set for the options specified without a value, e.g. skip_name_resolve in my.cnf.
+	noValue bool
+}
+
+// configDialect describes how the parameters of a configuration are validated.
+type configDialect struct {
+	params  map[string]paramSpec
+	managed []string
+	// warnUnknown reports the parameters missing in params as warnings. They are passed
+	// as is since params lists only the parameters with the known ranges.
+	warnUnknown bool
+	// openPrefixes are the prefixes of the parameters which are not validated,
+	// e.g. the server parameters of mongod.
+	openPrefixes []string
+	// customParams allows the parameters with a dot in the name. In PostgreSQL they are
+	// parameters of the extensions.
+	customParams bool
+	// sizeUnits and timeUnits are the units accepted in the values.
+	sizeUnits map[string]float64
+	timeUnits map[string]float64
+	boolWords []string
+}
+
+const (
+	kb = 1024
+	mb = 1024 * kb
+	gb = 1024 * mb
+	tb = 1024 * gb
+)
+
+var (
+	//nolint:gochecknoglobals
+	mysqlSizeUnits = map[string]float64{"k": kb, "m": mb, "g": gb, "t": tb}
+	//nolint:gochecknoglobals
+	pgSizeUnits = map[string]float64{"B": 1, "kB": kb, "MB": mb, "GB": gb, "TB": tb}
+	//nolint:gochecknoglobals
+	pgTimeUnits = map[string]float64{"us": 0.001, "ms": 1, "s": 1000, "min": 60000, "h": 3600000, "d": 86400000}
+
+	unitValueRegexp = regexp.MustCompile(`^(-?[0-9.]+)\s*([a-zA-Z]*)$`)
+)
+
+// validateEngineConfig validates the engine and proxy configurations of the database cluster.
+// The configurations the existing database cluster already has are not validated again.
+// It returns the warnings about the parameters which are not validated.
+func validateEngineConfig(cluster *DatabaseCluster, existing *everestv1alpha1.DatabaseCluster) ([]string, error) {
+	if cluster.Spec == nil {
+		return nil, nil
+	}
+
+	var issues, warnings []string
+	add := func(field string, found, warned []configIssue) {
+		for _, i := range sortedIssues(found) {
+			issues = append(issues, fmt.Sprintf("%s line %d: %s", field, i.line, i.msg))
+		}
+		for _, i := range sortedIssues(warned) {
+			warnings = append(warnings, fmt.Sprintf("%s line %d: %s", field, i.line, i.msg))
+		}
+	}
+	if cfg := cluster.Spec.Engine.Config; cfg != nil && strings.TrimSpace(*cfg) != "" &&
+		(existing == nil || existing.Spec.Engine.Config != *cfg) {
+		found, warned := engineConfigIssues(everestv1alpha1.EngineType(cluster.Spec.Engine.Type), *cfg)
+		add("spec.engine.config", found, warned)
+	}
+	if p := cluster.Spec.Proxy; p != nil && p.Config != nil && p.Type != nil && strings.TrimSpace(*p.Config) != "" &&
+		(existing == nil || existing.Spec.Proxy.Config != *p.Config || string(existing.Spec.Proxy.Type) != string(*p.Type)) {
+		found, warned := proxyConfigIssues(everestv1alpha1.ProxyType(*p.Type), *p.Config)
+		add("spec.proxy.config", found, warned)
+	}
+	if len(issues) == 0 {
+		return warnings, nil
+	}
+	return warnings, fmt.Errorf("%w: %s", errInvalidConfig, strings.Join(issues, "; "))
+}
+
+func sortedIssues(issues []configIssue) []configIssue {
+	slices.SortStableFunc(issues, func(a, b configIssue) int { return a.line - b.line })
+	return issues
+}
+
+// engineConfigIssues validates the engine configuration. It returns the issues and the warnings.
+func engineConfigIssues(engine everestv1alpha1.EngineType, cfg string) ([]configIssue, []configIssue) {
+	switch engine {
+	case everestv1alpha1.DatabaseEnginePXC:
+		entries, issues := parseINIConfig(cfg, true)
+		return mysqlDialect.check(issues, mysqlEntries(entries))
+	case everestv1alpha1.DatabaseEnginePSMDB:
+		entries, issues := parseYAMLConfig(cfg)
+		return mongodDialect.check(issues, entries)
+	case everestv1alpha1.DatabaseEnginePostgresql:
+		entries, issues := parsePGConfig(cfg)
+		return pgDialect.check(issues, entries)
+	}
+	return nil, nil
+}
+
+// proxyConfigIssues validates the proxy configuration. HAProxy and ProxySQL configurations
+// are merged by the operator with its own ones, so they are passed as is.
+// It returns the issues and the warnings.
+func proxyConfigIssues(proxy everestv1alpha1.ProxyType, cfg string) ([]configIssue, []configIssue) {
+	switch proxy { //nolint:exhaustive
+	case everestv1alpha1.ProxyTypeMongos:
+		entries, issues := parseYAMLConfig(cfg)
+		return mongosDialect.check(issues, entries)
+	case everestv1alpha1.ProxyTypePGBouncer:
+		entries, issues := parseINIConfig(cfg, false)
+		// The other sections list databases and users, not options.
+		entries = slices.DeleteFunc(entries, func(e configEntry) bool { return e.section != "pgbouncer" })
+		return pgbouncerDialect.check(issues, entries)
+	}
+	return nil, nil
+}
+
+// check appends the issues of the entries to the given ones and returns them with the warnings.
+func (d *configDialect) check(issues []configIssue, entries []configEntry) ([]configIssue, []configIssue) {
+	var warnings []configIssue
+	for _, e := range entries {
+		if d.isManaged(e.name) {
+			issues = append(issues, configIssue{e.line, fmt.Sprintf("parameter '%s' is managed by the operator", e.name)})
+			continue
+		}
+		spec, ok := d.params[e.name]
+		if !ok {
+			if d.warnUnknown && !d.isOpen(e.name) {
+				warnings = append(warnings, configIssue{e.line, fmt.Sprintf("unknown parameter '%s' is not validated", e.name)})
+			}
+			continue
+		}
+		if err := d.checkValue(spec, e); err != nil {
+			issues = append(issues, configIssue{e.line, fmt.Sprintf("parameter '%s': %s", e.name, err)})
+		}
+	}
+	return issues, warnings
+}
+
+func (d *configDialect) isManaged(name string) bool {
+	for _, m := range d.managed {
+		if name == m || strings.HasPrefix(name, m+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *configDialect) isOpen(name string) bool {
+	if d.customParams && strings.Contains(name, ".") {
+		return true
+	}
+	for _, p := range d.openPrefixes {
+		if strings.HasPrefix(name, p+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *configDialect) checkValue(spec paramSpec, e configEntry) error { //nolint:cyclop
+	if e.noValue {
+		if spec.kind == paramBool {
+			return nil
+		}
+		return errors.New("a value is required")
+	}
+
+	var v float64
+	var err error
+	switch spec.kind {
+	case paramString:
+		return nil
+	case paramBool:
+		if !slices.Contains(d.boolWords, strings.ToLower(e.value)) {
+			return fmt.Errorf("'%s' is not a boolean value", e.value)
+		}
+		return nil
+	case paramEnum:
+		for _, val := range spec.values {
+			if strings.EqualFold(val, e.value) {
+				return nil
+			}
+		}
+		return fmt.Errorf("'%s' is not one of %s", e.value, strings.Join(spec.values, ", "))
+	case paramInt:
+		var i int64
+		i, err = strconv.ParseInt(e.value, 10, 64)
+		v = float64(i)
+	case paramFloat:
+		v, err = strconv.ParseFloat(e.value, 64)
+	case paramSize:
+		v, err = parseUnitValue(e.value, spec.unit, d.sizeUnits)
+	case paramDuration:
+		v, err = parseUnitValue(e.value, spec.unit, d.timeUnits)
+	}
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Errorf("invalid value '%s'", e.value)
+	}
+	if v < spec.min || (spec.max != 0 && v > spec.max) {
+		return fmt.Errorf("value '%s' is out of range", e.value)
+	}
+	return nil
+}
+
+// parseUnitValue parses a number with an optional unit and returns it in the base unit.
+func parseUnitValue(value string, unit float64, units map[string]float64) (float64, error) {
+	m := unitValueRegexp.FindStringSubmatch(value)
+	if m == nil {
+		return 0, errors.New("invalid value")
+	}
+	n, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, err
+	}
+	if m[2] == "" {
+		if unit == 0 {
+			unit = 1
+		}
+		return n * unit, nil
+	}
+	// MySQL units are case insensitive and PostgreSQL ones are not.
+	mult, ok := units[m[2]]
+	if !ok {
+		mult, ok = units[strings.ToLower(m[2])]
+	}
+	if !ok {
+		return 0, fmt.Errorf("unknown unit '%s'", m[2])
+	}
+	return n * mult, nil
+}
+
+// parseINIConfig parses an INI configuration like my.cnf or pgbouncer.ini.
+// Comments start with # or ;. If sectionRequired is set, parameters outside of sections are rejected.
+func parseINIConfig(cfg string, sectionRequired bool) ([]configEntry, []configIssue) {
+	var entries []configEntry
+	var issues []configIssue
+	section := ""
+	for i, raw := range strings.Split(cfg, "\n") {
+		line := strings.TrimSpace(raw)
+		n := i + 1
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "!"):
+			issues = append(issues, configIssue{n, "include directives are not supported"})
+			continue
+		case strings.HasPrefix(line, "["):
+			if !strings.HasSuffix(line, "]") || len(line) < 3 {
+				issues = append(issues, configIssue{n, fmt.Sprintf("invalid section header '%s'", line)})
+				continue
+			}
+			section = strings.ToLower(strings.TrimSpace(line[1 : len(line)-1]))
+			continue
+		}
+		if section == "" && sectionRequired {
+			issues = append(issues, configIssue{n, "parameter outside of a section"})
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(stripINIComment(line), "=")
+		name = strings.TrimSpace(name)
+		if name == "" || strings.ContainsAny(name, " \t\"'") {
+			issues = append(issues, configIssue{n, fmt.Sprintf("invalid line '%s'", line)})
+			continue
+		}
+		value, ok := unquote(strings.TrimSpace(value))
+		if !ok {
+			issues = append(issues, configIssue{n, "unterminated quoted value"})
+			continue
+		}
+		entries = append(entries, configEntry{line: n, section: section, name: name, value: value, noValue: !hasValue})
+	}
+	return entries, issues
+}
+
+// stripINIComment removes a trailing # comment unless it is quoted.
+func stripINIComment(line string) string {
+	var quote rune
+	for i, c := range line {
+		switch {
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote == 0 && (c == '"' || c == '\''):
+			quote = c
+		case quote == 0 && c == '#':
+			return strings.TrimSpace(line[:i])
+		}
+	}
+	return line
+}
+
+func unquote(value string) (string, bool) {
+	if value == "" || (value[0] != '"' && value[0] != '\'') {
+		return value, true
+	}
+	if len(value) < 2 || value[len(value)-1] != value[0] {
+		return "", false
+	}
+	return value[1 : len(value)-1], true
+}
+
+// mysqlEntries returns the [mysqld] options with normalized names.
+// Options of the other sections are not used by the server and are not validated.
+func mysqlEntries(entries []configEntry) []configEntry {
+	result := make([]configEntry, 0, len(entries))
+	for _, e := range entries {
+		if e.section != "mysqld" {
+			continue
+		}
+		e.name = strings.ReplaceAll(strings.ToLower(e.name), "-", "_")
+		switch {
+		case strings.HasPrefix(e.name, "loose_"):
+			// The server ignores unknown loose options, so only the known ones are validated.
+			e.name = strings.TrimPrefix(e.name, "loose_")
+			if _, ok := mysqlDialect.params[e.name]; !ok && !mysqlDialect.isManaged(e.name) {
+				continue
+			}
+		case strings.HasPrefix(e.name, "skip_") && e.noValue:
+			name := strings.TrimPrefix(e.name, "skip_")
+			if spec, ok := mysqlDialect.params[name]; (ok && spec.kind == paramBool) || mysqlDialect.isManaged(name) {
+				e.name = name
+			}
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
+// parsePGConfig parses a postgresql.conf configuration.
+func parsePGConfig(cfg string) ([]configEntry, []configIssue) {
+	var entries []configEntry
+	var issues []configIssue
+	for i, raw := range strings.Split(cfg, "\n") {
+		line := strings.TrimSpace(raw)
+		n := i + 1
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		name, rest := line, ""
+		if idx := strings.IndexAny(line, " \t="); idx >= 0 {
+			name, rest = line[:idx], strings.TrimSpace(line[idx:])
+		}
+		name = strings.ToLower(name)
+		if slices.Contains([]string{"include", "include_dir", "include_if_exists"}, name) {
+			issues = append(issues, configIssue{n, "include directives are not supported"})
+			continue
+		}
+		rest = strings.TrimSpace(strings.TrimPrefix(rest, "="))
+		value, ok := pgValue(rest)
+		if !ok {
+			issues = append(issues, configIssue{n, fmt.Sprintf("invalid line '%s'", line)})
+			continue
+		}
+		entries = append(entries, configEntry{line: n, name: name, value: value})
+	}
+	return entries, issues
+}
+
+// pgValue returns the value without the trailing comment and quotes.
+func pgValue(s string) (string, bool) {
+	if strings.HasPrefix(s, "'") {
+		var b strings.Builder
+		for i := 1; i < len(s); i++ {
+			switch {
+			case s[i] == '\'' && i+1 < len(s) && s[i+1] == '\'':
+				b.WriteByte('\'')
+				i++
+			case s[i] == '\\' && i+1 < len(s):
+				b.WriteByte(s[i+1])
+				i++
+			case s[i] == '\'':
+				tail := strings.TrimSpace(s[i+1:])
+				return b.String(), tail == "" || strings.HasPrefix(tail, "#")
+			default:
+				b.WriteByte(s[i])
+			}
+		}
+		return "", false
+	}
+	value, _, _ := strings.Cut(s, "#")
+	value = strings.TrimSpace(value)
+	return value, value != "" && !strings.ContainsAny(value, " \t")
+}
+
+// parseYAMLConfig parses a YAML configuration like mongod.conf.
+// Nested keys are joined with dots, e.g. storage.wiredTiger.engineConfig.cacheSizeGB.
+func parseYAMLConfig(cfg string) ([]configEntry, []configIssue) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(cfg), &doc); err != nil {
+		return nil, []configIssue{yamlIssue(err)}
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, []configIssue{{root.Line, "the configuration must be a mapping"}}
+	}
+	var entries []configEntry
+	flattenYAML(root, "", &entries)
+	return entries, nil
+}
+
+func flattenYAML(node *yaml.Node, prefix string, entries *[]configEntry) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		name := key.Value
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		if value.Kind == yaml.MappingNode {
+			flattenYAML(value, name, entries)
+			continue
+		}
+		*entries = append(*entries, configEntry{line: key.Line, name: name, value: value.Value})
+	}
+}
+
+var yamlErrorRegexp = regexp.MustCompile(`^yaml: (?:line (\d+): )?`)
+
+func yamlIssue(err error) configIssue {
+	msg := err.Error()
+	m := yamlErrorRegexp.FindStringSubmatch(msg)
+	if m == nil {
+		return configIssue{1, msg}
+	}
+	line, convErr := strconv.Atoi(m[1])
+	if convErr != nil {
+		line = 1
+	}
+	return configIssue{line, msg[len(m[0]):]}
+}
@@ -0,0 +1,321 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+const (
+	maxInt32  = 1<<31 - 1
+	maxUint32 = 1<<32 - 1
+	// maxSafeInt is the biggest integer a float64 holds exactly.
+	maxSafeInt = 1 << 53
+)
+
+//nolint:gochecknoglobals
+var (
+	boolParam   = paramSpec{kind: paramBool}
+	stringParam = paramSpec{kind: paramString}
+)
+
+func intParam(min, max float64) paramSpec {
+	return paramSpec{kind: paramInt, min: min, max: max}
+}
+
+func floatParam(min, max float64) paramSpec {
+	return paramSpec{kind: paramFloat, min: min, max: max}
+}
+
+func enumParam(values ...string) paramSpec {
+	return paramSpec{kind: paramEnum, values: values}
+}
+
+func sizeParam(unit, min, max float64) paramSpec {
+	return paramSpec{kind: paramSize, unit: unit, min: min, max: max}
+}
+
+func durationParam(unit, min, max float64) paramSpec {
+	return paramSpec{kind: paramDuration, unit: unit, min: min, max: max}
+}
+
+// mysqlDialect validates the [mysqld] section of my.cnf for PXC.
+//
+//nolint:gochecknoglobals
+var mysqlDialect = &configDialect{
+	warnUnknown: true,
+	sizeUnits:   mysqlSizeUnits,
+	boolWords:   []string{"on", "off", "1", "0", "true", "false"},
+	managed: []string{
+		"port", "bind_address", "admin_address", "admin_port", "mysqlx_port", "socket", "datadir",
+		"server_id", "log_bin", "binlog_format", "gtid_mode", "enforce_gtid_consistency",
+		"log_slave_updates", "log_replica_updates", "ssl_ca", "ssl_cert", "ssl_key",
+		"wsrep_provider", "wsrep_cluster_address", "wsrep_cluster_name", "wsrep_node_address",
+		"wsrep_node_name", "wsrep_node_incoming_address", "wsrep_sst_method", "wsrep_sst_donor",
+		"wsrep_sst_receive_address",
+	},
+	params: map[string]paramSpec{
+		"innodb_buffer_pool_size":         sizeParam(1, 5*mb, 0),
+		"innodb_buffer_pool_instances":    intParam(1, 64),
+		"innodb_buffer_pool_chunk_size":   sizeParam(1, mb, 0),
+		"innodb_log_file_size":            sizeParam(1, 4*mb, 512*gb),
+		"innodb_redo_log_capacity":        sizeParam(1, 8*mb, 128*gb),
+		"innodb_log_buffer_size":          sizeParam(1, 256*kb, 4*gb),
+		"innodb_flush_log_at_trx_commit":  enumParam("0", "1", "2"),
+		"innodb_flush_method":             enumParam("fsync", "O_DSYNC", "littlesync", "nosync", "O_DIRECT", "O_DIRECT_NO_FSYNC"),
+		"innodb_io_capacity":              intParam(100, maxSafeInt),
+		"innodb_io_capacity_max":          intParam(100, maxSafeInt),
+		"innodb_file_per_table":           boolParam,
+		"innodb_read_io_threads":          intParam(1, 64),
+		"innodb_write_io_threads":         intParam(1, 64),
+		"innodb_purge_threads":            intParam(1, 32),
+		"innodb_thread_concurrency":       intParam(0, 1000),
+		"innodb_lock_wait_timeout":        intParam(1, 1073741824),
+		"innodb_autoinc_lock_mode":        enumParam("0", "1", "2"),
+		"innodb_print_all_deadlocks":      boolParam,
+		"innodb_stats_on_metadata":        boolParam,
+		"innodb_adaptive_hash_index":      boolParam,
+		"innodb_doublewrite":              stringParam,
+		"innodb_open_files":               intParam(10, maxInt32),
+		"innodb_temp_data_file_path":      stringParam,
+		"max_connections":                 intParam(1, 100000),
+		"max_user_connections":            intParam(0, maxUint32),
+		"max_connect_errors":              intParam(1, maxSafeInt),
+		"max_allowed_packet":              sizeParam(1, kb, gb),
+		"table_open_cache":                intParam(1, 524288),
+		"table_open_cache_instances":      intParam(1, 64),
+		"table_definition_cache":          intParam(400, 524288),
+		"thread_cache_size":               intParam(0, 16384),
+		"thread_stack":                    sizeParam(1, 128*kb, 0),
+		"tmp_table_size":                  sizeParam(1, kb, 0),
+		"max_heap_table_size":             sizeParam(1, 16*kb, 0),
+		"sort_buffer_size":                sizeParam(1, 32*kb, 0),
+		"join_buffer_size":                sizeParam(1, 128, 0),
+		"read_buffer_size":                sizeParam(1, 8*kb, 2*gb),
+		"read_rnd_buffer_size":            sizeParam(1, 1, 2*gb),
+		"wait_timeout":                    intParam(1, 31536000),
+		"interactive_timeout":             intParam(1, 31536000),
+		"net_read_timeout":                intParam(1, 31536000),
+		"net_write_timeout":               intParam(1, 31536000),
+		"connect_timeout":                 intParam(2, 31536000),
+		"lock_wait_timeout":               intParam(1, 31536000),
+		"long_query_time":                 floatParam(0, 31536000),
+		"slow_query_log":                  boolParam,
+		"log_queries_not_using_indexes":   boolParam,
+		"log_slow_verbosity":              stringParam,
+		"log_error_verbosity":             enumParam("1", "2", "3"),
+		"general_log":                     boolParam,
+		"character_set_server":            stringParam,
+		"collation_server":                stringParam,
+		"default_time_zone":               stringParam,
+		"sql_mode":                        stringParam,
+		"transaction_isolation":           enumParam("READ-UNCOMMITTED", "READ-COMMITTED", "REPEATABLE-READ", "SERIALIZABLE"),
+		"lower_case_table_names":          enumParam("0", "1", "2"),
+		"explicit_defaults_for_timestamp": boolParam,
+		"skip_name_resolve":               boolParam,
+		"performance_schema":              boolParam,
+		"binlog_expire_logs_seconds":      intParam(0, maxUint32),
+		"binlog_cache_size":               sizeParam(1, 4*kb, 0),
+		"binlog_row_image":                enumParam("full", "minimal", "noblob"),
+		"max_binlog_size":                 sizeParam(1, 4*kb, gb),
+		"sync_binlog":                     intParam(0, maxUint32),
+		"group_concat_max_len":            intParam(4, maxSafeInt),
+		"open_files_limit":                intParam(0, maxSafeInt),
+		"default_authentication_plugin":   enumParam("mysql_native_password", "sha256_password", "caching_sha2_password"),
+		"authentication_policy":           stringParam,
+		"event_scheduler":                 enumParam("ON", "OFF", "DISABLED"),
+		"local_infile":                    boolParam,
+		"wsrep_slave_threads":             intParam(1, 512),
+		"wsrep_applier_threads":           intParam(1, 512),
+		"wsrep_provider_options":          stringParam,
+		"wsrep_sync_wait":                 intParam(0, 15),
+		"wsrep_retry_autocommit":          intParam(0, 10000),
+		"wsrep_trx_fragment_size":         intParam(0, maxSafeInt),
+		"wsrep_trx_fragment_unit":         enumParam("bytes", "rows", "statements"),
+		"wsrep_log_conflicts":             boolParam,
+		"wsrep_debug":                     stringParam,
+		"pxc_strict_mode":                 enumParam("DISABLED", "PERMISSIVE", "ENFORCING", "MASTER"),
+		"pxc_maint_transition_period":     intParam(0, maxSafeInt),
+		"pxc_encrypt_cluster_traffic":     boolParam,
+	},
+}
+
+// pgDialect validates postgresql.conf for PostgreSQL.
+//
+//nolint:gochecknoglobals
+var pgDialect = &configDialect{
+	warnUnknown:  true,
+	customParams: true,
+	sizeUnits:    pgSizeUnits,
+	timeUnits:    pgTimeUnits,
+	boolWords:    []string{"on", "off", "true", "false", "yes", "no", "1", "0"},
+	managed: []string{
+		"port", "listen_addresses", "unix_socket_directories", "data_directory", "config_file",
+		"hba_file", "ident_file", "external_pid_file", "ssl", "ssl_cert_file", "ssl_key_file",
+		"ssl_ca_file", "ssl_crl_file", "archive_mode", "archive_command", "restore_command",
+		"wal_level", "hot_standby", "primary_conninfo", "primary_slot_name", "max_wal_senders",
+		"max_replication_slots", "wal_log_hints", "synchronous_standby_names",
+		"shared_preload_libraries", "recovery_target", "recovery_target_time",
+		"recovery_target_lsn", "recovery_target_name", "recovery_target_xid",
+		"recovery_target_action", "recovery_target_timeline",
+	},
+	params: map[string]paramSpec{
+		"max_connections":                     intParam(1, 262143),
+		"superuser_reserved_connections":      intParam(0, 262143),
+		"shared_buffers":                      sizeParam(8*kb, 128*kb, maxInt32*8*kb),
+		"effective_cache_size":                sizeParam(8*kb, 8*kb, maxInt32*8*kb),
+		"work_mem":                            sizeParam(kb, 64*kb, maxInt32*kb),
+		"maintenance_work_mem":                sizeParam(kb, mb, maxInt32*kb),
+		"autovacuum_work_mem":                 sizeParam(kb, -kb, maxInt32*kb),
+		"logical_decoding_work_mem":           sizeParam(kb, 64*kb, maxInt32*kb),
+		"temp_buffers":                        sizeParam(8*kb, 800*kb, maxInt32*8*kb),
+		"wal_buffers":                         sizeParam(8*kb, -8*kb, 262143*8*kb),
+		"min_wal_size":                        sizeParam(mb, 2*mb, maxInt32*mb),
+		"max_wal_size":                        sizeParam(mb, 2*mb, maxInt32*mb),
+		"wal_keep_size":                       sizeParam(mb, 0, maxInt32*mb),
+		"wal_compression":                     stringParam,
+		"checkpoint_timeout":                  durationParam(1000, 30*1000, 86400*1000),
+		"checkpoint_completion_target":        floatParam(0, 1),
+		"random_page_cost":                    floatParam(0, 0),
+		"seq_page_cost":                       floatParam(0, 0),
+		"effective_io_concurrency":            intParam(0, 1000),
+		"max_worker_processes":                intParam(0, 262143),
+		"max_parallel_workers":                intParam(0, 1024),
+		"max_parallel_workers_per_gather":     intParam(0, 1024),
+		"max_parallel_maintenance_workers":    intParam(0, 1024),
+		"default_statistics_target":           intParam(1, 10000),
+		"statement_timeout":                   durationParam(1, 0, maxInt32),
+		"idle_in_transaction_session_timeout": durationParam(1, 0, maxInt32),
+		"idle_session_timeout":                durationParam(1, 0, maxInt32),
+		"lock_timeout":                        durationParam(1, 0, maxInt32),
+		"deadlock_timeout":                    durationParam(1, 1, maxInt32),
+		"log_min_duration_statement":          durationParam(1, -1, maxInt32),
+		"log_autovacuum_min_duration":         durationParam(1, -1, maxInt32),
+		"log_statement":                       enumParam("none", "ddl", "mod", "all"),
+		"log_min_messages": enumParam("debug5", "debug4", "debug3", "debug2", "debug1",
+			"info", "notice", "warning", "error", "log", "fatal", "panic"),
+		"log_connections":                 boolParam,
+		"log_disconnections":              boolParam,
+		"log_lock_waits":                  boolParam,
+		"log_checkpoints":                 boolParam,
+		"log_line_prefix":                 stringParam,
+		"log_temp_files":                  sizeParam(kb, -kb, maxInt32*kb),
+		"autovacuum":                      boolParam,
+		"autovacuum_max_workers":          intParam(1, 262143),
+		"autovacuum_naptime":              durationParam(1000, 1000, maxInt32),
+		"autovacuum_vacuum_scale_factor":  floatParam(0, 100),
+		"autovacuum_analyze_scale_factor": floatParam(0, 100),
+		"autovacuum_vacuum_threshold":     intParam(0, maxInt32),
+		"autovacuum_analyze_threshold":    intParam(0, maxInt32),
+		"autovacuum_vacuum_cost_limit":    intParam(-1, 10000),
+		"autovacuum_freeze_max_age":       intParam(100000, 2000000000),
+		"huge_pages":                      enumParam("on", "off", "try"),
+		"jit":                             boolParam,
+		"synchronous_commit":              enumParam("on", "off", "local", "remote_write", "remote_apply"),
+		"default_transaction_isolation": enumParam("read uncommitted", "read committed",
+			"repeatable read", "serializable"),
+		"timezone":                       stringParam,
+		"log_timezone":                   stringParam,
+		"datestyle":                      stringParam,
+		"lc_messages":                    stringParam,
+		"lc_monetary":                    stringParam,
+		"lc_numeric":                     stringParam,
+		"lc_time":                        stringParam,
+		"search_path":                    stringParam,
+		"client_encoding":                stringParam,
+		"track_io_timing":                boolParam,
+		"track_activity_query_size":      sizeParam(1, 100, mb),
+		"tcp_keepalives_idle":            durationParam(1000, 0, maxInt32),
+		"tcp_keepalives_interval":        durationParam(1000, 0, maxInt32),
+		"tcp_keepalives_count":           intParam(0, maxInt32),
+		"bgwriter_delay":                 durationParam(1, 10, 10000),
+		"bgwriter_lru_maxpages":          intParam(0, 1073741823),
+		"bgwriter_lru_multiplier":        floatParam(0, 10),
+		"enable_seqscan":                 boolParam,
+		"enable_indexscan":               boolParam,
+		"enable_bitmapscan":              boolParam,
+		"enable_hashjoin":                boolParam,
+		"enable_mergejoin":               boolParam,
+		"enable_nestloop":                boolParam,
+		"enable_partitionwise_join":      boolParam,
+		"enable_partitionwise_aggregate": boolParam,
+	},
+}
+
+// mongodDialect validates mongod.conf for PSMDB.
+//
+//nolint:gochecknoglobals
+var mongodDialect = &configDialect{
+	warnUnknown:  true,
+	boolWords:    []string{"true", "false"},
+	openPrefixes: []string{"setParameter", "systemLog.component"},
+	managed: []string{
+		"net.port", "net.bindIp", "net.bindIpAll", "net.tls", "net.ssl", "replication.replSetName",
+		"replication.enableMajorityReadConcern", "sharding", "security.keyFile",
+		"security.clusterAuthMode", "security.authorization", "security.vault",
+		"security.encryptionKeyFile", "storage.dbPath", "processManagement", "systemLog.destination",
+		"systemLog.path",
+	},
+	params: map[string]paramSpec{
+		"storage.engine":                                      enumParam("wiredTiger", "inMemory"),
+		"storage.directoryPerDB":                              boolParam,
+		"storage.syncPeriodSecs":                              floatParam(0, 31536000),
+		"storage.journal.commitIntervalMs":                    intParam(1, 500),
+		"storage.wiredTiger.engineConfig.cacheSizeGB":         floatParam(0.25, 10000),
+		"storage.wiredTiger.engineConfig.journalCompressor":   enumParam("none", "snappy", "zlib", "zstd"),
+		"storage.wiredTiger.engineConfig.directoryForIndexes": boolParam,
+		"storage.wiredTiger.collectionConfig.blockCompressor": enumParam("none", "snappy", "zlib", "zstd"),
+		"storage.wiredTiger.indexConfig.prefixCompression":    boolParam,
+		"storage.inMemory.engineConfig.inMemorySizeGB":        floatParam(0.25, 10000),
+		"replication.oplogSizeMB":                             intParam(990, 1024*1024),
+		"operationProfiling.mode":                             enumParam("off", "slowOp", "all"),
+		"operationProfiling.slowOpThresholdMs":                intParam(0, maxInt32),
+		"operationProfiling.slowOpSampleRate":                 floatParam(0, 1),
+		"operationProfiling.rateLimit":                        intParam(1, maxInt32),
+		"systemLog.verbosity":                                 intParam(0, 5),
+		"systemLog.quiet":                                     boolParam,
+		"systemLog.logAppend":                                 boolParam,
+		"net.maxIncomingConnections":                          intParam(1, 1000000),
+		"net.compression.compressors":                         stringParam,
+		"security.enableEncryption":                           boolParam,
+		"security.encryptionCipherMode":                       enumParam("AES256-CBC", "AES256-GCM"),
+		"security.redactClientLogData":                        boolParam,
+		"security.javascriptEnabled":                          boolParam,
+		"auditLog.destination":                                enumParam("file", "syslog", "console"),
+		"auditLog.format":                                     enumParam("JSON", "BSON"),
+		"auditLog.path":                                       stringParam,
+		"auditLog.filter":                                     stringParam,
+	},
+}
+
+// mongosDialect validates the mongos configuration. Any mongos option is
+// accepted except the ones managed by the operator.
+//
+//nolint:gochecknoglobals
+var mongosDialect = &configDialect{
+	managed: []string{
+		"net.port", "net.bindIp", "net.bindIpAll", "net.tls", "net.ssl", "sharding.configDB",
+		"security.keyFile", "security.clusterAuthMode", "processManagement",
+	},
+}
+
+// pgbouncerDialect validates the PgBouncer configuration. Any PgBouncer option is
+// accepted except the ones managed by the operator.
+//
+//nolint:gochecknoglobals
+var pgbouncerDialect = &configDialect{
+	managed: []string{
+		"listen_addr", "listen_port", "unix_socket_dir", "auth_file", "auth_type", "auth_query",
+		"auth_user", "client_tls_sslmode", "client_tls_ca_file", "client_tls_cert_file",
+		"client_tls_key_file", "server_tls_sslmode", "server_tls_ca_file",
+	},
+}
@@ -0,0 +1,197 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngineConfigIssues(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		engine   everestv1alpha1.EngineType
+		config   string
+		issues   []configIssue
+		warnings []configIssue
+	}{
+		{
+			name:   "valid my.cnf",
+			engine: everestv1alpha1.DatabaseEnginePXC,
+			config: `[mysqld]
+# tuning
+innodb-buffer-pool-size = 2G
+max_connections=500  # inline comment
+skip-name-resolve
+loose_some_plugin_option = 1
+sql_mode = "STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION"
+
+[sst]
+xbstream-opts = --decompress`,
+		},
+		{
+			name:   "invalid my.cnf",
+			engine: everestv1alpha1.DatabaseEnginePXC,
+			config: `max_connections = 10
+[mysqld
+[mysqld]
+innodb_bufer_pool_size = 2G
+innodb_buffer_pool_size = 1M
+innodb_flush_log_at_trx_commit = 3
+max_connections
+port = 3307
+skip-log-bin
+wait_timeout = 'unterminated`,
+			issues: []configIssue{
+				{1, "parameter outside of a section"},
+				{2, "invalid section header '[mysqld'"},
+				{10, "unterminated quoted value"},
+				{5, "parameter 'innodb_buffer_pool_size': value '1M' is out of range"},
+				{6, "parameter 'innodb_flush_log_at_trx_commit': '3' is not one of 0, 1, 2"},
+				{7, "parameter 'max_connections': a value is required"},
+				{8, "parameter 'port' is managed by the operator"},
+				{9, "parameter 'log_bin' is managed by the operator"},
+			},
+			warnings: []configIssue{{4, "unknown parameter 'innodb_bufer_pool_size' is not validated"}},
+		},
+		{
+			name:   "valid postgresql.conf",
+			engine: everestv1alpha1.DatabaseEnginePostgresql,
+			config: `shared_buffers = 128MB
+work_mem 4096
+log_min_duration_statement = -1
+statement_timeout = '30s' # comment
+default_transaction_isolation = 'read committed'
+pg_stat_monitor.pgsm_max = 100`,
+		},
+		{
+			name:   "invalid postgresql.conf",
+			engine: everestv1alpha1.DatabaseEnginePostgresql,
+			config: `include 'other.conf'
+shared_buffers = 128mb
+work_mem = 16kB
+checkpoint_timeout = 2d
+wal_level = logical
+max_connection = 100
+autovacuum = maybe
+search_path = "$user", public`,
+			issues: []configIssue{
+				{1, "include directives are not supported"},
+				{8, "invalid line 'search_path = \"$user\", public'"},
+				{2, "parameter 'shared_buffers': invalid value '128mb'"},
+				{3, "parameter 'work_mem': value '16kB' is out of range"},
+				{4, "parameter 'checkpoint_timeout': value '2d' is out of range"},
+				{5, "parameter 'wal_level' is managed by the operator"},
+				{7, "parameter 'autovacuum': 'maybe' is not a boolean value"},
+			},
+			warnings: []configIssue{{6, "unknown parameter 'max_connection' is not validated"}},
+		},
+		{
+			name:   "valid mongod.conf",
+			engine: everestv1alpha1.DatabaseEnginePSMDB,
+			config: `storage:
+  wiredTiger:
+    engineConfig:
+      cacheSizeGB: 1.5
+operationProfiling:
+  mode: slowOp
+setParameter:
+  ttlMonitorSleepSecs: 120`,
+		},
+		{
+			name:   "invalid mongod.conf",
+			engine: everestv1alpha1.DatabaseEnginePSMDB,
+			config: `operationProfiling:
+  mode: sometimes
+  slowOpThresholdMs: -1
+replication:
+  replSetName: rs1
+storage:
+  wiredTiger:
+    engineConfig:
+      cacheSize: 1`,
+			issues: []configIssue{
+				{2, "parameter 'operationProfiling.mode': 'sometimes' is not one of off, slowOp, all"},
+				{3, "parameter 'operationProfiling.slowOpThresholdMs': value '-1' is out of range"},
+				{5, "parameter 'replication.replSetName' is managed by the operator"},
+			},
+			warnings: []configIssue{{9, "unknown parameter 'storage.wiredTiger.engineConfig.cacheSize' is not validated"}},
+		},
+		{
+			name:   "mongod.conf syntax error",
+			engine: everestv1alpha1.DatabaseEnginePSMDB,
+			config: "storage:\n  engine: wiredTiger\n   directoryPerDB: true",
+			issues: []configIssue{{3, "mapping values are not allowed in this context"}},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			issues, warnings := engineConfigIssues(tc.engine, tc.config)
+			assert.Equal(t, tc.issues, issues)
+			assert.Equal(t, tc.warnings, warnings)
+		})
+	}
+}
+
+func TestProxyConfigIssues(t *testing.T) {
+	t.Parallel()
+	issues, _ := proxyConfigIssues(everestv1alpha1.ProxyTypePGBouncer, `[databases]
+listen_port = host=db port=5432
+[pgbouncer]
+pool_mode = transaction
+listen_port = 6433`)
+	assert.Equal(t, []configIssue{{5, "parameter 'listen_port' is managed by the operator"}}, issues)
+
+	issues, _ = proxyConfigIssues(everestv1alpha1.ProxyTypeMongos, "net:\n  port: 27018\nsetParameter:\n  cursorTimeoutMillis: 1000")
+	assert.Equal(t, []configIssue{{2, "parameter 'net.port' is managed by the operator"}}, issues)
+
+	issues, warnings := proxyConfigIssues(everestv1alpha1.ProxyTypeHAProxy, "anything goes")
+	assert.Empty(t, issues)
+	assert.Empty(t, warnings)
+}
+
+func TestValidateEngineConfig(t *testing.T) {
+	t.Parallel()
+	cluster := &DatabaseCluster{}
+	err := json.Unmarshal([]byte(`{"spec": {
+		"engine": {"type": "pxc", "config": "[mysqld]\nport = 3307\nmax_connections = 0\ninnodb_strict_mode = ON"},
+		"proxy": {"type": "haproxy", "config": "global\n  maxconn 2048"}
+	}}`), cluster)
+	require.NoError(t, err)
+
+	warnings, err := validateEngineConfig(cluster, nil)
+	assert.Equal(t, []string{"spec.engine.config line 4: unknown parameter 'innodb_strict_mode' is not validated"}, warnings)
+	require.ErrorIs(t, err, errInvalidConfig)
+	assert.Equal(t, "invalid configuration: spec.engine.config line 2: parameter 'port' is managed by the operator; "+
+		"spec.engine.config line 3: parameter 'max_connections': value '0' is out of range", err.Error())
+
+	// The configuration the database cluster already has is not validated again.
+	existing := &everestv1alpha1.DatabaseCluster{}
+	existing.Spec.Engine.Config = *cluster.Spec.Engine.Config
+	warnings, err = validateEngineConfig(cluster, existing)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	existing.Spec.Engine.Config = "[mysqld]\nport = 3307"
+	_, err = validateEngineConfig(cluster, existing)
+	require.ErrorIs(t, err, errInvalidConfig)
+}
@@ -59,12 +59,42 @@ const (
 	Proxysql  DatabaseClusterSpecProxyType = "proxysql"
 )
 
+// Defines values for DatabaseClusterEventSeverity.
+const (
+	Info    DatabaseClusterEventSeverity = "info"
+	Warning DatabaseClusterEventSeverity = "warning"
+)
+
+// Defines values for DatabaseClusterHealthStatus.
+const (
+	Degraded  DatabaseClusterHealthStatus = "degraded"
+	Healthy   DatabaseClusterHealthStatus = "healthy"
+	Paused    DatabaseClusterHealthStatus = "paused"
+	Unhealthy DatabaseClusterHealthStatus = "unhealthy"
+)
+
+// Defines values for DatabaseClusterPodHealthRole.
+const (
+	Backup DatabaseClusterPodHealthRole = "backup"
+	Engine DatabaseClusterPodHealthRole = "engine"
+	Other  DatabaseClusterPodHealthRole = "other"
+	Proxy  DatabaseClusterPodHealthRole = "proxy"
+)
+
 // Defines values for DatabaseClusterRestoreSpecDataSourcePitrType.
 const (
 	DatabaseClusterRestoreSpecDataSourcePitrTypeDate   DatabaseClusterRestoreSpecDataSourcePitrType = "date"
 	DatabaseClusterRestoreSpecDataSourcePitrTypeLatest DatabaseClusterRestoreSpecDataSourcePitrType = "latest"
 )
 
+// Defines values for DatabaseClusterVolumeHealthResizeStatus.
+const (
+	FileSystemResizePending DatabaseClusterVolumeHealthResizeStatus = "fileSystemResizePending"
+	Pending                 DatabaseClusterVolumeHealthResizeStatus = "pending"
+	ResizeFailed            DatabaseClusterVolumeHealthResizeStatus = "resizeFailed"
+	Resizing                DatabaseClusterVolumeHealthResizeStatus = "resizing"
+)
+
 // Defines values for MonitoringInstanceBaseType.
 const (
 	MonitoringInstanceBaseTypePmm MonitoringInstanceBaseType = "pmm"
@@ -85,6 +115,242 @@ const (
 	MonitoringInstanceUpdateParamsTypePmm MonitoringInstanceUpdateParamsType = "pmm"
 )
 
+// Defines values for PowerScheduleStatusLastAction.
+const (
+	Pause  PowerScheduleStatusLastAction = "pause"
+	Resume PowerScheduleStatusLastAction = "resume"
+)
+
+// Defines values for PowerScheduleStatusResult.
+const (
+	Failed    PowerScheduleStatusResult = "failed"
+	Succeeded PowerScheduleStatusResult = "succeeded"
+)
+
+// Defines values for SettingSource.
+const (
+	Cluster   SettingSource = "cluster"
+	Namespace SettingSource = "namespace"
+	None      SettingSource = "none"
+)
+
+// Defines values for ExportDatabaseClusterParamsFormat.
+const (
+	Json ExportDatabaseClusterParamsFormat = "json"
+	Yaml ExportDatabaseClusterParamsFormat = "yaml"
+)
+
+// BackupArtifact backup found in a backup storage
+type BackupArtifact struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// DatabaseClusterBackupName Name of the DatabaseClusterBackup referring to the backup
+	DatabaseClusterBackupName *string `json:"databaseClusterBackupName,omitempty"`
+
+	// DatabaseClusterBackupNamespace Namespace of the DatabaseClusterBackup referring to the backup
+	DatabaseClusterBackupNamespace *string `json:"databaseClusterBackupNamespace,omitempty"`
+
+	// DatabaseClusterName Name of the source database cluster
+	DatabaseClusterName string `json:"databaseClusterName"`
+
+	// DatabaseClusterUID UID of the source database cluster
+	DatabaseClusterUID *string `json:"databaseClusterUID,omitempty"`
+
+	// Destination Location of the backup as set in the status of DatabaseClusterBackup
+	Destination string `json:"destination"`
+
+	// EngineType Engine type of the backup, recognized from the layout
+	EngineType string `json:"engineType"`
+
+	// Name Name of the backup in the backup storage
+	Name string `json:"name"`
+
+	// Orphaned No DatabaseClusterBackup refers to the backup
+	Orphaned  bool   `json:"orphaned"`
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+}
+
+// BackupArtifactImport defines model for BackupArtifactImport.
+type BackupArtifactImport struct {
+	// Destinations Destinations of the backups to import
+	Destinations []string `json:"destinations"`
+
+	// Namespace Namespace of the DatabaseClusterBackup objects
+	Namespace string `json:"namespace"`
+}
+
+// BackupArtifactList defines model for BackupArtifactList.
+type BackupArtifactList = []BackupArtifact
+
+// BackupCopy copy of a backup in another backup storage
+type BackupCopy struct {
+	// BackupStorageName Name of the backup storage the backup is copied to
+	BackupStorageName string `json:"backupStorageName"`
+
+	// BytesCopied Number of the bytes copied to the backup storage
+	BytesCopied *int64 `json:"bytesCopied,omitempty"`
+
+	// DatabaseClusterBackupName Name of the database cluster backup registered for the copy
+	DatabaseClusterBackupName *string `json:"databaseClusterBackupName,omitempty"`
+
+	// Destination Location of the copy in the backup storage
+	Destination *string    `json:"destination,omitempty"`
+	FinishedAt  *time.Time `json:"finishedAt,omitempty"`
+
+	// Message Why the copy failed
+	Message *string `json:"message,omitempty"`
+
+	// ObjectsCopied Number of the objects copied to the backup storage. The objects already stored there are skipped
+	ObjectsCopied *int64    `json:"objectsCopied,omitempty"`
+	StartedAt     time.Time `json:"startedAt"`
+
+	// State State of the copy, one of running, succeeded or failed
+	State string `json:"state"`
+}
+
+// BackupCopyParams parameters of copying a backup
+type BackupCopyParams struct {
+	// BackupStorageName Name of the backup storage to copy the backup to
+	BackupStorageName string `json:"backupStorageName"`
+}
+
+// BackupReplicationPolicy policy copying the new successful backups of a database cluster to other backup storages.
+// The copies are registered as database cluster backups which can be restored
+type BackupReplicationPolicy struct {
+	// BackupStorageNames Names of the backup storages to copy the backups to
+	BackupStorageNames []string `json:"backupStorageNames"`
+
+	// Enabled Copy the new backups
+	Enabled bool `json:"enabled"`
+
+	// EnabledAt The backups completed since then are copied
+	EnabledAt *time.Time `json:"enabledAt,omitempty"`
+}
+
+// BackupRetentionDecision decision of the backup retention policy about a backup
+type BackupRetentionDecision struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Delete The backup is deleted by the policy
+	Delete bool   `json:"delete"`
+	Name   string `json:"name"`
+
+	// Reasons Why the backup is kept
+	Reasons *[]string `json:"reasons,omitempty"`
+	State   *string   `json:"state,omitempty"`
+}
+
+// BackupRetentionPolicy retention policy of the backups of database clusters. A backup is deleted if no rule keeps it.
+// Failed backups are kept only by keepDays. The latest successful backup, the backups needed for
+// the point-in-time recovery, the backups used by running restores, the unfinished backups, the final backups
+// and the backups taken before restores are always kept.
+// The copies of the backups are kept by the same rules, counted separately in each backup storage. The files of
+// the deleted copies are removed from their backup storage, except the PostgreSQL ones which share the repository of the database cluster
+type BackupRetentionPolicy struct {
+	// Enabled Delete the backups which are not kept by any rule
+	Enabled bool `json:"enabled"`
+
+	// KeepDaily Keep the latest successful backup of each of the given number of the latest days with backups
+	KeepDaily *int `json:"keepDaily,omitempty"`
+
+	// KeepDays Keep the successful backups taken in the given number of days
+	KeepDays *int `json:"keepDays,omitempty"`
+
+	// KeepLast Keep the given number of the latest successful backups
+	KeepLast *int `json:"keepLast,omitempty"`
+
+	// KeepMonthly Keep the latest successful backup of each of the given number of the latest months with backups
+	KeepMonthly *int `json:"keepMonthly,omitempty"`
+
+	// KeepWeekly Keep the latest successful backup of each of the given number of the latest weeks with backups
+	KeepWeekly *int `json:"keepWeekly,omitempty"`
+}
+
+// BackupRetentionPreview backups deleted and kept by the backup retention policy in effect, the latest first
+type BackupRetentionPreview struct {
+	Backups []BackupRetentionDecision `json:"backups"`
+
+	// Policy retention policy of the backups of database clusters. A backup is deleted if no rule keeps it.
+	// Failed backups are kept only by keepDays. The latest successful backup, the backups needed for
+	// the point-in-time recovery, the backups used by running restores, the unfinished backups, the final backups
+	// and the backups taken before restores are always kept.
+	// The copies of the backups are kept by the same rules, counted separately in each backup storage. The files of
+	// the deleted copies are removed from their backup storage, except the PostgreSQL ones which share the repository of the database cluster
+	Policy BackupRetentionPolicy `json:"policy"`
+
+	// Source Where the setting in effect is set
+	Source SettingSource `json:"source"`
+}
+
+// BackupSchedulePreview next runs of the backup schedules of a database cluster
+type BackupSchedulePreview struct {
+	Schedules []BackupScheduleRuns `json:"schedules"`
+
+	// Timezone Time zone of the local run times
+	Timezone string `json:"timezone"`
+}
+
+// BackupScheduleRun defines model for BackupScheduleRun.
+type BackupScheduleRun struct {
+	// Local Run time in the requested time zone
+	Local time.Time `json:"local"`
+	Utc   time.Time `json:"utc"`
+}
+
+// BackupScheduleRuns defines model for BackupScheduleRuns.
+type BackupScheduleRuns struct {
+	BackupStorageName *string `json:"backupStorageName,omitempty"`
+	Enabled           bool    `json:"enabled"`
+
+	// Error Reason the schedule is invalid
+	Error *string `json:"error,omitempty"`
+	Name  string  `json:"name"`
+
+	// NextRuns Next run times, empty if the schedule is disabled or invalid
+	NextRuns []BackupScheduleRun `json:"nextRuns"`
+	Schedule string              `json:"schedule"`
+}
+
+// BackupStats backup statistics over a time window. The backups are counted by their creation time
+type BackupStats struct {
+	// AverageDurationSeconds Average duration of the successful backups in the window
+	AverageDurationSeconds *int64 `json:"averageDurationSeconds,omitempty"`
+
+	// Backups Backups created in the window, the most recent first
+	Backups             *[]BackupStatsEntry `json:"backups,omitempty"`
+	DatabaseClusterName *string             `json:"databaseClusterName,omitempty"`
+	Failed              int                 `json:"failed"`
+	InProgress          int                 `json:"inProgress"`
+
+	// LastDurationSeconds Duration of the last successful backup in the window
+	LastDurationSeconds *int64 `json:"lastDurationSeconds,omitempty"`
+
+	// LastSuccessfulBackupAt Completion time of the last successful backup, including the ones before the window
+	LastSuccessfulBackupAt           *time.Time `json:"lastSuccessfulBackupAt,omitempty"`
+	SecondsSinceLastSuccessfulBackup *int64     `json:"secondsSinceLastSuccessfulBackup,omitempty"`
+
+	// Storages Bytes stored in every backup storage, including the point-in-time recovery logs and the backups before the window
+	Storages    []BackupStorageUsage `json:"storages"`
+	Succeeded   int                  `json:"succeeded"`
+	WindowEnd   time.Time            `json:"windowEnd"`
+	WindowStart time.Time            `json:"windowStart"`
+}
+
+// BackupStatsEntry defines model for BackupStatsEntry.
+type BackupStatsEntry struct {
+	BackupStorageName string     `json:"backupStorageName"`
+	CompletedAt       *time.Time `json:"completedAt,omitempty"`
+	CreatedAt         *time.Time `json:"createdAt,omitempty"`
+	DurationSeconds   *int64     `json:"durationSeconds,omitempty"`
+	Name              string     `json:"name"`
+
+	// SizeBytes Size of the backup read from the backup storage. Omitted if the storage could not be read
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+
+	// State State of the backup as reported by the operator
+	State string `json:"state"`
+}
+
 // BackupStorage Backup storage information
 type BackupStorage struct {
 	// AllowedNamespaces List of namespaces allowed to use this backup storage
@@ -100,9 +366,60 @@ type BackupStorage struct {
 // BackupStorageType defines model for BackupStorage.Type.
 type BackupStorageType string
 
+// BackupStorageUsage defines model for BackupStorageUsage.
+type BackupStorageUsage struct {
+	BytesStored int64 `json:"bytesStored"`
+
+	// Error Why the backup storage could not be read. bytesStored is 0 in this case
+	Error *string `json:"error,omitempty"`
+
+	// Name Name of the backup storage
+	Name string `json:"name"`
+}
+
 // BackupStoragesList defines model for BackupStoragesList.
 type BackupStoragesList = []BackupStorage
 
+// BackupVerification verification of a backup by a test restore. It is in progress until finishedAt is set
+type BackupVerification struct {
+	// DatabaseClusterName Name of the temporary database cluster
+	DatabaseClusterName string `json:"databaseClusterName"`
+
+	// DurationSeconds Duration of the verification
+	DurationSeconds *int64     `json:"durationSeconds,omitempty"`
+	FinishedAt      *time.Time `json:"finishedAt,omitempty"`
+
+	// Message Why the verification failed
+	Message *string `json:"message,omitempty"`
+
+	// Passed The backup was restored and the restored database cluster passed the sanity check
+	Passed           *bool     `json:"passed,omitempty"`
+	SandboxNamespace string    `json:"sandboxNamespace"`
+	StartedAt        time.Time `json:"startedAt"`
+}
+
+// BackupVerificationSettings settings of the verification of backups by test restores
+type BackupVerificationSettings struct {
+	// Enabled Verify the latest successful backup of every database cluster on the schedule if it is not verified yet
+	Enabled bool `json:"enabled"`
+
+	// SandboxNamespace Namespace managed by Everest where the temporary database clusters are created.
+	// The backups of the same namespace are restored by name, the backups of other namespaces are restored from their backup storage
+	SandboxNamespace string `json:"sandboxNamespace"`
+
+	// Schedule Cron expression of the scheduled verifications in UTC. Required when the verification is enabled
+	Schedule *string `json:"schedule,omitempty"`
+}
+
+// CostBreakdown Monthly cost by resource
+type CostBreakdown struct {
+	BackupStorage float64 `json:"backupStorage"`
+	Cpu           float64 `json:"cpu"`
+	Memory        float64 `json:"memory"`
+	Storage       float64 `json:"storage"`
+	Total         float64 `json:"total"`
+}
+
 // CreateBackupStorageParams Backup storage parameters
 type CreateBackupStorageParams struct {
 	AccessKey string `json:"accessKey"`
@@ -454,12 +771,132 @@ type DatabaseClusterBackupList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// DatabaseClusterBackupRetention backup retention policy in effect for a database cluster
+type DatabaseClusterBackupRetention struct {
+	// Policy retention policy of the backups of database clusters. A backup is deleted if no rule keeps it.
+	// Failed backups are kept only by keepDays. The latest successful backup, the backups needed for
+	// the point-in-time recovery, the backups used by running restores, the unfinished backups, the final backups
+	// and the backups taken before restores are always kept.
+	// The copies of the backups are kept by the same rules, counted separately in each backup storage. The files of
+	// the deleted copies are removed from their backup storage, except the PostgreSQL ones which share the repository of the database cluster
+	Policy BackupRetentionPolicy `json:"policy"`
+
+	// Source Where the setting in effect is set
+	Source SettingSource `json:"source"`
+}
+
+// DatabaseClusterBundle portable definition of a database cluster
+type DatabaseClusterBundle struct {
+	// Credentials credentials secret of the database cluster
+	Credentials *DatabaseClusterBundleCredentials `json:"credentials,omitempty"`
+
+	// DatabaseCluster DatabaseCluster is the Schema for the databaseclusters API.
+	DatabaseCluster DatabaseCluster `json:"databaseCluster"`
+
+	// PowerSchedule pauses and resumes a database cluster on a schedule
+	PowerSchedule *PowerSchedule `json:"powerSchedule,omitempty"`
+
+	// References objects the database cluster refers to. They must exist in the installation the bundle is imported into
+	References *DatabaseClusterBundleReferences `json:"references,omitempty"`
+
+	// Version Version of the bundle format
+	Version string `json:"version"`
+}
+
+// DatabaseClusterBundleCredentials credentials secret of the database cluster
+type DatabaseClusterBundleCredentials struct {
+	Data       map[string]string `json:"data"`
+	SecretName string            `json:"secretName"`
+}
+
+// DatabaseClusterBundleReferences objects the database cluster refers to. They must exist in the installation the bundle is imported into
+type DatabaseClusterBundleReferences struct {
+	BackupStorages   *[]string `json:"backupStorages,omitempty"`
+	MonitoringConfig *string   `json:"monitoringConfig,omitempty"`
+}
+
+// DatabaseClusterCostEstimate Estimated monthly cost of a database cluster.
+// The compute of paused database clusters is not charged.
+// Backup storage assumes every retained backup is as large as the storage of one database node.
+type DatabaseClusterCostEstimate struct {
+	Currency string `json:"currency"`
+
+	// Monthly Monthly cost by resource
+	Monthly  CostBreakdown `json:"monthly"`
+	Name     string        `json:"name"`
+	Warnings *[]string     `json:"warnings,omitempty"`
+}
+
 // DatabaseClusterCredential kubernetes object
 type DatabaseClusterCredential struct {
 	Password *string `json:"password,omitempty"`
 	Username *string `json:"username,omitempty"`
 }
 
+// DatabaseClusterDeletionProtection deletion protection in effect for a database cluster
+type DatabaseClusterDeletionProtection struct {
+	// Protection protection of database clusters from deletion
+	Protection DeletionProtection `json:"protection"`
+
+	// Source Where the setting in effect is set
+	Source SettingSource `json:"source"`
+}
+
+// DatabaseClusterEvent Kubernetes event related to a database cluster
+type DatabaseClusterEvent struct {
+	// Count Number of occurrences of the event
+	Count int32 `json:"count"`
+
+	// FirstTime Time of the first occurrence of the event
+	FirstTime time.Time `json:"firstTime"`
+	Message   string    `json:"message"`
+
+	// Object Kubernetes object the event is about
+	Object   DatabaseClusterEventObject   `json:"object"`
+	Reason   string                       `json:"reason"`
+	Severity DatabaseClusterEventSeverity `json:"severity"`
+
+	// Source Component reporting the event
+	Source *string `json:"source,omitempty"`
+
+	// Time Time of the last occurrence of the event
+	Time time.Time `json:"time"`
+}
+
+// DatabaseClusterEventSeverity defines model for DatabaseClusterEvent.Severity.
+type DatabaseClusterEventSeverity string
+
+// DatabaseClusterEventList defines model for DatabaseClusterEventList.
+type DatabaseClusterEventList = []DatabaseClusterEvent
+
+// DatabaseClusterEventObject Kubernetes object the event is about
+type DatabaseClusterEventObject struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// DatabaseClusterFromTemplateParams defines model for DatabaseClusterFromTemplateParams.
+type DatabaseClusterFromTemplateParams struct {
+	// DatabaseCluster DatabaseCluster object merged on top of the template. Its metadata.name is required
+	DatabaseCluster map[string]interface{} `json:"databaseCluster"`
+	TemplateName    string                 `json:"templateName"`
+}
+
+// DatabaseClusterHealth health of a database cluster and its components
+type DatabaseClusterHealth struct {
+	Pods []DatabaseClusterPodHealth `json:"pods"`
+
+	// Reasons Human readable reasons of the status
+	Reasons []string `json:"reasons"`
+
+	// Status Rolled-up health of the database cluster
+	Status  DatabaseClusterHealthStatus   `json:"status"`
+	Volumes []DatabaseClusterVolumeHealth `json:"volumes"`
+}
+
+// DatabaseClusterHealthStatus Rolled-up health of the database cluster
+type DatabaseClusterHealthStatus string
+
 // DatabaseClusterList DatabaseClusterList is an object that contains the list of the existing database clusters.
 type DatabaseClusterList struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
@@ -479,8 +916,36 @@ type DatabaseClusterPitr struct {
 	Gaps             *bool      `json:"gaps,omitempty"`
 	LatestBackupName *string    `json:"latestBackupName,omitempty"`
 	LatestDate       *time.Time `json:"latestDate,omitempty"`
+
+	// Ranges contiguous ranges of the point-in-time recovery logs stored after a backup, oldest first. Omitted if the logs could not be listed, the dates are estimated from the latest backup then
+	Ranges *[]DatabaseClusterPitrRange `json:"ranges,omitempty"`
+}
+
+// DatabaseClusterPitrRange range of dates the database cluster can be recovered to
+type DatabaseClusterPitrRange struct {
+	// BackupName Name of the backup the range starts from
+	BackupName   string    `json:"backupName"`
+	EarliestDate time.Time `json:"earliestDate"`
+	LatestDate   time.Time `json:"latestDate"`
+}
+
+// DatabaseClusterPodHealth defines model for DatabaseClusterPodHealth.
+type DatabaseClusterPodHealth struct {
+	// LastTerminationReason Reason of the last container termination, e.g. OOMKilled or Error
+	LastTerminationReason *string `json:"lastTerminationReason,omitempty"`
+	Name                  string  `json:"name"`
+	Node                  *string `json:"node,omitempty"`
+	Phase                 string  `json:"phase"`
+	Ready                 bool    `json:"ready"`
+
+	// Restarts Sum of the restarts of all containers of the pod
+	Restarts int32                        `json:"restarts"`
+	Role     DatabaseClusterPodHealthRole `json:"role"`
 }
 
+// DatabaseClusterPodHealthRole defines model for DatabaseClusterPodHealth.Role.
+type DatabaseClusterPodHealthRole string
+
 // DatabaseClusterRestore DatabaseClusterRestore is the Schema for the databaseclusterrestores API.
 type DatabaseClusterRestore struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
@@ -544,6 +1009,67 @@ type DatabaseClusterRestoreList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// DatabaseClusterRestorePhase defines model for DatabaseClusterRestorePhase.
+type DatabaseClusterRestorePhase struct {
+	// ElapsedSeconds Time spent in the phase, until now for the current phase
+	ElapsedSeconds int64      `json:"elapsedSeconds"`
+	FinishedAt     *time.Time `json:"finishedAt,omitempty"`
+
+	// Phase Phase of a restore, one of preparing, downloadingBaseBackup, applyingLogs, restarting, ready or failed
+	Phase     RestorePhase `json:"phase"`
+	StartedAt time.Time    `json:"startedAt"`
+}
+
+// DatabaseClusterRestoreProgress progress of a database cluster restore
+type DatabaseClusterRestoreProgress struct {
+	// DatabaseClusterStatus Status of the restored database cluster
+	DatabaseClusterStatus *string `json:"databaseClusterStatus,omitempty"`
+	FailureReason         *string `json:"failureReason,omitempty"`
+
+	// Phase Phase of a restore, one of preparing, downloadingBaseBackup, applyingLogs, restarting, ready or failed
+	Phase RestorePhase `json:"phase"`
+
+	// Phases Phases the restore went through, oldest first
+	Phases []DatabaseClusterRestorePhase `json:"phases"`
+
+	// State State of the restore reported by the operator
+	State *string `json:"state,omitempty"`
+}
+
+// DatabaseClusterTemplate named, partial database cluster spec used as a starting point for new database clusters
+type DatabaseClusterTemplate struct {
+	// AllowedNamespaces List of namespaces allowed to use the template. The template is allowed in any namespace if empty
+	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
+	Description       *string   `json:"description,omitempty"`
+	Name              string    `json:"name"`
+
+	// Spec Partial spec of the DatabaseCluster object
+	Spec map[string]interface{} `json:"spec"`
+}
+
+// DatabaseClusterTemplateList defines model for DatabaseClusterTemplateList.
+type DatabaseClusterTemplateList = []DatabaseClusterTemplate
+
+// DatabaseClusterVolumeHealth defines model for DatabaseClusterVolumeHealth.
+type DatabaseClusterVolumeHealth struct {
+	Capacity *string `json:"capacity,omitempty"`
+	Name     string  `json:"name"`
+
+	// Phase Binding status of the persistent volume claim
+	Phase string `json:"phase"`
+
+	// RequestedCapacity Requested size of the persistent volume claim if it differs from the capacity
+	RequestedCapacity *string `json:"requestedCapacity,omitempty"`
+	ResizeMessage     *string `json:"resizeMessage,omitempty"`
+
+	// ResizeStatus Progress of the resize of the persistent volume claim. Omitted if the volume is not being resized
+	ResizeStatus *DatabaseClusterVolumeHealthResizeStatus `json:"resizeStatus,omitempty"`
+	StorageClass *string                                  `json:"storageClass,omitempty"`
+}
+
+// DatabaseClusterVolumeHealthResizeStatus Progress of the resize of the persistent volume claim. Omitted if the volume is not being resized
+type DatabaseClusterVolumeHealthResizeStatus string
+
 // DatabaseEngine DatabaseEngine is the Schema for the databaseengines API.
 type DatabaseEngine struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
@@ -608,15 +1134,51 @@ type DatabaseEngineList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// DeletionProtection protection of database clusters from deletion
+type DeletionProtection struct {
+	// Enabled Refuse to delete the database cluster
+	Enabled bool `json:"enabled"`
+
+	// Reason Reason of the last change. Required to weaken the protection of a namespace
+	Reason *string `json:"reason,omitempty"`
+
+	// RequireConfirmation Require the confirmName parameter set to the database cluster name to delete it
+	RequireConfirmation *bool `json:"requireConfirmation,omitempty"`
+
+	// UpdatedAt Time of the last change. Ignored on update
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// DeletionProtectionRemoval defines model for DeletionProtectionRemoval.
+type DeletionProtectionRemoval struct {
+	// Reason Reason to remove the protection. It is logged for audit
+	Reason string `json:"reason"`
+}
+
 // Error Error response
 type Error struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// FinalBackup backup taken before the deletion of a database cluster. The database cluster is deleted once the backup succeeds. It is in progress until finishedAt is set
+type FinalBackup struct {
+	// BackupName Name of the database cluster backup
+	BackupName        string `json:"backupName"`
+	BackupStorageName string `json:"backupStorageName"`
+
+	// FinishedAt Time the final backup or the deletion failed at
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// Message Why the final backup or the deletion failed
+	Message   *string   `json:"message,omitempty"`
+	StartedAt time.Time `json:"startedAt"`
+}
+
 // KubernetesClusterInfo kubernetes cluster info
 type KubernetesClusterInfo struct {
-	ClusterType       string   `json:"clusterType"`
-	StorageClassNames []string `json:"storageClassNames"`
+	ClusterType       string             `json:"clusterType"`
+	StorageClassNames []string           `json:"storageClassNames"`
+	StorageClasses    []StorageClassInfo `json:"storageClasses"`
 }
 
 // KubernetesClusterResources kubernetes cluster resources
@@ -709,9 +1271,205 @@ type MonitoringInstanceUpdateParamsType string
 // MonitoringInstancesList defines model for MonitoringInstancesList.
 type MonitoringInstancesList = []MonitoringInstance
 
+// NamespaceBackupStats defines model for NamespaceBackupStats.
+type NamespaceBackupStats struct {
+	DatabaseClusters []BackupStats `json:"databaseClusters"`
+	Namespace        string        `json:"namespace"`
+
+	// Total backup statistics over a time window. The backups are counted by their creation time
+	Total BackupStats `json:"total"`
+}
+
+// NamespaceCostEstimate defines model for NamespaceCostEstimate.
+type NamespaceCostEstimate struct {
+	Clusters []DatabaseClusterCostEstimate `json:"clusters"`
+	Currency string                        `json:"currency"`
+
+	// Monthly Monthly cost by resource
+	Monthly   CostBreakdown `json:"monthly"`
+	Namespace string        `json:"namespace"`
+}
+
+// NamespaceCostEstimateList defines model for NamespaceCostEstimateList.
+type NamespaceCostEstimateList = []NamespaceCostEstimate
+
 // NamespaceList defines model for NamespaceList.
 type NamespaceList = []string
 
+// NamespaceQuota limits of the resources requested by the database clusters of a namespace. Omitted limits are not enforced
+type NamespaceQuota struct {
+	// Clusters Maximum number of database clusters
+	Clusters *int `json:"clusters,omitempty"`
+
+	// Cpu Total CPU of all database clusters
+	Cpu *string `json:"cpu,omitempty"`
+
+	// Memory Total memory of all database clusters
+	Memory *string `json:"memory,omitempty"`
+
+	// PerCluster resource limits. Omitted limits are not enforced
+	PerCluster *ResourceQuota `json:"perCluster,omitempty"`
+
+	// Storage Total storage of all database clusters
+	Storage *string `json:"storage,omitempty"`
+}
+
+// NamespaceQuotaUsage defines model for NamespaceQuotaUsage.
+type NamespaceQuotaUsage struct {
+	Namespace string `json:"namespace"`
+
+	// Quota limits of the resources requested by the database clusters of a namespace. Omitted limits are not enforced
+	Quota *NamespaceQuota `json:"quota,omitempty"`
+
+	// Usage resources requested by the database clusters of a namespace
+	Usage NamespaceResourceUsage `json:"usage"`
+}
+
+// NamespaceQuotaUsageList defines model for NamespaceQuotaUsageList.
+type NamespaceQuotaUsageList = []NamespaceQuotaUsage
+
+// NamespaceResourceUsage resources requested by the database clusters of a namespace
+type NamespaceResourceUsage struct {
+	Clusters int    `json:"clusters"`
+	Cpu      string `json:"cpu"`
+	Memory   string `json:"memory"`
+	Storage  string `json:"storage"`
+}
+
+// PowerSchedule pauses and resumes a database cluster on a schedule
+type PowerSchedule struct {
+	Enabled bool `json:"enabled"`
+
+	// PauseSchedule cron expression of the time to pause the database cluster
+	PauseSchedule *string `json:"pauseSchedule,omitempty"`
+
+	// ResumeSchedule cron expression of the time to resume the database cluster
+	ResumeSchedule *string `json:"resumeSchedule,omitempty"`
+
+	// Status result of the last action taken by the power schedule. Ignored on update
+	Status *PowerScheduleStatus `json:"status,omitempty"`
+
+	// Timezone IANA time zone the schedules are evaluated in. Defaults to UTC
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// PowerScheduleStatus result of the last action taken by the power schedule. Ignored on update
+type PowerScheduleStatus struct {
+	LastAction     *PowerScheduleStatusLastAction `json:"lastAction,omitempty"`
+	LastActionTime *time.Time                     `json:"lastActionTime,omitempty"`
+	Message        *string                        `json:"message,omitempty"`
+	Result         *PowerScheduleStatusResult     `json:"result,omitempty"`
+}
+
+// PowerScheduleStatusLastAction defines model for PowerScheduleStatus.LastAction.
+type PowerScheduleStatusLastAction string
+
+// PowerScheduleStatusResult defines model for PowerScheduleStatus.Result.
+type PowerScheduleStatusResult string
+
+// PreRestoreBackup backup taken before a restore of a database cluster. The restore is created once the backup succeeds. It is in progress until finishedAt is set
+type PreRestoreBackup struct {
+	// BackupName Name of the database cluster backup
+	BackupName        string `json:"backupName"`
+	BackupStorageName string `json:"backupStorageName"`
+
+	// FinishedAt Time the restore was created at or the pre-restore backup or the restore creation failed at
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// Message Why the pre-restore backup or the restore creation failed
+	Message *string `json:"message,omitempty"`
+
+	// RestoreName Name of the restore. A generated name is set once the restore is created
+	RestoreName *string   `json:"restoreName,omitempty"`
+	StartedAt   time.Time `json:"startedAt"`
+
+	// TimeoutMinutes How long the backup may take before the restore is abandoned
+	TimeoutMinutes int `json:"timeoutMinutes"`
+}
+
+// PreRestoreBackupSettings settings of the backups taken of database clusters before they are restored. The restore is created only
+// after the backup succeeds. The pre-restore backup is enabled if the namespace has no settings
+type PreRestoreBackupSettings struct {
+	// BackupStorageName Name of the backup storage to take the backups to. By default, the storage of the backup schedules of the
+	// database cluster is used or the storage of the restored backup if it has no schedules
+	BackupStorageName *string `json:"backupStorageName,omitempty"`
+
+	// Enabled Take a backup of the database cluster before restoring it
+	Enabled bool `json:"enabled"`
+
+	// TimeoutMinutes How long to wait for the backup to succeed before the restore is abandoned
+	TimeoutMinutes *int `json:"timeoutMinutes,omitempty"`
+}
+
+// PriceTable Prices used to estimate the cost of database clusters.
+// Storage of the database clusters is priced per storage class. The default storage class is used for database clusters without a storage class.
+type PriceTable struct {
+	// BackupStorageGiBMonth Price of one GiB of backup storage per month
+	BackupStorageGiBMonth *float64 `json:"backupStorageGiBMonth,omitempty"`
+
+	// CpuHour Price of one CPU per hour
+	CpuHour  float64 `json:"cpuHour"`
+	Currency *string `json:"currency,omitempty"`
+
+	// MemoryGiBHour Price of one GiB of memory per hour
+	MemoryGiBHour float64 `json:"memoryGiBHour"`
+
+	// StorageClassGiBMonth Price of one GiB of storage per month for each storage class
+	StorageClassGiBMonth map[string]float64 `json:"storageClassGiBMonth"`
+}
+
+// ResourceQuota resource limits. Omitted limits are not enforced
+type ResourceQuota struct {
+	Cpu     *string `json:"cpu,omitempty"`
+	Memory  *string `json:"memory,omitempty"`
+	Storage *string `json:"storage,omitempty"`
+}
+
+// RestorePhase Phase of a restore, one of preparing, downloadingBaseBackup, applyingLogs, restarting, ready or failed
+type RestorePhase = string
+
+// RestoreToNewClusterParams The database cluster to create from a backup. Omitted fields are copied from the source database cluster.
+// If the source database cluster does not exist anymore, cpu, memory and storageSize are required and the database cluster has a single replica by default
+type RestoreToNewClusterParams struct {
+	// Cpu CPU of each engine replica, e.g. "1" or "500m"
+	Cpu *string `json:"cpu,omitempty"`
+
+	// EngineType Engine type of the backup, one of pxc, psmdb and postgresql. Only used if the source database cluster does not exist anymore and the backup does not record its engine type
+	EngineType *string `json:"engineType,omitempty"`
+
+	// EngineVersion Engine version of the new database cluster. It should be of the same major version as the source database cluster and not older.
+	// Required if the source database cluster does not exist anymore and the backup does not record its engine version
+	EngineVersion *string `json:"engineVersion,omitempty"`
+
+	// Memory Memory of each engine replica, e.g. "2G"
+	Memory *string `json:"memory,omitempty"`
+
+	// Name Name of the new database cluster
+	Name string `json:"name"`
+
+	// Namespace Namespace of the new database cluster. Defaults to the namespace of the backup
+	Namespace *string `json:"namespace,omitempty"`
+
+	// PitrDate UTC date to recover to. The accepted format: "2006-01-02T15:04:05Z". If omitted, the backup is restored as is
+	PitrDate     *string `json:"pitrDate,omitempty"`
+	Replicas     *int32  `json:"replicas,omitempty"`
+	StorageClass *string `json:"storageClass,omitempty"`
+
+	// StorageSize Storage size of each engine replica. It can not be smaller than the storage of the source database cluster
+	StorageSize *string `json:"storageSize,omitempty"`
+}
+
+// SettingSource Where the setting in effect is set
+type SettingSource string
+
+// StorageClassInfo capabilities of a storage class
+type StorageClassInfo struct {
+	// AllowVolumeExpansion Volumes of the storage class can be expanded, so the storage of database clusters using it can grow
+	AllowVolumeExpansion bool   `json:"allowVolumeExpansion"`
+	Default              bool   `json:"default"`
+	Name                 string `json:"name"`
+}
+
 // UpdateBackupStorageParams Backup storage parameters
 type UpdateBackupStorageParams struct {
 	AccessKey *string `json:"accessKey,omitempty"`
@@ -815,36 +1573,222 @@ type IoK8sApimachineryPkgApisMetaV1StatusV2 struct {
 	Status *string `json:"status,omitempty"`
 }
 
-// CreateBackupStorageJSONRequestBody defines body for CreateBackupStorage for application/json ContentType.
-type CreateBackupStorageJSONRequestBody = CreateBackupStorageParams
+// ListAllDatabaseClustersParams defines parameters for ListAllDatabaseClusters.
+type ListAllDatabaseClustersParams struct {
+	// Search Case-insensitive text to search in the names, label keys and label values of the database clusters
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
 
-// UpdateBackupStorageJSONRequestBody defines body for UpdateBackupStorage for application/json ContentType.
-type UpdateBackupStorageJSONRequestBody = UpdateBackupStorageParams
+	// Namespace Only list the database clusters of the namespace
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
 
-// CreateMonitoringInstanceJSONRequestBody defines body for CreateMonitoringInstance for application/json ContentType.
-type CreateMonitoringInstanceJSONRequestBody = MonitoringInstanceCreateParams
+	// Engine Only list the database clusters of the engine type
+	Engine *string `form:"engine,omitempty" json:"engine,omitempty"`
 
-// UpdateMonitoringInstanceJSONRequestBody defines body for UpdateMonitoringInstance for application/json ContentType.
-type UpdateMonitoringInstanceJSONRequestBody = MonitoringInstanceUpdateParams
+	// Version Only list the database clusters of the engine version
+	Version *string `form:"version,omitempty" json:"version,omitempty"`
 
-// CreateDatabaseClusterBackupJSONRequestBody defines body for CreateDatabaseClusterBackup for application/json ContentType.
-type CreateDatabaseClusterBackupJSONRequestBody = DatabaseClusterBackup
+	// Status Only list the database clusters in the status
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
 
-// CreateDatabaseClusterRestoreJSONRequestBody defines body for CreateDatabaseClusterRestore for application/json ContentType.
-type CreateDatabaseClusterRestoreJSONRequestBody = DatabaseClusterRestore
+	// StorageClass Only list the database clusters using the storage class. Database clusters without a storage class use the default one
+	StorageClass *string `form:"storageClass,omitempty" json:"storageClass,omitempty"`
 
-// UpdateDatabaseClusterRestoreJSONRequestBody defines body for UpdateDatabaseClusterRestore for application/json ContentType.
-type UpdateDatabaseClusterRestoreJSONRequestBody = DatabaseClusterRestore
+	// BackupStorage Only list the database clusters with backup schedules or point-in-time recovery using the backup storage
+	BackupStorage *string `form:"backupStorage,omitempty" json:"backupStorage,omitempty"`
 
-// CreateDatabaseClusterJSONRequestBody defines body for CreateDatabaseCluster for application/json ContentType.
-type CreateDatabaseClusterJSONRequestBody = DatabaseCluster
+	// MonitoringInstance Only list the database clusters monitored by the monitoring instance
+	MonitoringInstance *string `form:"monitoringInstance,omitempty" json:"monitoringInstance,omitempty"`
+}
 
-// UpdateDatabaseClusterJSONRequestBody defines body for UpdateDatabaseCluster for application/json ContentType.
+// GetNamespaceBackupStatsParams defines parameters for GetNamespaceBackupStats.
+type GetNamespaceBackupStatsParams struct {
+	// Days Number of days before now the backups are counted over. Defaults to 30
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+}
+
+// RestoreDatabaseClusterBackupToNewClusterParams defines parameters for RestoreDatabaseClusterBackupToNewCluster.
+type RestoreDatabaseClusterBackupToNewClusterParams struct {
+	// Force Proceed even if the Kubernetes cluster does not have enough available resources. A Warning header is returned instead of an error
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// CreateDatabaseClusterParams defines parameters for CreateDatabaseCluster.
+type CreateDatabaseClusterParams struct {
+	// Force Proceed even if the Kubernetes cluster does not have enough available resources. A Warning header is returned instead of an error
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// CreateDatabaseClusterFromTemplateParams defines parameters for CreateDatabaseClusterFromTemplate.
+type CreateDatabaseClusterFromTemplateParams struct {
+	// Force Proceed even if the Kubernetes cluster does not have enough available resources. A Warning header is returned instead of an error
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// ImportDatabaseClusterParams defines parameters for ImportDatabaseCluster.
+type ImportDatabaseClusterParams struct {
+	// Force Proceed even if the Kubernetes cluster does not have enough available resources. A Warning header is returned instead of an error
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// DeleteDatabaseClusterParams defines parameters for DeleteDatabaseCluster.
+type DeleteDatabaseClusterParams struct {
+	// ConfirmName Name of the database cluster to confirm the deletion. Required if the deletion protection of the database cluster requires confirmation
+	ConfirmName *string `form:"confirmName,omitempty" json:"confirmName,omitempty"`
+
+	// FinalBackup Take a backup of the database cluster before deleting it. The request is accepted right away and the database cluster is deleted in the background once the backup succeeds. The progress is available in the final backup of the database cluster. The backup is kept after the deletion
+	FinalBackup *bool `form:"finalBackup,omitempty" json:"finalBackup,omitempty"`
+
+	// FinalBackupStorageName Name of the backup storage to take the final backup to. Defaults to the active backup storage of the database cluster
+	FinalBackupStorageName *string `form:"finalBackupStorageName,omitempty" json:"finalBackupStorageName,omitempty"`
+}
+
+// UpdateDatabaseClusterParams defines parameters for UpdateDatabaseCluster.
+type UpdateDatabaseClusterParams struct {
+	// Force Proceed even if the Kubernetes cluster does not have enough available resources. A Warning header is returned instead of an error
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// PreviewDatabaseClusterBackupSchedulesParams defines parameters for PreviewDatabaseClusterBackupSchedules.
+type PreviewDatabaseClusterBackupSchedulesParams struct {
+	// Count Number of next runs to return for every schedule. Defaults to 5
+	Count *int `form:"count,omitempty" json:"count,omitempty"`
+
+	// Timezone IANA time zone to show the next runs in. Defaults to UTC
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty"`
+}
+
+// GetDatabaseClusterBackupStatsParams defines parameters for GetDatabaseClusterBackupStats.
+type GetDatabaseClusterBackupStatsParams struct {
+	// Days Number of days before now the backups are counted over. Defaults to 30
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+}
+
+// ExportDatabaseClusterParams defines parameters for ExportDatabaseCluster.
+type ExportDatabaseClusterParams struct {
+	// Format Format of the bundle. Defaults to json
+	Format *ExportDatabaseClusterParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// IncludeCredentials Include the credentials secret of the database cluster in the bundle
+	IncludeCredentials *bool `form:"includeCredentials,omitempty" json:"includeCredentials,omitempty"`
+}
+
+// ExportDatabaseClusterParamsFormat defines parameters for ExportDatabaseCluster.
+type ExportDatabaseClusterParamsFormat string
+
+// GetDatabaseClusterLogsParams defines parameters for GetDatabaseClusterLogs.
+type GetDatabaseClusterLogsParams struct {
+	// Pod Return the logs of the specified pod only
+	Pod *string `form:"pod,omitempty" json:"pod,omitempty"`
+
+	// Container Return the logs of the specified container only
+	Container *string `form:"container,omitempty" json:"container,omitempty"`
+
+	// TailLines Number of lines from the end of the logs of every container to return
+	TailLines *int64 `form:"tailLines,omitempty" json:"tailLines,omitempty"`
+
+	// SinceSeconds Return the logs newer than the specified number of seconds
+	SinceSeconds *int64 `form:"sinceSeconds,omitempty" json:"sinceSeconds,omitempty"`
+
+	// Previous Return the logs of the previously terminated containers
+	Previous *bool `form:"previous,omitempty" json:"previous,omitempty"`
+
+	// Follow Keep the connection open and stream new log lines
+	Follow *bool `form:"follow,omitempty" json:"follow,omitempty"`
+}
+
+// ListDatabaseClusterTemplatesParams defines parameters for ListDatabaseClusterTemplates.
+type ListDatabaseClusterTemplatesParams struct {
+	// Namespace Return only the templates allowed in the namespace
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// CreateBackupStorageJSONRequestBody defines body for CreateBackupStorage for application/json ContentType.
+type CreateBackupStorageJSONRequestBody = CreateBackupStorageParams
+
+// UpdateBackupStorageJSONRequestBody defines body for UpdateBackupStorage for application/json ContentType.
+type UpdateBackupStorageJSONRequestBody = UpdateBackupStorageParams
+
+// ImportBackupArtifactsJSONRequestBody defines body for ImportBackupArtifacts for application/json ContentType.
+type ImportBackupArtifactsJSONRequestBody = BackupArtifactImport
+
+// UpdateBackupVerificationSettingsJSONRequestBody defines body for UpdateBackupVerificationSettings for application/json ContentType.
+type UpdateBackupVerificationSettingsJSONRequestBody = BackupVerificationSettings
+
+// EstimateDatabaseClusterCostJSONRequestBody defines body for EstimateDatabaseClusterCost for application/json ContentType.
+type EstimateDatabaseClusterCostJSONRequestBody = DatabaseCluster
+
+// CreateMonitoringInstanceJSONRequestBody defines body for CreateMonitoringInstance for application/json ContentType.
+type CreateMonitoringInstanceJSONRequestBody = MonitoringInstanceCreateParams
+
+// UpdateMonitoringInstanceJSONRequestBody defines body for UpdateMonitoringInstance for application/json ContentType.
+type UpdateMonitoringInstanceJSONRequestBody = MonitoringInstanceUpdateParams
+
+// UpdateNamespaceBackupRetentionJSONRequestBody defines body for UpdateNamespaceBackupRetention for application/json ContentType.
+type UpdateNamespaceBackupRetentionJSONRequestBody = BackupRetentionPolicy
+
+// CreateDatabaseClusterBackupJSONRequestBody defines body for CreateDatabaseClusterBackup for application/json ContentType.
+type CreateDatabaseClusterBackupJSONRequestBody = DatabaseClusterBackup
+
+// CopyDatabaseClusterBackupJSONRequestBody defines body for CopyDatabaseClusterBackup for application/json ContentType.
+type CopyDatabaseClusterBackupJSONRequestBody = BackupCopyParams
+
+// RestoreDatabaseClusterBackupToNewClusterJSONRequestBody defines body for RestoreDatabaseClusterBackupToNewCluster for application/json ContentType.
+type RestoreDatabaseClusterBackupToNewClusterJSONRequestBody = RestoreToNewClusterParams
+
+// CreateDatabaseClusterRestoreJSONRequestBody defines body for CreateDatabaseClusterRestore for application/json ContentType.
+type CreateDatabaseClusterRestoreJSONRequestBody = DatabaseClusterRestore
+
+// UpdateDatabaseClusterRestoreJSONRequestBody defines body for UpdateDatabaseClusterRestore for application/json ContentType.
+type UpdateDatabaseClusterRestoreJSONRequestBody = DatabaseClusterRestore
+
+// CreateDatabaseClusterJSONRequestBody defines body for CreateDatabaseCluster for application/json ContentType.
+type CreateDatabaseClusterJSONRequestBody = DatabaseCluster
+
+// CreateDatabaseClusterFromTemplateJSONRequestBody defines body for CreateDatabaseClusterFromTemplate for application/json ContentType.
+type CreateDatabaseClusterFromTemplateJSONRequestBody = DatabaseClusterFromTemplateParams
+
+// ImportDatabaseClusterJSONRequestBody defines body for ImportDatabaseCluster for application/json ContentType.
+type ImportDatabaseClusterJSONRequestBody = DatabaseClusterBundle
+
+// UpdateDatabaseClusterJSONRequestBody defines body for UpdateDatabaseCluster for application/json ContentType.
 type UpdateDatabaseClusterJSONRequestBody = DatabaseCluster
 
+// UpdateDatabaseClusterBackupReplicationJSONRequestBody defines body for UpdateDatabaseClusterBackupReplication for application/json ContentType.
+type UpdateDatabaseClusterBackupReplicationJSONRequestBody = BackupReplicationPolicy
+
+// UpdateDatabaseClusterBackupRetentionJSONRequestBody defines body for UpdateDatabaseClusterBackupRetention for application/json ContentType.
+type UpdateDatabaseClusterBackupRetentionJSONRequestBody = BackupRetentionPolicy
+
+// UpdateDatabaseClusterDeletionProtectionJSONRequestBody defines body for UpdateDatabaseClusterDeletionProtection for application/json ContentType.
+type UpdateDatabaseClusterDeletionProtectionJSONRequestBody = DeletionProtection
+
+// UnprotectDatabaseClusterJSONRequestBody defines body for UnprotectDatabaseCluster for application/json ContentType.
+type UnprotectDatabaseClusterJSONRequestBody = DeletionProtectionRemoval
+
+// UpdateDatabaseClusterPowerScheduleJSONRequestBody defines body for UpdateDatabaseClusterPowerSchedule for application/json ContentType.
+type UpdateDatabaseClusterPowerScheduleJSONRequestBody = PowerSchedule
+
 // UpdateDatabaseEngineJSONRequestBody defines body for UpdateDatabaseEngine for application/json ContentType.
 type UpdateDatabaseEngineJSONRequestBody = DatabaseEngine
 
+// UpdateNamespaceDeletionProtectionJSONRequestBody defines body for UpdateNamespaceDeletionProtection for application/json ContentType.
+type UpdateNamespaceDeletionProtectionJSONRequestBody = DeletionProtection
+
+// UpdateNamespacePreRestoreBackupJSONRequestBody defines body for UpdateNamespacePreRestoreBackup for application/json ContentType.
+type UpdateNamespacePreRestoreBackupJSONRequestBody = PreRestoreBackupSettings
+
+// UpdateNamespaceQuotaJSONRequestBody defines body for UpdateNamespaceQuota for application/json ContentType.
+type UpdateNamespaceQuotaJSONRequestBody = NamespaceQuota
+
+// UpdatePriceTableJSONRequestBody defines body for UpdatePriceTable for application/json ContentType.
+type UpdatePriceTableJSONRequestBody = PriceTable
+
+// CreateDatabaseClusterTemplateJSONRequestBody defines body for CreateDatabaseClusterTemplate for application/json ContentType.
+type CreateDatabaseClusterTemplateJSONRequestBody = DatabaseClusterTemplate
+
+// UpdateDatabaseClusterTemplateJSONRequestBody defines body for UpdateDatabaseClusterTemplate for application/json ContentType.
+type UpdateDatabaseClusterTemplateJSONRequestBody = DatabaseClusterTemplate
+
 // AsDatabaseClusterSpecEngineResourcesCpu0 returns the union data inside the DatabaseCluster_Spec_Engine_Resources_Cpu as a DatabaseClusterSpecEngineResourcesCpu0
 func (t DatabaseCluster_Spec_Engine_Resources_Cpu) AsDatabaseClusterSpecEngineResourcesCpu0() (DatabaseClusterSpecEngineResourcesCpu0, error) {
 	var body DatabaseClusterSpecEngineResourcesCpu0
@@ -1296,9 +2240,30 @@ type ServerInterface interface {
 	// Partial update of the specified backup storage
 	// (PATCH /backup-storages/{name})
 	UpdateBackupStorage(ctx echo.Context, name string) error
+	// List the backups in the specified backup storage
+	// (GET /backup-storages/{name}/artifacts)
+	ListBackupArtifacts(ctx echo.Context, name string) error
+	// Import backups from the specified backup storage
+	// (POST /backup-storages/{name}/artifacts/import)
+	ImportBackupArtifacts(ctx echo.Context, name string) error
+	// Get the backup verification settings
+	// (GET /backup-verification)
+	GetBackupVerificationSettings(ctx echo.Context) error
+	// Set the backup verification settings
+	// (PUT /backup-verification)
+	UpdateBackupVerificationSettings(ctx echo.Context) error
 	// Get the cluster type and storage classes of a kubernetes cluster
 	// (GET /cluster-info)
 	GetKubernetesClusterInfo(ctx echo.Context) error
+	// Estimate the monthly cost of the database clusters of all namespaces managed by Everest
+	// (GET /cost-estimates)
+	ListNamespaceCostEstimates(ctx echo.Context) error
+	// Estimate the monthly cost of a proposed database cluster
+	// (POST /cost-estimates/database-cluster)
+	EstimateDatabaseClusterCost(ctx echo.Context) error
+	// List the database clusters of all namespaces managed by Everest
+	// (GET /database-clusters)
+	ListAllDatabaseClusters(ctx echo.Context, params ListAllDatabaseClustersParams) error
 	// List of the created monitoring instances
 	// (GET /monitoring-instances)
 	ListMonitoringInstances(ctx echo.Context) error
@@ -1317,6 +2282,21 @@ type ServerInterface interface {
 	// Get all namespaces managed by Everest
 	// (GET /namespaces)
 	ListNamespaces(ctx echo.Context) error
+	// Delete the default backup retention policy of the namespace
+	// (DELETE /namespaces/{namespace}/backup-retention)
+	DeleteNamespaceBackupRetention(ctx echo.Context, namespace string) error
+	// Get the default backup retention policy of the namespace
+	// (GET /namespaces/{namespace}/backup-retention)
+	GetNamespaceBackupRetention(ctx echo.Context, namespace string) error
+	// Set the default backup retention policy of the namespace
+	// (PUT /namespaces/{namespace}/backup-retention)
+	UpdateNamespaceBackupRetention(ctx echo.Context, namespace string) error
+	// Get the backup statistics of the database clusters in the specified namespace
+	// (GET /namespaces/{namespace}/backup-stats)
+	GetNamespaceBackupStats(ctx echo.Context, namespace string, params GetNamespaceBackupStatsParams) error
+	// Estimate the monthly cost of the database clusters of the specified namespace
+	// (GET /namespaces/{namespace}/cost-estimate)
+	GetNamespaceCostEstimate(ctx echo.Context, namespace string) error
 	// Create a database cluster backup
 	// (POST /namespaces/{namespace}/database-cluster-backups)
 	CreateDatabaseClusterBackup(ctx echo.Context, namespace string) error
@@ -1326,6 +2306,21 @@ type ServerInterface interface {
 	// Returns the specified cluster backup
 	// (GET /namespaces/{namespace}/database-cluster-backups/{name})
 	GetDatabaseClusterBackup(ctx echo.Context, namespace string, name string) error
+	// List the copies of the specified backup
+	// (GET /namespaces/{namespace}/database-cluster-backups/{name}/copies)
+	ListDatabaseClusterBackupCopies(ctx echo.Context, namespace string, name string) error
+	// Copy the specified backup to another backup storage
+	// (POST /namespaces/{namespace}/database-cluster-backups/{name}/copies)
+	CopyDatabaseClusterBackup(ctx echo.Context, namespace string, name string) error
+	// Restore the specified backup into a new database cluster
+	// (POST /namespaces/{namespace}/database-cluster-backups/{name}/restore-to-new-cluster)
+	RestoreDatabaseClusterBackupToNewCluster(ctx echo.Context, namespace string, name string, params RestoreDatabaseClusterBackupToNewClusterParams) error
+	// Get the verification of the specified backup
+	// (GET /namespaces/{namespace}/database-cluster-backups/{name}/verification)
+	GetDatabaseClusterBackupVerification(ctx echo.Context, namespace string, name string) error
+	// Verify the specified backup
+	// (POST /namespaces/{namespace}/database-cluster-backups/{name}/verification)
+	VerifyDatabaseClusterBackup(ctx echo.Context, namespace string, name string) error
 	// Create a database cluster restore
 	// (POST /namespaces/{namespace}/database-cluster-restores)
 	CreateDatabaseClusterRestore(ctx echo.Context, namespace string) error
@@ -1338,33 +2333,111 @@ type ServerInterface interface {
 	// Replace the specified cluster restore
 	// (PUT /namespaces/{namespace}/database-cluster-restores/{name})
 	UpdateDatabaseClusterRestore(ctx echo.Context, namespace string, name string) error
+	// Get the progress of the specified database cluster restore
+	// (GET /namespaces/{namespace}/database-cluster-restores/{name}/progress)
+	GetDatabaseClusterRestoreProgress(ctx echo.Context, namespace string, name string) error
 	// List of the created database clusters
 	// (GET /namespaces/{namespace}/database-clusters)
 	ListDatabaseClusters(ctx echo.Context, namespace string) error
 	// Create a database cluster
 	// (POST /namespaces/{namespace}/database-clusters)
-	CreateDatabaseCluster(ctx echo.Context, namespace string) error
+	CreateDatabaseCluster(ctx echo.Context, namespace string, params CreateDatabaseClusterParams) error
+	// Create a database cluster from a template
+	// (POST /namespaces/{namespace}/database-clusters/from-template)
+	CreateDatabaseClusterFromTemplate(ctx echo.Context, namespace string, params CreateDatabaseClusterFromTemplateParams) error
+	// Import a database cluster
+	// (POST /namespaces/{namespace}/database-clusters/import)
+	ImportDatabaseCluster(ctx echo.Context, namespace string, params ImportDatabaseClusterParams) error
 	// Delete the specified database cluster
 	// (DELETE /namespaces/{namespace}/database-clusters/{name})
-	DeleteDatabaseCluster(ctx echo.Context, namespace string, name string) error
+	DeleteDatabaseCluster(ctx echo.Context, namespace string, name string, params DeleteDatabaseClusterParams) error
 	// Get the specified database cluster
 	// (GET /namespaces/{namespace}/database-clusters/{name})
 	GetDatabaseCluster(ctx echo.Context, namespace string, name string) error
 	// Replace the specified database cluster
 	// (PUT /namespaces/{namespace}/database-clusters/{name})
-	UpdateDatabaseCluster(ctx echo.Context, namespace string, name string) error
+	UpdateDatabaseCluster(ctx echo.Context, namespace string, name string, params UpdateDatabaseClusterParams) error
+	// Delete the backup replication policy of the specified database cluster
+	// (DELETE /namespaces/{namespace}/database-clusters/{name}/backup-replication)
+	DeleteDatabaseClusterBackupReplication(ctx echo.Context, namespace string, name string) error
+	// Get the backup replication policy of the specified database cluster
+	// (GET /namespaces/{namespace}/database-clusters/{name}/backup-replication)
+	GetDatabaseClusterBackupReplication(ctx echo.Context, namespace string, name string) error
+	// Set the backup replication policy of the specified database cluster
+	// (PUT /namespaces/{namespace}/database-clusters/{name}/backup-replication)
+	UpdateDatabaseClusterBackupReplication(ctx echo.Context, namespace string, name string) error
+	// Delete the backup retention policy of the specified database cluster
+	// (DELETE /namespaces/{namespace}/database-clusters/{name}/backup-retention)
+	DeleteDatabaseClusterBackupRetention(ctx echo.Context, namespace string, name string) error
+	// Get the backup retention policy of the specified database cluster
+	// (GET /namespaces/{namespace}/database-clusters/{name}/backup-retention)
+	GetDatabaseClusterBackupRetention(ctx echo.Context, namespace string, name string) error
+	// Set the backup retention policy of the specified database cluster
+	// (PUT /namespaces/{namespace}/database-clusters/{name}/backup-retention)
+	UpdateDatabaseClusterBackupRetention(ctx echo.Context, namespace string, name string) error
+	// Preview the backup retention policy of the specified database cluster
+	// (GET /namespaces/{namespace}/database-clusters/{name}/backup-retention/preview)
+	PreviewDatabaseClusterBackupRetention(ctx echo.Context, namespace string, name string) error
+	// Preview the next runs of the backup schedules of the specified database cluster
+	// (GET /namespaces/{namespace}/database-clusters/{name}/backup-schedules/preview)
+	PreviewDatabaseClusterBackupSchedules(ctx echo.Context, namespace string, name string, params PreviewDatabaseClusterBackupSchedulesParams) error
 	// List of the created database cluster backups
 	// (GET /namespaces/{namespace}/database-clusters/{name}/backups)
 	ListDatabaseClusterBackups(ctx echo.Context, namespace string, name string) error
+	// Get the backup statistics of the specified database cluster
+	// (GET /namespaces/{namespace}/database-clusters/{name}/backups/stats)
+	GetDatabaseClusterBackupStats(ctx echo.Context, namespace string, name string, params GetDatabaseClusterBackupStatsParams) error
 	// Get the specified database cluster credentials
 	// (GET /namespaces/{namespace}/database-clusters/{name}/credentials)
 	GetDatabaseClusterCredentials(ctx echo.Context, namespace string, name string) error
+	// Get the deletion protection of the specified database cluster
+	// (GET /namespaces/{namespace}/database-clusters/{name}/deletion-protection)
+	GetDatabaseClusterDeletionProtection(ctx echo.Context, namespace string, name string) error
+	// Set the deletion protection of the specified database cluster
+	// (PUT /namespaces/{namespace}/database-clusters/{name}/deletion-protection)
+	UpdateDatabaseClusterDeletionProtection(ctx echo.Context, namespace string, name string) error
+	// Remove the deletion protection of the specified database cluster
+	// (POST /namespaces/{namespace}/database-clusters/{name}/deletion-protection/unprotect)
+	UnprotectDatabaseCluster(ctx echo.Context, namespace string, name string) error
+	// Get the events of the specified database cluster
+	// (GET /namespaces/{namespace}/database-clusters/{name}/events)
+	GetDatabaseClusterEvents(ctx echo.Context, namespace string, name string) error
+	// Export the specified database cluster
+	// (GET /namespaces/{namespace}/database-clusters/{name}/export)
+	ExportDatabaseCluster(ctx echo.Context, namespace string, name string, params ExportDatabaseClusterParams) error
+	// Get the final backup of the specified database cluster
+	// (GET /namespaces/{namespace}/database-clusters/{name}/final-backup)
+	GetDatabaseClusterFinalBackup(ctx echo.Context, namespace string, name string) error
+	// Get the health of the specified database cluster
+	// (GET /namespaces/{namespace}/database-clusters/{name}/health)
+	GetDatabaseClusterHealth(ctx echo.Context, namespace string, name string) error
+	// Get the logs of the specified database cluster
+	// (GET /namespaces/{namespace}/database-clusters/{name}/logs)
+	GetDatabaseClusterLogs(ctx echo.Context, namespace string, name string, params GetDatabaseClusterLogsParams) error
+	// Pause the specified database cluster
+	// (POST /namespaces/{namespace}/database-clusters/{name}/pause)
+	PauseDatabaseCluster(ctx echo.Context, namespace string, name string) error
 	// Get the Point-in-Time related data for the specified database cluster
 	// (GET /namespaces/{namespace}/database-clusters/{name}/pitr)
 	GetDatabaseClusterPitr(ctx echo.Context, namespace string, name string) error
+	// Delete the power schedule of the specified database cluster
+	// (DELETE /namespaces/{namespace}/database-clusters/{name}/power-schedule)
+	DeleteDatabaseClusterPowerSchedule(ctx echo.Context, namespace string, name string) error
+	// Get the power schedule of the specified database cluster
+	// (GET /namespaces/{namespace}/database-clusters/{name}/power-schedule)
+	GetDatabaseClusterPowerSchedule(ctx echo.Context, namespace string, name string) error
+	// Set the power schedule of the specified database cluster
+	// (PUT /namespaces/{namespace}/database-clusters/{name}/power-schedule)
+	UpdateDatabaseClusterPowerSchedule(ctx echo.Context, namespace string, name string) error
+	// Get the pre-restore backup of the specified database cluster
+	// (GET /namespaces/{namespace}/database-clusters/{name}/pre-restore-backup)
+	GetDatabaseClusterPreRestoreBackup(ctx echo.Context, namespace string, name string) error
 	// List of the created database cluster restores
 	// (GET /namespaces/{namespace}/database-clusters/{name}/restores)
 	ListDatabaseClusterRestores(ctx echo.Context, namespace string, name string) error
+	// Resume the specified database cluster
+	// (POST /namespaces/{namespace}/database-clusters/{name}/resume)
+	ResumeDatabaseCluster(ctx echo.Context, namespace string, name string) error
 	// List of the available database engines
 	// (GET /namespaces/{namespace}/database-engines)
 	ListDatabaseEngines(ctx echo.Context, namespace string) error
@@ -1374,9 +2447,54 @@ type ServerInterface interface {
 	// Update the specified database engine
 	// (PUT /namespaces/{namespace}/database-engines/{name})
 	UpdateDatabaseEngine(ctx echo.Context, namespace string, name string) error
+	// Get the default deletion protection of the namespace
+	// (GET /namespaces/{namespace}/deletion-protection)
+	GetNamespaceDeletionProtection(ctx echo.Context, namespace string) error
+	// Set the default deletion protection of the namespace
+	// (PUT /namespaces/{namespace}/deletion-protection)
+	UpdateNamespaceDeletionProtection(ctx echo.Context, namespace string) error
+	// Get the pre-restore backup settings of the namespace
+	// (GET /namespaces/{namespace}/pre-restore-backup)
+	GetNamespacePreRestoreBackup(ctx echo.Context, namespace string) error
+	// Set the pre-restore backup settings of the namespace
+	// (PUT /namespaces/{namespace}/pre-restore-backup)
+	UpdateNamespacePreRestoreBackup(ctx echo.Context, namespace string) error
+	// Delete the quota of the specified namespace
+	// (DELETE /namespaces/{namespace}/quota)
+	DeleteNamespaceQuota(ctx echo.Context, namespace string) error
+	// Get the quota and the current usage of the specified namespace
+	// (GET /namespaces/{namespace}/quota)
+	GetNamespaceQuota(ctx echo.Context, namespace string) error
+	// Set the quota of the specified namespace
+	// (PUT /namespaces/{namespace}/quota)
+	UpdateNamespaceQuota(ctx echo.Context, namespace string) error
+	// Get the price table used to estimate the cost of database clusters
+	// (GET /price-table)
+	GetPriceTable(ctx echo.Context) error
+	// Set the price table used to estimate the cost of database clusters
+	// (PUT /price-table)
+	UpdatePriceTable(ctx echo.Context) error
+	// Get the quotas and the current usage of all namespaces managed by Everest
+	// (GET /quotas)
+	ListNamespaceQuotas(ctx echo.Context) error
 	// Get the capacity and available resources of a kubernetes cluster
 	// (GET /resources)
 	GetKubernetesClusterResources(ctx echo.Context) error
+	// List of the database cluster templates
+	// (GET /templates)
+	ListDatabaseClusterTemplates(ctx echo.Context, params ListDatabaseClusterTemplatesParams) error
+	// Create a database cluster template
+	// (POST /templates)
+	CreateDatabaseClusterTemplate(ctx echo.Context) error
+	// Delete the specified database cluster template
+	// (DELETE /templates/{name})
+	DeleteDatabaseClusterTemplate(ctx echo.Context, name string) error
+	// Get the specified database cluster template
+	// (GET /templates/{name})
+	GetDatabaseClusterTemplate(ctx echo.Context, name string) error
+	// Replace the specified database cluster template
+	// (PUT /templates/{name})
+	UpdateDatabaseClusterTemplate(ctx echo.Context, name string) error
 	// Get Everest Backend version info
 	// (GET /version)
 	VersionInfo(ctx echo.Context) error
@@ -1453,6 +2571,56 @@ func (w *ServerInterfaceWrapper) UpdateBackupStorage(ctx echo.Context) error {
 	return err
 }
 
+// ListBackupArtifacts converts echo context to params.
+func (w *ServerInterfaceWrapper) ListBackupArtifacts(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListBackupArtifacts(ctx, name)
+	return err
+}
+
+// ImportBackupArtifacts converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportBackupArtifacts(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportBackupArtifacts(ctx, name)
+	return err
+}
+
+// GetBackupVerificationSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBackupVerificationSettings(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBackupVerificationSettings(ctx)
+	return err
+}
+
+// UpdateBackupVerificationSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateBackupVerificationSettings(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateBackupVerificationSettings(ctx)
+	return err
+}
+
 // GetKubernetesClusterInfo converts echo context to params.
 func (w *ServerInterfaceWrapper) GetKubernetesClusterInfo(ctx echo.Context) error {
 	var err error
@@ -1462,6 +2630,91 @@ func (w *ServerInterfaceWrapper) GetKubernetesClusterInfo(ctx echo.Context) erro
 	return err
 }
 
+// ListNamespaceCostEstimates converts echo context to params.
+func (w *ServerInterfaceWrapper) ListNamespaceCostEstimates(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListNamespaceCostEstimates(ctx)
+	return err
+}
+
+// EstimateDatabaseClusterCost converts echo context to params.
+func (w *ServerInterfaceWrapper) EstimateDatabaseClusterCost(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EstimateDatabaseClusterCost(ctx)
+	return err
+}
+
+// ListAllDatabaseClusters converts echo context to params.
+func (w *ServerInterfaceWrapper) ListAllDatabaseClusters(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAllDatabaseClustersParams
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+	}
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Optional query parameter "engine" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "engine", ctx.QueryParams(), &params.Engine)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter engine: %s", err))
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "storageClass" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "storageClass", ctx.QueryParams(), &params.StorageClass)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter storageClass: %s", err))
+	}
+
+	// ------------- Optional query parameter "backupStorage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "backupStorage", ctx.QueryParams(), &params.BackupStorage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter backupStorage: %s", err))
+	}
+
+	// ------------- Optional query parameter "monitoringInstance" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "monitoringInstance", ctx.QueryParams(), &params.MonitoringInstance)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter monitoringInstance: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListAllDatabaseClusters(ctx, params)
+	return err
+}
+
 // ListMonitoringInstances converts echo context to params.
 func (w *ServerInterfaceWrapper) ListMonitoringInstances(ctx echo.Context) error {
 	var err error
@@ -1537,8 +2790,8 @@ func (w *ServerInterfaceWrapper) ListNamespaces(ctx echo.Context) error {
 	return err
 }
 
-// CreateDatabaseClusterBackup converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateDatabaseClusterBackup(ctx echo.Context) error {
+// DeleteNamespaceBackupRetention converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteNamespaceBackupRetention(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -1549,12 +2802,12 @@ func (w *ServerInterfaceWrapper) CreateDatabaseClusterBackup(ctx echo.Context) e
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateDatabaseClusterBackup(ctx, namespace)
+	err = w.Handler.DeleteNamespaceBackupRetention(ctx, namespace)
 	return err
 }
 
-// DeleteDatabaseClusterBackup converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteDatabaseClusterBackup(ctx echo.Context) error {
+// GetNamespaceBackupRetention converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNamespaceBackupRetention(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -1564,21 +2817,29 @@ func (w *ServerInterfaceWrapper) DeleteDatabaseClusterBackup(ctx echo.Context) e
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
 	}
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNamespaceBackupRetention(ctx, namespace)
+	return err
+}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+// UpdateNamespaceBackupRetention converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateNamespaceBackupRetention(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteDatabaseClusterBackup(ctx, namespace, name)
+	err = w.Handler.UpdateNamespaceBackupRetention(ctx, namespace)
 	return err
 }
 
-// GetDatabaseClusterBackup converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDatabaseClusterBackup(ctx echo.Context) error {
+// GetNamespaceBackupStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNamespaceBackupStats(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -1588,21 +2849,22 @@ func (w *ServerInterfaceWrapper) GetDatabaseClusterBackup(ctx echo.Context) erro
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
 	}
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNamespaceBackupStatsParams
+	// ------------- Optional query parameter "days" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	err = runtime.BindQueryParameter("form", true, false, "days", ctx.QueryParams(), &params.Days)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter days: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDatabaseClusterBackup(ctx, namespace, name)
+	err = w.Handler.GetNamespaceBackupStats(ctx, namespace, params)
 	return err
 }
 
-// CreateDatabaseClusterRestore converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateDatabaseClusterRestore(ctx echo.Context) error {
+// GetNamespaceCostEstimate converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNamespaceCostEstimate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -1613,12 +2875,12 @@ func (w *ServerInterfaceWrapper) CreateDatabaseClusterRestore(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateDatabaseClusterRestore(ctx, namespace)
+	err = w.Handler.GetNamespaceCostEstimate(ctx, namespace)
 	return err
 }
 
-// DeleteDatabaseClusterRestore converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteDatabaseClusterRestore(ctx echo.Context) error {
+// CreateDatabaseClusterBackup converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateDatabaseClusterBackup(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -1628,21 +2890,13 @@ func (w *ServerInterfaceWrapper) DeleteDatabaseClusterRestore(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
 	}
 
-	// ------------- Path parameter "name" -------------
-	var name string
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteDatabaseClusterRestore(ctx, namespace, name)
+	err = w.Handler.CreateDatabaseClusterBackup(ctx, namespace)
 	return err
 }
 
-// GetDatabaseClusterRestore converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDatabaseClusterRestore(ctx echo.Context) error {
+// DeleteDatabaseClusterBackup converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDatabaseClusterBackup(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -1661,12 +2915,12 @@ func (w *ServerInterfaceWrapper) GetDatabaseClusterRestore(ctx echo.Context) err
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDatabaseClusterRestore(ctx, namespace, name)
+	err = w.Handler.DeleteDatabaseClusterBackup(ctx, namespace, name)
 	return err
 }
 
-// UpdateDatabaseClusterRestore converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateDatabaseClusterRestore(ctx echo.Context) error {
+// GetDatabaseClusterBackup converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterBackup(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -1685,12 +2939,12 @@ func (w *ServerInterfaceWrapper) UpdateDatabaseClusterRestore(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateDatabaseClusterRestore(ctx, namespace, name)
+	err = w.Handler.GetDatabaseClusterBackup(ctx, namespace, name)
 	return err
 }
 
-// ListDatabaseClusters converts echo context to params.
-func (w *ServerInterfaceWrapper) ListDatabaseClusters(ctx echo.Context) error {
+// ListDatabaseClusterBackupCopies converts echo context to params.
+func (w *ServerInterfaceWrapper) ListDatabaseClusterBackupCopies(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -1700,29 +2954,21 @@ func (w *ServerInterfaceWrapper) ListDatabaseClusters(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListDatabaseClusters(ctx, namespace)
-	return err
-}
-
-// CreateDatabaseCluster converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateDatabaseCluster(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "namespace" -------------
-	var namespace string
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateDatabaseCluster(ctx, namespace)
+	err = w.Handler.ListDatabaseClusterBackupCopies(ctx, namespace, name)
 	return err
 }
 
-// DeleteDatabaseCluster converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteDatabaseCluster(ctx echo.Context) error {
+// CopyDatabaseClusterBackup converts echo context to params.
+func (w *ServerInterfaceWrapper) CopyDatabaseClusterBackup(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -1741,12 +2987,12 @@ func (w *ServerInterfaceWrapper) DeleteDatabaseCluster(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteDatabaseCluster(ctx, namespace, name)
+	err = w.Handler.CopyDatabaseClusterBackup(ctx, namespace, name)
 	return err
 }
 
-// GetDatabaseCluster converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDatabaseCluster(ctx echo.Context) error {
+// RestoreDatabaseClusterBackupToNewCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreDatabaseClusterBackupToNewCluster(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -1764,13 +3010,22 @@ func (w *ServerInterfaceWrapper) GetDatabaseCluster(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RestoreDatabaseClusterBackupToNewClusterParams
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", ctx.QueryParams(), &params.Force)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDatabaseCluster(ctx, namespace, name)
+	err = w.Handler.RestoreDatabaseClusterBackupToNewCluster(ctx, namespace, name, params)
 	return err
 }
 
-// UpdateDatabaseCluster converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateDatabaseCluster(ctx echo.Context) error {
+// GetDatabaseClusterBackupVerification converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterBackupVerification(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -1789,12 +3044,12 @@ func (w *ServerInterfaceWrapper) UpdateDatabaseCluster(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateDatabaseCluster(ctx, namespace, name)
+	err = w.Handler.GetDatabaseClusterBackupVerification(ctx, namespace, name)
 	return err
 }
 
-// ListDatabaseClusterBackups converts echo context to params.
-func (w *ServerInterfaceWrapper) ListDatabaseClusterBackups(ctx echo.Context) error {
+// VerifyDatabaseClusterBackup converts echo context to params.
+func (w *ServerInterfaceWrapper) VerifyDatabaseClusterBackup(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -1813,12 +3068,884 @@ func (w *ServerInterfaceWrapper) ListDatabaseClusterBackups(ctx echo.Context) er
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListDatabaseClusterBackups(ctx, namespace, name)
+	err = w.Handler.VerifyDatabaseClusterBackup(ctx, namespace, name)
 	return err
 }
 
-// GetDatabaseClusterCredentials converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDatabaseClusterCredentials(ctx echo.Context) error {
+// CreateDatabaseClusterRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateDatabaseClusterRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateDatabaseClusterRestore(ctx, namespace)
+	return err
+}
+
+// DeleteDatabaseClusterRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDatabaseClusterRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDatabaseClusterRestore(ctx, namespace, name)
+	return err
+}
+
+// GetDatabaseClusterRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterRestore(ctx, namespace, name)
+	return err
+}
+
+// UpdateDatabaseClusterRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateDatabaseClusterRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateDatabaseClusterRestore(ctx, namespace, name)
+	return err
+}
+
+// GetDatabaseClusterRestoreProgress converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterRestoreProgress(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterRestoreProgress(ctx, namespace, name)
+	return err
+}
+
+// ListDatabaseClusters converts echo context to params.
+func (w *ServerInterfaceWrapper) ListDatabaseClusters(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListDatabaseClusters(ctx, namespace)
+	return err
+}
+
+// CreateDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateDatabaseClusterParams
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", ctx.QueryParams(), &params.Force)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateDatabaseCluster(ctx, namespace, params)
+	return err
+}
+
+// CreateDatabaseClusterFromTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateDatabaseClusterFromTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateDatabaseClusterFromTemplateParams
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", ctx.QueryParams(), &params.Force)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateDatabaseClusterFromTemplate(ctx, namespace, params)
+	return err
+}
+
+// ImportDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportDatabaseClusterParams
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", ctx.QueryParams(), &params.Force)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportDatabaseCluster(ctx, namespace, params)
+	return err
+}
+
+// DeleteDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDatabaseClusterParams
+	// ------------- Optional query parameter "confirmName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "confirmName", ctx.QueryParams(), &params.ConfirmName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter confirmName: %s", err))
+	}
+
+	// ------------- Optional query parameter "finalBackup" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "finalBackup", ctx.QueryParams(), &params.FinalBackup)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter finalBackup: %s", err))
+	}
+
+	// ------------- Optional query parameter "finalBackupStorageName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "finalBackupStorageName", ctx.QueryParams(), &params.FinalBackupStorageName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter finalBackupStorageName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDatabaseCluster(ctx, namespace, name, params)
+	return err
+}
+
+// GetDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseCluster(ctx, namespace, name)
+	return err
+}
+
+// UpdateDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateDatabaseClusterParams
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", ctx.QueryParams(), &params.Force)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateDatabaseCluster(ctx, namespace, name, params)
+	return err
+}
+
+// DeleteDatabaseClusterBackupReplication converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDatabaseClusterBackupReplication(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDatabaseClusterBackupReplication(ctx, namespace, name)
+	return err
+}
+
+// GetDatabaseClusterBackupReplication converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterBackupReplication(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterBackupReplication(ctx, namespace, name)
+	return err
+}
+
+// UpdateDatabaseClusterBackupReplication converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateDatabaseClusterBackupReplication(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateDatabaseClusterBackupReplication(ctx, namespace, name)
+	return err
+}
+
+// DeleteDatabaseClusterBackupRetention converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDatabaseClusterBackupRetention(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDatabaseClusterBackupRetention(ctx, namespace, name)
+	return err
+}
+
+// GetDatabaseClusterBackupRetention converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterBackupRetention(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterBackupRetention(ctx, namespace, name)
+	return err
+}
+
+// UpdateDatabaseClusterBackupRetention converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateDatabaseClusterBackupRetention(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateDatabaseClusterBackupRetention(ctx, namespace, name)
+	return err
+}
+
+// PreviewDatabaseClusterBackupRetention converts echo context to params.
+func (w *ServerInterfaceWrapper) PreviewDatabaseClusterBackupRetention(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PreviewDatabaseClusterBackupRetention(ctx, namespace, name)
+	return err
+}
+
+// PreviewDatabaseClusterBackupSchedules converts echo context to params.
+func (w *ServerInterfaceWrapper) PreviewDatabaseClusterBackupSchedules(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PreviewDatabaseClusterBackupSchedulesParams
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter count: %s", err))
+	}
+
+	// ------------- Optional query parameter "timezone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timezone", ctx.QueryParams(), &params.Timezone)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter timezone: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PreviewDatabaseClusterBackupSchedules(ctx, namespace, name, params)
+	return err
+}
+
+// ListDatabaseClusterBackups converts echo context to params.
+func (w *ServerInterfaceWrapper) ListDatabaseClusterBackups(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListDatabaseClusterBackups(ctx, namespace, name)
+	return err
+}
+
+// GetDatabaseClusterBackupStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterBackupStats(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterBackupStatsParams
+	// ------------- Optional query parameter "days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "days", ctx.QueryParams(), &params.Days)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter days: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterBackupStats(ctx, namespace, name, params)
+	return err
+}
+
+// GetDatabaseClusterCredentials converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterCredentials(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterCredentials(ctx, namespace, name)
+	return err
+}
+
+// GetDatabaseClusterDeletionProtection converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterDeletionProtection(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterDeletionProtection(ctx, namespace, name)
+	return err
+}
+
+// UpdateDatabaseClusterDeletionProtection converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateDatabaseClusterDeletionProtection(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateDatabaseClusterDeletionProtection(ctx, namespace, name)
+	return err
+}
+
+// UnprotectDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) UnprotectDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UnprotectDatabaseCluster(ctx, namespace, name)
+	return err
+}
+
+// GetDatabaseClusterEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterEvents(ctx, namespace, name)
+	return err
+}
+
+// ExportDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportDatabaseClusterParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "includeCredentials" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeCredentials", ctx.QueryParams(), &params.IncludeCredentials)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter includeCredentials: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportDatabaseCluster(ctx, namespace, name, params)
+	return err
+}
+
+// GetDatabaseClusterFinalBackup converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterFinalBackup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterFinalBackup(ctx, namespace, name)
+	return err
+}
+
+// GetDatabaseClusterHealth converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterHealth(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterHealth(ctx, namespace, name)
+	return err
+}
+
+// GetDatabaseClusterLogs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterLogs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterLogsParams
+	// ------------- Optional query parameter "pod" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pod", ctx.QueryParams(), &params.Pod)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pod: %s", err))
+	}
+
+	// ------------- Optional query parameter "container" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "container", ctx.QueryParams(), &params.Container)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter container: %s", err))
+	}
+
+	// ------------- Optional query parameter "tailLines" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tailLines", ctx.QueryParams(), &params.TailLines)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tailLines: %s", err))
+	}
+
+	// ------------- Optional query parameter "sinceSeconds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sinceSeconds", ctx.QueryParams(), &params.SinceSeconds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sinceSeconds: %s", err))
+	}
+
+	// ------------- Optional query parameter "previous" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "previous", ctx.QueryParams(), &params.Previous)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter previous: %s", err))
+	}
+
+	// ------------- Optional query parameter "follow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "follow", ctx.QueryParams(), &params.Follow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter follow: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterLogs(ctx, namespace, name, params)
+	return err
+}
+
+// PauseDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) PauseDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PauseDatabaseCluster(ctx, namespace, name)
+	return err
+}
+
+// GetDatabaseClusterPitr converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterPitr(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -1837,12 +3964,12 @@ func (w *ServerInterfaceWrapper) GetDatabaseClusterCredentials(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDatabaseClusterCredentials(ctx, namespace, name)
+	err = w.Handler.GetDatabaseClusterPitr(ctx, namespace, name)
 	return err
 }
 
-// GetDatabaseClusterPitr converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDatabaseClusterPitr(ctx echo.Context) error {
+// DeleteDatabaseClusterPowerSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDatabaseClusterPowerSchedule(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -1861,7 +3988,79 @@ func (w *ServerInterfaceWrapper) GetDatabaseClusterPitr(ctx echo.Context) error
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDatabaseClusterPitr(ctx, namespace, name)
+	err = w.Handler.DeleteDatabaseClusterPowerSchedule(ctx, namespace, name)
+	return err
+}
+
+// GetDatabaseClusterPowerSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterPowerSchedule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterPowerSchedule(ctx, namespace, name)
+	return err
+}
+
+// UpdateDatabaseClusterPowerSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateDatabaseClusterPowerSchedule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateDatabaseClusterPowerSchedule(ctx, namespace, name)
+	return err
+}
+
+// GetDatabaseClusterPreRestoreBackup converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterPreRestoreBackup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterPreRestoreBackup(ctx, namespace, name)
 	return err
 }
 
@@ -1889,6 +4088,30 @@ func (w *ServerInterfaceWrapper) ListDatabaseClusterRestores(ctx echo.Context) e
 	return err
 }
 
+// ResumeDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) ResumeDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ResumeDatabaseCluster(ctx, namespace, name)
+	return err
+}
+
 // ListDatabaseEngines converts echo context to params.
 func (w *ServerInterfaceWrapper) ListDatabaseEngines(ctx echo.Context) error {
 	var err error
@@ -1953,6 +4176,145 @@ func (w *ServerInterfaceWrapper) UpdateDatabaseEngine(ctx echo.Context) error {
 	return err
 }
 
+// GetNamespaceDeletionProtection converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNamespaceDeletionProtection(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNamespaceDeletionProtection(ctx, namespace)
+	return err
+}
+
+// UpdateNamespaceDeletionProtection converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateNamespaceDeletionProtection(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateNamespaceDeletionProtection(ctx, namespace)
+	return err
+}
+
+// GetNamespacePreRestoreBackup converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNamespacePreRestoreBackup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNamespacePreRestoreBackup(ctx, namespace)
+	return err
+}
+
+// UpdateNamespacePreRestoreBackup converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateNamespacePreRestoreBackup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateNamespacePreRestoreBackup(ctx, namespace)
+	return err
+}
+
+// DeleteNamespaceQuota converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteNamespaceQuota(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteNamespaceQuota(ctx, namespace)
+	return err
+}
+
+// GetNamespaceQuota converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNamespaceQuota(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNamespaceQuota(ctx, namespace)
+	return err
+}
+
+// UpdateNamespaceQuota converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateNamespaceQuota(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateNamespaceQuota(ctx, namespace)
+	return err
+}
+
+// GetPriceTable converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPriceTable(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPriceTable(ctx)
+	return err
+}
+
+// UpdatePriceTable converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdatePriceTable(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdatePriceTable(ctx)
+	return err
+}
+
+// ListNamespaceQuotas converts echo context to params.
+func (w *ServerInterfaceWrapper) ListNamespaceQuotas(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListNamespaceQuotas(ctx)
+	return err
+}
+
 // GetKubernetesClusterResources converts echo context to params.
 func (w *ServerInterfaceWrapper) GetKubernetesClusterResources(ctx echo.Context) error {
 	var err error
@@ -1962,6 +4324,81 @@ func (w *ServerInterfaceWrapper) GetKubernetesClusterResources(ctx echo.Context)
 	return err
 }
 
+// ListDatabaseClusterTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) ListDatabaseClusterTemplates(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDatabaseClusterTemplatesParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListDatabaseClusterTemplates(ctx, params)
+	return err
+}
+
+// CreateDatabaseClusterTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateDatabaseClusterTemplate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateDatabaseClusterTemplate(ctx)
+	return err
+}
+
+// DeleteDatabaseClusterTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDatabaseClusterTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDatabaseClusterTemplate(ctx, name)
+	return err
+}
+
+// GetDatabaseClusterTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterTemplate(ctx, name)
+	return err
+}
+
+// UpdateDatabaseClusterTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateDatabaseClusterTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateDatabaseClusterTemplate(ctx, name)
+	return err
+}
+
 // VersionInfo converts echo context to params.
 func (w *ServerInterfaceWrapper) VersionInfo(ctx echo.Context) error {
 	var err error
@@ -2003,171 +4440,408 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/backup-storages/:name", wrapper.DeleteBackupStorage)
 	router.GET(baseURL+"/backup-storages/:name", wrapper.GetBackupStorage)
 	router.PATCH(baseURL+"/backup-storages/:name", wrapper.UpdateBackupStorage)
+	router.GET(baseURL+"/backup-storages/:name/artifacts", wrapper.ListBackupArtifacts)
+	router.POST(baseURL+"/backup-storages/:name/artifacts/import", wrapper.ImportBackupArtifacts)
+	router.GET(baseURL+"/backup-verification", wrapper.GetBackupVerificationSettings)
+	router.PUT(baseURL+"/backup-verification", wrapper.UpdateBackupVerificationSettings)
 	router.GET(baseURL+"/cluster-info", wrapper.GetKubernetesClusterInfo)
+	router.GET(baseURL+"/cost-estimates", wrapper.ListNamespaceCostEstimates)
+	router.POST(baseURL+"/cost-estimates/database-cluster", wrapper.EstimateDatabaseClusterCost)
+	router.GET(baseURL+"/database-clusters", wrapper.ListAllDatabaseClusters)
 	router.GET(baseURL+"/monitoring-instances", wrapper.ListMonitoringInstances)
 	router.POST(baseURL+"/monitoring-instances", wrapper.CreateMonitoringInstance)
 	router.DELETE(baseURL+"/monitoring-instances/:name", wrapper.DeleteMonitoringInstance)
 	router.GET(baseURL+"/monitoring-instances/:name", wrapper.GetMonitoringInstance)
 	router.PATCH(baseURL+"/monitoring-instances/:name", wrapper.UpdateMonitoringInstance)
 	router.GET(baseURL+"/namespaces", wrapper.ListNamespaces)
+	router.DELETE(baseURL+"/namespaces/:namespace/backup-retention", wrapper.DeleteNamespaceBackupRetention)
+	router.GET(baseURL+"/namespaces/:namespace/backup-retention", wrapper.GetNamespaceBackupRetention)
+	router.PUT(baseURL+"/namespaces/:namespace/backup-retention", wrapper.UpdateNamespaceBackupRetention)
+	router.GET(baseURL+"/namespaces/:namespace/backup-stats", wrapper.GetNamespaceBackupStats)
+	router.GET(baseURL+"/namespaces/:namespace/cost-estimate", wrapper.GetNamespaceCostEstimate)
 	router.POST(baseURL+"/namespaces/:namespace/database-cluster-backups", wrapper.CreateDatabaseClusterBackup)
 	router.DELETE(baseURL+"/namespaces/:namespace/database-cluster-backups/:name", wrapper.DeleteDatabaseClusterBackup)
 	router.GET(baseURL+"/namespaces/:namespace/database-cluster-backups/:name", wrapper.GetDatabaseClusterBackup)
+	router.GET(baseURL+"/namespaces/:namespace/database-cluster-backups/:name/copies", wrapper.ListDatabaseClusterBackupCopies)
+	router.POST(baseURL+"/namespaces/:namespace/database-cluster-backups/:name/copies", wrapper.CopyDatabaseClusterBackup)
+	router.POST(baseURL+"/namespaces/:namespace/database-cluster-backups/:name/restore-to-new-cluster", wrapper.RestoreDatabaseClusterBackupToNewCluster)
+	router.GET(baseURL+"/namespaces/:namespace/database-cluster-backups/:name/verification", wrapper.GetDatabaseClusterBackupVerification)
+	router.POST(baseURL+"/namespaces/:namespace/database-cluster-backups/:name/verification", wrapper.VerifyDatabaseClusterBackup)
 	router.POST(baseURL+"/namespaces/:namespace/database-cluster-restores", wrapper.CreateDatabaseClusterRestore)
 	router.DELETE(baseURL+"/namespaces/:namespace/database-cluster-restores/:name", wrapper.DeleteDatabaseClusterRestore)
 	router.GET(baseURL+"/namespaces/:namespace/database-cluster-restores/:name", wrapper.GetDatabaseClusterRestore)
 	router.PUT(baseURL+"/namespaces/:namespace/database-cluster-restores/:name", wrapper.UpdateDatabaseClusterRestore)
+	router.GET(baseURL+"/namespaces/:namespace/database-cluster-restores/:name/progress", wrapper.GetDatabaseClusterRestoreProgress)
 	router.GET(baseURL+"/namespaces/:namespace/database-clusters", wrapper.ListDatabaseClusters)
 	router.POST(baseURL+"/namespaces/:namespace/database-clusters", wrapper.CreateDatabaseCluster)
+	router.POST(baseURL+"/namespaces/:namespace/database-clusters/from-template", wrapper.CreateDatabaseClusterFromTemplate)
+	router.POST(baseURL+"/namespaces/:namespace/database-clusters/import", wrapper.ImportDatabaseCluster)
 	router.DELETE(baseURL+"/namespaces/:namespace/database-clusters/:name", wrapper.DeleteDatabaseCluster)
 	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name", wrapper.GetDatabaseCluster)
 	router.PUT(baseURL+"/namespaces/:namespace/database-clusters/:name", wrapper.UpdateDatabaseCluster)
+	router.DELETE(baseURL+"/namespaces/:namespace/database-clusters/:name/backup-replication", wrapper.DeleteDatabaseClusterBackupReplication)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/backup-replication", wrapper.GetDatabaseClusterBackupReplication)
+	router.PUT(baseURL+"/namespaces/:namespace/database-clusters/:name/backup-replication", wrapper.UpdateDatabaseClusterBackupReplication)
+	router.DELETE(baseURL+"/namespaces/:namespace/database-clusters/:name/backup-retention", wrapper.DeleteDatabaseClusterBackupRetention)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/backup-retention", wrapper.GetDatabaseClusterBackupRetention)
+	router.PUT(baseURL+"/namespaces/:namespace/database-clusters/:name/backup-retention", wrapper.UpdateDatabaseClusterBackupRetention)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/backup-retention/preview", wrapper.PreviewDatabaseClusterBackupRetention)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/backup-schedules/preview", wrapper.PreviewDatabaseClusterBackupSchedules)
 	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/backups", wrapper.ListDatabaseClusterBackups)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/backups/stats", wrapper.GetDatabaseClusterBackupStats)
 	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/credentials", wrapper.GetDatabaseClusterCredentials)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/deletion-protection", wrapper.GetDatabaseClusterDeletionProtection)
+	router.PUT(baseURL+"/namespaces/:namespace/database-clusters/:name/deletion-protection", wrapper.UpdateDatabaseClusterDeletionProtection)
+	router.POST(baseURL+"/namespaces/:namespace/database-clusters/:name/deletion-protection/unprotect", wrapper.UnprotectDatabaseCluster)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/events", wrapper.GetDatabaseClusterEvents)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/export", wrapper.ExportDatabaseCluster)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/final-backup", wrapper.GetDatabaseClusterFinalBackup)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/health", wrapper.GetDatabaseClusterHealth)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/logs", wrapper.GetDatabaseClusterLogs)
+	router.POST(baseURL+"/namespaces/:namespace/database-clusters/:name/pause", wrapper.PauseDatabaseCluster)
 	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/pitr", wrapper.GetDatabaseClusterPitr)
+	router.DELETE(baseURL+"/namespaces/:namespace/database-clusters/:name/power-schedule", wrapper.DeleteDatabaseClusterPowerSchedule)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/power-schedule", wrapper.GetDatabaseClusterPowerSchedule)
+	router.PUT(baseURL+"/namespaces/:namespace/database-clusters/:name/power-schedule", wrapper.UpdateDatabaseClusterPowerSchedule)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/pre-restore-backup", wrapper.GetDatabaseClusterPreRestoreBackup)
 	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/restores", wrapper.ListDatabaseClusterRestores)
+	router.POST(baseURL+"/namespaces/:namespace/database-clusters/:name/resume", wrapper.ResumeDatabaseCluster)
 	router.GET(baseURL+"/namespaces/:namespace/database-engines", wrapper.ListDatabaseEngines)
 	router.GET(baseURL+"/namespaces/:namespace/database-engines/:name", wrapper.GetDatabaseEngine)
 	router.PUT(baseURL+"/namespaces/:namespace/database-engines/:name", wrapper.UpdateDatabaseEngine)
+	router.GET(baseURL+"/namespaces/:namespace/deletion-protection", wrapper.GetNamespaceDeletionProtection)
+	router.PUT(baseURL+"/namespaces/:namespace/deletion-protection", wrapper.UpdateNamespaceDeletionProtection)
+	router.GET(baseURL+"/namespaces/:namespace/pre-restore-backup", wrapper.GetNamespacePreRestoreBackup)
+	router.PUT(baseURL+"/namespaces/:namespace/pre-restore-backup", wrapper.UpdateNamespacePreRestoreBackup)
+	router.DELETE(baseURL+"/namespaces/:namespace/quota", wrapper.DeleteNamespaceQuota)
+	router.GET(baseURL+"/namespaces/:namespace/quota", wrapper.GetNamespaceQuota)
+	router.PUT(baseURL+"/namespaces/:namespace/quota", wrapper.UpdateNamespaceQuota)
+	router.GET(baseURL+"/price-table", wrapper.GetPriceTable)
+	router.PUT(baseURL+"/price-table", wrapper.UpdatePriceTable)
+	router.GET(baseURL+"/quotas", wrapper.ListNamespaceQuotas)
 	router.GET(baseURL+"/resources", wrapper.GetKubernetesClusterResources)
+	router.GET(baseURL+"/templates", wrapper.ListDatabaseClusterTemplates)
+	router.POST(baseURL+"/templates", wrapper.CreateDatabaseClusterTemplate)
+	router.DELETE(baseURL+"/templates/:name", wrapper.DeleteDatabaseClusterTemplate)
+	router.GET(baseURL+"/templates/:name", wrapper.GetDatabaseClusterTemplate)
+	router.PUT(baseURL+"/templates/:name", wrapper.UpdateDatabaseClusterTemplate)
 	router.GET(baseURL+"/version", wrapper.VersionInfo)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-	"H4sIAAAAAAAC/+x9e3PbNvboV8Gov5kmXYm2k3Zn1//s2I639W3demxn79yNcxuIhCSsSYAFQNlKmu/+",
-	"GxwAfIIS9bBjb/hPYpEgHud9Dg4OPg1CnqScEabk4PDTQIYzkmD48xiHt1l6pbjAU6If4CiiinKG4wvB",
-	"UyIUJXJwOMGxJMNBRGQoaKrfDw7tt0iajxFlEy4SDC+Hg7T09acBjmN+R6JfcUJkikPzsNrbL1QqxCeI",
-	"5W2Q/QopjjJJkJpRicaVQQfDAVUkge7UIiWDw4FUgrLp4PPQPcBC4IX+Pc7CW6L0HLzNK9PxvGdtHwoy",
-	"9X4zHNyPpnykH47kLU1HPDWQHaWcMkXE4FCJjOQz/TQgLEsGh+8G8vVgOMAfM0EG74fNATMReyYCM/kj",
-	"o4JEug+YbmXRtqehBxvFKHz8HxIqPUqFNKRGjx40B/f/CDIZHA6+2Stoa88S1l6VqjyoOBEEK1JpdoEF",
-	"Nj1vToKp7oMoImSTAsOQSPkzWXhR+ATpszr69YygMOZZlK/VtN4LOVOYMiIQK+F4E7quDniklyRQRCaU",
-	"ET2qbg5jaCCoGSnxPfx88+uVeW2kAJoplcrDvb3bbEwEI4rIgPK9iIdSzzkkqZJ7fE7EnJK7vTsubimb",
-	"ju6omo0MCco9gPTeNxGToxiPSTyCB4PhgNzjJI0BdndyFJG5b9nbc6UkoSCqjWQei2cLwi3PaE1efoMV",
-	"HmNJTuJMwhLr6K41QFQCUq+AoTVK4WdkW4WmlURHF2dBk9VS+i8ipIV+jawuzuw7S1pmnLl5pgnNjAg0",
-	"RiUSJBVEEqZAr+jHmCGzrgBdEaE/RHLGszhCIWdzIhQSJORTRj/mvUnNoXqYGCsiFQI0MxyjOY4zMkSY",
-	"RSjBCySI7hdlrNQDNJEBOufCqLjDnLKnVAW3fwOyDnmSZIyqBfCjoONMcSH3IjIn8Z6k0xEW4YwqEqpM",
-	"kD2c0hFMlulFySCJvhFE8kyEQN4N2rmlLGqC8mfKIo0n7JgTplpATD/Si748vbpGrn8DVQPAoqksYKnh",
-	"QNmECNNyIngCvRAWAX/AjzCmhCkks3FClUbSHxmRSoM5QCeYMa7QmKAsjbAiUYDOGDrBCYlPsCQPDkkN",
-	"PTnSIPPCMiEKazIuMWPBJjIl4UreuEpJWCHeiEjNwEgqrEA61j4I/ObQWybxhJxwNqHTTGDl55eWlmhC",
-	"SRxpGQ3qhzCZCY1cbBAEsjvEDIWgaDVrFN9KlLEJVcDVqeBRFkKPmSRBAbEx5zHBDPQSqLTm3KzytaLC",
-	"Kb6UhHRCQ78dSBgex8RDzKfmhaHnSYynZlX6oe1ZeueWUuWRZhdn15duXpWlO91lSFlrLpoQEBhzIhaN",
-	"6Y7LBopfMR/Xm7hxy6qy0gjdzQjgiiA3TwcWD71uBDHdrxdcWRpzHJ1p+TfH8ZWP2t/WmyCWJWMi9Fok",
-	"CTmLJBoTdUeI0ftjymI+lch0XcKSlrJTIhpKzq3Ip6e0vI6y2Gd/XblXZsWxNccc2eUfliwuL6ZswzrZ",
-	"uscVcgkeiSJOLg3rlqWKM69invPSbogDOrfL9RKJ3yBsW0mzq7INpoxkPuEp9SH1stog7z+nOIue0LxW",
-	"HAmizd3BcGDMTENnr195yK6gpnZiyoWE4GzJSmoU3CSCAhVDZ8TlvfnovGr6r8EgWnVdgSb36ynzLick",
-	"DCYbsrpfC/wx50oqgVNtHmDEyB2y1lwbrbeMdlx6W2cma2pobGkyJmBGPBIvgUqElRq1EfgIM8Vq5lEb",
-	"WM3cALqFMxvtsiY0JnsRFSRUXCyCjcgEBvYidmytBbMaPzjeHDca+QDy5tjh1E29iYomSFZqUlCaI8pG",
-	"FaVZlZgNJGsT0Euq+czfXp9oKrX0Ap2CIaldXu38pMogNMHqEN0MXu3v/3W0fzDaf3V98MPh/veH+z/8",
-	"+2bgxbJz0SIywVmsZQXMph5EuF6k+WT0JxqMbnUBsLbx8OzHxonwOHmfG2j97EE0YVPKiE9k6+duHs7T",
-	"Qqb5CrPKoKDZpzEZXZ+2qzq+PFI7jWmIveLavGnKadt3/qlHPieU0URD8sAnqwsHyDOqfYWwtZtybyam",
-	"4IBodic4nNWmEaCzCdLOiCRq2PhId6Zf0iTlEiR3DahpBrY6W/w2GRy++9ScdMOdf18nrZOLtw5W+s98",
-	"ClZMJBCRBamgPdLB4eD/v7i5+cufo5f/ePHi3f7o7+//8uLmJoC/vnv5j5d/5r/+8vLlixfvfj7/8fri",
-	"9D19+ec7liW35tefL96R0/fd+3n58h//A1GRIlIz0ozOxciuywVEEpJwsdgaKOfQjYOL6fR5g8bH57KI",
-	"qddsDxc3q3ClM/eWS9MwxtLDISf6sesw7wke2tiki+CkREgqtdGF5jzOEmhGvQpB0o9ka1xf0Y/5SnWH",
-	"uQPWOo/ngvCypgdQtdt5n5YoHIt+G81zqia9DzUouFRTQeQfsf4hk2jsDy1KIq4gMij9ZsPbagOvFQ+v",
-	"kY0mu9ARhBHMK28wZd4W5nMxvuoiXfNVhlMRPId2PsAmnFHFDUbqg5/n73IZUzxZzl9FQ6M6/fA897Sq",
-	"AxWjel/o5DLwq9sOms8Z9FUlZsM5jrmLEQOf5KCJX3TQRII7XSxAGhPIDj7MdwEoA0MkcK/Mx0PjvGp9",
-	"Csb3eGFih/nWRIBuGLrWj7Q/yhCO0xm2ESzMIifybRzEEd+bBcMJDR0MjmLnCaMJwSoTBE2xIkXfpj89",
-	"SJJkSrtQATpTEAXjLF6gsaZyE/XKZwbeQUu84LK8SCTIhAjCNC440xSttApj6IJHVxokldayCf8lTnWS",
-	"SYUSrMJZhYIqw6Q8Cjygd+x7waM8rFQGhcYHQCHBtxBXwKogITzHNIaQAGWSRgThEspWMiksaKVvW5Ol",
-	"msxGCU5Ht2Qhy700W9luEpzqTo3N1r47uLaaeiYmV30PEixX83BsA0UJvtd2NcIJzxjExEKepJkqzOR8",
-	"p9IbfF+2QVeRlnsJZnhKRnm3o4KP9gYeSnD7Al872i7d/kgNccYhWoo4x3HgyuT9UIl4QpV1jMt8O0QU",
-	"woja3wXjz5IMnRjmpxKRe+0cURUvnFdJoiHiakbEHZXghmOmvaIYjHBA/chpANhjCoqZhGa3h9yHhER2",
-	"sEelsm5Od4q1JPRFfEBjVcKkUvHU7nK5uJhn30Hw+4WnP/04j5fAj4rnXvVItSpMtZoQFCtve3RH41hr",
-	"LpymMbXo1n1P6Zwwa1cF6EhTTmL2cFCIrb0vibKbgGWVoDhQi+CxMc3u7V6o2Wd2Ia88/hC27WF1izmY",
-	"Na0MOZB77YN7giLwvNqZabvCkKM2MnmJ2dRnWZ1dlN+7AdymwtmFi2EK8/7FydmbS404GO0l8IgWqQ5q",
-	"E8GTKm4VaGMqEeNlW63d3KjMqLQ1qyeDo0gQKfVEGapMBXGB7qia8UxBNFclWN4uCYYV2SbN4JjbFl8a",
-	"ILPQ118PwbYak2I/nYucnkrOTKnf/G2X6NlmkShDJF86EFWZRR+H6uNQXywOtToEYWi1FoFIOJtyvfAZ",
-	"NrrO6jwbjJiOecZCIrqGwav7WxAB9+7/KqwyuToFA5pVtkv5WBIxXy8LI1R0Tq7a4nRH5df14JoxG1i+",
-	"z/ICwjPgaL70Sd8Zl8rvAv5k37gRXMtSmoAbxIpboSWMP1sgIVJ6F3NuXhj7TwlcztZFeKzVh9fkKe0P",
-	"caE8Bg8XqtgfEqrLrDvs3AqCo4VPAONo0RT50Fq7yLLjvrCNbLaHKhVXOC4rle59t1CwJdmcjOCX9d5b",
-	"od7NuK0R+nFLuo63WbdEP7uV2qf79el+X126n80uWDfpz3wWPKWkhzzFYEVyQXlILuiUat6pO4Qwmc1y",
-	"IKrz2MIMcDBY3xhow07IkzQmyhcqOHGvch1BjZI2aXD/4WN0hyXKewjK+kJzBqRN+PBicjR9Q5oX5QGl",
-	"wknqaCBLpRIEJxbr30qT7mkT17oNHhGpKGvJPn1TvHSTmGRx7EmO8RLcFKceJP6IU4lopHl4AjlfE+ss",
-	"YwjppxqVmuGNgZWnScZ86k8BBRz7FW5Oxg79+XkRrDoQL8z//eY62J2Z6UDEcLrE7I5YaQ3hOhv6qkYn",
-	"jBtOJYj8Bl+WJECvpx9UT+eBnE5novxWmicw06v/R1H/Hbj4RBAQUzhu4qPwxC18G/yWYinvuABcFieW",
-	"BOdq0LKJ7xzEVa07TL2T6NmZ0OmlzROXNr2cecpy5sKbetuSbitIDEYhDNc4X4NFTIlUb6xJVEiSV/uv",
-	"Xo8OXo1eH1y/en34w98Pf/j7vzsbiX5DjrKIhpqZqiZcSpUAa61mzOGJcvi3WcnaXlb4ljCvXWf4tJoO",
-	"3ZiZabTT5XZA2KXJpV4pYG27bkEWm6DdR1n6KMvXF2WxnLJ2mMV+F/jOHWx3UMaw4/JjYP3RmP5oTH80",
-	"ZmdHY9YKUJalRDkmWULoajosSYkdxiWdMNsgMNkqzyqRyW5WW2kzsJnc7w+alWZeyUHJp1uTirvYr7Jj",
-	"dvJYS213Ey1zRldvcD1tB9ZZ3L0f+xT92NOWM43V9yvcIJMW0rs/vfvzFbk/hjPA7TFg13+ZnO7aEeCg",
-	"raiepf2qaF0j/bN5CBmsPqkwi4qzRTJLUy5c4Kk0LxmgSzqdKcT4HaLqW2lO26T3IfAA5EUF6Cd+R+Y2",
-	"Pd0mBKVyiNIpNMJsYRLQrX+02nBrPRi2ykSzAF/HNDttg787P1PGgPccnDagRFbhjtLpm7lrxCeNc9+F",
-	"ZmxzQpcdrmjuYENfhaFUzgKztlLrDIIcIOi09sqhtPbtsHhgcgw1LXEeS0QTU65PzTyWrqCKhrhcLq0U",
-	"FYQvf8Jy5qVyeHthPViv0ZtJfxm2tmNMvoP7PbgfAdz5CYvWk0M9Fh4eC80Heik9Wp4WWnxN9DKw4qJk",
-	"Ni+ZhM8MaI8CWHRQhjC6/ZssHxLaKiJgxl0eCSjabBcBcNZL72o8Tcff+pS9w/+UHP5TIbgnFA6PNVBT",
-	"ziRpVlVoDUT6xvg5z6yxsZ8zNuFLE3BcME9D0VP4AF5eW3/HIwNhEwJKqEAV3cpG8rvBNH01GA6m6Wvt",
-	"bnT1r2reSnkOvhHfdwHDZfvBNA8syhzZ4rZAklL9qNk5jWNaXqI5BFEumzw4HGSUqb9+DzF7Km+v7HmK",
-	"bl+Yg1bHC0U6D9Mgk3IRZ5M1VRzOO8rX93k4CHGKQ6oW/6VrPXHLa1CcezEs4dtHZkUtkzOmXX6zn4nj",
-	"2J6rWyaqm98eY0n+L1Uz2EHxnLgrVWaxX9SuDWj4+KZytq/Ota20+N67CD2R5ZVh/OM/1LUFSXPktWrD",
-	"16uNp0nS3GbrXtrcViNPKPuFsKk2UQ827exzJ6KqEMaWBAaHO7tUV3nKNewfBvQbcFwH5JlzCKULGnYi",
-	"HYbrfn5xft5xhbbq9cOIFj2NhjbR/Nh4iFNqbw/YBbaHlYzijTlfmvL/O6I4j3K6OD9vAu0qJeGgo6x4",
-	"C4bybsjtQcnMWPQVMvMuaL3LUzwa2aMQcmpt9L1Slxj49pevPIXLV9puKVl5/UjrfSINBmsNZpzOiSBS",
-	"ueiF332aZHF8wpOEqm2kTiq4no4/jbd7N/O2WNYa8qusBsrTKnoflhfts5kpB/ccpzTB4UzjfxGkt1P9",
-	"QAbaow7mB4Em2XNiPOsmMes3pXJQzg03USy5YGpGFA1LhaCgSNwMz8kQURbGWaQlkanah1mE5lhQnsn8",
-	"YLkxPwJ0VIQ6EryADsz+HGdgBH36DVrq6QyRm9hnb7UfRVnmYQ73Bvq3NfbopFw+UkG5/oQqxFnt5D7w",
-	"JxJEZYKRyISyirTy/DoL2JkTaIa1IS1MbLfYQDP5cCbcQyXiKf4jI3lUbEzyWzKolPDCbDXaMI0LrpUi",
-	"OhoFZi8QpArEEU3peUHJ3FQ3YOQe6rlATC/fysvhfmKgYoqsh5y5YqfQl56WDQqlXEoKJfgm5ZVWL63Q",
-	"6w5nmE1JhLgwIFAzzBBGE3KHEsoyDS5ArrYRSGRA4lDvQpamBpSDtjm7msm8RFSOSQNKV3rKHNUMcewg",
-	"ZSFtcDmhQqo89DNEGYuJlGjBMzMfQUJCc1AqfkuYiaJhhgiEjWx0qKU2ZmLKkZ4pkpzwjHkCw802zQoR",
-	"MhtLjW79DkjOzh7QcTej4awowwPc5Q6fOvS7BUIpn/xLR0JOD0QIjHyNJANrSWJIiYUamYQ16lbYmbtJ",
-	"SZSxW8bvGFCvAa/uxqEiJhOFMgYsxaK8BlyUgV0iiaA4ph+LSmP5RGlxMBm9IBTof0xCrDUnVaZMlULh",
-	"LGPahdHzd2+VLdtp4s/SNnpZrMee+mDc0GV9TWYheQGyjVbigrE8jiAQixmaHwQHP6CIu7JKpTEM7Wup",
-	"zzQa9SKst+enlO+IVFSbb2z6XaVOsWbcWOMPJnECQd48Wq/HFQQEaVvfijt5CHtN+ge5x6EKapVE/vr9",
-	"YFkhqlb9fWX8WVPXrzhPXYiRb2Vpr8DKALczUdk1MTtmrnhraFeqOBzeEQll9qC7FW+Gs61ECtC/QB6A",
-	"ghoTpOyhdZxL4lKXkBECEgplLOERFB8Es80JFzPzAF3wNDMHnaCcLEFyIRVJAnRJcDTSKuzBQ+faxc+E",
-	"ICxcjGzJvBFm0SgX5+HCWzebxJNfKLttIsy9MdsUby9/qe9O5HjptP4bdsPenF5cnp4cXZ++QUXA2HAZ",
-	"1DHUWhxPcaMOIEMHwat9TcEES1ITN1SiNMaMGa0JtXsSPifuswP3WdDt8FQnc8lk5JxomdNWPAde6hXN",
-	"aUSsJdAsYwRFFantD00wjTNRMZpCLDWIND0nWaxoGhOjiUwpNsJCzb1EmBIONWtYw8fvIBjQ5ZIm31/C",
-	"yuhvU2kScACjDTWHaLcBMEyVRP/n6rdf66LvHPafQCOhiBthmXKpJvS+qAE44QIxIoHrlKF0om0/7duY",
-	"RX0kgo8oi8i9Zlj0Tz1Xs7mF05Tgsk3BTYgI4Kg7gLqkevISRRlk10/M1zM81+CswTBAv1nTG+jz1Gyk",
-	"yMMbhtANhFVuBmhUIrb8oRWkhuWKMsXmQ1Am7/bfBx16MCaJmXxeQNl2cTNYq2zWEZplCWYjQXAEBl7p",
-	"dV69CZdUDAAhQOWK1NYItYwOknFk6nBiqFzl3TeHEljSuwWNLBetPakzK/pzS5kkqVpUKlVW2Cm3r3fO",
-	"5m+IwjSWv89ftfG6bWE3dK2ZnYcmUMGVhsPOj/6f07VOXBpDWnEnMMqfe6RGycLT3HwJ0C+YGqOrsmeV",
-	"7/7fQeXznOly+0YSVZgMoBrplMEZI8M85rovY74Upb9dpNodtYFSknnvxj2y9geWMkusfMFsUbRy9AbI",
-	"1XJvjmMaDbUNkrGoCId7fDzgcr90OzESwDCVFUjOGbOowlLykILKuqPKLMgAzQHTyOIA/aoFWRxX3hpp",
-	"5HBl+iSRlTyVKu3LYmdrqxpPJGgquK98moYCvCqBui7tfSCwHnl5rUH3hGw9qn6zg0HRbwxJnhBkMoOo",
-	"g3lEJxMiitQG69SQqBjiZ8qiL52pwFpDc7BjszV80Iu7wqMxYoeyaWy7Nz6iSy2zcZvoZYvkVmJxNFFw",
-	"6QbXy2kW+52Ua2/n1aQoK12xOOG2kGKOL8f7YJEpQbUuv9IYteaLSVYx0ZNyYgrIH4Vvibl8ATwCRRA2",
-	"936ObI43l3lHqqq98j5n/A7FnEGZ7DtMVT5LfOvSa+rdB93KJmbUQ/xvz97UsRm0oinHdxuq6vTr39fL",
-	"JBGjaUYjspf7VEJ+k1EfVW6pBpfoP7M0E6qxChsursBxnCsP9q1yLUxEy0Wf+pS2h05pC3nkc1Oy6dRI",
-	"zp+ury8cbnRby2LUBWiHaB/RvCZ0Rx6xinaHOrBkh/V5dTvOq9vCoyhXh4WANmk9cVvN4NuaLPJNi60c",
-	"kLvZojZzuJbHeGc3g38aO/BmYBe6hWeCjpylHsZYmPgXZob9LBSB/caZFpjEhDn5nAihrUyqvBBdUcLX",
-	"IqnACvoN9lIO0c3gKoOdTu2LivJKH5wctTUBwSk7+S6J2FpZeVMrv0FHmZqZqL9+dMOO4rjMfshtHR5d",
-	"nLlC6eiD/ogLG7o4RMcECyLQTba//zqEwD/8ST6gGXi9xhrDCPwTuzNAGUpjTNlIkXsFAYRrqMas31mN",
-	"zsc21D5e2M2LD8TMJlSxbarFjfpgLQH44co667cQQxFUe2Y03/6RoSCEBTeQEkMVFBG6ICLkDOerNaxU",
-	"2ik8HBwE+8G+TbdnOKWDw8HrYD94ZetZABXtmW3pkd08hmdTotp3uc2NxCaMWt3S1ojNCe8sst9UtvIl",
-	"pLoYXxaGerW/73bwiNk/gctEDGr3/mN53K5thRCpjgSpB0BHdT0IXDDJ4oJLNIy+3+FMTCayZ/C3TLYM",
-	"/8NjDH/mLBkbgCC24XAgsyTBYtEZzwpPZaNWCuQkpdx3QMJkadk7navdOftMM9R337mY3HffQVTuw4cP",
-	"+r9P+p8iRqelmXztaPZmMHSvtRRxr0uPi/wJ89L8Pii1yJNATAPz8/db/Ttvk+c82BHgZ62NSZkwDUg2",
-	"CglTAsejg5uBbvE5X9LyteGPmSBLlwctlqwwT/5Yskjb/+84hKDy72b81uXWWhfrLlbVEAAG7RXGHORX",
-	"bx1zU0Z/JzTvGcnmDXn44LpU86hChHZLwRU9Lidk2CyPx5FeveBaX3CtFjFL5NbnYUMT7n3SDPHZyLKY",
-	"eMshwXOjol3EpJnnVWUJ802dJUr5aYfvvBcxWpHczCKDcoxYzVxi6KG75L9Ku8MSDurm1/sGXX/vcyB7",
-	"+ltGf92IoV1xeq2uH4laj7x+JOqp01YvM58MzXYgryWWHlahtxqfUBTHLgHZhQ1aRgiQyfi1lTmqTc1+",
-	"VNAgck+S8NOg893bNe350N3sGgCKDNBvbdDNdwVdqKq3ep4TB6/HbSssIHs+c+QiL0tVkjvMCfvBsPlb",
-	"vtvfXNONUfP0p09l+Y/VPiDd+Qfs6W9jDbIFNTiKvP2btHRYHEgcufMs6wWmPCca/dEpz3mYhyS7tuM3",
-	"PeHtJE7VgnZHYIkH2e0hqyNfd8W2EWhIiT5ogv9QpNMGN+wYSxK5fC/33mwTpwTubUS3ZGEizNVcekZI",
-	"JCt9XWXhDGE5RHRiujpEaZJ8sBnOH/Tf0Fn5S5unErkYdmWMoDVK4zna9TAmzYoznC12zXk7Mr5c0MZ3",
-	"HK5n5a0iN+1Mt5KT21THppGcc++5eF84x8s7nf2RlvP3X3lg53sz14cd3idVGFdowjMWPf3wkp9CV+m7",
-	"jpGmpAP5/0jUdrR//oi038v9nrG6xMCSjbiqJRxmAjgbaBbz4ZPWLI9hG1YKLrTYhskq2/CLxLZ6IfHf",
-	"IyTW4OLVNiqrFJNo1cY4jss1JRLM8NSkFtmcH29Eo1Ip5sFou1rhozNZNwTv6jXWIGZsefj7856rlzly",
-	"gUt7dxBUu1meh9JyNW2Lb+y/H3UNQZxPul38utdfXAa3XAbrF70tcPzyrnnnVbQJ4Ff7B48/GXerthXL",
-	"Zh6vHn8eR/YCpz5M4QlTtMsOJ/sjL5zfbyLLNg1erJBr5punKdeGy0ZsAT4k3WtZA7aDPU14btPP37nk",
-	"uvf5VWu+hbtMxGfggK55kKcPSu4m3rI2w7cEWy7h9I1cj2V/JKrn12fKr1tbIz1bGrbsyDm7VMTuGr5N",
-	"vAr7bTe34jJv/DX4FfmteR0dC3cR6lPzLJas4wu4Fktm87i+xZKJ9M7FOs5FIUJahJqD9GZSbVv/ok3C",
-	"eR2MpyLh1rNY3I29W5kslxXx1fsYPdN3ZqyVfL+Rl9HGuE03o+fa5+tpbGCd9NzZxdVYiz3TzMueaYzD",
-	"dfWq2YnqOfQROPR5uEB2b7t3gdZ3gSZZ3Au8ssDrJpB26Yesl9df5wh/Un+NHuTTCKg8Div2xwl2d5zA",
-	"R20ttN+l9EW9u25Bwa8kGthZBz618N8TUXrdtF28eOCoXx/u2zLct1TErKNXN43rrZRS3sDe8/I8tvM4",
-	"+hBez8jLQ3hrMXXn8xgrObMZuevZ8jnE6Hq+28WpjTWZbo2Q3ErG88bket574tG3zVyOJxBu6+XFrmJb",
-	"D21975UOJGwc5EKukw6xruO8aS97nkkWYB+xe7iIXYl1dpgRmHN3KAhcw4BjubJcUrvQQeVuVtv0J5XW",
-	"PZs/eTYvENaz+UMY+jX+2a0CT6kSK3n7glOmRpSNrmlCkCBxLozs3RpbuvEXehI9rz8DXgdM9Vy+MZdv",
-	"y0m7Zf5y5v/m5nveSwf7/bJo23P7c0mu6y34B7TgS9yzk7wTwqaUdWDp4t78fEru02V8fJq3+e9POTFr",
-	"7al/e+pfSmx1sjdgX4/cS7vB63qppodlVuqpa/EcVFa+nOeiayx0ew7bpeuYU0Erc7XsEHkLAa3iler+",
-	"0FfOLg+3r9POKU97W6fn8J2W5VqDybUGLe5SXVnxHqc4pGoB9c0LlZ13sFXF+8vSla6PV/a+GLWnvs1r",
-	"329OF83a9/PiLuRWYnR3Ph7j8JawyN2HDFd4NojNXqD80DcquDucN68Ft3RV0K3BhdGWmYgHh4O9+cFA",
-	"qxULxzqwdJcLNaNsmseS7JWaBSeg0jEBq9g0Npr6sb0zJ8g9XdUDURt1WziVtV6d5thirqgUcvLPOT9P",
-	"s80oxca0fxBXEWWNMY7rV5vYnqs3m3x+//l/AwAA//9WYswOY/wAAA==",
+	"H4sIAAAAAAAC/+y9e3PjNpYo/lVQmls16awkuzvJ1Iz/2ep2dxLfSSce2z1zd+P8NjAJSViTAAOAtpVM",
+	"f/dfAQcAQRKUqIfdcsLarUlbxBvnhfP8bZTwvOCMMCVHJ7+NZLIgOTb/fIOT27J4LRSd4UTpX1IiE0EL",
+	"RTkbnYxuzHc04yVLEWUII/uLVFzgORmNR4XgBRGKEjNgIghWJH1txppxkWM1OhmlWJGJorlur5YFGZ2M",
+	"pBKUzUcfx/ojvsGSnGalVETAkr7HOWkvR/+K+AypBUFvY92QIDMi9MhIcdMM1rvRvLLAScfk5tNjrWD9",
+	"niUvRUKQ64cS6Nhj7A9nb9tDfzh7u83IRCrKMIzRHPI7npgvblwLLlgiSZSGIDOZwqqUukn0CGOTEjan",
+	"jFyZn5tzvjPfkO5Tn3aMBEn4nNFfSYpmgufmW4aXvFSxSdjaC7DboSz8q8KF1ohcFAvMSBoZla+CH9kF",
+	"PDecZwQzPbakv5I3S0VkDdUoU3/5smpPmSJzIkYfP45HgvxSUqEX8yNstXas9YuNA2ewoZ/8FPzmf0mi",
+	"9Irq1OQsL7gwdKBOIoJpZPtc3gZf66duzoTCoOMRVSQ33VtnnlN2Bh9f+jViIfDSXfFu+A3ble3bjpww",
+	"zDSub3n9yX1HpTk3v8f/I8hsdDL601FFyI8sFT+qdx199KP7PUOLU14s25tOeLHU+8UBaGPG1YKIdaQe",
+	"Pl/C1+/7oo4dLfyJSpTwgpIUKR5DohsN5KemRWSKMr8hwk+im1ajxbF0La5szZOa5NPNLcic6r81GeLC",
+	"NNUHvzN1NbfXmxjNKKNysRlzzomUerjWUv61WFZLmGGakTRKAAFb+t2ebbzy/qboKmiJM0FwujQfdY8F",
+	"EQRhQZC8pUVhltTjtqXCYkOhRbOwyKlc6p/D2xkjzswPomSMsvkYyTJJCElJirjoPLgGLWmjmltBuPhu",
+	"wqJx/xwLnEcIbqF/J4oIQ271mrXsgivOs1es5wAwwSfFt9h+91YvSJFRwJJzntEkQvMK87vfql4LI/dw",
+	"MVLOyswzHEMYW0itOIoRSDm9Zldw7ZRIA4UB3mPZRR0kul/QZIESzNCN7gPAfM3Wn73s4GLx05eR45dw",
+	"/t3ctMlMCMM3WQyVT93A+izt4FG5xY7wOvLcuArWpVldRhRJkaQsMQyDmUMF6jAaxzFV04MfWLYcnShR",
+	"knWQ5XYzjp3tKiBThOk1vyUJlVFSndovjasQrieyUIhveKm60W2b9xTRx7bqcBGVCFql6AYuDVYTvS8n",
+	"FbcmEgTLqAzneEM12y0p1EZg5unreiFr5Lfc48K6aELrXhqiJ5+10FdO0evIgdIZYhyJMiPolpBCIqqm",
+	"1+xrQ+j9cBqO9ZkgzrKlvgPd9C1eSmBvGVZEqjZFGtfWxICNzLi4ZnCHlKkJZQYyzNPnjohlvU8p4c4t",
+	"O3LURkKrkjkZwXWA32eUYU8VrxlmaW1QhW+Jpl0zLjz9gj3i7B4v4frr1LFxvP48LDhKzUL0GcoxSnjJ",
+	"DBkgmlUpkhmRh+BkERUNZjQz48OZuFupEeWc3wUPQtok5GNEHhK9GD3AOZdqLsjlP77TnNzRarnQI+nv",
+	"ghRcUsXFsksIjBDyTiL61iy3djQwoZ6OceUPCbOlOZ8oxgIw0SwC538npEBqBYjpXZiztbuZ0zvCEKvJ",
+	"abZvqq/2nqpFQO5zymhe5qOT45ig5aB8xboiXBjAi7LoevQies37HZZqxbwr9tleUq8J33OmFo99B7me",
+	"ZItb+Bcht4+9tntCbjdaWgdz7kPWBbmj5L5LgVlRZ026QkLTxZYpQ2Q2I4kahzuaUSFVh1gmN3yxt2WI",
+	"CBssPLvaYEDL4zQXNVq9dd0viVKUzS+hcfMW7Bh+LWO/3+57uUwWJC0z0nktjDwozYNakqrt2CF6t47e",
+	"t9/w8N0CL0omY+euOeivnMWkKM1bf7WPOgMaPMGZ3gvSndbrhfzQ42D164/yomRtVZqZu73GC7sYRzP1",
+	"9ERq8Fdu9aPxiDxgLWGPTkavjl99OTl+OTl+eXV8fGL+/z+OX54cH3cJ2S3xrVSJXkZtyC8mL19Nvnh5",
+	"9eoLGPK/e47WOC899NjutNcxyfY5RR+tER2z58qRV4sQXESO2sjAwLnsEhCViLI7nNF0lZK5/YE8KLf8",
+	"xpvOYgtA2BiRvFBLLWg2p02pNFtAXARL2A4volK5/Vy/62P0Bfpc/18vnWgA96Nx8ADz219xyQor2Wmk",
+	"kgorKhVNJNKCL8IA7feUpfweZMNQ3HRiJTACKpB5amkWYMGyDkL4jmjgeVsK0+iSJJylkcW8hnYotQ29",
+	"gaUt11jshAX201QFrKY+7Rs7pn0v1scGHpZzqZAgCWEVI9sENPThv2NKLGOQ0WHKausgQeF18ltkc5Sd",
+	"Cz4XRMr49wxLtfb83zbOXXdqH/42Z69HuvQDWbW7iilCjOLCQdLqhYwRZUlWpk4RZd4Y9iUVX+BqtSQc",
+	"yyVlCfkust5epqLxyCmMInBmFOxW3UoZIvqJ2Xo/1fcUf5eijM8laj4lY1vfCEjNCj4YnXWMgDnlaxzC",
+	"YMp3LO2vbYEulwqL3iqaBlEMRwiXEK7WI04NS4KLWkM0AW+35IteE7eJFmobxVUbt3vAaic/rdlHG2p6",
+	"+itpKeZwYCNuKhZ+yKmyyh21IO53zUOy1LzLjeIW97c3rDUeVIZzQQouAlWdvkCsuOjNba2hYBMtum1l",
+	"GF+aUr1AnJ0HsDPDmSTjKBPyp0MZnAXYkxvsNMv4PUm9zTVySdoKqk/DG1Ilsr2Q4qiUmkpQ2bZ79Vcy",
+	"3pTJLVGdcF9bzm8bSHKCzKN9xqOHyZxP9I8TbaCa8AJOdmJIJBGgtPYr/W1EmH4q/ziSX4zGI/xrKcJL",
+	"qyYsRdZbVxps2o40jtzGWtD44MyCDYqiEe7SsIee+NshWDd0yJ0YN0XBlIhKdAy8nUqUYEl2cvPotKV2",
+	"nGyw97XnJ7cw8tuu3Tb+fxJBZ9b61d7hXfC1ZvPX+kRkFBxWeTtFZwqeMaiwzAaVTNEMVTZk/V2StjZk",
+	"Y6cmRbRPBxbLft5HmwqA4a77kedHsZPXDr/bXl5gKWOK4cB4c4+lu6fUy0/+h+YZIhjRatUZVUuULEhy",
+	"G3cpwiy94Q81J7iY6Xsza3lToeT7RyaMvyS6sSmEeKvHisCEtF9iMKF/89LnsoYGsr/i3qxjuV5zaiTf",
+	"1h21NAgzRA2CaUIHiyUpWhLV+9a6XJtyzPAcZIl3d0QYNa1xmliNifbJDDKdteQEpjFvsvG82rT3QHmz",
+	"NF/GTZMamNFDBh/26jTQGItKTtl3hM3VIvTzCqA0UFU03meCM0QeCkFkaKB1HdIadBga+OHqdIouLAjr",
+	"82JtKKISVbqMvkbn1s3FAP2US/VGEHyb8vsIVbdmBpRwabTaglQa225hv467vLwJTUmgzddzJ0XZs2VO",
+	"ci6WPRvLjRahuMJZr7aNg9ar9yurZm2IwiM3Q/TwDcjX2G/lSLO9aFw53bQlY0M2/k6WUeJ7gHJzm1El",
+	"GS9Tv1dofZRwpjBlFt87XN96ydsNhZvekkApmVFGUgTNzRwes/17xPz59vtL+AzghBZKFfLk6Oi2vCGC",
+	"EUXklPKjlCdSrzkhhZJHWmOhLRlH91zcUjafaKvWBMBEHpmTPvpTyuQkwzckm5gfaip2fC8nKbkbjR/j",
+	"tSBJIojqApmnektUgBuuaMM3RsPnNiLe1RsgKs2lXhpB2XtXOv7l2dfr87NpG9UK+k8i4v47r8/P7DcL",
+	"WtLRfP2bBjSY0cAYNa90QSRhqhKvmXVTnKJLInRHJBfm+ZJwdkeECr3TYTTv9m1lCHPN2vXiDmclGRtp",
+	"L8eaxutxUcmCEUwTOUXvuYCn94mH7DlV09u/GrBOeJ6XWgo0+CjoTam4kEcpuSPZkaTzCRbJgiqSqFKQ",
+	"I1zQiVmssSvKaZ7+ybEXGQPlW8oistHfKTMvM+yQ0yy1OjGnJ7x4d3nl2RecKhxg1VRWZ6nPgbIZEdDS",
+	"q24ISw1+mD+SjBKmZbGbnCrpDGH6mKfoFDP7jiyL1Mg16IyhU5yT7BRL8ugnqU9PTvSRyfiLQmENxgEy",
+	"VmgiC5KsxY3LgiQ14E2JNBKMdIqmRodpXE3zgUk8I6eczejcPq4i+NLREs0oyVLwO1IcESZLI21iuCBD",
+	"uxPMrGyJkrCvNA5JymB1IXhaJmbEUpJpVBC+8TruKPO1pMIxvoIk4cOwp6D/Dj4APM8yPIdd6R9Xej0W",
+	"VEWo2fnZ1YVbV23rjncBKFNrSnC68228ct80m7h5Q1ZZaxS8DNw6O0Xc8XYnpseNHldZZBynZ0wRcYez",
+	"yxi0f2g2CbxQrB0E3RB1T6y4fkOZMTnA0HK0k/9JzfGgocZ1n2DHmRXHmh4OocQVvanArhvxkKiDy/SJ",
+	"IOL0AlA3pCpOvMq4x6X9AEf4KlvpmRrRLUV20h4qlMGs74wJVJAxO3+tgR/fQ5y9HutnqDgSRIu7DY3T",
+	"F6/iBoHOx2oTChL9eO3eSY+4gcDi3rDGx+C8LvpvgCCadV1696M2n7q0AYcWkMDXx4UhaoJ/w7mSSuAC",
+	"whG0V3mXF5DdZsdsb4KvTWSCH81taTAmRox4IlwyLNHs1Pwsp3F9oFpE2AZWCzeBbtEIldEusEcpFSRR",
+	"XCynW4GJmTh6sTc9gqLevmk1ih3I2zfNSKn2VbSPZC0n7TA41yhmTG8dB1W/8g9XpxpKLbyYQY0gqZ+8",
+	"+vFTKLjQHKsTdD16dXz8F+NV9erq5Vcnx1+eHH/139ej6C0rH9c6w2Xm1KmjphJBx2m6xbhoV7e76Wjs",
+	"X3i2MzwiIo+8j61r/Ri5aIgN7Qy3tevwmkJovkasgiuI+Uzo392YdqjmfUWoton1iZJr+NKm03Zs3zVC",
+	"n72L6ssYra4eQJFZ7SdUeYfDLyij5gGi0d14z9aXMUVnM6PrlUSNW52c77eOfZUkbR8qKOkwW/4wG538",
+	"GHFsaD3nf2qC1un5B3dW+p9+CZZM5ISZkNcCK0WE7vD/fXZ9/R//nrz4z88++/F48ref/uOz6+up+dfn",
+	"L/7zxb/9X//x4sVnn/349/ffXJ2/+4m++PePrMxv4a9/f/YjefdT/3FevPjP/2O0IpWmZqIRnYuJ3ZdT",
+	"iFTKyJ0O5b0Zxp0LDPq8jyaG54EytumPYD40sLKyxK6ipkmGZQRDTvXPbkA/kvnR6iadBqcgQlKpCFPo",
+	"jmdlbprRKEPQjh4737VxCXELC9xDutfxXC68ZnvTR9Ut5/22guGQKstCwGqKh0QfBQTJyF8y/YfM05u4",
+	"alEScWk0gzIuNnyoN4hK8eYzstpkpzrSI9tPUWXKXZeaz+n46pt0zdebMmsOArGDzTmjisONREw39pun",
+	"MdUvq/GragisM36e7yOtmoeKUXMsdHoxjbPbHpzPCfR1JmbVOQ65qxmnMcpB8zjpoLk0z+lqAxJEIDv5",
+	"2FsBKDOCyNR9gs5jeLxiQXwcHDiKONPEFF0zdKV/ohJhhnBWLLDVYGndq717qwdxwPd2yXBOE3cGWhPm",
+	"7PwEq1IQNMeKVGPDeHqSPC+VfkIZfwutBYNwQIIkAa2XX5mcdusLLsJNQv4QwvRdcEYQYUqYcJZznmqF",
+	"4LTWWrbPf8WjOi+lQjlWyaIGQbVpCp5OI0fv0Pecp16tFB6Fvg9zCjm+NXoFrCoQwneYZvqcEGWSpgTh",
+	"4Mr6eeesfds2aKkGs0mOi8ktWcpwlHYrO0yOCz0oyGzd1sGN2dQzEbmaNkgjucKPN1ZRlOMHLVcjnGvX",
+	"e8g2kBelqsRkb6mMKt9XGehq1PIIvBwmfthJhUdHowgkOLvAH/3aLuw5NC+OsrUX5zDOPGX8OFQibl1n",
+	"NTkL8HaMqEL2vWuEPwsyxsMWG+cX8qAfR1RlS/eqJOkYfEbuqTTPcMz0qygzQri5+onjAMbGNK1WkoC1",
+	"R0f2ktRO9qRQ1u/RXeAy6v91bn6vq0ml4oW1cjXc5UK7g+APkTjPc/2z15eYP2ov9/qLVLPCQrMJQbGK",
+	"tkf3NMs058JFkdEgzw3Eh4JcpQPmtdkMbDjGP9O0s+5ZDZZgklUwJXhmBiIP1hYKdman8mp6Kk231DnA",
+	"ntaqHMhDwWVMKWJ+rw8GbdcIctRqJi8wiwZcnJ2H390Ezqhwdu50mAK+f3Z69vZCX5yZ7YXBEU1S3alp",
+	"pVr9biFnjvE1C2W1bnGjtqLANKsXg9NUECmJcZuqLQVxYUKBIdsFIyrH8naFMiyIxWwpx5xZfKWCzJ6+",
+	"7j12uVVcR70YB0/BYyYY13/toz3bThMFQPKpFVG1VQx6qEEP9cn0UOtVEACrDQ1Eztmc640vsPk+sjzP",
+	"KiPmN7xkCRF91eB1+5bRgEftvyaF5XoXDNOsZi7lN5KIu828MBJF78hll57udfi5qVwDsYF5O8tnRj1j",
+	"HpovYtR3waWKPwG/tV/cDK5l4CbgJvGprLC2LW7kL/8ePoD8pwQOo4hssqSoyFMN7bJONgQeLlRlHxKq",
+	"z6p7WG5N+rloWHi6bJN801o/kWW/0Z1ms1tVaTxXQ6bSf+wOCLYg68HIpWpdeer9hNt4ttd1OFT33lnt",
+	"6Od8ywd3v8Hd74/m7me9CzZ1+oNu00NyevAuBmucC8IpuaBzk6WsFVyjF7OdD0R9HTuIAVUk9qbCQNft",
+	"+JjszvwDoC3Qk0AaDBe18r/8xoSQ+RGmvbML2PifyJTwIZxQKpwXDgbKQipBcG5v/c/SJ9voP/nKxLhB",
+	"1mi3iFmZZRHnmCjAzXEsp8Y3uJCIphqHZ5RY1ZRLMKu7oJRohK/SbRgnFO1kGI/TWhX2bVftrv9NldOk",
+	"B/Ca9f+0PQ92sag9gFg3tdYRGBTUdVb1VddOwDOcSkPyu7KfDnz60fm0V+T0ijWOXntMMTOw/ydh/72x",
+	"2PuodqZI6k5zZ9XzaxOuHWBCuh5hRG9Klsa8a/VD0NgVDS2hVWD82nNIBDGMAWcb45NZy2nQv50+acMh",
+	"4dV7T8Rl4Ee8qv95rbE5X2c03mo3F1X3Ps4WLsuC6Ws9JmvRcncv17K8ykWjeXa94eG0fof1tQYX7HxO",
+	"OvK7RjMgrLIkdmm8q+XChB2JSZroULWFs+h/ABe1W6/vH7rK6I6rGiXG+XUJjgGG0ztJiDKpcJYB1w0u",
+	"23g92Hw2lCneOryaHF5nWmstBU1HlvjhrTsbHW/9TiqaR6U19yVFeRh4HaUaPuUxGEu1egbMeM2G0sX6",
+	"Jwss5ibCvhEwjKUscyJtAgGILfBZmnVvLFGm++p/hPo/65/hZ2Q8JdNISuKkFBoU4iGkeZXKdhVtqEeq",
+	"r8qQc48Fc6ka+t5vPATVr7taZQ/4r1C/fcOV6toKJG0miKW85yKtp0EUnEerCpWSCHcQ61r3AE+TIloz",
+	"VcEVSVRH8nlogwrfaAtWX5tgJU9oL2nvvL4ausf1vtOSV0Q0rW6W6BZIkMygsuK9eL72flhVw4QnFhqr",
+	"BOdmnn4KWZOTUaeX7Ug6a0c0zYKpOmfqm6+mo2bLhoKAOfIfAoMolpzVQR5y4FuxAyLcW3NLTeCoWoYB",
+	"61ooH3maEfUslR2xP6du3TaLmnt8uMNqDaTWnn+Gdz7+SEbgUXj/wTH4k6yubGwB0V9UX4TYKONUbIAY",
+	"z10BBqvwz+sO7MkZFnYDddnqWOdemRUcnfNNEus2DtsMN+52Cmzs52vB8yuSF5pOVIk/Vma86hb9oCLJ",
+	"6nwG9mByouUAkxiIF2GmrMy4kp4pidyTceqMcn6bkW25rv1kylrrrSTsbwnOYpFrC/N7R2EdrUehSqIK",
+	"HCPvz3RrTcY5T+2yInDcWczk2zLHzFjuzEvRtqtyixgF8KalTWI64wueZSSdlAWqDqnjveEoIzTUVCIl",
+	"c4EhU2jJqp+tO1mMZEI0w9aH+U/Tves82ym/4JTcKY9H1lDpVtEDpHopKfemnhz0kgeulxw0koeskTyP",
+	"Bul2BOY6MdxM18Q6gkVGiVRv7XO8q8bAV387+epv/91bAI6bfChLaYJV09hTUCUgX3Xd7INnyt1/kJzR",
+	"1KuJWoAAT+uB062VQaM9b1d0uJdq2KHzkpfSeZCGiUc6kna73JNm99hnMudZ6ouktFImm4617K2aDJN0",
+	"7HicdVYkXr8SFOw11M2esFqYw91KAKBKGK/VKMPqA9LQu3WK5uhsjTDSoTPz3kXmJF2x05jyq3dVRf1P",
+	"mNrk1JSdIfOPhEOPAKlND7xw4bUJx+Fh9ZAeKuGvXUUFS3VFRG4NyBf+6Rot9RE+A6ucdqrqP0ZkOp+i",
+	"H354/3ea2WIc74TgYrNqIDyNfygWWDbO+wKKuUXx3vmntYmRIAA1EYt0mbttukb6b5xl1Y4DQtHXVY5n",
+	"tTx01rvShSi4Cx2NRybEY/TTOuCwGkAzrjsXt+Ngez2A4wJSTqyVLm27fr5ovhDe4Iw2OKP90ZzRLKZs",
+	"7I1m+02jRrWd8gkBOq7OljVkEBoyCA0ZhPaWQWgjP86QSoSum8GFrofDgErs0X3TEbMt/Dc76VnNgXN3",
+	"m02Hb2Gw8lqonl9ugyruw63fztlLXRe03Y9ToRO6BoHrsLV39uIHJd4hK/HsJZ27F2cdoUiGC0nSzkIs",
+	"xmgqC8K8U5B5oo1tJRnG7/3LCYypCrk33CMVafFP51WAWtv0HsqduD1V44ybR9f/hRoWSGyoVu2XDtOa",
+	"pYvrqvVcrg4KC5QC0WIzsUPXtW5KQSqtyn6uxXSKrNQ0kOEy0T0xiCx4OV/UtZRb6hGba4lZ+NbVd3OL",
+	"27q6m4MrexA9YMjZsdsr03JWOkYFForGQmhkQRJ4bWCgwBhcKYBGaizW6Vmb3eRjVX0LLeFXwV+IVo0p",
+	"MzXy/TBaD23q5m5kq+1bkqLSxckcZ9kkt+HHrQ7u8dzfQeDcXoq5A/fqiToNhC60vwXpO6vkBC8bWQNM",
+	"xP7oZVUk5WT06ptGTkCIQR29+uqb4IB02rcwuUhtCtvGxWOvj7N2iYj12WwAx7v4s7gxeri01CzdLS6Y",
+	"4AIn1mGpv3bXk7yGDkDbn9i8EXXbnYKwArs3vGRpXAFsa3+fBguNZv0haZ/Eh7Y2VUpnxgXYS0z+HKJr",
+	"0AO/X/F8gRZd3Oc84G2Wdq5faMsCZT9aT9sbog8aRkrDhIbEXMHIrgn+qdUhl0upSH5hOpzXGxHwbIt7",
+	"pgEmnbp8mL1U2gAeq1DhXUdy3vr3NYpqIBGDgnpQUP+BFNSAGUYxDceu/9VwibbpqrrkFwv7G0YnxPOb",
+	"wHKMXk4qzNIqSaYsCysaNtYlp+iCzhfKPKGo+rOEtJHFQ2JwwCT4mKJv+T25s3nWrO25kGNUzE0jLRtB",
+	"9T2AqPWqtc4Mp+uUaPbAN1Geves6f5cIMryBaIyR1OhU1rAjSCN55xqByT483MD5sMtMsCq2p8v92Kuy",
+	"wnQmTQe/5gqm/kDQu8Ynd6WNvuPqB0iWo2GJ80wimkPdObWYRoLZqKIJzuJ2YtPzWywXUSg3X8+xin+t",
+	"YKNHoMOKDPTDcT/BcXtpvuu0h1t4glto/6C3MlzLYV1LrIlT3QRi84pFxMSAbjuNvQ7KEEa3f5Vhtsud",
+	"bDYw72pbTdVmNxuNk16Gp8ZhmmbgngeTzGGZZHqEewZRnqFA64OLzRm6mND+pQ4vyKyENMumL+mKRom5",
+	"FvZ3oVxgNidBeW3F0T3RHtQu5WOwNVzpc7t0XVRA/UnL1+LaLuoy/0FL43/gSzIjSVRXdmHvkWBPhKqO",
+	"6oUp9jajNaGE7gDO5szYVDizcLulx+yKmmxtWLogOb8DZlsHijU3aHwycn5HGpdkUvhTqb295y7nd5lS",
+	"ta5ee2MTdvbYHsCTts0/9c9IEFlwJttmrm7XiRjOfU0ZzrqyEzo3IwOiN2TGLSw5/OpIBWAMFc1f9VGZ",
+	"fubeE1LzLCuThJBUuiOlJpob9KBgQa1MoPq7JGprf/KO3EwxHIt6srVa1a2zEQyASGbNUu12nWrSneLM",
+	"qFQR3iqkuT7hvxbLvvONohrc3QzAwSWMo45y1QQxgK+CZq094kyHQ6/KWuCBC+Kmm+VVwP5hlVEr9dV6",
+	"fbJm3vpxNC+0d/e8+EIvdpOgx2rYDYIOL4NuZt/rIg3D7cU201pJryO/6E61HTn3UDTr0F9F7EhF+Z5m",
+	"GQ2PE9K6hoXgRyejEnwitHGSyttLmyG2Xw+w8r1ZKtJ7mhZ9DJpNwL5VpRt/7fenswUGBqff4V69Pa0F",
+	"gpUlrLrvGJhV1ZnOmFSYgesxzjKbKXwVYrT7vsGS/IuqhSEqkRzivgPk5tHcJnj/jyLK3vGoFFm0cr+L",
+	"n49u4k3UtLl+/kdxFaAS5e2ZN/IBcHp7byHM87alL4QUeUuLCS9AJTMxjxYifE54faYnv60Uh/oO9rEX",
+	"UNUAY0cAM+nq+9SLeg0l2Vw1FthYvZCby1cOgv7b7y/hM4BEr3Is2m35jpL7o3subimbT3SBiQmchTwy",
+	"YHH0p5TJSYZvSGYwWI7Gj3T0W2Bcj8uDzKpV5on9UIfxpt3P37/vuUNbx/9xSIteRoubaHxs/YgL+ney",
+	"3BeijWspn7bGfEnE9v37MKfz9+/bh6ZNnaOetOJDke4N3B4VzOCJXAOz6IbkRk5C7f4xhuChtUrrvD4r",
+	"TH+ZNxw1MnulAYkyL65wttEMEQ8Ur2Axg43bW4lJM/5Umvnzou+PrR3Ha6NHjufR8th1nfmK84vkphuP",
+	"ko0PcSMQjl/DKihuDb9WIvJd/1FyUGTWEdbWC6q8tYIacUQGzq5tVWVdyVf5cDVKEBGN90msBlEAXg0q",
+	"YqsPVrUyYv6qvuTScczp3FY4augzNJaYakU2Rjo2buWp9/IvceWFqxQUGxy+9hv/L19+E5ugIKJncln3",
+	"woHLXVUgGhYXZJrssfsr2i/rYR3GPjjNTlv27KaFvzjo7IUvfrulm6tXN3dasMJV1ADG/anfXrfD+ar/",
+	"SrStr7l1rTsg7Ep87MSnFdjQpZ9aT4j12H6kqt8aAnzeTKPcsLTgUhJpawFDRtZIsAVnCCPpBllhb4mU",
+	"a9QTdM+fCM50ZTtBZJhJ2USpKg7pZTuThnkkPEavjtHn6HP0cvJVh79umW+/CujeZxl/XbWKylTeO5W1",
+	"dSC2eRx/5TEP2bPX37+GpervZpXuqoC/EG3GhHIWbIreBiVKP1yd1jbwrtQXe/SGiIyynSwzsV3E8LLM",
+	"VM14hME0Zo0RSxu7fE+E31PcsNROgPLa2xa9nkMD08hBQ9S5uero8pbuHskKuwwXYi0hUJKry9M6xkPO",
+	"BbGhOpsYc7CPzVlhyRFVJhBbFeUPaMDxAVa4OgWsnGmlEGTiWtSNLu5XVwTmMYw9G8/eQQh10/Xnbxvq",
+	"ArdzwogwR+HyckqiKuBoA85ebE5A8Xip3lNWqpi29Ft+jzJuXTLsiWhfCw37oR0zWCC+wSzlLFyiZ97b",
+	"G7laS/2pB+raNNGRfdniwbKeG8tmYYv7Q1S7XRqKb7ecrkDtbHnNXLa3CIZfxSGOSmSpvgtCqeLRFtiU",
+	"3HXLj6Rk75E0JJIUzInjisPV1s6ET9GbpSu7PW4mig9H8SwRfr9mMdu1CQnkIjaOO1N/FCZsyG3ajX4d",
+	"L7Lc5ZFypTeEPT530T64XliB0RrFvTRiGGOzZ/zleNyJPhzdY6q8m36VdsSCQw9kspXwRycvv/zyeHWd",
+	"302ECEETcuXMi80QKpoQe2GK+yx71gsG7CjtFKjX7LJ+q9HCBYUeO0UFER4IEm1dtT4PcKb1Tx549Cm2",
+	"B60KRdcHXIck39A377WipWP/rhjCN/SN/mcDYfT6jZqmxoR4eWNk+IhiwNoS4R3zLS/Fmmm1ikBPstBN",
+	"N58jUGx5OB19uHzbrU74hr7psSx7GtBlhwWGhvXwIrrckzccfv0OWhdZVbmugdFoXTyqu87mOXbsMYaL",
+	"dSVK5xPb6rW20HPBy7m/Wmmtgih4WG+hrmmmiogE5YM4bSni2FycqYJOCqzHHSOtbc041pGVb3wJvDHC",
+	"RZEtKZt/x+dy7BISmg5QTpeLSoKrVh4dLLZvu/Ir/j25d7kivf2jwX5i3EZxZ/MybpaOO1VXOqMkS+FK",
+	"E17QMLOpBYJI3Ziz2aoGKOUEwlih6A5my9wcalKUY4fJWklhL9VUDAZJB6DcfIwyzwVE+FM2z4grC69f",
+	"lpbkXLMuUGzXceczQD7ru24Hs+kxr0cvr0f67q5HXx0f59ejuCDgguO6POZ9FquKFVeg9ZCMIRrObLfg",
+	"Us0Fkb9kU/SDDpgrZSWXbXbQ/vhgxqqVIAkXkMSeVOvr3lqn27vd3V29YlYsy4J5XlqH9ht/FFILhjn+",
+	"Xy78GFiu3Knekt4Cz1IDgd4h97FPyK4vLgp26cXfe434KhB79U0crthaYTp20l0jeQ10ezjzafXthQqm",
+	"+guhBtVdeezeRhN67CfRHDqbIQ5UbBxeJpWVeI8lojL+dnYpIX5rZ4ddJfGujZkfjwKyFkuzYj76ZAYR",
+	"ADE4k2DmUlGbpB3mbYdZ7DHTAfujXhlqY+JBvepRRInhatPb52FQvclripx6rFpMqIZnnMV1di2Xytbs",
+	"2oHthmZUUWL1+03xKeKqBVky3j0UmHUU/zMNZNP9xwzpIsiI7p5qaJO8eQ/tV0Ip4XFnes8Fv4++8rys",
+	"HFO396sZw2wZFDvSOL7j2D2DP0Uta2fgXBEVjGc4k60kMI1qcD5WIXIZSUKktL4vrcvfn1dd/fG0kUPd",
+	"TZncVtUN2yJWkvHSiy4IWh9VqbbtbcQKRK/M1SPIvOsT1FDsOjTrsddDCu5m53dEEKk8L466ZuuK1ac8",
+	"z6naxcuoEFwvJ67Z7T/MXVcQ4wb+SiEOhcuqRh+Hm44hEOUmLgsXNMfJQt//clrczvUPcpoThad3L6ca",
+	"ZN+T2DvLfUHw8w2pyiZB+KJcMrUgiiaBm4KpZrnAd2SMKEuy0qTkyahUYPi7w4LyUvokPWatUqt+3RBG",
+	"r6oHgMQMVhz9Dcpi6eWMkVvYx1hmUKYoK0lHsQZWwvg3hjk46cx4W+q/MTwhkS27WXk7GPxEgqhSME1h",
+	"9VaqchfmMIDhiDv7DDBynDkq78cMEgTE+VGJeIF/KYkPh7whXsFEpTQfIMeEtSM7EScI5cMKZkyBqmQU",
+	"WgmiBCU2vIiRB2X2xmfVSqpzP4VT0ZeEUcKZSwZkxtLLsky+4FJS3dMJtLDTWk5fs2+IyDKKzRwUeZgh",
+	"jGbkHuWgK4TLLbCUgdLYXL2LVTWPPn/aUH0f+JXZp79JOMp7mmV6iVBsPsGZOyn4bF1kofKfi3HSeRQz",
+	"IiVa8hLWI0hCqD9KxbX+G96kDBETH2WtJtO4vJZjyrTrmyL5abzUYbuNz8ns4UyWN1JfN1MW5OzqzXXc",
+	"L2iy8NoNwC5XPt9dv9ugET99TwdCjg+kyDj1mte/OWtJMpOtWhpRtQn9fuVuUdoGd8v4PfMvFRjGXUVG",
+	"ZgqVzKAUS50YjNJSnxeSRFCc0V+xDXoLFkqhfpkJD/yMUAP/NyQxlnmqqkKvJdMuy4hXX80R2PO0oYEl",
+	"u31R7cdWo2Ec4LK5J9gIlbvsxEXhmlcgQP7dy+nLr1DKzbr1KNUcAPuUKaKlNiMcePV/DFI+t3pnyuaf",
+	"m2ZORteIm2UuhvDURPf6MG14NhpC2jW24o4ecmH/IA84UdN+yUQb2Bt7UwjAXawsks4okQEZ+bMMgsTD",
+	"RzuV9XB5zDyZvFnaOGYJEaVQQ4QAsYBOltJYijRF/zT0wDCoG4KUs7l6ShwMaVQehkKhkuU81SsGa4wj",
+	"LrDyKTrnRQkFmKwHgTSpznRMLk4nmoU9esy0dum3+u2JGYJnE8zSiSfnyTJeLTSbfUfZbcwqCF8gPv3D",
+	"xXfNsHR/L732f82u2dt35xfvTl9fvXuLgkKWBsuk4gXSXBzPcTU+oCFl6OX01bGGYIIlaZAbKlGRYcaA",
+	"a94QG1brur103ab91LC9xCXwLznVNKcr06v5qHd0R1NiJYEwW4gp1an5Ci6oHQ/ZRK+h0JRgSSTAc15m",
+	"ihYZAU5kPW2YqWRKtOm1LQ3r84k/EMynpnsp4Jfh31Bk29yBmW2sMcTkONU3TJVE//fyh++bpO89Xtql",
+	"E5Ry5XWGM/qgSZD1FTC5To1LHFYA6UTLfvptA5v6lQg+oSwlDxph0deg/dVyCC4KgkOZgkNIiDlHPYDe",
+	"UgIeZmlpdDdWd7zAd/o4G2c4RT9Y0dvA5zvQfcuTa4bQtXm0Xo/QJAA2/6MlpC7tsjtC6GiYyY/HP017",
+	"jAAiCSyeMCX0Cboh4qq3TseN12ihK21OfKXN4LO7a+CT9g9zCFOEripcs0KoRXRDGSdGFDKGB5xGE6Z0",
+	"pyt4jSwWbbyoM0v6vaRsUs9aHm5EgDo6efl672j+lihMM/k/d6+6cN22AErpxGyvmkAVVgKGvX/9X47X",
+	"3iwDPqJP2RKMsHuEagQSnsZmm1LAIzVGl+HLyqd9udezV0jn5RtJVCUyGNZIjcebQx6zaiu+5FglCxv4",
+	"DZFpzn5gtIR+dHgeWfkDau/DOJgtq1YO3szlarp3hzOajhEXqGRpFf4WeeMZLI9TN0N7pUUqS5DcY8xe",
+	"FZaSJ9SwLG0qhxyf5tDcYQItnqLvNSHLstpXoEburmBMklrKM+2bE3tjVhPRBM0FL4v4KZhPwVE3qX3s",
+	"COyLPNzrtH8mTj2r/rKHSdEPDEmeu2ze1J05ZM2t7H+Vf7WfQifV+dQpalinak5/2f180Gf31YuGBvZG",
+	"Mzy8EV1OMau3SV90UG4llq9niojOMgRnM5Pi04i/48pPmTIkoUvor+PvK7CogS4inaJLnlsC77IUgfYk",
+	"zEhk6I/xu9JMPTMvAkWcn+7Eeg1x6QdSde7lx1w0/Y3cKvGty6vUHH7arw5fSSPA/+HsbfM2p53X5O+7",
+	"66qa8BuP4y0lEZN5SVNy5N9UQv6ppDGo3JENruB/sDVQ1ViGrW8pwVnmmQf7s3ItQKPltE9DLrPHzmWW",
+	"2EKYjasr53OgnN9eXZ27u9FtLYpRp6Ado2Ot8bPKi544YhntHnlgIIcNCdX2nFBthxdFmGmfyor+T9el",
+	"btsZLLzRYqcHyP1i2Vi5DTXQm7sefQ1y4PXIbnSHlwl67ST1JMMC9F+YAfrZUzTod1OqyttDOx4ImhJE",
+	"1XR1jM+qejPVraAfjC1F+yxclsbS6ZyI/E4fHRxlQRKjnLKL75OBUzOrqK39T+h1qRag9VfGC+Z1loXo",
+	"h5zp8PX5mXPfQj/rTlxY1cUJekOwIAJdl8fHXyRG8W/+SX5GC/PqBWkMI/M+sZYByrTmSZc4JA/KKBBM",
+	"KRXzzXJ0fmNV7TdLa7z4mcBqEpXZpoJIon62koD5A5gafDU6FEGZkoh6849MBCEMnHkVVcZd75yIhDPs",
+	"dwuoFFgKT0Yvp8fTY5tnleGCjk5GX0yPp69sqUkDRUdglp5Y47H5bU5Ut5Xb0D6rRq2btPXFesA7S22f",
+	"milfQnSUecuaqV4dHzsLHgH7iXZetFd79L8Wx+3eegbpw0x6boCjJh80WDArswpL9Bl9uceVQMq9yOQf",
+	"mOyY/qunmP7MSTJWAUFsw/FIlnmOTTmbfves8Fy2ypiaHCQFj2XGhawsCBuXrvpwTj7TCPX5504n9/nn",
+	"Riv3888/6//8pv+n0tFpaia/cDB7PRq7z5qKuM/Bz5X/BHyEv18GLbwTCDSAP//nliyDNt7nwc5g/my0",
+	"AZcJaEDKSUKYEjibvLwe6RYf/ZZW7w3/WgqycnumxYodeuePFZu04/8PToxS+X9g/s7tNlpX+6521SIA",
+	"cO01xBz5CjtvOBRa3wvMR2ayfkMRPLhakDgQWpNCFWdWOWRYL4+noV4D4dqccK0nMSvo1sdxixMe/aYR",
+	"4iPQsoxEKxVXWX69xqTt51VHCejTRInAP+3kx+Y03TFrIy0lmSh7E4Rjkxq5Mv812B0Hd9AUv35qwfWX",
+	"sQfkAH+r4K8fMHQzzqjU9Q1Rm4HXN0QdOmwNNPNgYLYHeK2Q9LBKooXyofyiTTjGZytnmCLw+LUlmepN",
+	"wR41bQF5xEn4MOB8/3JNtz90P7nGHIq08Tux0/VWQaeqGqSe54TBm2HbVhLQkZ5ihhO1RjkQxs3PeMlS",
+	"p1WDx8l6SqDB90EJbH//7Pz/nb4Yo/M379Fn55fv3755AdqRuQYaHYSIPjuHCLXLf3z3AmV4yUsbCVpp",
+	"5KfojV2SC5FupMeDz6bXLMPzufUOE8UCQ+h5l0rjtT+V3z+LdXt9ZkqVL4+/fPzpG5EmjCuA/sPT6oQI",
+	"StlKZNyRUBzRvODCbHilPiiOi86T09d1NI7Kfo0yjkw2AmrpdLE+4I4LlGRce5WAxhbWFgyHBXgih0b4",
+	"+lRUdEZzgkuaCpOf2LP18UARxciZWcNBEpL9yzD1bcLWV4svRo9vYejpxZF11C4GRAO1OyBqByBWCSM+",
+	"e8BO1O6OaCNe4qskrX6wN5IshZ2rVCrSeAQSqRy1kt0P+n8GI/jkTo+OC9FZBzl965e2BbwaOMjqNh0Y",
+	"pjHGCK/uMgJ1l/uGuvDZ2Ql4j8Up+sLc1frzfGrWMaDLftDlcj/ooqm3FdQmzp1gJdm2jcHJOcgHA1H3",
+	"Lrq/XcIoRrbjdageEQTjEw7QtzWx3gEaHGTe/lU6OORSTVwauW5Vyrsw0ZxNgu8TznVnlM6yMP9Ajhme",
+	"gxuK9Q+JKjKiqe8fVajoTte/EZg+gaALCSppQpAybmQuDNUGP5PDkngfD2ocIOvBopB85IaeJFW6/Pir",
+	"f+UqsQmc4VJH2nYkqKw9s1sQ7UaP1L94JHGlMVMXGEWy4DydXLKyGsigyfudovwKZIrjdBOJe+j6tyMp",
+	"U3DYnNHMdMGCIJcPxbDYhOc3lLnIE8htDs2kVbgsqwlMD/3XNKJj0wt9nWVvm7V41mjZtIf2hDJJmKSK",
+	"6owaOpuG4kgSLJJFTbs3tjkVbskS4sfhT4gC6CS9Tlv3S0lMpQWrroPxR6sUdOPmYo1ZL1t5IzVVZMfU",
+	"4ff9z15P7BebH1rUJq8SUxYPyWi878VU+XRi66m+7vE0nMrdOUFHYcB9jB2Eyd65j6NweVVIM/3w276J",
+	"hZEvm2GTFXNGOrcU5Kbb64HqtUXSbwtkojQm2lOb5sSl81sG245rzxsLv2n4F+xx5bbCZpVAoaPkZmRV",
+	"ebvi2qeyDTYo6+BxvaNtbmcZvaEGsay9gpiJg63N3PwjwBn39Y9UE3zMx2RX8cIBBvfi9d9x7Q7Y8shl",
+	"dwcAvI4NVwXhmdeQRD9r8vVzlZxoes10LurUZc9w30EyLEhiBLRbsgReUM9MxghJZW2syzJZICzHOrzR",
+	"DHWCijz/2eaL+ln/2wwW9rRR/6mLCKrNMe30eX8fI9OP8QZdUwG345nzvvsyPp0LfOTMBlTezQ++G+nW",
+	"YnIX69jWL/59VMSJOcdHcae3Z8QKUeoP7Cb/JAqUGFU5TA+BDSB0Hb/r6bef9wD/b4jaDfbfPyHsD3R/",
+	"QKw+EQX5VljVEVwAfglbcBboeNCc5Slkw1q5+g7ZMF8nG36SSIGBSPx+iMQGWLxeRmW11Pyd3HhHC/nT",
+	"WMU3U1+0CO/6PTZO7Og3/++Pzs1REAW5O3pK+E4DDN2R744KntFk2TJBVBEZnaZpp3Tm934ULPQ7v9DQ",
+	"LHRKjY6ngz9JmOPC72UDMt8ylrSJu/s8hNh+Kql9U6gLSEn121rxvWt0s3/IQNzP7hYB6Zj0/4zAd9+e",
+	"k36v53A6g+Zna9l7b7ix0tf4SXEDJIbDRo/HcofugRlX3ffxCZygB1Tem//znlB5vdQnFVZyrXu0t5tj",
+	"RaWiiUFmYozr7WrXjSg4l8efCiR4lk1Mhb51HPDSLOtTo3fLvv+9L5OS6iz21j2R8fta7CAUMC1NXnV+",
+	"1yxd+MVxh4VfD1mz6fvi11/85as1ta9/eopXSng1A27vGgpUR6bVrkP+sRxD+Lj1vwPtaz68e3ZG715q",
+	"N7rX/FJ/z9JufMeDKusP53y/HqED99wOLG567U4s61kbeI8jPvauam7MnSEaqP97Fbvjm+3p3O/I+if3",
+	"pui9iy5C8+r45dMvBsAtRZb8wDpePf06Xtuaz4PgEvEs6aYdfQIyN6Rl2/qbrKFr0Ocw6dp41Ywdh2+y",
+	"zmtaA6mXoJzOe5t//UeXXfYnN8qqzCvTZ+AzsGEli+GZsh8XmY0RvkPBfmHKT8jNUPYbogZ8fab4urM0",
+	"MqAloGVPzHk8RnyU8IKSHvGB0K4z7R91lYH6VBGIgs8pLOQ5Yv+BYGyvunX+sJftinSD2iKSHOvAUwCu",
+	"xst+SYiiuoVLhYXSwy99wTWX2q6TCijua97H0oHqwZAp7DWn0tS9RVha3/aul3etxprLAtjWa/Bi+fuR",
+	"Jp5DAkB94hvlLlYc7j9QliveQ4fy6hEW3rVkB6BSwz5J/8iU7svjvz2NZtgJEBLhzMREA0kzmT5viKY9",
+	"9m/rglAHq8NSqTj47k8YH1e4s/RyoviEkfv1KV1q0UZt4sS5kkrgoiBpdxrGsc8AkS1dtDbcHoZQbmP/",
+	"ornlCV2ZWKlEGZkpVDLFSx0OPr1mZ5btdHTxZdfIg+aOmC1zLsgYkel8arPNgM7JwJaqwZ7LAAqJKqLb",
+	"D3LCNivY+qr2QXIEs3eRVuGOMFkkxvACbinKv6749+T+1Of6+MPystbU54InhKTaX4EhCkv4eyt3VwUT",
+	"C3ynr4eX80VV1c9XkdQ17NG/sGCa3tiicUZQ0c8zkwRYKoJTW4MUcD9u759xEQ/iv+E8I5g9Hl+2YBRC",
+	"zGoGHYPyHgz55afOuBRg4h/JhFoRq0/DqFvXwAWiylyFKV+Ms4qFGwp8YJoWgx1d2gvDnjoQ4hEZ9EZZ",
+	"iCF9kqO3GZaqlRI2urubJcK1DLG9dbFhAtRBM/NUqWYHRUwv4gf0B91jiZj2WrTIQA4zhLIPpu6srek7",
+	"EYje1Zn66gqGDCqihWG8wg1UYpbe8IfQ69uoaTRjXpDkVit2WOqlbTxTRNxjkbbVwQbuB9XNeoLz6okJ",
+	"zlUTlAa1yCdWi4Ay5CCJHGDxtjRtE/nJ57jfwifO9p2iDywjEoxuhSBuzODIUyr187Bd/GWMsGsGdOCa",
+	"xV4nCuvq3zMqAtWAUxXoG7XuSECG3fTtmjN6prkAXscSAvPZ6Q1mkFSOqyo3rF1RhjIjotuy5dMCyoxP",
+	"E54fBTu3UinCjHFlgGds6+9cM7c6r4G/I6K19HtMlQbOWUuvYvmKVYE0z8pMU8sDfM0Ms0DYjK15Gp/5",
+	"quiKc5i3EHwu9BUa3lX4VKIdN9pBsnXy0taPFSfTeA+YF3JIQecLhfA9XgbZFCNz6mvHTqVwzdo6hc5k",
+	"Uw1meOFl9j+Ce6bbbd83qDv1Q3PQXLGPT+ChuWI1TRfN8QhA1RyQBd82ke1Sy3icCTDbo84qfFmZ9vLj",
+	"nv1GzwWxJ7LaGzjOHKwUFBD2Fv12ZcQckT4IiemJBBd3HDVVkEnkijegzUYnzzyhfyaes5V+pUPmcYi4",
+	"ndCzq/Nsl/on6j17KHxns1eYl/F28ce7qDGVwYF28NTrjVhr8X4rF9r+etsBa5+vG+0WMuOAnX38aDdC",
+	"z2iajAtSZDjZlK9CnosBQ58AQ5/Hw9Tm2hsepps/TGdlNhC8kOD1I0iP+Q458q+zdZbrYoFlzEm5E2tc",
+	"3aDQ0wq+QE2VcZxKhR/JnT5t/0rPeFVIdWNx6txt9FkS7WcrCfljHwzi9Ud+F9ocdhZhr7XvTQf2TMA2",
+	"K5QSq/S1NpZJ/q7TnQw1gh6tPksM2rpzAY03NX72szUdHn8b3Hz35TR7aBayA3mB9Ht6ZMuehjH7QSJ8",
+	"o01dcaO3CUgAU1nC85yw1LheFTzjcwpF70om8YwgzkjlLoBuSIJLaTBQ884pzjJ+/8G0PA0LGT2pMa3H",
+	"eR5Q+pUvj794/Ol9VjD0S8kVRuRB07AD89lZxSq2SkLXkraO9ONtokheZDYt3aYeO3oA65Coh5hesyhp",
+	"a4XjUIlyIubW8YR7K6MbyKCPrSD5fy9/+B5aI1OmAkmSY6ZoIsfXTHJT+FEiCcXG2gUqRfAQN9jamGp6",
+	"za7Z55+/g7qfn39+cs0Q+vnnn/V/ftP/g9D1yDX+3qjPTtD1SOY4yyb5Uv6SXY/Grl3jPnRTO4b+mls9",
+	"HPw8Yn4wM8zk5fXo47hqrY/AtoSoJfuH3hBNsNR/fvHxI3Qw//nol95Pmvha8PzK3f4gWTw3ySK8vtWB",
+	"RB6tnLJFx/wJmnZXTd5YDPn0/H/gXU/k7e+A6en9blt6lboPyzNxQmkwzf2xc4iR3YGP35QszQgiD9Zj",
+	"0/JtCt5BNj7aOXtqiomzDCpzQrwCdA8caBFlwL+5QP/1+v130xZjOjNrHp65z50ZvTF3b3AgHHOJ82z3",
+	"MaNMzUNpO9ID+g1M65kwrU/BOSKxsZIkgqhD5ilALB/1SbilB+NadWbUhfF5met2863Ys1PFRiuH3D5s",
+	"RgU8w83VGrZ9YSd0PM190bYgRZIwRjFt24BMX+mGdqqtGJ+yTb6HbW6wrSt8S5oxPRGKDyUhYPW6pJ1y",
+	"8S+tmJ4wQMQ9h2LOzS42sjvUBzUifdqhLxXnt6PMqMbwNVEvjdhPU4cNgm7CG+oSCPQMbzpd9iuxYCUA",
+	"tVND6Yip9hYUr1fZ0A0wlIlvDNGx2fW7uIQB1kLO8/Te3aei9+vg6jtkptrlRUIlViHCQcZMfApj/idL",
+	"8NGHeoQBqY34jC9fffFpTsvyEveg9BTs4N3HNxKzetcmXysrtd2cBkHpOXhFDa4W+6hgviHSbeAOvhbx",
+	"ov7gA+4NSeEO3FvkANzWe9O+35ObxuArcdDe9o+tJatquPtN91Sc+QKjvmOjxGj3JkA9YJSTPveu9AXb",
+	"Nym8cxEse+Bx+5Mvh1Lzu7y+dkCNXZ5ndo4wnb7ONumKy66dW3PifgU2unI5Duj4PDJBBvf07CpufxqF",
+	"2WFHvTwewVlZ0P/RCE5oP9C2mbwA/a21kQRzU4kIgzxq2AYnQSr/fg/jgWwdfIz0BhTrahUqPOnLciCz",
+	"vycye/nIZHand5s+my1ebbbbhm+2FAylLQqIzM0QuSoN78pnnNvFQH6HR9yBPeI2xZRdnnBdk1KGyGxG",
+	"EuXTn67C1LMOu+oCS8Q44vduXJviYA1Sb/D8G9D42ZVUrS5tEE5+F2/APdOrlS/AHcSJM2UcsiQqBElI",
+	"SlgCMT0rSdJmr7qBGh34m85eUM8XXR3GPqWlcKCcv8tn3V4p5z4edUeFIHeU3HfmlLlc8HubTX4DrduC",
+	"tOVKeDtCLqv7BaS5N7q5yjBnnYMrxdsdzkqsAl8IqsLKBi4/t03r76q2WCWeUduBo3OLqp/Dtgey/owt",
+	"DI62WwgeSORzJJH29g6TTPoECWvJZLgNRh4UEiXUhzXkktwRsWxmXehBRylDH65ODcW0sRFWViKpGfxX",
+	"zshGpO3SbWggbXsMKirzGz3LzN+8CfMADzujToH7dxdfjwb5qjMYqGSq5lyV4weal/no5OXx8XiUU2b/",
+	"GrslUqbInIjYGs9ef//agAzSMKPnlZqxh+AqEWX1pX24Ou1YXAB81foIZAcZnYzelYIX5OgNERllo/En",
+	"4A4O0Afm8HthDhWYNsKvfBKbT8UmdkvIiNwgPfIyvvFNB+L9TJSfQ3bJx8suGaDOHqvzNbH7SCqs1meH",
+	"Nvxarzg4Wi24zTDNfB1ByOpMBUqt9zQ8hyX9VdOvO1MyznDpe8pSfj/2MYc3S0V8HTrKGhKlDR8tZVVA",
+	"bov4KRdKitVAYR5FPEzxUjrlBOPhmwdUIAaESGoAoS6JfXHcIYjpIeNC4hd/+WqNkPgEUpiBpUH2+h1Y",
+	"faTCikpFk08hZwUJSNbS4RXP6XCY9eTwtNZ6IIcHL3BVFzYIXI8RY9rAn/2iuIt3n1Q5VdaieiwPy979",
+	"WCRROoZob44sb+2iz6t9DtTlGVCXyL0Ngs1zFmxWZHF6HFeWrSb0+ZJcjwSzPysoYp7zO5JC6sl7vBzr",
+	"958ZrWS2PcKOKJoY736+LQOBegZl3XoRo6s40H1Kv5aBiv7OXFv2T0X3JD0eeSrYnev3wpDQXYmzI7IS",
+	"4TKlLlOYT/yHkSBYuvS/sWpvS1mR7Ebej0rCdKKnbSdjXi0f3ChDdpZnR8ANLMafrpCkUcMQ2JU90A40",
+	"faDpe00Qshs53DtZh+qXa/UAiuYko8wTlCBZEoywfuljk+jYG2lcjdAxKngKNpqCCEmlviF0x7My110x",
+	"zfs8+d/BNgYi/Aye+eaunpnRdqBh7dd9X8TfP816cPUlojTrnfnslImU0X6kFWFZlZ5QC+x9nqGchTEK",
+	"K96rDEWLYMGSBpHx0SzAX3ORY680hkus23cNgnRm3ctx3RGQMG3S/XFke5nyET+N16/jjCVZmRLnU9HM",
+	"6d+ZLJcF6+5YJYWh6zazdYkCn8gP50mLbgws4uBZRECCn5AvmLzUE5Aw10q09fzx+JawMDONF857KChe",
+	"Owek2pBcVIOYZKCWiSyIICvznEfT57Ul3q9rCfafESN5BgLrmgz2l5+SAlx1gI0nBK6kjIe+e6oWCNeh",
+	"8x5LxMgdEVW4w0HKmLFU809IURYEZ2qxlpZAs17RJpqHuzxb0lRq0N30fe3jGfwtrHcQLJ/BM9je1SDg",
+	"POc3cF/M3ztlyvh8vdZON3JrM+Slp7nF9ZOaQ+AM6fPGlLk44rzMFC0y8uDexJwRJJUgOAdmA67TRl9Y",
+	"CDKjD5XTdMHBduOHNBRo2oO2fad3PFC2vT2ZLyB4rgknFWzoq+IsW7oFNN6jBU9H+52wgokV0/pGoy1d",
+	"xDVYyqoUOGGpW4lbFYBvtRofadixJIVp9p0etbYkq1Uw7uB/+XIUeIof9wknbJ4WI/d6KQvMGqfG/M4k",
+	"SThLZccqJWUJufRN+iz05TYLdfRGB5bxUmZLpIjIKTPxJRUl6YIq223DomF/J6SwMSKMOWNKQRjEfgBp",
+	"0mlNMz4HAOhUBekc/LsqVhR5UEdFhmmDHbVy9w+c/9ly/jgJe3S+X+BSkm53i3PsPNTW8Xi4YC6QTHBG",
+	"ZFwdkWr33PsFzQi6JaSATB/SRUO1ubaZflBzDzWjBmr0ROHbPfB9/zSIKrH27XHOKVMTyiZXNCdIkMzH",
+	"l/YKGwCHnETH6UGtJczmxMXw5UUZlIUn6IYyQ44/O/9/py/GiBeazSeLkt3q3y7fv33zwggC/3r9HZJk",
+	"nhu75WfnXKq5IJf/+O5FEPbZLjva421yTtVA554FnTM3NcQubS327ITW+6dE/J4In0OoZ/ps02mDHEH9",
+	"kmCf61FdTpSBFgwpsA8kBfYW0L5D8aIdMSvCWQe0OngWW7+j4SFRf5HXceKwlRp7JhZrCg/tSCyioXUD",
+	"vTjooIy1pOKqEzIi8PB08RgDifv9xNPtlcht9WoRZGLDH/p6q2VYEami7mrYhVJs5KsWrCHwWHO/JILg",
+	"qOdaH4lNkAsYZvBOexxa1DzgZ+qiVnNCswo466vWhs7DFJciSPTk1MQuYMcUmX6UHjkyL6q2g3h18BpP",
+	"e1tDlsxHzJIZYE8Hcttb2B7Hy5ysirLX35v+O9hkq1z7joLOg9V2sNoOL5GnigKPoOveBQXC5pT1kAvw",
+	"HaaZMbL6Jbiuq4SBd77NpyUUT4FusNeBhe7OQlcCWxPe4dg3A3f4+HGbJKYwwqon7jvX4jnwRr+d58LU",
+	"7OkOGLbPzKIeCjqRq0NPD+r1DXGlrpP/g6PLI+Q0WospUd0LyLdIcS0il+aK0k+SzmjA8G0xvCc2bsVB",
+	"95QX2JwMSZFJktGGQtnCcaPt46UKkwDHuO/3rsMB5s18VL74nLN+HWAyWsjutyLvVggCDpOq37bKPrsX",
+	"pJiifxFt8nGBw8H461IidnDog0epP3RC1wHv95o+dWe8X8E8t7DlWqRuVFmT1qrboeGMEIoqXcnS+MU7",
+	"I9pKFnpw1tmntJRe2pMf0GmvNs8mQO/IRp8cPxos8TBR5BF8sTbAjqvVN/+0vlgDVu/fJ2p3rF7BJH8p",
+	"ucI9ozNM27YbRTh7LBrDY+8/zFyHx9WGKIZdohh6QEWc06yUxGBUl4wtKYUgTKFS4jnZBAJD+epQwW9/",
+	"V1rf6gd9WAPl3V6e2hoGt5Cs1mHR9Jpd+WZUIsJmXCTaLW9BWETkwqLyieHCaZan6IecKv1bRnOqoBnj",
+	"yg83vV6rljggNNq/4NXYZYe4Vbus7vV/fDJUH7B8e/lqS/6lZapC0IRMlLaZr1UtmLbItIVSxYojIhXN",
+	"ne0g4WCKb+FyjKmd69GuzMSPKs77WQ7RkTk8UuvDnHA2o/NSHGjOzJ2AwEGhbtMjhmt/8AYMoAFyj/Ho",
+	"XQVtjQt/4mftdngwENrlviCyAfya+hrCvT7TIjTrFuNwllXEXqIcMzyHpIi2fkDU1a7Of+XoaaX6Td3d",
+	"DlO03vFSuriyIJKXIiHrQSPBBU6oWpp1VO5vfgCzEnRbFdRZERxfld2p3MrtMh4RNlbMOlCqraFzB7hw",
+	"QHn7V2nBUZG8MDGCvaKAWg5CVfce4T9XQeOV7zObGVIn8zTT+lk05vH7Kt9S6/HWyMoYfj8Iv3t3BINH",
+	"8O4ewSuBscMD3p0/SKjRkJhTQbAiCHeP34J16NJx1aPH9ehrztbXtc9txjr3WW1MD8H15afYApxwiioA",
+	"zZYHEZvytyd4TLqbwpkgOF0i8kClkgeFl72QZj1O1jhS4JDfw/yzonhCJ95G83EFeNtbiRjM8EdPZfU0",
+	"+hWHEocZprUxWPbhVpsGpayF/nYGhoME/eNPwW8G5FKHnnJqf5gV1VRekCLDyfa8JZpk6lAQ7ODF0U8Z",
+	"azKQh+dMHjbH235i6R0Rcl2Aiyvpqt3LCEuR7YMom/EWgfgnfDyDb48G1Xaa/lDcIrYrd2WGhesAQlaK",
+	"bHQyOrp7Ofr4kz/bVqVdXShFLXRYgssEbOMcgvrgp5V+3RI7rbb6OO4/2DodbUtLtMngPmVAe51pM9nC",
+	"NsNWYfKNUeHDTmtFQSae+Jptg91mATfL7kneuAxNO8wRKhXjs1SEfIN53jQzuduxwcfx0v68yYjGfmQt",
+	"SkEIwQow0j1GH3/6+P8PAD5qaCpgegIA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"io/fs"
 	"net/http"
+	"sync"
 
 	"github.com/labstack/echo/v4"
 	echomiddleware "github.com/labstack/echo/v4/middleware"
@@ -44,6 +45,9 @@ type EverestServer struct {
 	l          *zap.SugaredLogger
 	echo       *echo.Echo
 	kubeClient *kubernetes.Kubernetes
+
+	// preRestoreBackups holds the pre-restore backups run by this process.
+	preRestoreBackups sync.Map
 }
 
 type authValidator interface {
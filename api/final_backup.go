@@ -0,0 +1,323 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// finalBackupAnnotation marks the backups taken before the deletion of a database cluster.
+	// Such backups are kept after the database cluster is deleted.
+	finalBackupAnnotation = "everest.percona.com/final-backup"
+	// deletionFinalBackupAnnotation holds the final backup of a database cluster being deleted.
+	deletionFinalBackupAnnotation = "everest.percona.com/deletion-final-backup"
+
+	finalBackupParam            = "finalBackup"
+	finalBackupStorageNameParam = "finalBackupStorageName"
+
+	finalBackupPollInterval = 10 * time.Second
+	finalBackupTimeout      = 2 * time.Hour
+	finalBackupSaveTimeout  = time.Minute
+	// backupNameTimeLayout is the layout of the time in the names of the backups taken by Everest.
+	backupNameTimeLayout = "20060102150405"
+)
+
+var (
+	errFinalBackupStorage = fmt.Errorf("the database cluster has no active backup storage. Set the %s parameter", finalBackupStorageNameParam)
+	errInvalidFinalBackup = errors.New("invalid final backup")
+	errFinalBackupRunning = errors.New("the final backup of the database cluster is already in progress")
+	errFinalBackupFailed  = errors.New("final backup failed, the database cluster was not deleted")
+)
+
+// GetDatabaseClusterFinalBackup returns the final backup of the specified database cluster being deleted.
+func (e *EverestServer) GetDatabaseClusterFinalBackup(ctx echo.Context, namespace, name string) error {
+	db, err := e.kubeClient.GetDatabaseCluster(ctx.Request().Context(), namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	fb, err := deletionFinalBackup(db)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not parse the final backup")})
+	}
+	if fb == nil {
+		return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("The deletion of the database cluster with a final backup was never requested")})
+	}
+
+	return ctx.JSON(http.StatusOK, fb)
+}
+
+// deleteWithFinalBackup starts the final backup of the database cluster and deletes
+// the database cluster in the background once the backup succeeds.
+func (e *EverestServer) deleteWithFinalBackup(ctx echo.Context, namespace, name, storageName string) error {
+	reqCtx := ctx.Request().Context()
+	fb, engineType, err := e.startFinalBackup(reqCtx, namespace, name, storageName, time.Now())
+	if err != nil {
+		return e.finalBackupErrorResponse(ctx, err)
+	}
+
+	// The final backup and the deletion outlive the request.
+	go func() {
+		fCtx, cancel := context.WithTimeout(context.WithoutCancel(reqCtx), finalBackupTimeout)
+		defer cancel()
+		e.runFinalBackup(fCtx, namespace, name, engineType, fb)
+	}()
+
+	return ctx.JSON(http.StatusAccepted, fb)
+}
+
+// deletionFinalBackup returns the final backup of the database cluster or nil if it has none.
+func deletionFinalBackup(db *everestv1alpha1.DatabaseCluster) (*FinalBackup, error) {
+	v, ok := db.Annotations[deletionFinalBackupAnnotation]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+	fb := &FinalBackup{}
+	if err := json.Unmarshal([]byte(v), fb); err != nil {
+		return nil, err
+	}
+	return fb, nil
+}
+
+// finalBackupRunning returns true if the final backup is in progress.
+// A final backup which has not finished in time is considered abandoned.
+func finalBackupRunning(fb *FinalBackup, now time.Time) bool {
+	return fb != nil && fb.FinishedAt == nil && now.Before(fb.StartedAt.Add(finalBackupTimeout))
+}
+
+// startFinalBackup creates a backup of the database cluster and records it on the database cluster.
+// It returns the engine type of the database cluster with the final backup.
+func (e *EverestServer) startFinalBackup(
+	ctx context.Context,
+	namespace, name, storageName string,
+	now time.Time,
+) (*FinalBackup, everestv1alpha1.EngineType, error) {
+	db, err := e.kubeClient.GetDatabaseCluster(ctx, namespace, name)
+	if err != nil {
+		return nil, "", err
+	}
+	current, err := deletionFinalBackup(db)
+	if err != nil {
+		return nil, "", err
+	}
+	if finalBackupRunning(current, now) {
+		return nil, "", errFinalBackupRunning
+	}
+	if storageName == "" {
+		storageName = db.Status.ActiveStorage
+	}
+	if storageName == "" {
+		return nil, "", errFinalBackupStorage
+	}
+
+	backup := finalBackup(db, storageName, now)
+	dbb := &DatabaseClusterBackup{}
+	if err := roundTrip(backup, dbb); err != nil {
+		return nil, "", err
+	}
+	if err := e.validateDatabaseClusterBackup(ctx, namespace, dbb); err != nil {
+		return nil, "", fmt.Errorf("%w: %w", errInvalidFinalBackup, err)
+	}
+
+	fb := &FinalBackup{
+		BackupName:        backup.Name,
+		BackupStorageName: storageName,
+		StartedAt:         now.UTC(),
+	}
+	// The final backup is recorded first, so two deletions don't take two final backups.
+	err = e.updateFinalBackup(ctx, namespace, name, func(current *FinalBackup) error {
+		if finalBackupRunning(current, now) {
+			return errFinalBackupRunning
+		}
+		return nil
+	}, fb)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := e.kubeClient.CreateDatabaseClusterBackup(ctx, namespace, backup); err != nil {
+		e.finishFinalBackup(ctx, namespace, name, fb, err)
+		return nil, "", err
+	}
+	e.l.Infow("Taking the final backup of database cluster",
+		"namespace", namespace, "name", name, "backup", backup.Name, "backupStorage", storageName)
+	return fb, db.Spec.Engine.Type, nil
+}
+
+// updateFinalBackup stores the final backup on the database cluster if check allows it.
+func (e *EverestServer) updateFinalBackup(
+	ctx context.Context,
+	namespace, name string,
+	check func(current *FinalBackup) error,
+	fb *FinalBackup,
+) error {
+	data, err := json.Marshal(fb)
+	if err != nil {
+		return err
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		db, err := e.kubeClient.GetDatabaseCluster(ctx, namespace, name)
+		if err != nil {
+			return err
+		}
+		if check != nil {
+			current, err := deletionFinalBackup(db)
+			if err != nil {
+				return err
+			}
+			if err := check(current); err != nil {
+				return err
+			}
+		}
+		if db.Annotations == nil {
+			db.Annotations = make(map[string]string)
+		}
+		db.Annotations[deletionFinalBackupAnnotation] = string(data)
+		_, err = e.kubeClient.UpdateDatabaseCluster(ctx, db)
+		return err
+	})
+}
+
+// runFinalBackup waits for the final backup to succeed and deletes the database cluster.
+// A failure is recorded on the database cluster, which is kept.
+func (e *EverestServer) runFinalBackup(
+	ctx context.Context,
+	namespace, name string,
+	engineType everestv1alpha1.EngineType,
+	fb *FinalBackup,
+) {
+	getBackup := func(ctx context.Context) (*everestv1alpha1.DatabaseClusterBackup, error) {
+		return e.kubeClient.GetDatabaseClusterBackup(ctx, namespace, fb.BackupName)
+	}
+	err := waitForBackup(ctx, getBackup, engineType, finalBackupPollInterval)
+	if err != nil {
+		err = fmt.Errorf("%w: backup %s: %w", errFinalBackupFailed, fb.BackupName, err)
+	} else {
+		e.l.Infow("Final backup of database cluster succeeded", "namespace", namespace, "name", name, "backup", fb.BackupName)
+		err = e.kubeClient.DeleteDatabaseCluster(ctx, namespace, name)
+	}
+
+	// The result must not be lost when the final backup times out.
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), finalBackupSaveTimeout)
+	defer cancel()
+	if err != nil {
+		e.finishFinalBackup(saveCtx, namespace, name, fb, err)
+		return
+	}
+	if err := e.deleteDatabaseClusterSettings(saveCtx, namespace, name); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not delete the settings of the deleted database cluster")))
+	}
+}
+
+// finishFinalBackup records the failure of the final backup or of the deletion on the database cluster.
+func (e *EverestServer) finishFinalBackup(ctx context.Context, namespace, name string, fb *FinalBackup, err error) {
+	e.l.Error(errors.Join(err, fmt.Errorf("failed to delete database cluster %s/%s with a final backup", namespace, name)))
+	fb.FinishedAt = pointer.ToTime(time.Now().UTC())
+	fb.Message = pointer.ToString(err.Error())
+	if err := e.updateFinalBackup(ctx, namespace, name, nil, fb); err != nil {
+		e.l.Error(errors.Join(err, fmt.Errorf("failed to save the final backup of database cluster %s/%s", namespace, name)))
+	}
+}
+
+// finalBackup returns the final backup of the database cluster to the backup storage.
+// The engine of the database cluster is recorded on the backup, so it can be restored once the database cluster is gone.
+// The backup is created without labels so the operator still sets the default ones,
+// and without owner references so it is not garbage collected with the database cluster.
+func finalBackup(db *everestv1alpha1.DatabaseCluster, storageName string, now time.Time) *everestv1alpha1.DatabaseClusterBackup {
+	backup := &everestv1alpha1.DatabaseClusterBackup{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: databaseClusterAPIVersion,
+			Kind:       "DatabaseClusterBackup",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-final-%s", db.Name, now.UTC().Format(backupNameTimeLayout)),
+			Namespace: db.Namespace,
+			Annotations: map[string]string{
+				finalBackupAnnotation:      "true",
+				backupEngineTypeAnnotation: string(db.Spec.Engine.Type),
+			},
+		},
+		Spec: everestv1alpha1.DatabaseClusterBackupSpec{
+			DBClusterName:     db.Name,
+			BackupStorageName: storageName,
+		},
+	}
+	if db.Spec.Engine.Version != "" {
+		backup.Annotations[backupEngineVersionAnnotation] = db.Spec.Engine.Version
+	}
+	return backup
+}
+
+// waitForBackup polls the backup until it succeeds, fails or the context is done.
+func waitForBackup(
+	ctx context.Context,
+	getBackup func(ctx context.Context) (*everestv1alpha1.DatabaseClusterBackup, error),
+	engineType everestv1alpha1.EngineType,
+	interval time.Duration,
+) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		backup, err := getBackup(ctx)
+		switch {
+		case err != nil && !k8serrors.IsNotFound(err):
+			return err
+		case err != nil:
+			// The backup may not be visible yet right after it is created.
+		case successStatus(backup.Status.State, engineType):
+			return nil
+		case failedStatus(backup.Status.State, engineType):
+			return fmt.Errorf("backup is in %s state", backup.Status.State)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("backup did not complete in time: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *EverestServer) finalBackupErrorResponse(ctx echo.Context, err error) error {
+	e.l.Error(err)
+	switch {
+	case k8serrors.IsNotFound(err):
+		return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+	case errors.Is(err, errFinalBackupStorage), errors.Is(err, errInvalidFinalBackup):
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	case errors.Is(err, errFinalBackupRunning):
+		return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString(err.Error())})
+	default:
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not start the final backup")})
+	}
+}
@@ -0,0 +1,144 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestFinalBackup(t *testing.T) {
+	t.Parallel()
+	db := &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "mysql", Namespace: "production"},
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{Type: everestv1alpha1.DatabaseEnginePXC, Version: "8.0.35-27"},
+		},
+	}
+	backup := finalBackup(db, "s3", time.Date(2024, 2, 20, 11, 40, 53, 0, time.UTC))
+
+	assert.Equal(t, "mysql-final-20240220114053", backup.Name)
+	assert.Equal(t, "production", backup.Namespace)
+	assert.Equal(t, "true", backup.Annotations[finalBackupAnnotation])
+	assert.Equal(t, everestv1alpha1.DatabaseEnginePXC, backupEngineType(backup))
+	assert.Equal(t, "8.0.35-27", backup.Annotations[backupEngineVersionAnnotation])
+	assert.Empty(t, backup.Labels)
+	assert.Empty(t, backup.OwnerReferences)
+	assert.Equal(t, everestv1alpha1.DatabaseClusterBackupSpec{DBClusterName: "mysql", BackupStorageName: "s3"}, backup.Spec)
+}
+
+func TestWaitForBackup(t *testing.T) {
+	t.Parallel()
+	notFound := k8serrors.NewNotFound(schema.GroupResource{Resource: databaseClusterBackupKind}, "backup")
+	cases := []struct {
+		name       string
+		engineType everestv1alpha1.EngineType
+		states     []string
+		errs       []error
+		fails      bool
+	}{
+		{
+			name:       "pxc succeeded",
+			engineType: everestv1alpha1.DatabaseEnginePXC,
+			states:     []string{"", "Starting", "Running", "Succeeded"},
+		},
+		{
+			name:       "psmdb ready after the backup appears",
+			engineType: everestv1alpha1.DatabaseEnginePSMDB,
+			states:     []string{"", "requested", "ready"},
+			errs:       []error{notFound},
+		},
+		{
+			name:       "psmdb error",
+			engineType: everestv1alpha1.DatabaseEnginePSMDB,
+			states:     []string{"running", "error"},
+			fails:      true,
+		},
+		{
+			name:       "pg failed",
+			engineType: everestv1alpha1.DatabaseEnginePostgresql,
+			states:     []string{"Running", "Failed"},
+			fails:      true,
+		},
+		{
+			name:       "never completes",
+			engineType: everestv1alpha1.DatabaseEnginePXC,
+			states:     []string{"Running"},
+			fails:      true,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			calls := 0
+			getBackup := func(context.Context) (*everestv1alpha1.DatabaseClusterBackup, error) {
+				defer func() { calls++ }()
+				if calls < len(tc.errs) {
+					return nil, tc.errs[calls]
+				}
+				state := tc.states[min(calls-len(tc.errs), len(tc.states)-1)]
+				return &everestv1alpha1.DatabaseClusterBackup{
+					Status: everestv1alpha1.DatabaseClusterBackupStatus{State: everestv1alpha1.BackupState(state)},
+				}, nil
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			err := waitForBackup(ctx, getBackup, tc.engineType, time.Millisecond)
+			if tc.fails {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFinalBackupRunning(t *testing.T) {
+	t.Parallel()
+	startedAt := time.Date(2024, 2, 20, 11, 40, 53, 0, time.UTC)
+	running := &FinalBackup{BackupName: "mysql-final-20240220114053", BackupStorageName: "s3", StartedAt: startedAt}
+	failed := &FinalBackup{BackupName: "mysql-final-20240220114053", BackupStorageName: "s3", StartedAt: startedAt, FinishedAt: &startedAt}
+
+	assert.False(t, finalBackupRunning(nil, startedAt))
+	assert.True(t, finalBackupRunning(running, startedAt.Add(time.Hour)))
+	assert.False(t, finalBackupRunning(running, startedAt.Add(finalBackupTimeout)))
+	assert.False(t, finalBackupRunning(failed, startedAt.Add(time.Minute)))
+}
+
+func TestDeletionFinalBackup(t *testing.T) {
+	t.Parallel()
+	db := &everestv1alpha1.DatabaseCluster{}
+	fb, err := deletionFinalBackup(db)
+	require.NoError(t, err)
+	assert.Nil(t, fb)
+
+	db.Annotations = map[string]string{
+		deletionFinalBackupAnnotation: `{"backupName": "mysql-final-20240220114053", "backupStorageName": "s3", "startedAt": "2024-02-20T11:40:53Z", "message": "backup failed"}`,
+	}
+	fb, err = deletionFinalBackup(db)
+	require.NoError(t, err)
+	assert.Equal(t, "mysql-final-20240220114053", fb.BackupName)
+	assert.Equal(t, "backup failed", *fb.Message)
+}
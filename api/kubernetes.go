@@ -29,6 +29,15 @@ import (
 
 // GetKubernetesClusterResources returns all and available resources of a Kubernetes cluster.
 func (e *EverestServer) GetKubernetesClusterResources(ctx echo.Context) error {
+	res, err := e.getKubernetesClusterResources(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func (e *EverestServer) getKubernetesClusterResources(ctx echo.Context) (*KubernetesClusterResources, error) {
 	// Get cluster type
 	clusterType, err := e.kubeClient.GetClusterType(ctx.Request().Context())
 	if err != nil {
@@ -42,18 +51,11 @@ func (e *EverestServer) GetKubernetesClusterResources(ctx echo.Context) error {
 		volumes, err = e.kubeClient.GetPersistentVolumes(ctx.Request().Context())
 		if err != nil {
 			e.l.Error(err)
-			return ctx.JSON(http.StatusInternalServerError, Error{
-				Message: pointer.ToString("Could not get persistent volumes"),
-			})
+			return nil, errors.New("could not get persistent volumes")
 		}
 	}
 
-	res, err := e.calculateClusterResources(ctx, e.kubeClient, clusterType, volumes)
-	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
-	}
-
-	return ctx.JSON(http.StatusOK, res)
+	return e.calculateClusterResources(ctx, e.kubeClient, clusterType, volumes)
 }
 
 func (e *EverestServer) calculateClusterResources(
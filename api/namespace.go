@@ -2,6 +2,7 @@ package api
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/AlekSi/pointer"
 	"github.com/labstack/echo/v4"
@@ -18,3 +19,15 @@ func (e *EverestServer) ListNamespaces(ctx echo.Context) error {
 	}
 	return ctx.JSON(http.StatusOK, namespaces)
 }
+
+// dbClusterEntryKey returns the key identifying a database cluster in the
+// config maps storing per database cluster settings.
+// Namespace names may not contain dots, so the key is split back
+// at its first dot.
+func dbClusterEntryKey(namespace, name string) string {
+	return namespace + "." + name
+}
+
+func parseDBClusterEntryKey(key string) (string, string, bool) {
+	return strings.Cut(key, ".")
+}
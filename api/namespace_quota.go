@@ -0,0 +1,379 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// namespaceQuotasConfigMapName is the name of the config map in the Everest
+	// namespace which stores the quotas of the database namespaces.
+	namespaceQuotasConfigMapName = "everest-namespace-quotas"
+	// defaultEngineReplicas is the number of engine replicas the operator runs if none is set.
+	defaultEngineReplicas = 3
+)
+
+var errQuotaExceeded = errors.New("namespace quota exceeded")
+
+// resourceUsage holds the resources requested by database clusters.
+type resourceUsage struct {
+	cpu      resource.Quantity
+	memory   resource.Quantity
+	storage  resource.Quantity
+	clusters int
+}
+
+func (u *resourceUsage) add(o resourceUsage) {
+	u.cpu.Add(o.cpu)
+	u.memory.Add(o.memory)
+	u.storage.Add(o.storage)
+	u.clusters += o.clusters
+}
+
+func (u *resourceUsage) sub(o resourceUsage) {
+	u.cpu.Sub(o.cpu)
+	u.memory.Sub(o.memory)
+	u.storage.Sub(o.storage)
+	u.clusters -= o.clusters
+}
+
+func (u *resourceUsage) toAPI() NamespaceResourceUsage {
+	return NamespaceResourceUsage{
+		Cpu:      u.cpu.String(),
+		Memory:   u.memory.String(),
+		Storage:  u.storage.String(),
+		Clusters: u.clusters,
+	}
+}
+
+// ListNamespaceQuotas returns the quotas and the current usage of all database namespaces.
+func (e *EverestServer) ListNamespaceQuotas(ctx echo.Context) error {
+	namespaces, err := e.kubeClient.GetDBNamespaces(ctx.Request().Context(), e.kubeClient.Namespace())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Failed getting watched namespaces"),
+		})
+	}
+
+	result := make(NamespaceQuotaUsageList, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		q, err := e.namespaceQuotaUsage(ctx.Request().Context(), namespace)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{
+				Message: pointer.ToString(fmt.Sprintf("Could not get the quota usage of namespace %s", namespace)),
+			})
+		}
+		result = append(result, *q)
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// GetNamespaceQuota returns the quota and the current usage of the specified namespace.
+func (e *EverestServer) GetNamespaceQuota(ctx echo.Context, namespace string) error {
+	q, err := e.namespaceQuotaUsage(ctx.Request().Context(), namespace)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not get the quota usage of the namespace"),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, q)
+}
+
+// UpdateNamespaceQuota sets the quota of the specified namespace.
+func (e *EverestServer) UpdateNamespaceQuota(ctx echo.Context, namespace string) error {
+	quota := &NamespaceQuota{}
+	if err := e.getBodyFromContext(ctx, quota); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get NamespaceQuota from the request body"),
+		})
+	}
+	if err := validateNamespaceQuota(quota); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	namespaces, err := e.kubeClient.GetDBNamespaces(ctx.Request().Context(), e.kubeClient.Namespace())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Failed getting watched namespaces"),
+		})
+	}
+	if err := validateAllowedNamespaces([]string{namespace}, namespaces); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	data, err := json.Marshal(quota)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the quota")})
+	}
+	if err := e.kubeClient.SetConfigMapEntry(ctx.Request().Context(), namespaceQuotasConfigMapName, namespace, string(data)); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the quota")})
+	}
+
+	return ctx.JSON(http.StatusOK, quota)
+}
+
+// DeleteNamespaceQuota deletes the quota of the specified namespace.
+func (e *EverestServer) DeleteNamespaceQuota(ctx echo.Context, namespace string) error {
+	if err := e.kubeClient.DeleteConfigMapEntry(ctx.Request().Context(), namespaceQuotasConfigMapName, namespace); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete the quota")})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// enforceNamespaceQuota checks the database cluster fits into the quota of the namespace
+// together with the other database clusters of the namespace.
+// An update is refused only if it increases the usage of the namespace, so the database
+// clusters of a namespace over its quota can still be scaled down.
+// The error wraps errQuotaExceeded if the quota is exceeded.
+func (e *EverestServer) enforceNamespaceQuota(ctx context.Context, namespace string, dbc *DatabaseCluster) error {
+	quota, err := e.getNamespaceQuota(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	if quota == nil {
+		return nil
+	}
+
+	db := &everestv1alpha1.DatabaseCluster{}
+	if err := roundTrip(dbc, db); err != nil {
+		return err
+	}
+	// The database cluster being updated is replaced by the requested one.
+	usage, current, err := e.namespaceUsage(ctx, namespace, db.Name)
+	if err != nil {
+		return err
+	}
+	return checkNamespaceQuota(quota, usage, databaseClusterUsage(db), current)
+}
+
+func (e *EverestServer) quotaErrorResponse(ctx echo.Context, err error) error {
+	if errors.Is(err, errQuotaExceeded) {
+		return ctx.JSON(http.StatusForbidden, Error{Message: pointer.ToString(err.Error())})
+	}
+	e.l.Error(err)
+	return ctx.JSON(http.StatusInternalServerError, Error{
+		Message: pointer.ToString("Could not check the namespace quota"),
+	})
+}
+
+func (e *EverestServer) namespaceQuotaUsage(ctx context.Context, namespace string) (*NamespaceQuotaUsage, error) {
+	quota, err := e.getNamespaceQuota(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	usage, _, err := e.namespaceUsage(ctx, namespace, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &NamespaceQuotaUsage{
+		Namespace: namespace,
+		Quota:     quota,
+		Usage:     usage.toAPI(),
+	}, nil
+}
+
+func (e *EverestServer) getNamespaceQuota(ctx context.Context, namespace string) (*NamespaceQuota, error) {
+	quotas, err := e.kubeClient.GetConfigMapData(ctx, namespaceQuotasConfigMapName)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := quotas[namespace]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+
+	quota := &NamespaceQuota{}
+	if err := json.Unmarshal([]byte(data), quota); err != nil {
+		return nil, errors.Join(err, fmt.Errorf("could not parse the quota of namespace %s", namespace))
+	}
+	return quota, nil
+}
+
+// namespaceUsage sums the resources requested by the database clusters of the namespace
+// except the one with the excluded name. It also returns the resources requested by the
+// excluded database cluster or nil if it does not exist.
+func (e *EverestServer) namespaceUsage(ctx context.Context, namespace, exclude string) (resourceUsage, *resourceUsage, error) {
+	usage := resourceUsage{}
+	clusters, err := e.kubeClient.ListDatabaseClusters(ctx, namespace)
+	if err != nil {
+		return usage, nil, err
+	}
+	var excluded *resourceUsage
+	for _, db := range clusters.Items {
+		db := db
+		if db.Name == exclude {
+			excluded = pointer.To(databaseClusterUsage(&db))
+			continue
+		}
+		usage.add(databaseClusterUsage(&db))
+	}
+	return usage, excluded, nil
+}
+
+// databaseClusterUsage returns the resources requested by the database cluster
+// including its proxies.
+func databaseClusterUsage(db *everestv1alpha1.DatabaseCluster) resourceUsage {
+	engine := db.Spec.Engine
+	replicas := engineReplicas(db)
+	usage := resourceUsage{
+		cpu:      mulQuantity(engine.Resources.CPU, replicas),
+		memory:   mulQuantity(engine.Resources.Memory, replicas),
+		storage:  mulQuantity(engine.Storage.Size, replicas),
+		clusters: 1,
+	}
+	if db.Spec.Proxy.Replicas != nil {
+		proxy := db.Spec.Proxy
+		usage.cpu.Add(mulQuantity(proxy.Resources.CPU, *proxy.Replicas))
+		usage.memory.Add(mulQuantity(proxy.Resources.Memory, *proxy.Replicas))
+	}
+	return usage
+}
+
+// engineReplicas returns the number of engine replicas of the database cluster
+// with the operator default applied.
+func engineReplicas(db *everestv1alpha1.DatabaseCluster) int32 {
+	if db.Spec.Engine.Replicas == 0 {
+		return defaultEngineReplicas
+	}
+	return db.Spec.Engine.Replicas
+}
+
+func mulQuantity(q resource.Quantity, n int32) resource.Quantity {
+	return *resource.NewMilliQuantity(q.MilliValue()*int64(n), q.Format)
+}
+
+func validateNamespaceQuota(quota *NamespaceQuota) error {
+	limits := map[string]*string{
+		"cpu":     quota.Cpu,
+		"memory":  quota.Memory,
+		"storage": quota.Storage,
+	}
+	if quota.PerCluster != nil {
+		limits["perCluster.cpu"] = quota.PerCluster.Cpu
+		limits["perCluster.memory"] = quota.PerCluster.Memory
+		limits["perCluster.storage"] = quota.PerCluster.Storage
+	}
+	for field, limit := range limits {
+		if _, err := parseQuotaLimit(limit); err != nil {
+			return fmt.Errorf("invalid '%s': %w", field, err)
+		}
+	}
+	if quota.Clusters != nil && *quota.Clusters < 0 {
+		return errors.New("'clusters' should not be negative")
+	}
+	return nil
+}
+
+// quotaCheck is a limit of the quota checked against the requested quantity.
+type quotaCheck struct {
+	name  string
+	limit *string
+	// used is nil for the per cluster limits.
+	used      *resource.Quantity
+	requested resource.Quantity
+	current   resource.Quantity
+}
+
+// checkNamespaceQuota checks the requested resources fit into the quota together with the used ones.
+// The current resources are the ones of the updated database cluster or nil on create.
+// The resources which are not increased by the update are not checked.
+func checkNamespaceQuota(quota *NamespaceQuota, used, requested resourceUsage, current *resourceUsage) error {
+	var cur resourceUsage
+	if current != nil {
+		cur = *current
+	}
+	var checks []quotaCheck
+	if quota.PerCluster != nil {
+		checks = append(checks,
+			quotaCheck{name: "per cluster CPU", limit: quota.PerCluster.Cpu, requested: requested.cpu, current: cur.cpu},
+			quotaCheck{name: "per cluster memory", limit: quota.PerCluster.Memory, requested: requested.memory, current: cur.memory},
+			quotaCheck{name: "per cluster storage", limit: quota.PerCluster.Storage, requested: requested.storage, current: cur.storage},
+		)
+	}
+	checks = append(checks,
+		quotaCheck{name: "CPU", limit: quota.Cpu, used: &used.cpu, requested: requested.cpu, current: cur.cpu},
+		quotaCheck{name: "memory", limit: quota.Memory, used: &used.memory, requested: requested.memory, current: cur.memory},
+		quotaCheck{name: "storage", limit: quota.Storage, used: &used.storage, requested: requested.storage, current: cur.storage},
+	)
+	for _, c := range checks {
+		if c.requested.Cmp(c.current) <= 0 {
+			continue
+		}
+		if err := checkQuotaLimit(c.name, c.limit, c.used, c.requested); err != nil {
+			return err
+		}
+	}
+	if quota.Clusters != nil && current == nil && used.clusters+requested.clusters > *quota.Clusters {
+		return fmt.Errorf("%w: the namespace already has %d database clusters out of %d allowed",
+			errQuotaExceeded, used.clusters, *quota.Clusters)
+	}
+	return nil
+}
+
+// checkQuotaLimit checks the requested quantity together with the used one fits into the limit.
+// The used quantity is nil for the per cluster limits.
+func checkQuotaLimit(name string, limit *string, used *resource.Quantity, requested resource.Quantity) error {
+	l, err := parseQuotaLimit(limit)
+	if err != nil || l == nil {
+		return err
+	}
+	if used == nil {
+		if requested.Cmp(*l) > 0 {
+			return fmt.Errorf("%w: requested %s %s, quota %s", errQuotaExceeded, name, requested.String(), l.String())
+		}
+		return nil
+	}
+	total := used.DeepCopy()
+	total.Add(requested)
+	if total.Cmp(*l) > 0 {
+		return fmt.Errorf("%w: requested %s %s, used %s, quota %s",
+			errQuotaExceeded, name, requested.String(), used.String(), l.String())
+	}
+	return nil
+}
+
+func parseQuotaLimit(limit *string) (*resource.Quantity, error) {
+	if limit == nil || *limit == "" {
+		return nil, nil //nolint:nilnil
+	}
+	q, err := resource.ParseQuantity(*limit)
+	if err != nil {
+		return nil, err
+	}
+	return &q, nil
+}
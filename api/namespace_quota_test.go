@@ -0,0 +1,135 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestDatabaseClusterUsage(t *testing.T) {
+	t.Parallel()
+	db := &everestv1alpha1.DatabaseCluster{}
+	err := json.Unmarshal([]byte(`{"spec": {
+		"engine": {"replicas": 3, "resources": {"cpu": "1", "memory": "2G"}, "storage": {"size": "10G"}},
+		"proxy": {"replicas": 2, "resources": {"cpu": "200m", "memory": "100M"}}
+	}}`), db)
+	require.NoError(t, err)
+
+	usage := databaseClusterUsage(db)
+	assert.Equal(t, int64(3400), usage.cpu.MilliValue())
+	assert.Equal(t, int64(6200000000), usage.memory.Value())
+	assert.Equal(t, int64(30000000000), usage.storage.Value())
+	assert.Equal(t, 1, usage.clusters)
+
+	// The operator runs 3 replicas if none is set.
+	db = &everestv1alpha1.DatabaseCluster{}
+	err = json.Unmarshal([]byte(`{"spec": {
+		"engine": {"resources": {"cpu": "1", "memory": "2G"}, "storage": {"size": "10G"}}
+	}}`), db)
+	require.NoError(t, err)
+
+	usage = databaseClusterUsage(db)
+	assert.Equal(t, int64(3000), usage.cpu.MilliValue())
+	assert.Equal(t, int64(6000000000), usage.memory.Value())
+	assert.Equal(t, int64(30000000000), usage.storage.Value())
+}
+
+func TestCheckNamespaceQuota(t *testing.T) {
+	t.Parallel()
+	used := resourceUsage{
+		cpu:      resource.MustParse("6"),
+		memory:   resource.MustParse("12G"),
+		storage:  resource.MustParse("100G"),
+		clusters: 2,
+	}
+	requested := resourceUsage{
+		cpu:      resource.MustParse("3"),
+		memory:   resource.MustParse("6G"),
+		storage:  resource.MustParse("30G"),
+		clusters: 1,
+	}
+	cases := []struct {
+		name    string
+		quota   []byte
+		current *resourceUsage
+		err     error
+	}{
+		{
+			name:  "empty quota",
+			quota: []byte(`{}`),
+			err:   nil,
+		},
+		{
+			name:  "fits into the quota",
+			quota: []byte(`{"cpu": "9", "memory": "18G", "storage": "130G", "clusters": 3, "perCluster": {"cpu": "3"}}`),
+			err:   nil,
+		},
+		{
+			name:  "total cpu exceeded",
+			quota: []byte(`{"cpu": "8"}`),
+			err:   errors.New("namespace quota exceeded: requested CPU 3, used 6, quota 8"),
+		},
+		{
+			name:  "total storage exceeded",
+			quota: []byte(`{"storage": "120G"}`),
+			err:   errors.New("namespace quota exceeded: requested storage 30G, used 100G, quota 120G"),
+		},
+		{
+			name:  "clusters exceeded",
+			quota: []byte(`{"clusters": 2}`),
+			err:   errors.New("namespace quota exceeded: the namespace already has 2 database clusters out of 2 allowed"),
+		},
+		{
+			name:    "update over the quota which does not increase the usage",
+			quota:   []byte(`{"cpu": "8", "storage": "120G", "clusters": 2, "perCluster": {"memory": "4G"}}`),
+			current: &resourceUsage{cpu: resource.MustParse("4"), memory: resource.MustParse("6G"), storage: resource.MustParse("30G"), clusters: 1},
+			err:     nil,
+		},
+		{
+			name:    "update increasing the usage over the quota",
+			quota:   []byte(`{"cpu": "8", "storage": "120G"}`),
+			current: &resourceUsage{cpu: resource.MustParse("4"), memory: resource.MustParse("6G"), storage: resource.MustParse("20G"), clusters: 1},
+			err:     errors.New("namespace quota exceeded: requested storage 30G, used 100G, quota 120G"),
+		},
+		{
+			name:  "per cluster memory exceeded",
+			quota: []byte(`{"perCluster": {"memory": "4G"}}`),
+			err:   errors.New("namespace quota exceeded: requested per cluster memory 6G, quota 4G"),
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			quota := &NamespaceQuota{}
+			err := json.Unmarshal(tc.quota, quota)
+			require.NoError(t, err)
+			err = checkNamespaceQuota(quota, used, requested, tc.current)
+			if tc.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, errQuotaExceeded)
+			assert.Equal(t, tc.err.Error(), err.Error())
+		})
+	}
+}
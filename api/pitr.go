@@ -0,0 +1,385 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// pxcBinlogDir is the directory of the PXC binlogs under the backup prefix of the database cluster.
+	pxcBinlogDir = "pitr"
+	// pbmOplogDir is the directory of the PBM oplog chunks under the backup prefix of the database cluster.
+	pbmOplogDir = "pbmPitr"
+	// pgBackRestArchiveDir is the directory of the pgBackRest WAL archive under the backup prefix of the database cluster.
+	pgBackRestArchiveDir = "archive"
+
+	pbmOplogTimeLayout = "20060102150405"
+	walSegmentsPerLog  = 0x100
+)
+
+var (
+	// binlog_<timestamp of the first event>_<hash>, the GTID sets are stored next to them with the -gtid-set suffix.
+	pxcBinlogRegex = regexp.MustCompile(`^binlog_(\d+)_[0-9a-f]+$`)
+	// <first write>-<counter>.<last write>-<counter>.oplog with an optional compression suffix.
+	pbmOplogRegex = regexp.MustCompile(`^(\d{14})-\d+\.(\d{14})-\d+\.oplog`)
+	// <timeline><log><segment>-<checksum> with an optional compression suffix.
+	walSegmentRegex = regexp.MustCompile(`^([0-9A-F]{8})([0-9A-F]{8})([0-9A-F]{8})-[0-9a-f]{40}`)
+)
+
+// pitrWindow is a range of time covered by contiguous point-in-time recovery logs.
+type pitrWindow struct {
+	start time.Time
+	end   time.Time
+}
+
+// pitrStorageName returns the name of the backup storage the point-in-time recovery logs are uploaded to.
+func pitrStorageName(db *everestv1alpha1.DatabaseCluster) string {
+	if db.Spec.Backup.PITR.BackupStorageName != nil && *db.Spec.Backup.PITR.BackupStorageName != "" {
+		return *db.Spec.Backup.PITR.BackupStorageName
+	}
+	return db.Status.ActiveStorage
+}
+
+// pitrUploadInterval returns the time between the uploads of the point-in-time recovery logs.
+func pitrUploadInterval(db *everestv1alpha1.DatabaseCluster) time.Duration {
+	if db.Spec.Backup.PITR.UploadIntervalSec != nil && *db.Spec.Backup.PITR.UploadIntervalSec > 0 {
+		return time.Duration(*db.Spec.Backup.PITR.UploadIntervalSec) * time.Second
+	}
+	return time.Duration(getDefaultUploadInterval(db.Spec.Engine.Type)) * time.Second
+}
+
+// databaseClusterPitrRanges returns the ranges of dates the database cluster can be recovered to
+// from its backups.
+func (e *EverestServer) databaseClusterPitrRanges(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+	backups []everestv1alpha1.DatabaseClusterBackup,
+) ([]DatabaseClusterPitrRange, error) {
+	storageName := pitrStorageName(db)
+	if storageName == "" {
+		return []DatabaseClusterPitrRange{}, nil
+	}
+	storage, err := e.kubeClient.GetBackupStorage(ctx, storageName)
+	if err != nil {
+		return nil, err
+	}
+
+	var dir string
+	switch db.Spec.Engine.Type {
+	case everestv1alpha1.DatabaseEnginePXC:
+		dir = pxcBinlogDir
+	case everestv1alpha1.DatabaseEnginePSMDB:
+		dir = pbmOplogDir
+	case everestv1alpha1.DatabaseEnginePostgresql:
+		dir = pgBackRestArchiveDir
+	default:
+		return nil, fmt.Errorf("unsupported engine type %s", db.Spec.Engine.Type)
+	}
+	objects, err := e.listStorageObjects(ctx, storage, fmt.Sprintf("%s/%s/%s/", db.Name, db.UID, dir))
+	if err != nil {
+		return nil, err
+	}
+
+	interval := pitrUploadInterval(db)
+	windows := pitrWindows(db.Spec.Engine.Type, objects, interval)
+	return pitrRanges(windows, backups, db.Spec.Engine.Type, interval), nil
+}
+
+// backupPitrRanges returns the ranges of dates the backup can be recovered to.
+// It returns nil if the ranges are unknown because the source database cluster of the backup does not exist anymore
+// or its point-in-time recovery logs can't be listed.
+func (e *EverestServer) backupPitrRanges(
+	ctx context.Context,
+	backup *everestv1alpha1.DatabaseClusterBackup,
+) ([]DatabaseClusterPitrRange, error) {
+	db, err := e.kubeClient.GetDatabaseCluster(ctx, backup.Namespace, backupClusterName(backup))
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil //nolint:nilnil
+		}
+		return nil, err
+	}
+	if !db.Spec.Backup.PITR.Enabled {
+		return []DatabaseClusterPitrRange{}, nil
+	}
+	ranges, err := e.databaseClusterPitrRanges(ctx, db, []everestv1alpha1.DatabaseClusterBackup{*backup})
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list the point-in-time recovery logs, the ranges are unknown")))
+		return nil, nil //nolint:nilnil
+	}
+	return ranges, nil
+}
+
+// pitrWindows returns the windows covered by contiguous logs among the objects of the backup storage.
+func pitrWindows(engineType everestv1alpha1.EngineType, objects []storageObject, interval time.Duration) []pitrWindow {
+	switch engineType {
+	case everestv1alpha1.DatabaseEnginePXC:
+		return binlogWindows(objects, interval)
+	case everestv1alpha1.DatabaseEnginePSMDB:
+		return oplogWindows(objects)
+	case everestv1alpha1.DatabaseEnginePostgresql:
+		return walWindows(objects)
+	}
+	return nil
+}
+
+// binlogWindows returns the windows covered by the PXC binlogs.
+// A binlog starts at the time of its first event and ends when it is uploaded.
+// The next binlog is contiguous if it starts before the previous one is uploaded.
+func binlogWindows(objects []storageObject, interval time.Duration) []pitrWindow {
+	var windows []pitrWindow
+	for _, o := range objects {
+		m := pxcBinlogRegex.FindStringSubmatch(path.Base(o.key))
+		if m == nil {
+			continue
+		}
+		ts, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		start := time.Unix(ts, 0).UTC()
+		end := o.lastModified.UTC()
+		if end.Before(start) {
+			end = start
+		}
+		windows = append(windows, pitrWindow{start: start, end: end})
+	}
+	return mergePitrWindows(windows, interval)
+}
+
+// oplogWindows returns the windows covered by the PBM oplog chunks.
+// The chunks of every replica set are needed, so the windows are the intersection of the windows of the replica sets.
+func oplogWindows(objects []storageObject) []pitrWindow {
+	byReplset := make(map[string][]pitrWindow)
+	var replsets []string
+	for _, o := range objects {
+		m := pbmOplogRegex.FindStringSubmatch(path.Base(o.key))
+		if m == nil {
+			continue
+		}
+		start, err := time.Parse(pbmOplogTimeLayout, m[1])
+		if err != nil {
+			continue
+		}
+		end, err := time.Parse(pbmOplogTimeLayout, m[2])
+		if err != nil {
+			continue
+		}
+		// pbmPitr/<replset>/<date>/<chunk>
+		replset := path.Base(path.Dir(path.Dir(o.key)))
+		if _, ok := byReplset[replset]; !ok {
+			replsets = append(replsets, replset)
+		}
+		byReplset[replset] = append(byReplset[replset], pitrWindow{start: start, end: end})
+	}
+
+	var windows []pitrWindow
+	for i, replset := range replsets {
+		merged := mergePitrWindows(byReplset[replset], time.Second)
+		if i == 0 {
+			windows = merged
+			continue
+		}
+		windows = intersectPitrWindows(windows, merged)
+	}
+	return windows
+}
+
+// walWindows returns the windows covered by the PostgreSQL WAL segments archived by pgBackRest.
+// The segments are contiguous if they are on the same timeline and their numbers are. A segment covers
+// the time until it is archived. A new timeline starts a new window: it branches off the previous one
+// at a point which is not known without reading its history file.
+func walWindows(objects []storageObject) []pitrWindow {
+	type segment struct {
+		timeline uint64
+		number   uint64
+		archived time.Time
+	}
+	var segments []segment
+	for _, o := range objects {
+		m := walSegmentRegex.FindStringSubmatch(path.Base(o.key))
+		if m == nil {
+			continue
+		}
+		timeline, err := strconv.ParseUint(m[1], 16, 32)
+		if err != nil {
+			continue
+		}
+		log, err := strconv.ParseUint(m[2], 16, 32)
+		if err != nil {
+			continue
+		}
+		seg, err := strconv.ParseUint(m[3], 16, 32)
+		if err != nil {
+			continue
+		}
+		segments = append(segments, segment{timeline: timeline, number: log*walSegmentsPerLog + seg, archived: o.lastModified.UTC()})
+	}
+	slices.SortFunc(segments, func(a, b segment) int {
+		if a.timeline != b.timeline {
+			return cmp.Compare(a.timeline, b.timeline)
+		}
+		if a.number != b.number {
+			return cmp.Compare(a.number, b.number)
+		}
+		return a.archived.Compare(b.archived)
+	})
+
+	var windows []pitrWindow
+	for i, s := range segments {
+		if i > 0 && s.timeline == segments[i-1].timeline && s.number <= segments[i-1].number+1 {
+			w := &windows[len(windows)-1]
+			if s.archived.Before(w.start) {
+				w.start = s.archived
+			}
+			if s.archived.After(w.end) {
+				w.end = s.archived
+			}
+			continue
+		}
+		windows = append(windows, pitrWindow{start: s.archived, end: s.archived})
+	}
+	slices.SortStableFunc(windows, func(a, b pitrWindow) int {
+		return a.start.Compare(b.start)
+	})
+	return windows
+}
+
+// mergePitrWindows sorts the windows and merges the ones with less than the gap between them.
+func mergePitrWindows(windows []pitrWindow, gap time.Duration) []pitrWindow {
+	slices.SortFunc(windows, func(a, b pitrWindow) int {
+		return a.start.Compare(b.start)
+	})
+	var merged []pitrWindow
+	for _, w := range windows {
+		if len(merged) > 0 && !w.start.After(merged[len(merged)-1].end.Add(gap)) {
+			last := &merged[len(merged)-1]
+			if w.end.After(last.end) {
+				last.end = w.end
+			}
+			continue
+		}
+		merged = append(merged, w)
+	}
+	return merged
+}
+
+// intersectPitrWindows returns the windows covered by both lists of sorted windows.
+func intersectPitrWindows(a, b []pitrWindow) []pitrWindow {
+	var windows []pitrWindow
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		start, end := a[i].start, a[i].end
+		if b[j].start.After(start) {
+			start = b[j].start
+		}
+		if b[j].end.Before(end) {
+			end = b[j].end
+		}
+		if !end.Before(start) {
+			windows = append(windows, pitrWindow{start: start, end: end})
+		}
+		if a[i].end.Before(b[j].end) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return windows
+}
+
+// pitrRanges returns the ranges the backups can be recovered to with the logs.
+// A window of logs is usable from the first successful backup completed in it.
+// The logs of the backup start a bit after it completes, the interval is accepted in between.
+func pitrRanges(
+	windows []pitrWindow,
+	backups []everestv1alpha1.DatabaseClusterBackup,
+	engineType everestv1alpha1.EngineType,
+	interval time.Duration,
+) []DatabaseClusterPitrRange {
+	ranges := make([]DatabaseClusterPitrRange, 0, len(windows))
+	for _, w := range windows {
+		var base *everestv1alpha1.DatabaseClusterBackup
+		var baseTime time.Time
+		for i, b := range backups {
+			if !successStatus(b.Status.State, engineType) {
+				continue
+			}
+			t := backupCompletedAt(b)
+			if t.IsZero() || t.Before(w.start.Add(-interval)) || t.After(w.end) {
+				continue
+			}
+			if base == nil || t.Before(baseTime) {
+				base, baseTime = &backups[i], t
+			}
+		}
+		if base == nil {
+			continue
+		}
+		earliest := baseTime
+		if earliest.Before(w.start) {
+			earliest = w.start
+		}
+		ranges = append(ranges, DatabaseClusterPitrRange{
+			EarliestDate: earliest.Truncate(time.Second).UTC(),
+			LatestDate:   w.end.Truncate(time.Second).UTC(),
+			BackupName:   base.Name,
+		})
+	}
+	return ranges
+}
+
+// backupCompletedAt returns the time the backup completed at, or the time it was created at if unknown.
+func backupCompletedAt(b everestv1alpha1.DatabaseClusterBackup) time.Time {
+	switch {
+	case b.Status.CompletedAt != nil:
+		return b.Status.CompletedAt.Time
+	case b.Status.CreatedAt != nil:
+		return b.Status.CreatedAt.Time
+	}
+	return time.Time{}
+}
+
+// pitrRangeContains reports whether one of the ranges contains the date.
+func pitrRangeContains(ranges []DatabaseClusterPitrRange, date time.Time) bool {
+	return slices.ContainsFunc(ranges, func(r DatabaseClusterPitrRange) bool {
+		return !date.Before(r.EarliestDate) && !date.After(r.LatestDate)
+	})
+}
+
+// formatPitrRanges formats the ranges for the error messages.
+func formatPitrRanges(ranges []DatabaseClusterPitrRange) string {
+	if len(ranges) == 0 {
+		return "no point-in-time recovery logs are stored after the backup"
+	}
+	s := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		s = append(s, fmt.Sprintf("from %s to %s", r.EarliestDate.Format(dateFormat), r.LatestDate.Format(dateFormat)))
+	}
+	return "the recoverable dates are " + strings.Join(s, ", ")
+}
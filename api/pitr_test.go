@@ -0,0 +1,154 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPitrWindows(t *testing.T) {
+	t.Parallel()
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 2, hour, minute, 0, 0, time.UTC)
+	}
+	binlog := func(start, uploaded time.Time) storageObject {
+		return storageObject{key: fmt.Sprintf("db/uid/pitr/binlog_%d_0a1b2c", start.Unix()), lastModified: uploaded}
+	}
+	oplog := func(replset string, start, end time.Time) storageObject {
+		return storageObject{key: fmt.Sprintf("db/uid/pbmPitr/%s/%s/%s-1.%s-3.oplog.s2",
+			replset, start.Format("20060102"), start.Format(pbmOplogTimeLayout), end.Format(pbmOplogTimeLayout))}
+	}
+	wal := func(name string, archived time.Time) storageObject {
+		return storageObject{
+			key:          "db/uid/archive/db/15-1/0000000100000000/" + name + "-4f1e2d3c4b5a69788796a5b4c3d2e1f001122334.gz",
+			lastModified: archived,
+		}
+	}
+
+	cases := []struct {
+		name    string
+		engine  everestv1alpha1.EngineType
+		objects []storageObject
+		windows []pitrWindow
+	}{
+		{
+			name:   "binlogs",
+			engine: everestv1alpha1.DatabaseEnginePXC,
+			objects: []storageObject{
+				binlog(at(10, 0), at(10, 5)),
+				{key: fmt.Sprintf("db/uid/pitr/binlog_%d_0a1b2c-gtid-set", at(10, 0).Unix()), lastModified: at(10, 5)},
+				binlog(at(10, 4), at(10, 10)),
+				binlog(at(12, 0), at(12, 1)),
+			},
+			windows: []pitrWindow{{start: at(10, 0), end: at(10, 10)}, {start: at(12, 0), end: at(12, 1)}},
+		},
+		{
+			name:   "oplog of two replica sets",
+			engine: everestv1alpha1.DatabaseEnginePSMDB,
+			objects: []storageObject{
+				oplog("rs0", at(10, 0), at(10, 10)),
+				oplog("rs0", at(10, 10), at(10, 20)),
+				oplog("rs0", at(11, 0), at(11, 10)),
+				oplog("cfg", at(10, 5), at(10, 15)),
+				oplog("cfg", at(10, 15), at(11, 5)),
+			},
+			windows: []pitrWindow{{start: at(10, 5), end: at(10, 20)}, {start: at(11, 0), end: at(11, 5)}},
+		},
+		{
+			name:   "wal segments",
+			engine: everestv1alpha1.DatabaseEnginePostgresql,
+			objects: []storageObject{
+				wal("0000000100000000000000FF", at(10, 0)),
+				wal("000000010000000100000000", at(10, 1)),
+				wal("000000020000000100000000", at(10, 2)),
+				wal("000000020000000100000001", at(10, 3)),
+				wal("000000020000000100000005", at(11, 0)),
+				{key: "db/uid/archive/db/15-1/00000002.history", lastModified: at(10, 2)},
+			},
+			windows: []pitrWindow{
+				{start: at(10, 0), end: at(10, 1)},
+				{start: at(10, 2), end: at(10, 3)},
+				{start: at(11, 0), end: at(11, 0)},
+			},
+		},
+		{
+			name:   "wal segments of a timeline branching off after a restore",
+			engine: everestv1alpha1.DatabaseEnginePostgresql,
+			objects: []storageObject{
+				wal("000000010000000000000001", at(10, 0)),
+				wal("000000010000000000000002", at(10, 1)),
+				wal("000000010000000000000003", at(10, 2)),
+				wal("000000020000000000000002", at(10, 30)),
+				wal("000000020000000000000003", at(10, 31)),
+			},
+			windows: []pitrWindow{{start: at(10, 0), end: at(10, 2)}, {start: at(10, 30), end: at(10, 31)}},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.windows, pitrWindows(tc.engine, tc.objects, time.Minute))
+		})
+	}
+}
+
+func TestPitrRanges(t *testing.T) {
+	t.Parallel()
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 2, hour, minute, 0, 0, time.UTC)
+	}
+	backup := func(name, state string, completed time.Time) everestv1alpha1.DatabaseClusterBackup {
+		return everestv1alpha1.DatabaseClusterBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: everestv1alpha1.DatabaseClusterBackupStatus{
+				State:       everestv1alpha1.BackupState(state),
+				CreatedAt:   &metav1.Time{Time: completed.Add(-10 * time.Minute)},
+				CompletedAt: &metav1.Time{Time: completed},
+			},
+		}
+	}
+	windows := []pitrWindow{
+		{start: at(9, 0), end: at(10, 30)},
+		{start: at(11, 1), end: at(12, 0)},
+		{start: at(13, 0), end: at(14, 0)},
+	}
+	backups := []everestv1alpha1.DatabaseClusterBackup{
+		backup("second", "Succeeded", at(10, 0)),
+		backup("first", "Succeeded", at(9, 30)),
+		backup("failed", "Failed", at(13, 30)),
+		backup("before-logs", "Succeeded", at(11, 0)),
+	}
+
+	ranges := pitrRanges(windows, backups, everestv1alpha1.DatabaseEnginePXC, time.Minute)
+	require.Equal(t, []DatabaseClusterPitrRange{
+		{EarliestDate: at(9, 30), LatestDate: at(10, 30), BackupName: "first"},
+		{EarliestDate: at(11, 1), LatestDate: at(12, 0), BackupName: "before-logs"},
+	}, ranges)
+
+	assert.True(t, pitrRangeContains(ranges, at(10, 0)))
+	assert.False(t, pitrRangeContains(ranges, at(10, 45)))
+	assert.False(t, pitrRangeContains(ranges, at(13, 30)))
+	assert.Equal(t,
+		"the recoverable dates are from 2024-01-02T09:30:00Z to 2024-01-02T10:30:00Z, from 2024-01-02T11:01:00Z to 2024-01-02T12:00:00Z",
+		formatPitrRanges(ranges))
+}
@@ -0,0 +1,350 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/robfig/cron/v3"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// powerSchedulesConfigMapName is the name of the config map in the Everest
+	// namespace which stores the power schedules of all database clusters.
+	powerSchedulesConfigMapName = "everest-power-schedules"
+	powerScheduleInterval       = time.Minute
+	// powerScheduleLastRunKey holds the time the power schedules were last applied at,
+	// so the transitions missed while the backend was down are applied on startup.
+	// The key has no dot, so it never collides with the database cluster entries.
+	powerScheduleLastRunKey = "lastRunAt"
+	// powerScheduleMaxCatchUp limits how far back the missed transitions are looked for.
+	powerScheduleMaxCatchUp = 7 * 24 * time.Hour
+)
+
+var (
+	errPowerScheduleNoSchedules = errors.New("either 'pauseSchedule' or 'resumeSchedule' should be specified when the power schedule is enabled")
+	errPowerScheduleSameTime    = errors.New("'pauseSchedule' and 'resumeSchedule' should not be the same")
+)
+
+// PauseDatabaseCluster pauses the specified database cluster.
+func (e *EverestServer) PauseDatabaseCluster(ctx echo.Context, namespace, name string) error {
+	return e.setDatabaseClusterPaused(ctx, namespace, name, true)
+}
+
+// ResumeDatabaseCluster resumes the specified database cluster.
+func (e *EverestServer) ResumeDatabaseCluster(ctx echo.Context, namespace, name string) error {
+	return e.setDatabaseClusterPaused(ctx, namespace, name, false)
+}
+
+func (e *EverestServer) setDatabaseClusterPaused(ctx echo.Context, namespace, name string, paused bool) error {
+	db, err := e.updateDatabaseClusterPaused(ctx.Request().Context(), namespace, name, paused)
+	if err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	return ctx.JSON(http.StatusOK, db)
+}
+
+func (e *EverestServer) updateDatabaseClusterPaused(
+	ctx context.Context,
+	namespace, name string,
+	paused bool,
+) (*everestv1alpha1.DatabaseCluster, error) {
+	var db *everestv1alpha1.DatabaseCluster
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var err error
+		db, err = e.kubeClient.GetDatabaseCluster(ctx, namespace, name)
+		if err != nil {
+			return err
+		}
+		if db.Spec.Paused == paused {
+			return nil
+		}
+		db.Spec.Paused = paused
+		db, err = e.kubeClient.UpdateDatabaseCluster(ctx, db)
+		return err
+	})
+	return db, err
+}
+
+// GetDatabaseClusterPowerSchedule returns the power schedule of the specified database cluster.
+func (e *EverestServer) GetDatabaseClusterPowerSchedule(ctx echo.Context, namespace, name string) error {
+	schedules, err := e.kubeClient.GetConfigMapData(ctx.Request().Context(), powerSchedulesConfigMapName)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get power schedules")})
+	}
+	data, ok := schedules[dbClusterEntryKey(namespace, name)]
+	if !ok {
+		return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Power schedule is not found")})
+	}
+
+	ps := &PowerSchedule{}
+	if err := json.Unmarshal([]byte(data), ps); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not parse the power schedule")})
+	}
+
+	return ctx.JSON(http.StatusOK, ps)
+}
+
+// UpdateDatabaseClusterPowerSchedule sets the power schedule of the specified database cluster.
+func (e *EverestServer) UpdateDatabaseClusterPowerSchedule(ctx echo.Context, namespace, name string) error {
+	ps := &PowerSchedule{}
+	if err := e.getBodyFromContext(ctx, ps); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get PowerSchedule from the request body"),
+		})
+	}
+	if err := validatePowerSchedule(ps); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	if _, err := e.kubeClient.GetDatabaseCluster(ctx.Request().Context(), namespace, name); err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	// The status is owned by the power schedule job.
+	ps.Status = nil
+	if err := e.savePowerSchedule(ctx.Request().Context(), namespace, name, ps); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the power schedule")})
+	}
+
+	return ctx.JSON(http.StatusOK, ps)
+}
+
+// DeleteDatabaseClusterPowerSchedule deletes the power schedule of the specified database cluster.
+func (e *EverestServer) DeleteDatabaseClusterPowerSchedule(ctx echo.Context, namespace, name string) error {
+	err := e.kubeClient.DeleteConfigMapEntry(ctx.Request().Context(), powerSchedulesConfigMapName, dbClusterEntryKey(namespace, name))
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete the power schedule")})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (e *EverestServer) savePowerSchedule(ctx context.Context, namespace, name string, ps *PowerSchedule) error {
+	data, err := json.Marshal(ps)
+	if err != nil {
+		return err
+	}
+	return e.kubeClient.SetConfigMapEntry(ctx, powerSchedulesConfigMapName, dbClusterEntryKey(namespace, name), string(data))
+}
+
+// savePowerScheduleStatus stores the status of the power schedule as it is now, so changes made
+// since the power schedules were read are kept. The status of a deleted power schedule is dropped.
+func (e *EverestServer) savePowerScheduleStatus(ctx context.Context, namespace, name string, status *PowerScheduleStatus) error {
+	key := dbClusterEntryKey(namespace, name)
+	return e.kubeClient.UpdateConfigMapEntry(ctx, powerSchedulesConfigMapName, key, func(value string) (string, error) {
+		ps := &PowerSchedule{}
+		if err := json.Unmarshal([]byte(value), ps); err != nil {
+			return "", err
+		}
+		ps.Status = status
+		data, err := json.Marshal(ps)
+		return string(data), err
+	})
+}
+
+func validatePowerSchedule(ps *PowerSchedule) error {
+	if _, err := powerScheduleLocation(ps); err != nil {
+		return err
+	}
+	pause := strings.TrimSpace(pointer.GetString(ps.PauseSchedule))
+	resume := strings.TrimSpace(pointer.GetString(ps.ResumeSchedule))
+	if ps.Enabled && pause == "" && resume == "" {
+		return errPowerScheduleNoSchedules
+	}
+	if pause != "" && pause == resume {
+		return errPowerScheduleSameTime
+	}
+	for field, schedule := range map[string]string{"pauseSchedule": pause, "resumeSchedule": resume} {
+		if schedule == "" {
+			continue
+		}
+		if _, err := cron.ParseStandard(schedule); err != nil {
+			return fmt.Errorf("invalid '%s': %w", field, err)
+		}
+	}
+	return nil
+}
+
+func powerScheduleLocation(ps *PowerSchedule) (*time.Location, error) {
+	if ps.Timezone == nil || *ps.Timezone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(*ps.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'timezone': %w", err)
+	}
+	return loc, nil
+}
+
+// dueAction returns the power schedule action which became due in the (from, to] interval.
+// If both actions became due, the one which fired last wins.
+func dueAction(ps *PowerSchedule, from, to time.Time) (PowerScheduleStatusLastAction, bool, error) {
+	loc, err := powerScheduleLocation(ps)
+	if err != nil {
+		return "", false, err
+	}
+
+	var (
+		action  PowerScheduleStatusLastAction
+		firedAt time.Time
+	)
+	for a, schedule := range map[PowerScheduleStatusLastAction]*string{Pause: ps.PauseSchedule, Resume: ps.ResumeSchedule} {
+		if schedule == nil || strings.TrimSpace(*schedule) == "" {
+			continue
+		}
+		sched, err := cron.ParseStandard(*schedule)
+		if err != nil {
+			return "", false, err
+		}
+		last := lastActivation(sched, from.In(loc), to.In(loc))
+		if last.IsZero() {
+			continue
+		}
+		if firedAt.IsZero() || last.After(firedAt) {
+			action, firedAt = a, last
+		}
+	}
+	return action, !firedAt.IsZero(), nil
+}
+
+// lastActivation returns the last activation time of the schedule in the (from, to] interval
+// or zero time if the schedule was not activated.
+func lastActivation(sched cron.Schedule, from, to time.Time) time.Time {
+	var last time.Time
+	for next := sched.Next(from); !next.IsZero() && !next.After(to); next = sched.Next(next) {
+		last = next
+	}
+	return last
+}
+
+// RunPowerScheduleJob runs background job pausing and resuming database clusters according to their power schedules.
+func (e *EverestServer) RunPowerScheduleJob(ctx context.Context) {
+	e.l.Debug("Starting power schedule job.")
+
+	ticker := time.NewTicker(powerScheduleInterval)
+	defer ticker.Stop()
+
+	last := time.Now()
+	schedules, err := e.kubeClient.GetConfigMapData(ctx, powerSchedulesConfigMapName)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("failed to get the last power schedules run")))
+	} else if from := powerScheduleLastRun(schedules, last); from.Before(last) {
+		// Apply the latest transition of every schedule missed while the backend was down.
+		e.applyPowerSchedules(ctx, from, last)
+		e.savePowerScheduleLastRun(ctx, last)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			e.applyPowerSchedules(ctx, last, now)
+			e.savePowerScheduleLastRun(ctx, now)
+			last = now
+		}
+	}
+}
+
+// powerScheduleLastRun returns the time the power schedules were last applied at,
+// limited to powerScheduleMaxCatchUp before now. It returns now if the time is unknown.
+func powerScheduleLastRun(schedules map[string]string, now time.Time) time.Time {
+	last, err := time.Parse(time.RFC3339, schedules[powerScheduleLastRunKey])
+	if err != nil || last.After(now) {
+		return now
+	}
+	if earliest := now.Add(-powerScheduleMaxCatchUp); last.Before(earliest) {
+		return earliest
+	}
+	return last
+}
+
+func (e *EverestServer) savePowerScheduleLastRun(ctx context.Context, t time.Time) {
+	err := e.kubeClient.SetConfigMapEntry(ctx, powerSchedulesConfigMapName, powerScheduleLastRunKey, t.UTC().Format(time.RFC3339))
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("failed to save the last power schedules run")))
+	}
+}
+
+func (e *EverestServer) applyPowerSchedules(ctx context.Context, from, to time.Time) {
+	schedules, err := e.kubeClient.GetConfigMapData(ctx, powerSchedulesConfigMapName)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("failed to get power schedules")))
+		return
+	}
+
+	for key, data := range schedules {
+		namespace, name, ok := parseDBClusterEntryKey(key)
+		if !ok {
+			continue
+		}
+		ps := &PowerSchedule{}
+		if err := json.Unmarshal([]byte(data), ps); err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("failed to parse power schedule %s", key)))
+			continue
+		}
+		if !ps.Enabled {
+			continue
+		}
+		action, due, err := dueAction(ps, from, to)
+		if err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("failed to evaluate power schedule %s", key)))
+			continue
+		}
+		if !due {
+			continue
+		}
+
+		status := &PowerScheduleStatus{
+			LastAction:     &action,
+			LastActionTime: pointer.ToTime(to.UTC()),
+			Result:         pointer.To(Succeeded),
+		}
+		if _, err := e.updateDatabaseClusterPaused(ctx, namespace, name, action == Pause); err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("failed to %s database cluster %s/%s", action, namespace, name)))
+			status.Result = pointer.To(Failed)
+			status.Message = pointer.ToString(err.Error())
+		}
+		if err := e.savePowerScheduleStatus(ctx, namespace, name, status); err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("failed to save power schedule status %s", key)))
+		}
+	}
+}
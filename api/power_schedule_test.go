@@ -0,0 +1,184 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePowerSchedule(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		schedule []byte
+		err      error
+	}{
+		{
+			name:     "disabled schedule without crons is allowed",
+			schedule: []byte(`{"enabled": false}`),
+			err:      nil,
+		},
+		{
+			name:     "errPowerScheduleNoSchedules",
+			schedule: []byte(`{"enabled": true}`),
+			err:      errPowerScheduleNoSchedules,
+		},
+		{
+			name:     "errPowerScheduleSameTime",
+			schedule: []byte(`{"enabled": true, "pauseSchedule": "0 20 * * *", "resumeSchedule": "0 20 * * *"}`),
+			err:      errPowerScheduleSameTime,
+		},
+		{
+			name:     "invalid cron",
+			schedule: []byte(`{"enabled": true, "pauseSchedule": "0 25 * * *"}`),
+			err:      errors.New("invalid 'pauseSchedule': end of range (25) above maximum (23): 25"),
+		},
+		{
+			name:     "invalid timezone",
+			schedule: []byte(`{"enabled": true, "pauseSchedule": "0 20 * * *", "timezone": "Mars/Olympus"}`),
+			err:      errors.New("invalid 'timezone': unknown time zone Mars/Olympus"),
+		},
+		{
+			name:     "valid schedule",
+			schedule: []byte(`{"enabled": true, "pauseSchedule": "0 20 * * 1-5", "resumeSchedule": "0 8 * * 1-5", "timezone": "Europe/Berlin"}`),
+			err:      nil,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ps := &PowerSchedule{}
+			err := json.Unmarshal(tc.schedule, ps)
+			require.NoError(t, err)
+			err = validatePowerSchedule(ps)
+			if tc.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tc.err.Error(), err.Error())
+		})
+	}
+}
+
+func TestDueAction(t *testing.T) {
+	t.Parallel()
+	schedule := []byte(`{"enabled": true, "pauseSchedule": "0 20 * * *", "resumeSchedule": "0 8 * * *"}`)
+	cases := []struct {
+		name     string
+		schedule []byte
+		from     time.Time
+		to       time.Time
+		action   PowerScheduleStatusLastAction
+		due      bool
+	}{
+		{
+			name:     "nothing is due",
+			schedule: schedule,
+			from:     time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			to:       time.Date(2024, 1, 1, 12, 1, 0, 0, time.UTC),
+			due:      false,
+		},
+		{
+			name:     "pause is due",
+			schedule: schedule,
+			from:     time.Date(2024, 1, 1, 19, 59, 0, 0, time.UTC),
+			to:       time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC),
+			action:   Pause,
+			due:      true,
+		},
+		{
+			name:     "interval start is excluded",
+			schedule: schedule,
+			from:     time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC),
+			to:       time.Date(2024, 1, 1, 20, 1, 0, 0, time.UTC),
+			due:      false,
+		},
+		{
+			name:     "the action fired last wins",
+			schedule: schedule,
+			from:     time.Date(2024, 1, 1, 19, 0, 0, 0, time.UTC),
+			to:       time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),
+			action:   Resume,
+			due:      true,
+		},
+		{
+			name:     "schedule is evaluated in its time zone",
+			schedule: []byte(`{"enabled": true, "resumeSchedule": "0 8 * * *", "timezone": "Europe/Berlin"}`),
+			from:     time.Date(2024, 1, 1, 6, 59, 0, 0, time.UTC),
+			to:       time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC),
+			action:   Resume,
+			due:      true,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ps := &PowerSchedule{}
+			err := json.Unmarshal(tc.schedule, ps)
+			require.NoError(t, err)
+			action, due, err := dueAction(ps, tc.from, tc.to)
+			require.NoError(t, err)
+			assert.Equal(t, tc.due, due)
+			assert.Equal(t, tc.action, action)
+		})
+	}
+}
+
+func TestPowerScheduleLastRun(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name      string
+		schedules map[string]string
+		last      time.Time
+	}{
+		{
+			name:      "unknown",
+			schedules: map[string]string{},
+			last:      now,
+		},
+		{
+			name:      "invalid",
+			schedules: map[string]string{powerScheduleLastRunKey: "yesterday"},
+			last:      now,
+		},
+		{
+			name:      "in the future",
+			schedules: map[string]string{powerScheduleLastRunKey: "2024-01-10T13:00:00Z"},
+			last:      now,
+		},
+		{
+			name:      "missed",
+			schedules: map[string]string{powerScheduleLastRunKey: "2024-01-09T20:00:00Z"},
+			last:      time.Date(2024, 1, 9, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "missed too long ago",
+			schedules: map[string]string{powerScheduleLastRunKey: "2023-12-01T20:00:00Z"},
+			last:      now.Add(-powerScheduleMaxCatchUp),
+		},
+	}
+	for _, tc := range cases {
+		assert.True(t, tc.last.Equal(powerScheduleLastRun(tc.schedules, now)), tc.name)
+	}
+}
@@ -0,0 +1,499 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// preRestoreBackupConfigMapName is the name of the config map in the Everest namespace
+	// which stores the pre-restore backup settings of namespaces.
+	preRestoreBackupConfigMapName = "everest-pre-restore-backup"
+
+	// preRestoreBackupAnnotation holds the backup taken of the database cluster before the restore.
+	preRestoreBackupAnnotation = "everest.percona.com/pre-restore-backup"
+	// preRestoreBackupForAnnotation marks the backups taken before a restore. It holds the name
+	// of the restore unless the name is generated on creation.
+	preRestoreBackupForAnnotation = "everest.percona.com/pre-restore-backup-for"
+	// latestPreRestoreBackupAnnotation holds the latest pre-restore backup of a database cluster.
+	latestPreRestoreBackupAnnotation = "everest.percona.com/latest-pre-restore-backup"
+	// pendingRestoreAnnotation holds the restore of a database cluster created once its pre-restore backup succeeds,
+	// so the restore is not lost when Everest restarts.
+	pendingRestoreAnnotation = "everest.percona.com/pending-restore"
+
+	defaultPreRestoreBackupTimeoutMinutes = 60
+	preRestoreBackupPollInterval          = 10 * time.Second
+	preRestoreBackupSaveTimeout           = time.Minute
+)
+
+var (
+	errPreRestoreBackupNotReady  = errors.New("the database cluster is not ready, so it is restored without a pre-restore backup")
+	errPreRestoreBackupNoStorage = errors.New("no backup storage to take the pre-restore backup to. Set the backup storage in the pre-restore backup settings of the namespace")
+	errPreRestoreBackupInvalid   = errors.New("could not take the pre-restore backup")
+	errPreRestoreBackupRunning   = errors.New("a pre-restore backup of the database cluster is already in progress")
+	errPreRestoreBackupFailed    = errors.New("the pre-restore backup failed, the restore is not started")
+	errPendingRestoreMissing     = errors.New("the restore to create after the pre-restore backup is not stored, the restore is not started")
+)
+
+// GetDatabaseClusterPreRestoreBackup returns the latest pre-restore backup of the specified database cluster.
+func (e *EverestServer) GetDatabaseClusterPreRestoreBackup(ctx echo.Context, namespace, name string) error {
+	db, err := e.kubeClient.GetDatabaseCluster(ctx.Request().Context(), namespace, name)
+	if err != nil {
+		e.l.Error(err)
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	prb, err := latestPreRestoreBackup(db)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not parse the pre-restore backup")})
+	}
+	if prb == nil {
+		return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("The database cluster was never restored with a pre-restore backup")})
+	}
+
+	return ctx.JSON(http.StatusOK, prb)
+}
+
+// GetNamespacePreRestoreBackup returns the pre-restore backup settings of the specified namespace.
+func (e *EverestServer) GetNamespacePreRestoreBackup(ctx echo.Context, namespace string) error {
+	settings, err := e.preRestoreBackupSettings(ctx.Request().Context(), namespace)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the pre-restore backup settings")})
+	}
+
+	return ctx.JSON(http.StatusOK, settings)
+}
+
+// UpdateNamespacePreRestoreBackup sets the pre-restore backup settings of the specified namespace.
+func (e *EverestServer) UpdateNamespacePreRestoreBackup(ctx echo.Context, namespace string) error {
+	settings := &PreRestoreBackupSettings{}
+	if err := e.getBodyFromContext(ctx, settings); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get PreRestoreBackupSettings from the request body"),
+		})
+	}
+
+	reqCtx := ctx.Request().Context()
+	namespaces, err := e.kubeClient.GetDBNamespaces(reqCtx, e.kubeClient.Namespace())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Failed getting watched namespaces"),
+		})
+	}
+	if err := validateAllowedNamespaces([]string{namespace}, namespaces); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if pointer.GetString(settings.BackupStorageName) != "" {
+		if err := e.checkBackupStorageAllowed(reqCtx, *settings.BackupStorageName, namespace); err != nil {
+			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		}
+	}
+
+	data, err := json.Marshal(settings)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the pre-restore backup settings")})
+	}
+	if err := e.kubeClient.SetConfigMapEntry(reqCtx, preRestoreBackupConfigMapName, namespace, string(data)); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the pre-restore backup settings")})
+	}
+
+	return ctx.JSON(http.StatusOK, settings)
+}
+
+// preRestoreBackupSettings returns the pre-restore backup settings of the namespace.
+// The pre-restore backup is enabled in the namespaces without settings.
+func (e *EverestServer) preRestoreBackupSettings(ctx context.Context, namespace string) (*PreRestoreBackupSettings, error) {
+	data, err := e.kubeClient.GetConfigMapData(ctx, preRestoreBackupConfigMapName)
+	if err != nil {
+		return nil, err
+	}
+	settings := &PreRestoreBackupSettings{Enabled: true}
+	if v, ok := data[namespace]; ok {
+		if err := json.Unmarshal([]byte(v), settings); err != nil {
+			return nil, err
+		}
+	}
+	if settings.TimeoutMinutes == nil {
+		settings.TimeoutMinutes = pointer.ToInt(defaultPreRestoreBackupTimeoutMinutes)
+	}
+	return settings, nil
+}
+
+// latestPreRestoreBackup returns the latest pre-restore backup of the database cluster or nil if it has none.
+func latestPreRestoreBackup(db *everestv1alpha1.DatabaseCluster) (*PreRestoreBackup, error) {
+	v, ok := db.Annotations[latestPreRestoreBackupAnnotation]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+	prb := &PreRestoreBackup{}
+	if err := json.Unmarshal([]byte(v), prb); err != nil {
+		return nil, err
+	}
+	return prb, nil
+}
+
+// pendingRestore returns the restore waiting for the pre-restore backup of the database cluster or nil if it has none.
+func pendingRestore(db *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseClusterRestore, error) {
+	v, ok := db.Annotations[pendingRestoreAnnotation]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+	restore := &everestv1alpha1.DatabaseClusterRestore{}
+	if err := json.Unmarshal([]byte(v), restore); err != nil {
+		return nil, err
+	}
+	return restore, nil
+}
+
+// preRestoreBackupRunning returns true if the pre-restore backup is in progress.
+// A pre-restore backup which has not finished in time is considered abandoned.
+func preRestoreBackupRunning(prb *PreRestoreBackup, now time.Time) bool {
+	return prb != nil && prb.FinishedAt == nil &&
+		now.Before(prb.StartedAt.Add(time.Duration(prb.TimeoutMinutes)*time.Minute))
+}
+
+// startPreRestoreBackup creates a backup of the database cluster before the restore from the backup
+// and records it on the database cluster with the restore. It returns nil if the pre-restore backup is disabled in the namespace.
+func (e *EverestServer) startPreRestoreBackup(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+	restore *everestv1alpha1.DatabaseClusterRestore,
+	backup *everestv1alpha1.DatabaseClusterBackup,
+	now time.Time,
+) (*PreRestoreBackup, error) {
+	settings, err := e.preRestoreBackupSettings(ctx, db.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !settings.Enabled {
+		return nil, nil //nolint:nilnil
+	}
+	current, err := latestPreRestoreBackup(db)
+	if err != nil {
+		return nil, err
+	}
+	if preRestoreBackupRunning(current, now) {
+		return nil, errPreRestoreBackupRunning
+	}
+	if db.Status.Status != everestv1alpha1.AppStateReady {
+		return nil, errPreRestoreBackupNotReady
+	}
+	storageName := preRestoreBackupStorage(settings, db, backup)
+	if storageName == "" {
+		return nil, errPreRestoreBackupNoStorage
+	}
+
+	b := preRestoreBackup(db, storageName, restore.Name, now)
+	apiBackup := &DatabaseClusterBackup{}
+	if err := roundTrip(b, apiBackup); err != nil {
+		return nil, err
+	}
+	if err := e.validateDatabaseClusterBackup(ctx, db.Namespace, apiBackup); err != nil {
+		return nil, fmt.Errorf("%w: %w", errPreRestoreBackupInvalid, err)
+	}
+
+	prb := &PreRestoreBackup{
+		BackupName:        b.Name,
+		BackupStorageName: storageName,
+		TimeoutMinutes:    *settings.TimeoutMinutes,
+		StartedAt:         now.UTC(),
+	}
+	if restore.Name != "" {
+		prb.RestoreName = pointer.ToString(restore.Name)
+	}
+	if restore.Annotations == nil {
+		restore.Annotations = make(map[string]string)
+	}
+	restore.Annotations[preRestoreBackupAnnotation] = b.Name
+	// The pre-restore backup is recorded first, so two restores don't run at once.
+	err = e.updatePreRestoreBackup(ctx, db.Namespace, db.Name, func(current *PreRestoreBackup) error {
+		if preRestoreBackupRunning(current, now) {
+			return errPreRestoreBackupRunning
+		}
+		return nil
+	}, prb, restore)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := e.kubeClient.CreateDatabaseClusterBackup(ctx, db.Namespace, b); err != nil {
+		e.finishPreRestoreBackup(ctx, db.Namespace, db.Name, prb, err)
+		return nil, err
+	}
+	e.l.Infow("Taking pre-restore backup", "namespace", db.Namespace, "databaseCluster", db.Name,
+		"backup", b.Name, "restore", restore.Name)
+	return prb, nil
+}
+
+// updatePreRestoreBackup stores the pre-restore backup on the database cluster if check allows it.
+// The restore waiting for the pre-restore backup is stored with it or removed if it is nil.
+func (e *EverestServer) updatePreRestoreBackup(
+	ctx context.Context,
+	namespace, name string,
+	check func(current *PreRestoreBackup) error,
+	prb *PreRestoreBackup,
+	restore *everestv1alpha1.DatabaseClusterRestore,
+) error {
+	data, err := json.Marshal(prb)
+	if err != nil {
+		return err
+	}
+	var restoreData []byte
+	if restore != nil {
+		if restoreData, err = json.Marshal(restore); err != nil {
+			return err
+		}
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		db, err := e.kubeClient.GetDatabaseCluster(ctx, namespace, name)
+		if err != nil {
+			return err
+		}
+		if check != nil {
+			current, err := latestPreRestoreBackup(db)
+			if err != nil {
+				return err
+			}
+			if err := check(current); err != nil {
+				return err
+			}
+		}
+		if db.Annotations == nil {
+			db.Annotations = make(map[string]string)
+		}
+		db.Annotations[latestPreRestoreBackupAnnotation] = string(data)
+		if restore != nil {
+			db.Annotations[pendingRestoreAnnotation] = string(restoreData)
+		} else {
+			delete(db.Annotations, pendingRestoreAnnotation)
+		}
+		_, err = e.kubeClient.UpdateDatabaseCluster(ctx, db)
+		return err
+	})
+}
+
+// goRunPreRestoreBackup runs the pre-restore backup in the background until it times out
+// unless it already runs. The pre-restore backup and the restore outlive the request.
+// The goroutine updates its own copy of prb, which is not marshaled concurrently with the response.
+func (e *EverestServer) goRunPreRestoreBackup(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+	restore *everestv1alpha1.DatabaseClusterRestore,
+	prb PreRestoreBackup,
+) {
+	key := db.Namespace + "/" + prb.BackupName
+	if _, running := e.preRestoreBackups.LoadOrStore(key, struct{}{}); running {
+		return
+	}
+	go func() {
+		defer e.preRestoreBackups.Delete(key)
+		deadline := prb.StartedAt.Add(time.Duration(prb.TimeoutMinutes) * time.Minute)
+		pCtx, cancel := context.WithDeadline(context.WithoutCancel(ctx), deadline)
+		defer cancel()
+		e.runPreRestoreBackup(pCtx, db, restore, &prb)
+	}()
+}
+
+// runPreRestoreBackup waits for the pre-restore backup to succeed and creates the restore.
+// The outcome is recorded on the database cluster.
+func (e *EverestServer) runPreRestoreBackup(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+	restore *everestv1alpha1.DatabaseClusterRestore,
+	prb *PreRestoreBackup,
+) {
+	// The restore may have been created before Everest restarted.
+	lookupCtx, cancelLookup := context.WithTimeout(context.WithoutCancel(ctx), preRestoreBackupSaveTimeout)
+	defer cancelLookup()
+	created, err := e.preRestoreBackupRestore(lookupCtx, db.Namespace, prb.BackupName)
+	if err == nil && created == nil {
+		created, err = e.createPendingRestore(ctx, db, restore, prb)
+	}
+	if err == nil {
+		prb.RestoreName = pointer.ToString(created.Name)
+		e.l.Infow("Pre-restore backup succeeded, restore created", "namespace", db.Namespace,
+			"databaseCluster", db.Name, "backup", prb.BackupName, "restore", created.Name)
+	}
+
+	// The result must not be lost when the pre-restore backup times out.
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), preRestoreBackupSaveTimeout)
+	defer cancel()
+	e.finishPreRestoreBackup(saveCtx, db.Namespace, db.Name, prb, err)
+}
+
+// preRestoreBackupRestore returns the restore created after the pre-restore backup or nil if it is not created.
+func (e *EverestServer) preRestoreBackupRestore(ctx context.Context, namespace, backupName string) (*everestv1alpha1.DatabaseClusterRestore, error) {
+	restores, err := e.kubeClient.ListDatabaseClusterRestores(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range restores.Items {
+		if restores.Items[i].Annotations[preRestoreBackupAnnotation] == backupName {
+			return &restores.Items[i], nil
+		}
+	}
+	return nil, nil //nolint:nilnil
+}
+
+// createPendingRestore waits for the pre-restore backup to succeed and creates the restore.
+func (e *EverestServer) createPendingRestore(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+	restore *everestv1alpha1.DatabaseClusterRestore,
+	prb *PreRestoreBackup,
+) (*everestv1alpha1.DatabaseClusterRestore, error) {
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("%w: backup %s did not complete in %d minutes", errPreRestoreBackupFailed, prb.BackupName, prb.TimeoutMinutes)
+	}
+	getBackup := func(ctx context.Context) (*everestv1alpha1.DatabaseClusterBackup, error) {
+		return e.kubeClient.GetDatabaseClusterBackup(ctx, db.Namespace, prb.BackupName)
+	}
+	if err := waitForBackup(ctx, getBackup, db.Spec.Engine.Type, preRestoreBackupPollInterval); err != nil {
+		return nil, fmt.Errorf("%w: backup %s: %w", errPreRestoreBackupFailed, prb.BackupName, err)
+	}
+	return e.kubeClient.CreateDatabaseClusterRestore(ctx, restore)
+}
+
+// finishPreRestoreBackup records the creation of the restore or the failure on the database cluster.
+func (e *EverestServer) finishPreRestoreBackup(ctx context.Context, namespace, name string, prb *PreRestoreBackup, err error) {
+	if err != nil {
+		e.l.Error(errors.Join(err, fmt.Errorf("failed to restore database cluster %s/%s with a pre-restore backup", namespace, name)))
+		prb.Message = pointer.ToString(err.Error())
+	}
+	prb.FinishedAt = pointer.ToTime(time.Now().UTC())
+	if err := e.updatePreRestoreBackup(ctx, namespace, name, nil, prb, nil); err != nil {
+		e.l.Error(errors.Join(err, fmt.Errorf("failed to save the pre-restore backup of database cluster %s/%s", namespace, name)))
+	}
+}
+
+func (e *EverestServer) preRestoreBackupErrorResponse(ctx echo.Context, err error) error {
+	e.l.Error(err)
+	switch {
+	case errors.Is(err, errPreRestoreBackupNoStorage),
+		errors.Is(err, errPreRestoreBackupInvalid):
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	case errors.Is(err, errPreRestoreBackupRunning):
+		return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString(err.Error())})
+	default:
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not start the pre-restore backup")})
+	}
+}
+
+// preRestoreBackupStorage returns the backup storage to take the pre-restore backup to.
+// The PSMDB database clusters can take backups only to their active storage once they have one.
+func preRestoreBackupStorage(
+	settings *PreRestoreBackupSettings,
+	db *everestv1alpha1.DatabaseCluster,
+	backup *everestv1alpha1.DatabaseClusterBackup,
+) string {
+	if name := pointer.GetString(settings.BackupStorageName); name != "" {
+		return name
+	}
+	if db.Spec.Engine.Type == everestv1alpha1.DatabaseEnginePSMDB && db.Status.ActiveStorage != "" {
+		return db.Status.ActiveStorage
+	}
+	if names := backupStorageNames(db); len(names) > 0 {
+		return names[0]
+	}
+	return backup.Spec.BackupStorageName
+}
+
+// preRestoreBackup returns the backup of the database cluster taken before the restore.
+// The restore is linked to the backup unless its name is generated on creation.
+func preRestoreBackup(db *everestv1alpha1.DatabaseCluster, storageName, restoreName string, now time.Time) *everestv1alpha1.DatabaseClusterBackup {
+	return &everestv1alpha1.DatabaseClusterBackup{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: databaseClusterAPIVersion,
+			Kind:       "DatabaseClusterBackup",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-pre-restore-%s", db.Name, now.UTC().Format(backupNameTimeLayout)),
+			Namespace:   db.Namespace,
+			Annotations: map[string]string{preRestoreBackupForAnnotation: restoreName},
+		},
+		Spec: everestv1alpha1.DatabaseClusterBackupSpec{
+			DBClusterName:     db.Name,
+			BackupStorageName: storageName,
+		},
+	}
+}
+
+// RunPreRestoreBackupJob runs background job resuming the pre-restore backups interrupted by a restart of Everest,
+// so their restores are created or they fail.
+func (e *EverestServer) RunPreRestoreBackupJob(ctx context.Context) {
+	e.l.Debug("Starting pre-restore backup job.")
+
+	namespaces, err := e.kubeClient.GetDBNamespaces(ctx, e.kubeClient.Namespace())
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("failed to get watched namespaces")))
+		return
+	}
+	for _, namespace := range namespaces {
+		clusters, err := e.kubeClient.ListDatabaseClusters(ctx, namespace)
+		if err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("failed to list database clusters in %s", namespace)))
+			continue
+		}
+		for i := range clusters.Items {
+			db := &clusters.Items[i]
+			if err := e.resumePreRestoreBackup(ctx, db); err != nil {
+				e.l.Error(errors.Join(err, fmt.Errorf("failed to resume the pre-restore backup of %s/%s", namespace, db.Name)))
+			}
+		}
+	}
+}
+
+// resumePreRestoreBackup runs the unfinished pre-restore backup of the database cluster.
+// The pre-restore backups which timed out are failed unless their restore is already created.
+func (e *EverestServer) resumePreRestoreBackup(ctx context.Context, db *everestv1alpha1.DatabaseCluster) error {
+	prb, err := latestPreRestoreBackup(db)
+	if err != nil || prb == nil || prb.FinishedAt != nil {
+		return err
+	}
+	restore, err := pendingRestore(db)
+	if err != nil {
+		return err
+	}
+	if restore == nil {
+		e.finishPreRestoreBackup(ctx, db.Namespace, db.Name, prb, errPendingRestoreMissing)
+		return nil
+	}
+	e.l.Infow("Resuming pre-restore backup", "namespace", db.Namespace, "databaseCluster", db.Name,
+		"backup", prb.BackupName, "restore", restore.Name)
+	e.goRunPreRestoreBackup(ctx, db, restore, *prb)
+	return nil
+}
@@ -0,0 +1,154 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPreRestoreBackupStorage(t *testing.T) {
+	t.Parallel()
+	backup := &everestv1alpha1.DatabaseClusterBackup{
+		Spec: everestv1alpha1.DatabaseClusterBackupSpec{DBClusterName: "db", BackupStorageName: "restored"},
+	}
+	db := func(engine everestv1alpha1.EngineType, activeStorage string, schedules ...string) *everestv1alpha1.DatabaseCluster {
+		d := &everestv1alpha1.DatabaseCluster{}
+		d.Spec.Engine.Type = engine
+		d.Status.ActiveStorage = activeStorage
+		for _, s := range schedules {
+			d.Spec.Backup.Schedules = append(d.Spec.Backup.Schedules, everestv1alpha1.BackupSchedule{BackupStorageName: s})
+		}
+		return d
+	}
+
+	cases := []struct {
+		name     string
+		settings *PreRestoreBackupSettings
+		db       *everestv1alpha1.DatabaseCluster
+		storage  string
+	}{
+		{
+			name:     "namespace setting",
+			settings: &PreRestoreBackupSettings{Enabled: true, BackupStorageName: pointer.ToString("safety")},
+			db:       db(everestv1alpha1.DatabaseEnginePXC, "", "daily"),
+			storage:  "safety",
+		},
+		{
+			name:     "schedule",
+			settings: &PreRestoreBackupSettings{Enabled: true},
+			db:       db(everestv1alpha1.DatabaseEnginePXC, "", "daily", "weekly"),
+			storage:  "daily",
+		},
+		{
+			name:     "PSMDB active storage",
+			settings: &PreRestoreBackupSettings{Enabled: true},
+			db:       db(everestv1alpha1.DatabaseEnginePSMDB, "active", "daily"),
+			storage:  "active",
+		},
+		{
+			name:     "restored backup",
+			settings: &PreRestoreBackupSettings{Enabled: true, BackupStorageName: pointer.ToString("")},
+			db:       db(everestv1alpha1.DatabaseEnginePostgresql, ""),
+			storage:  "restored",
+		},
+	}
+	for _, tc := range cases {
+		assert.Equal(t, tc.storage, preRestoreBackupStorage(tc.settings, tc.db, backup), tc.name)
+	}
+}
+
+func TestPreRestoreBackup(t *testing.T) {
+	t.Parallel()
+	db := &everestv1alpha1.DatabaseCluster{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "prod"}}
+	now := time.Date(2024, 3, 20, 12, 30, 15, 0, time.UTC)
+
+	b := preRestoreBackup(db, "s3", "db-restore", now)
+	assert.Equal(t, "db-pre-restore-20240320123015", b.Name)
+	assert.Equal(t, "prod", b.Namespace)
+	assert.Equal(t, map[string]string{preRestoreBackupForAnnotation: "db-restore"}, b.Annotations)
+	assert.Empty(t, b.Labels)
+	assert.Equal(t, everestv1alpha1.DatabaseClusterBackupSpec{DBClusterName: "db", BackupStorageName: "s3"}, b.Spec)
+
+	assert.Equal(t, map[string]string{preRestoreBackupForAnnotation: ""}, preRestoreBackup(db, "s3", "", now).Annotations)
+}
+
+func TestPreRestoreBackupRunning(t *testing.T) {
+	t.Parallel()
+	startedAt := time.Date(2024, 3, 20, 12, 30, 15, 0, time.UTC)
+	running := &PreRestoreBackup{BackupName: "db-pre-restore-20240320123015", BackupStorageName: "s3", TimeoutMinutes: 60, StartedAt: startedAt}
+	finished := &PreRestoreBackup{BackupName: "db-pre-restore-20240320123015", BackupStorageName: "s3", TimeoutMinutes: 60, StartedAt: startedAt, FinishedAt: &startedAt}
+
+	cases := []struct {
+		name    string
+		prb     *PreRestoreBackup
+		now     time.Time
+		running bool
+	}{
+		{name: "none", now: startedAt},
+		{name: "in progress", prb: running, now: startedAt.Add(59 * time.Minute), running: true},
+		{name: "abandoned", prb: running, now: startedAt.Add(time.Hour)},
+		{name: "finished", prb: finished, now: startedAt.Add(time.Minute)},
+	}
+	for _, tc := range cases {
+		assert.Equal(t, tc.running, preRestoreBackupRunning(tc.prb, tc.now), tc.name)
+	}
+}
+
+func TestLatestPreRestoreBackup(t *testing.T) {
+	t.Parallel()
+	db := &everestv1alpha1.DatabaseCluster{}
+	prb, err := latestPreRestoreBackup(db)
+	require.NoError(t, err)
+	assert.Nil(t, prb)
+
+	db.Annotations = map[string]string{
+		latestPreRestoreBackupAnnotation: `{"backupName": "db-pre-restore-20240320123015", "backupStorageName": "s3", "restoreName": "db-restore", "timeoutMinutes": 60, "startedAt": "2024-03-20T12:30:15Z", "finishedAt": "2024-03-20T12:40:15Z"}`,
+	}
+	prb, err = latestPreRestoreBackup(db)
+	require.NoError(t, err)
+	assert.Equal(t, "db-pre-restore-20240320123015", prb.BackupName)
+	assert.Equal(t, "db-restore", *prb.RestoreName)
+	assert.Nil(t, prb.Message)
+}
+
+func TestPendingRestore(t *testing.T) {
+	t.Parallel()
+	db := &everestv1alpha1.DatabaseCluster{}
+	restore, err := pendingRestore(db)
+	require.NoError(t, err)
+	assert.Nil(t, restore)
+
+	db.Annotations = map[string]string{
+		pendingRestoreAnnotation: `{"metadata": {"name": "db-restore", "namespace": "prod", "annotations": {"everest.percona.com/pre-restore-backup": "db-pre-restore-20240320123015"}}, "spec": {"dbClusterName": "db", "dataSource": {"dbClusterBackupName": "daily"}}}`,
+	}
+	restore, err = pendingRestore(db)
+	require.NoError(t, err)
+	assert.Equal(t, "db-restore", restore.Name)
+	assert.Equal(t, "prod", restore.Namespace)
+	assert.Equal(t, "db-pre-restore-20240320123015", restore.Annotations[preRestoreBackupAnnotation])
+	assert.Equal(t, "db", restore.Spec.DBClusterName)
+	assert.Equal(t, "daily", restore.Spec.DataSource.DBClusterBackupName)
+
+	db.Annotations[pendingRestoreAnnotation] = "{"
+	_, err = pendingRestore(db)
+	require.Error(t, err)
+}
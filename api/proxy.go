@@ -156,3 +156,19 @@ func everestErrorHandler(logger *zap.SugaredLogger) func(http.ResponseWriter, *h
 		}
 	}
 }
+
+// removeQueryParams removes the Everest specific parameters from the request
+// so they are not proxied to Kubernetes.
+func removeQueryParams(req *http.Request, params ...string) {
+	q := req.URL.Query()
+	for _, p := range params {
+		q.Del(p)
+	}
+	req.URL.RawQuery = q.Encode()
+}
+
+// addWarningHeader adds a warning to the response the same way Kubernetes does,
+// so it is kept next to the warnings of the proxied response.
+func addWarningHeader(ctx echo.Context, message string) {
+	ctx.Response().Header().Add("Warning", fmt.Sprintf("299 - %q", message))
+}
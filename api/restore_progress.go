@@ -0,0 +1,416 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// restoreProgressAnnotation stores the phases the restore went through as JSON.
+	restoreProgressAnnotation = "everest.percona.com/restore-progress"
+
+	restoreProgressInterval = 10 * time.Second
+	restoreLogTailLines     = 200
+
+	psmdbBackupAgentContainer = "backup-agent"
+	pgRestoreLabel            = "postgres-operator.crunchydata.com/pgbackrest-restore"
+)
+
+// Phases of a restore.
+const (
+	restorePhasePreparing             RestorePhase = "preparing"
+	restorePhaseDownloadingBaseBackup RestorePhase = "downloadingBaseBackup"
+	restorePhaseApplyingLogs          RestorePhase = "applyingLogs"
+	restorePhaseRestarting            RestorePhase = "restarting"
+	restorePhaseReady                 RestorePhase = "ready"
+	restorePhaseFailed                RestorePhase = "failed"
+)
+
+// applyingLogsRegex matches the log lines of PBM and pgBackRest written while the oplog or WAL is replayed.
+var applyingLogsRegex = regexp.MustCompile(`(?i)oplog replay|replay oplog|archive-get command begin|redo starts at`)
+
+// restorePhaseRecord is a phase of the restore stored in the restoreProgressAnnotation.
+type restorePhaseRecord struct {
+	Phase     RestorePhase `json:"phase"`
+	StartedAt time.Time    `json:"startedAt"`
+}
+
+// GetDatabaseClusterRestoreProgress returns the phases of the specified database cluster restore.
+func (e *EverestServer) GetDatabaseClusterRestoreProgress(ctx echo.Context, namespace, name string) error {
+	c := ctx.Request().Context()
+	restore, err := e.kubeClient.GetDatabaseClusterRestore(c, namespace, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster restore is not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed getting database cluster restore")})
+	}
+	db, err := e.kubeClient.GetDatabaseCluster(c, namespace, restore.Spec.DBClusterName)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster is not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed getting database cluster")})
+	}
+	records, err := restorePhaseRecords(restore)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not parse the restore progress")})
+	}
+
+	now := time.Now()
+	phase := restorePhase(db.Spec.Engine.Type, restore, db, e.restoreApplyingLogs(c, db, restore))
+	progress := DatabaseClusterRestoreProgress{
+		Phase:  phase,
+		Phases: restorePhases(recordRestorePhase(records, restore, phase, now), now),
+	}
+	if restore.Status.State != "" {
+		progress.State = pointer.ToString(string(restore.Status.State))
+	}
+	if db.Status.Status != "" {
+		progress.DatabaseClusterStatus = pointer.ToString(string(db.Status.Status))
+	}
+	if phase == restorePhaseFailed {
+		progress.FailureReason = pointer.ToString(e.restoreFailureReason(c, db, restore))
+	}
+	return ctx.JSON(http.StatusOK, progress)
+}
+
+// restorePhase returns the phase of the restore from its state and the status of the database cluster.
+// The operators do not report when the logs are applied for PSMDB and PostgreSQL, so the caller tells it from the logs.
+func restorePhase(
+	engineType everestv1alpha1.EngineType,
+	restore *everestv1alpha1.DatabaseClusterRestore,
+	db *everestv1alpha1.DatabaseCluster,
+	applyingLogs bool,
+) RestorePhase {
+	afterRestore := restorePhaseRestarting
+	if db.Status.Status == everestv1alpha1.AppStateReady {
+		afterRestore = restorePhaseReady
+	}
+
+	state := string(restore.Status.State)
+	switch engineType {
+	case everestv1alpha1.DatabaseEnginePXC:
+		switch state {
+		case "Restoring":
+			return restorePhaseDownloadingBaseBackup
+		case "Point-in-time recovering":
+			return restorePhaseApplyingLogs
+		case "Starting Cluster":
+			return restorePhaseRestarting
+		case "Succeeded":
+			return afterRestore
+		case "Failed":
+			return restorePhaseFailed
+		}
+	case everestv1alpha1.DatabaseEnginePSMDB:
+		switch state {
+		case "running":
+			if applyingLogs {
+				return restorePhaseApplyingLogs
+			}
+			return restorePhaseDownloadingBaseBackup
+		case "ready":
+			return afterRestore
+		case "error", "rejected":
+			return restorePhaseFailed
+		}
+	case everestv1alpha1.DatabaseEnginePostgresql:
+		switch state {
+		case "Running":
+			if applyingLogs {
+				return restorePhaseApplyingLogs
+			}
+			return restorePhaseDownloadingBaseBackup
+		case "Succeeded":
+			return afterRestore
+		case "Failed":
+			return restorePhaseFailed
+		}
+	}
+	return restorePhasePreparing
+}
+
+// restoreApplyingLogs reports whether the logs of the restore show the oplog or WAL is being replayed.
+// Failures to read the logs are ignored since the logs only refine the phase.
+func (e *EverestServer) restoreApplyingLogs(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+	restore *everestv1alpha1.DatabaseClusterRestore,
+) bool {
+	if restore.Spec.DataSource.PITR == nil {
+		return false
+	}
+	var (
+		selector  *metav1.LabelSelector
+		container string
+	)
+	switch {
+	case db.Spec.Engine.Type == everestv1alpha1.DatabaseEnginePSMDB && restore.Status.State == "running":
+		selector, container = databaseClusterLabelSelector(db), psmdbBackupAgentContainer
+	case db.Spec.Engine.Type == everestv1alpha1.DatabaseEnginePostgresql && restore.Status.State == "Running":
+		selector = &metav1.LabelSelector{
+			MatchLabels: map[string]string{pgClusterLabel: db.Name},
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: pgRestoreLabel, Operator: metav1.LabelSelectorOpExists},
+			},
+		}
+	default:
+		return false
+	}
+
+	pods, err := e.kubeClient.GetPods(ctx, db.Namespace, selector)
+	if err != nil {
+		e.l.Debug(err)
+		return false
+	}
+	since := restore.CreationTimestamp
+	for _, pod := range pods.Items {
+		for _, c := range pod.Spec.Containers {
+			if container != "" && c.Name != container {
+				continue
+			}
+			stream, err := e.kubeClient.GetPodLogs(ctx, db.Namespace, pod.Name, &corev1.PodLogOptions{
+				Container: c.Name,
+				SinceTime: &since,
+				TailLines: pointer.ToInt64(restoreLogTailLines),
+			})
+			if err != nil {
+				e.l.Debug(err)
+				continue
+			}
+			found := false
+			scanner := bufio.NewScanner(stream)
+			for scanner.Scan() {
+				if applyingLogsRegex.MatchString(scanner.Text()) {
+					found = true
+					break
+				}
+			}
+			_ = stream.Close()
+			if found {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// restoreFailureReason returns the message of the restore or the latest warning event of the restore
+// and the pods of the database cluster.
+func (e *EverestServer) restoreFailureReason(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+	restore *everestv1alpha1.DatabaseClusterRestore,
+) string {
+	if restore.Status.Message != "" {
+		return restore.Status.Message
+	}
+	objects := map[eventObject]struct{}{
+		{kind: "DatabaseClusterRestore", name: restore.Name}:                   {},
+		{kind: upstreamKinds[db.Spec.Engine.Type].restore, name: restore.Name}: {},
+	}
+	pods, err := e.kubeClient.GetPods(ctx, db.Namespace, databaseClusterLabelSelector(db))
+	if err != nil {
+		e.l.Error(err)
+		return ""
+	}
+	for _, p := range pods.Items {
+		objects[eventObject{kind: "Pod", name: p.Name}] = struct{}{}
+	}
+	events, err := e.kubeClient.ListEvents(ctx, restore.Namespace, metav1.ListOptions{})
+	if err != nil {
+		e.l.Error(err)
+		return ""
+	}
+	return latestWarning(events.Items, objects, restore.CreationTimestamp.Time)
+}
+
+// latestWarning returns the message of the latest warning event of the objects since the time.
+func latestWarning(events []corev1.Event, objects map[eventObject]struct{}, since time.Time) string {
+	var (
+		message string
+		latest  time.Time
+	)
+	for i := range events {
+		ev := &events[i]
+		if ev.Type != corev1.EventTypeWarning {
+			continue
+		}
+		if _, ok := objects[eventObject{kind: ev.InvolvedObject.Kind, name: ev.InvolvedObject.Name}]; !ok {
+			continue
+		}
+		_, last := eventTimes(ev)
+		if last.Before(since) {
+			continue
+		}
+		if message == "" || last.After(latest) {
+			message, latest = ev.Message, last
+		}
+	}
+	return message
+}
+
+// restorePhaseRecords returns the phases stored in the annotation of the restore.
+func restorePhaseRecords(restore *everestv1alpha1.DatabaseClusterRestore) ([]restorePhaseRecord, error) {
+	data, ok := restore.Annotations[restoreProgressAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	var records []restorePhaseRecord
+	if err := json.Unmarshal([]byte(data), &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// recordRestorePhase appends the phase to the records if it changed.
+// The restore is preparing since its creation.
+func recordRestorePhase(
+	records []restorePhaseRecord,
+	restore *everestv1alpha1.DatabaseClusterRestore,
+	phase RestorePhase,
+	at time.Time,
+) []restorePhaseRecord {
+	if len(records) == 0 {
+		records = []restorePhaseRecord{{Phase: restorePhasePreparing, StartedAt: restore.CreationTimestamp.UTC()}}
+	}
+	if records[len(records)-1].Phase != phase {
+		records = append(records, restorePhaseRecord{Phase: phase, StartedAt: at.UTC()})
+	}
+	return records
+}
+
+// restorePhases returns the phases with the time spent in every one.
+// The final phases take no time.
+func restorePhases(records []restorePhaseRecord, now time.Time) []DatabaseClusterRestorePhase {
+	phases := make([]DatabaseClusterRestorePhase, 0, len(records))
+	for i, r := range records {
+		p := DatabaseClusterRestorePhase{Phase: r.Phase, StartedAt: r.StartedAt}
+		switch {
+		case i+1 < len(records):
+			finished := records[i+1].StartedAt
+			p.FinishedAt = &finished
+			p.ElapsedSeconds = int64(finished.Sub(r.StartedAt).Seconds())
+		case r.Phase != restorePhaseReady && r.Phase != restorePhaseFailed:
+			p.ElapsedSeconds = int64(now.Sub(r.StartedAt).Seconds())
+		}
+		phases = append(phases, p)
+	}
+	return phases
+}
+
+// RunRestoreProgressJob runs background job recording the phases of the running restores,
+// so the time of every phase is known.
+func (e *EverestServer) RunRestoreProgressJob(ctx context.Context) {
+	e.l.Debug("Starting restore progress job.")
+
+	ticker := time.NewTicker(restoreProgressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			e.recordRestoresProgress(ctx, now)
+		}
+	}
+}
+
+func (e *EverestServer) recordRestoresProgress(ctx context.Context, now time.Time) {
+	namespaces, err := e.kubeClient.GetDBNamespaces(ctx, e.kubeClient.Namespace())
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("failed to get watched namespaces")))
+		return
+	}
+	for _, namespace := range namespaces {
+		restores, err := e.kubeClient.ListDatabaseClusterRestores(ctx, namespace, metav1.ListOptions{})
+		if err != nil {
+			e.l.Error(errors.Join(err, errors.New("failed to list database cluster restores")))
+			continue
+		}
+		for i := range restores.Items {
+			if err := e.recordRestoreProgress(ctx, &restores.Items[i], now); err != nil {
+				e.l.Error(errors.Join(err, errors.New("failed to record the restore progress")))
+			}
+		}
+	}
+}
+
+// recordRestoreProgress stores the current phase of the restore in its annotation.
+func (e *EverestServer) recordRestoreProgress(ctx context.Context, restore *everestv1alpha1.DatabaseClusterRestore, now time.Time) error {
+	records, err := restorePhaseRecords(restore)
+	if err != nil {
+		return err
+	}
+	if len(records) > 0 {
+		if last := records[len(records)-1].Phase; last == restorePhaseReady || last == restorePhaseFailed {
+			return nil
+		}
+	}
+	db, err := e.kubeClient.GetDatabaseCluster(ctx, restore.Namespace, restore.Spec.DBClusterName)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	// the restores completed before the job started are not tracked
+	if len(records) == 0 && restore.IsComplete(db.Spec.Engine.Type) && db.Status.Status == everestv1alpha1.AppStateReady {
+		return nil
+	}
+
+	phase := restorePhase(db.Spec.Engine.Type, restore, db, e.restoreApplyingLogs(ctx, db, restore))
+	updated := recordRestorePhase(records, restore, phase, now)
+	if len(updated) == len(records) {
+		return nil
+	}
+	data, err := json.Marshal(updated)
+	if err != nil {
+		return err
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		r, err := e.kubeClient.GetDatabaseClusterRestore(ctx, restore.Namespace, restore.Name)
+		if err != nil {
+			return err
+		}
+		if r.Annotations == nil {
+			r.Annotations = make(map[string]string)
+		}
+		r.Annotations[restoreProgressAnnotation] = string(data)
+		_, err = e.kubeClient.UpdateDatabaseClusterRestore(ctx, r)
+		return err
+	})
+}
@@ -0,0 +1,109 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRestorePhase(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		engine       everestv1alpha1.EngineType
+		state        string
+		dbStatus     everestv1alpha1.AppState
+		applyingLogs bool
+		phase        RestorePhase
+	}{
+		{engine: everestv1alpha1.DatabaseEnginePXC, state: "", phase: restorePhasePreparing},
+		{engine: everestv1alpha1.DatabaseEnginePXC, state: "Stopping Cluster", phase: restorePhasePreparing},
+		{engine: everestv1alpha1.DatabaseEnginePXC, state: "Restoring", phase: restorePhaseDownloadingBaseBackup},
+		{engine: everestv1alpha1.DatabaseEnginePXC, state: "Point-in-time recovering", phase: restorePhaseApplyingLogs},
+		{engine: everestv1alpha1.DatabaseEnginePXC, state: "Starting Cluster", phase: restorePhaseRestarting},
+		{engine: everestv1alpha1.DatabaseEnginePXC, state: "Succeeded", dbStatus: everestv1alpha1.AppStateInit, phase: restorePhaseRestarting},
+		{engine: everestv1alpha1.DatabaseEnginePXC, state: "Succeeded", dbStatus: everestv1alpha1.AppStateReady, phase: restorePhaseReady},
+		{engine: everestv1alpha1.DatabaseEnginePXC, state: "Failed", phase: restorePhaseFailed},
+		{engine: everestv1alpha1.DatabaseEnginePSMDB, state: "requested", phase: restorePhasePreparing},
+		{engine: everestv1alpha1.DatabaseEnginePSMDB, state: "running", phase: restorePhaseDownloadingBaseBackup},
+		{engine: everestv1alpha1.DatabaseEnginePSMDB, state: "running", applyingLogs: true, phase: restorePhaseApplyingLogs},
+		{engine: everestv1alpha1.DatabaseEnginePSMDB, state: "rejected", phase: restorePhaseFailed},
+		{engine: everestv1alpha1.DatabaseEnginePostgresql, state: "Starting", phase: restorePhasePreparing},
+		{engine: everestv1alpha1.DatabaseEnginePostgresql, state: "Running", applyingLogs: true, phase: restorePhaseApplyingLogs},
+		{engine: everestv1alpha1.DatabaseEnginePostgresql, state: "Succeeded", dbStatus: everestv1alpha1.AppStateReady, phase: restorePhaseReady},
+	}
+	for _, tc := range cases {
+		restore := &everestv1alpha1.DatabaseClusterRestore{}
+		restore.Status.State = everestv1alpha1.RestoreState(tc.state)
+		db := &everestv1alpha1.DatabaseCluster{}
+		db.Status.Status = tc.dbStatus
+		assert.Equal(t, tc.phase, restorePhase(tc.engine, restore, db, tc.applyingLogs), "%s %q", tc.engine, tc.state)
+	}
+}
+
+func TestRestorePhases(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+	restore := &everestv1alpha1.DatabaseClusterRestore{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+	}
+
+	records := recordRestorePhase(nil, restore, restorePhaseDownloadingBaseBackup, created.Add(time.Minute))
+	records = recordRestorePhase(records, restore, restorePhaseDownloadingBaseBackup, created.Add(2*time.Minute))
+	assert.Len(t, records, 2)
+
+	phases := restorePhases(records, created.Add(5*time.Minute))
+	assert.Equal(t, []DatabaseClusterRestorePhase{
+		{Phase: restorePhasePreparing, StartedAt: created, FinishedAt: pointer.ToTime(created.Add(time.Minute)), ElapsedSeconds: 60},
+		{Phase: restorePhaseDownloadingBaseBackup, StartedAt: created.Add(time.Minute), ElapsedSeconds: 240},
+	}, phases)
+
+	records = recordRestorePhase(records, restore, restorePhaseReady, created.Add(10*time.Minute))
+	phases = restorePhases(records, created.Add(20*time.Minute))
+	assert.Equal(t, int64(540), phases[1].ElapsedSeconds)
+	assert.Equal(t, DatabaseClusterRestorePhase{Phase: restorePhaseReady, StartedAt: created.Add(10 * time.Minute)}, phases[2])
+}
+
+func TestLatestWarning(t *testing.T) {
+	t.Parallel()
+	since := time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+	event := func(kind, name, eventType, message string, at time.Time) corev1.Event {
+		return corev1.Event{
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Name: name},
+			Type:           eventType,
+			Message:        message,
+			LastTimestamp:  metav1.NewTime(at),
+		}
+	}
+	objects := map[eventObject]struct{}{
+		{kind: "PerconaXtraDBClusterRestore", name: "restore"}: {},
+		{kind: "Pod", name: "db-pxc-0"}:                        {},
+	}
+	events := []corev1.Event{
+		event("PerconaXtraDBClusterRestore", "restore", corev1.EventTypeWarning, "before the restore", since.Add(-time.Hour)),
+		event("Pod", "db-pxc-0", corev1.EventTypeWarning, "Back-off restarting failed container", since.Add(2*time.Minute)),
+		event("PerconaXtraDBClusterRestore", "restore", corev1.EventTypeWarning, "backup not found", since.Add(time.Minute)),
+		event("PerconaXtraDBClusterRestore", "restore", corev1.EventTypeNormal, "restore started", since.Add(3*time.Minute)),
+		event("Pod", "other", corev1.EventTypeWarning, "other pod", since.Add(4*time.Minute)),
+	}
+	assert.Equal(t, "Back-off restarting failed container", latestWarning(events, objects, since))
+	assert.Empty(t, latestWarning(events[3:], objects, since))
+}
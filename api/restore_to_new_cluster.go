@@ -0,0 +1,353 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// restoredFromAnnotation is set on the database clusters restored from a backup
+// to the namespaced name of the backup.
+const restoredFromAnnotation = "everest.percona.com/restored-from"
+
+var (
+	errRestoreBackupNotSucceeded   = errors.New("only successful backups can be restored")
+	errIncompatibleRestoreVersion  = errors.New("the engine version is not compatible with the backup")
+	errRestoreStorageTooSmall      = errors.New("the storage can not be smaller than the storage of the source database cluster")
+	errRestoreEngineTypeUnknown    = errors.New("the source database cluster of the backup does not exist and the backup does not record its engine type, set 'engineType'")
+	errRestoreSpecRequired         = errors.New("the source database cluster of the backup does not exist, the field is required")
+	errRestoreEngineVersionUnknown = errors.New("the source database cluster of the backup does not exist and the backup does not record its engine version, set 'engineVersion'")
+)
+
+// RestoreDatabaseClusterBackupToNewCluster creates a new database cluster bootstrapped from the specified backup.
+func (e *EverestServer) RestoreDatabaseClusterBackupToNewCluster( //nolint:funlen,cyclop
+	ctx echo.Context,
+	namespace, name string,
+	params RestoreDatabaseClusterBackupToNewClusterParams,
+) error {
+	req := &RestoreToNewClusterParams{}
+	if err := e.getBodyFromContext(ctx, req); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get RestoreToNewClusterParams from the request body"),
+		})
+	}
+	if err := validateRFC1035(req.Name, "name"); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	targetNamespace := pointer.GetString(req.Namespace)
+	if targetNamespace == "" {
+		targetNamespace = namespace
+	}
+
+	reqCtx := ctx.Request().Context()
+	if targetNamespace != namespace {
+		namespaces, err := e.kubeClient.GetDBNamespaces(reqCtx, e.kubeClient.Namespace())
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed getting watched namespaces")})
+		}
+		if err := validateAllowedNamespaces([]string{targetNamespace}, namespaces); err != nil {
+			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		}
+	}
+
+	backup, err := e.kubeClient.GetDatabaseClusterBackup(reqCtx, namespace, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Backup is not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed getting backup")})
+	}
+	source, err := e.kubeClient.GetDatabaseCluster(reqCtx, namespace, backupClusterName(backup))
+	if err != nil && !k8serrors.IsNotFound(err) {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed getting database cluster")})
+	}
+	if err != nil {
+		if source, err = deletedSourceCluster(backup, req); err != nil {
+			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		}
+	}
+	if !successStatus(backup.Status.State, source.Spec.Engine.Type) {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(errRestoreBackupNotSucceeded.Error())})
+	}
+	if err := e.checkBackupStorageAllowed(reqCtx, backup.Spec.BackupStorageName, targetNamespace); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	db, err := restoredCluster(source, backup, req, targetNamespace)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	// The point-in-time recovery ranges are checked by the validation of the data source
+	// unless the backup is not visible in the target namespace.
+	if db.Spec.DataSource.PITR != nil && db.Spec.DataSource.BackupSource != nil {
+		if err := e.checkRestorePitrDate(reqCtx, backup, db.Spec.DataSource.PITR.Date); err != nil {
+			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		}
+	}
+
+	dbc := &DatabaseCluster{}
+	if err := roundTrip(db, dbc); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not convert the database cluster")})
+	}
+	if err := e.validateDatabaseClusterCR(ctx, targetNamespace, dbc, nil); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := e.enforceNamespaceQuota(reqCtx, targetNamespace, dbc); err != nil {
+		return e.quotaErrorResponse(ctx, err)
+	}
+	if err := e.checkClusterCapacity(ctx, dbc, nil, params.Force); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	// The restored data contains the users of the source database cluster, so the new one needs the same credentials.
+	secret, err := e.kubeClient.GetSecret(reqCtx, source.Namespace, source.Spec.Engine.UserSecretsName)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not get the credentials of the source database cluster"),
+		})
+	}
+	if err != nil {
+		// The operator generates new credentials, the users of the restored data keep their passwords.
+		addWarningHeader(ctx, fmt.Sprintf("The credentials of the source database cluster are not found, "+
+			"set the passwords of the restored users in the %s secret", db.Spec.Engine.UserSecretsName))
+		secret = nil
+	}
+	if secret != nil {
+		_, err = e.kubeClient.CreateSecret(reqCtx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: db.Spec.Engine.UserSecretsName, Namespace: db.Namespace},
+			Type:       secret.Type,
+			Data:       secret.Data,
+		})
+		if err != nil {
+			e.l.Error(err)
+			if k8serrors.IsAlreadyExists(err) {
+				return ctx.JSON(http.StatusConflict, Error{
+					Message: pointer.ToString(fmt.Sprintf("Secret %s already exists", db.Spec.Engine.UserSecretsName)),
+				})
+			}
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not create the credentials secret")})
+		}
+	}
+
+	created, err := e.kubeClient.CreateDatabaseCluster(reqCtx, db)
+	if err != nil {
+		if secret != nil {
+			if err := e.kubeClient.DeleteSecret(reqCtx, db.Namespace, db.Spec.Engine.UserSecretsName); err != nil {
+				e.l.Error(errors.Join(err, errors.New("could not clean up the credentials secret")))
+			}
+		}
+		return e.createDatabaseClusterErrorResponse(ctx, err)
+	}
+	return ctx.JSON(http.StatusCreated, created)
+}
+
+// deletedSourceCluster returns the stand-in of the source database cluster of the backup which does not exist anymore.
+// Its engine type is the one recorded on the backup, or the one of the request otherwise. Its engine version is the
+// one recorded on the backup, so the version of the request is checked against it, and the request has to set the
+// version if the backup does not record it. The rest of its spec is left for the request to fill in, so the
+// resources of the new database cluster are required.
+func deletedSourceCluster(
+	backup *everestv1alpha1.DatabaseClusterBackup,
+	req *RestoreToNewClusterParams,
+) (*everestv1alpha1.DatabaseCluster, error) {
+	engineType := backupEngineType(backup)
+	if engineType == "" {
+		engineType = everestv1alpha1.EngineType(pointer.GetString(req.EngineType))
+	}
+	if _, ok := operatorEngine[engineType]; !ok {
+		return nil, fmt.Errorf("%w: %s", errRestoreEngineTypeUnknown, backupClusterName(backup))
+	}
+	engineVersion := backup.Annotations[backupEngineVersionAnnotation]
+	switch {
+	case engineVersion == "" && req.EngineVersion == nil:
+		return nil, fmt.Errorf("%w: %s", errRestoreEngineVersionUnknown, backupClusterName(backup))
+	case req.Cpu == nil:
+		return nil, fmt.Errorf("%w: 'cpu'", errRestoreSpecRequired)
+	case req.Memory == nil:
+		return nil, fmt.Errorf("%w: 'memory'", errRestoreSpecRequired)
+	case req.StorageSize == nil:
+		return nil, fmt.Errorf("%w: 'storageSize'", errRestoreSpecRequired)
+	}
+	return &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: backupClusterName(backup), Namespace: backup.Namespace},
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{
+				Type:            engineType,
+				Version:         engineVersion,
+				Replicas:        1,
+				UserSecretsName: databaseClusterSecretName(backupClusterName(backup)),
+			},
+		},
+	}, nil
+}
+
+// checkRestorePitrDate checks the point-in-time recovery date is covered by the logs uploaded after the backup.
+func (e *EverestServer) checkRestorePitrDate(ctx context.Context, backup *everestv1alpha1.DatabaseClusterBackup, date *everestv1alpha1.RestoreDate) error {
+	if date == nil {
+		return errDataSourceNoPitrDateSpecified
+	}
+	ranges, err := e.backupPitrRanges(ctx, backup)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get the point-in-time recovery ranges of the backup"))
+	}
+	if ranges != nil && !pitrRangeContains(ranges, date.Time.Time) {
+		return fmt.Errorf("%w: %s", errDataSourcePitrDateOutOfRange, formatPitrRanges(ranges))
+	}
+	return nil
+}
+
+// restoredCluster returns the database cluster restored from the backup in the namespace.
+// The fields omitted in the request are copied from the source database cluster, except
+// the backups which are left for the user to configure.
+func restoredCluster( //nolint:cyclop
+	source *everestv1alpha1.DatabaseCluster,
+	backup *everestv1alpha1.DatabaseClusterBackup,
+	req *RestoreToNewClusterParams,
+	namespace string,
+) (*everestv1alpha1.DatabaseCluster, error) {
+	engine := source.Spec.Engine.DeepCopy()
+	engine.UserSecretsName = databaseClusterSecretName(req.Name)
+	if req.EngineVersion != nil {
+		if err := checkRestoreEngineVersion(source.Spec.Engine.Type, source.Spec.Engine.Version, *req.EngineVersion); err != nil {
+			return nil, err
+		}
+		engine.Version = *req.EngineVersion
+	}
+	if req.Replicas != nil {
+		engine.Replicas = *req.Replicas
+	}
+	if req.Cpu != nil {
+		cpu, err := resource.ParseQuantity(*req.Cpu)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'cpu': %w", err)
+		}
+		engine.Resources.CPU = cpu
+	}
+	if req.Memory != nil {
+		memory, err := resource.ParseQuantity(*req.Memory)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'memory': %w", err)
+		}
+		engine.Resources.Memory = memory
+	}
+	if req.StorageSize != nil {
+		size, err := resource.ParseQuantity(*req.StorageSize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'storageSize': %w", err)
+		}
+		if size.Cmp(source.Spec.Engine.Storage.Size) < 0 {
+			return nil, fmt.Errorf("%w (%s)", errRestoreStorageTooSmall, source.Spec.Engine.Storage.Size.String())
+		}
+		engine.Storage.Size = size
+	}
+	if req.StorageClass != nil {
+		engine.Storage.Class = req.StorageClass
+	}
+
+	dataSource := &everestv1alpha1.DataSource{DBClusterBackupName: backup.Name}
+	if _, copied := backup.Annotations[copiedFromAnnotation]; copied || backup.Namespace != namespace {
+		// The backup is not visible in other namespaces and the operator can't restore copies of backups by their name,
+		// so they are restored from their backup storage.
+		dataSource = &everestv1alpha1.DataSource{BackupSource: backupSource(source.Spec.Engine.Type, backup)}
+	}
+	if req.PitrDate != nil {
+		date, err := time.Parse(dateFormat, *req.PitrDate)
+		if err != nil {
+			return nil, errDataSourceWrongDateFormat
+		}
+		dataSource.PITR = &everestv1alpha1.PITR{
+			Type: everestv1alpha1.PITRTypeDate,
+			Date: &everestv1alpha1.RestoreDate{Time: metav1.NewTime(date)},
+		}
+	}
+
+	proxy := *source.Spec.Proxy.DeepCopy()
+	if req.Replicas != nil && proxy.Replicas != nil {
+		proxy.Replicas = pointer.ToInt32(engine.Replicas)
+	}
+
+	return &everestv1alpha1.DatabaseCluster{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: databaseClusterAPIVersion,
+			Kind:       databaseClusterKindName,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        req.Name,
+			Namespace:   namespace,
+			Annotations: map[string]string{restoredFromAnnotation: backup.Namespace + "/" + backup.Name},
+		},
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			AllowUnsafeConfiguration: source.Spec.AllowUnsafeConfiguration || engine.Replicas == 1,
+			Engine:                   *engine,
+			Proxy:                    proxy,
+			DataSource:               dataSource,
+			Monitoring:               source.Spec.Monitoring.DeepCopy(),
+		},
+	}, nil
+}
+
+// checkRestoreEngineVersion checks a backup taken with the source version can be restored with the target one.
+// The target version should be of the same major version and not older, since the engines can't downgrade
+// the data files and the upgrades between major versions need a separate procedure.
+// The versions are compared without the Percona build suffix, e.g. 8.0.35 for 8.0.35-27.
+func checkRestoreEngineVersion(engineType everestv1alpha1.EngineType, source, target string) error {
+	sourceVersion, err := goversion.NewVersion(source)
+	if err != nil {
+		// The version of the source database cluster may be omitted and chosen by the operator.
+		return nil //nolint:nilerr
+	}
+	targetVersion, err := goversion.NewVersion(target)
+	if err != nil {
+		return fmt.Errorf("invalid 'engineVersion': %w", err)
+	}
+	if majorVersion(engineType, sourceVersion) != majorVersion(engineType, targetVersion) {
+		return fmt.Errorf("%w: %s is not of the same major version as %s", errIncompatibleRestoreVersion, target, source)
+	}
+	if targetVersion.Core().LessThan(sourceVersion.Core()) {
+		return fmt.Errorf("%w: %s is older than %s", errIncompatibleRestoreVersion, target, source)
+	}
+	return nil
+}
+
+// majorVersion returns the major version of the engine.
+// The MySQL and MongoDB major versions have two components, e.g. 8.0, while the PostgreSQL ones have one, e.g. 15.
+func majorVersion(engineType everestv1alpha1.EngineType, v *goversion.Version) string {
+	segments := v.Segments()
+	if engineType == everestv1alpha1.DatabaseEnginePostgresql {
+		return strconv.Itoa(segments[0])
+	}
+	return fmt.Sprintf("%d.%d", segments[0], segments[1])
+}
@@ -0,0 +1,192 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheckRestoreEngineVersion(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		engine everestv1alpha1.EngineType
+		source string
+		target string
+		err    error
+	}{
+		{engine: everestv1alpha1.DatabaseEnginePXC, source: "8.0.35-27", target: "8.0.35-27"},
+		{engine: everestv1alpha1.DatabaseEnginePXC, source: "8.0.34-26", target: "8.0.35-27"},
+		{engine: everestv1alpha1.DatabaseEnginePXC, source: "8.0.35-27", target: "8.0.34-26", err: errIncompatibleRestoreVersion},
+		{engine: everestv1alpha1.DatabaseEnginePXC, source: "5.7.44-31.65", target: "8.0.35-27", err: errIncompatibleRestoreVersion},
+		{engine: everestv1alpha1.DatabaseEnginePSMDB, source: "6.0.9-7", target: "6.0.12-9"},
+		{engine: everestv1alpha1.DatabaseEnginePSMDB, source: "6.0.12-9", target: "7.0.2-1", err: errIncompatibleRestoreVersion},
+		{engine: everestv1alpha1.DatabaseEnginePostgresql, source: "15.4", target: "15.5"},
+		{engine: everestv1alpha1.DatabaseEnginePostgresql, source: "15.5", target: "16.1", err: errIncompatibleRestoreVersion},
+		{engine: everestv1alpha1.DatabaseEnginePostgresql, source: "", target: "16.1"},
+	}
+	for _, tc := range cases {
+		err := checkRestoreEngineVersion(tc.engine, tc.source, tc.target)
+		if tc.err == nil {
+			assert.NoError(t, err, "%s %s -> %s", tc.engine, tc.source, tc.target)
+			continue
+		}
+		assert.ErrorIs(t, err, tc.err, "%s %s -> %s", tc.engine, tc.source, tc.target)
+	}
+}
+
+func TestRestoredCluster(t *testing.T) {
+	t.Parallel()
+	source := &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "prod"},
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{
+				Type:     everestv1alpha1.DatabaseEnginePXC,
+				Version:  "8.0.34-26",
+				Replicas: 3,
+				Storage:  everestv1alpha1.Storage{Size: resource.MustParse("10G")},
+				Resources: everestv1alpha1.Resources{
+					CPU:    resource.MustParse("1"),
+					Memory: resource.MustParse("2G"),
+				},
+				UserSecretsName: "everest-secrets-db",
+			},
+			Proxy: everestv1alpha1.Proxy{Type: everestv1alpha1.ProxyTypeHAProxy, Replicas: pointer.ToInt32(3)},
+			Backup: everestv1alpha1.Backup{
+				Enabled:   true,
+				Schedules: []everestv1alpha1.BackupSchedule{{Name: "daily", Enabled: true, Schedule: "0 0 * * *", BackupStorageName: "s3"}},
+			},
+		},
+	}
+	backup := &everestv1alpha1.DatabaseClusterBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-backup", Namespace: "prod"},
+		Spec:       everestv1alpha1.DatabaseClusterBackupSpec{DBClusterName: "db", BackupStorageName: "s3"},
+		Status:     everestv1alpha1.DatabaseClusterBackupStatus{Destination: pointer.ToString("s3://bucket/db/uid/db-backup")},
+	}
+
+	t.Run("same namespace", func(t *testing.T) {
+		t.Parallel()
+		db, err := restoredCluster(source, backup, &RestoreToNewClusterParams{Name: "db-copy"}, "prod")
+		require.NoError(t, err)
+		assert.Equal(t, "prod", db.Namespace)
+		assert.Equal(t, "prod/db-backup", db.Annotations[restoredFromAnnotation])
+		assert.Equal(t, &everestv1alpha1.DataSource{DBClusterBackupName: "db-backup"}, db.Spec.DataSource)
+		assert.Equal(t, "everest-secrets-db-copy", db.Spec.Engine.UserSecretsName)
+		assert.Equal(t, source.Spec.Engine.Resources, db.Spec.Engine.Resources)
+		assert.Equal(t, source.Spec.Proxy, db.Spec.Proxy)
+		assert.Empty(t, db.Spec.Backup.Schedules)
+		assert.False(t, db.Spec.AllowUnsafeConfiguration)
+	})
+
+	t.Run("other namespace with overrides", func(t *testing.T) {
+		t.Parallel()
+		db, err := restoredCluster(source, backup, &RestoreToNewClusterParams{
+			Name:          "db-copy",
+			PitrDate:      pointer.ToString("2024-03-20T12:00:00Z"),
+			EngineVersion: pointer.ToString("8.0.35-27"),
+			Replicas:      pointer.ToInt32(1),
+			Cpu:           pointer.ToString("500m"),
+			StorageSize:   pointer.ToString("20G"),
+		}, "staging")
+		require.NoError(t, err)
+		assert.Equal(t, "staging", db.Namespace)
+		assert.Equal(t, &everestv1alpha1.DataSource{
+			BackupSource: &everestv1alpha1.BackupSource{Path: "db/uid/db-backup", BackupStorageName: "s3"},
+			PITR: &everestv1alpha1.PITR{
+				Type: everestv1alpha1.PITRTypeDate,
+				Date: &everestv1alpha1.RestoreDate{Time: metav1.NewTime(time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC))},
+			},
+		}, db.Spec.DataSource)
+		assert.Equal(t, "8.0.35-27", db.Spec.Engine.Version)
+		assert.Equal(t, int32(1), db.Spec.Engine.Replicas)
+		assert.Equal(t, pointer.ToInt32(1), db.Spec.Proxy.Replicas)
+		assert.True(t, db.Spec.AllowUnsafeConfiguration)
+		assert.Equal(t, resource.MustParse("500m"), db.Spec.Engine.Resources.CPU)
+		assert.Equal(t, resource.MustParse("2G"), db.Spec.Engine.Resources.Memory)
+		assert.Equal(t, resource.MustParse("20G"), db.Spec.Engine.Storage.Size)
+	})
+
+	t.Run("invalid overrides", func(t *testing.T) {
+		t.Parallel()
+		_, err := restoredCluster(source, backup, &RestoreToNewClusterParams{Name: "db-copy", StorageSize: pointer.ToString("5G")}, "prod")
+		require.ErrorIs(t, err, errRestoreStorageTooSmall)
+		_, err = restoredCluster(source, backup, &RestoreToNewClusterParams{Name: "db-copy", EngineVersion: pointer.ToString("8.0.33-25")}, "prod")
+		require.ErrorIs(t, err, errIncompatibleRestoreVersion)
+		_, err = restoredCluster(source, backup, &RestoreToNewClusterParams{Name: "db-copy", PitrDate: pointer.ToString("yesterday")}, "prod")
+		require.ErrorIs(t, err, errDataSourceWrongDateFormat)
+	})
+}
+
+func TestDeletedSourceCluster(t *testing.T) {
+	t.Parallel()
+	backup := &everestv1alpha1.DatabaseClusterBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db-imported-0a1b2c3d",
+			Namespace: "prod",
+			Annotations: map[string]string{
+				backupEngineTypeAnnotation:    string(everestv1alpha1.DatabaseEnginePSMDB),
+				backupEngineVersionAnnotation: "6.0.12-9",
+			},
+		},
+		Spec:   everestv1alpha1.DatabaseClusterBackupSpec{DBClusterName: "db.imported", BackupStorageName: "s3"},
+		Status: everestv1alpha1.DatabaseClusterBackupStatus{Destination: pointer.ToString("s3://bucket/db/uid/2024-03-20T12:00:00Z")},
+	}
+	req := &RestoreToNewClusterParams{
+		Name:        "db-restored",
+		Cpu:         pointer.ToString("1"),
+		Memory:      pointer.ToString("2G"),
+		StorageSize: pointer.ToString("10G"),
+	}
+
+	source, err := deletedSourceCluster(backup, req)
+	require.NoError(t, err)
+	assert.Equal(t, "db", source.Name)
+	assert.Equal(t, "everest-secrets-db", source.Spec.Engine.UserSecretsName)
+	db, err := restoredCluster(source, backup, req, "prod")
+	require.NoError(t, err)
+	assert.Equal(t, everestv1alpha1.DatabaseEnginePSMDB, db.Spec.Engine.Type)
+	assert.Equal(t, "6.0.12-9", db.Spec.Engine.Version)
+	assert.Equal(t, int32(1), db.Spec.Engine.Replicas)
+	assert.True(t, db.Spec.AllowUnsafeConfiguration)
+	assert.Equal(t, resource.MustParse("1"), db.Spec.Engine.Resources.CPU)
+	assert.Equal(t, resource.MustParse("2G"), db.Spec.Engine.Resources.Memory)
+	assert.Equal(t, resource.MustParse("10G"), db.Spec.Engine.Storage.Size)
+
+	_, err = deletedSourceCluster(backup, &RestoreToNewClusterParams{Name: "db-restored", Cpu: pointer.ToString("1")})
+	require.ErrorIs(t, err, errRestoreSpecRequired)
+
+	// The version of the request is checked against the version recorded on the backup.
+	_, err = restoredCluster(source, backup, &RestoreToNewClusterParams{Name: "db-restored", EngineVersion: pointer.ToString("7.0.5-3")}, "prod")
+	require.ErrorIs(t, err, errIncompatibleRestoreVersion)
+
+	unknown := backup.DeepCopy()
+	unknown.Annotations = nil
+	_, err = deletedSourceCluster(unknown, req)
+	require.ErrorIs(t, err, errRestoreEngineTypeUnknown)
+	req.EngineType = pointer.ToString(string(everestv1alpha1.DatabaseEnginePXC))
+	_, err = deletedSourceCluster(unknown, req)
+	require.ErrorIs(t, err, errRestoreEngineVersionUnknown)
+	req.EngineVersion = pointer.ToString("8.0.35-27")
+	source, err = deletedSourceCluster(unknown, req)
+	require.NoError(t, err)
+	assert.Equal(t, everestv1alpha1.DatabaseEnginePXC, source.Spec.Engine.Type)
+}
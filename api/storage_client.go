@@ -0,0 +1,170 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/AlekSi/pointer"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+// storageClient reads and writes the objects in the bucket of a backup storage.
+type storageClient interface {
+	// list lists the objects with the key prefix.
+	list(ctx context.Context, prefix string) ([]storageObject, error)
+	// read returns the content of the object.
+	read(ctx context.Context, key string) (io.ReadCloser, error)
+	// write stores the content of the object.
+	write(ctx context.Context, key string, body io.Reader) error
+	// delete deletes the object.
+	delete(ctx context.Context, key string) error
+}
+
+// newStorageClient returns the client of the bucket of the backup storage.
+func (e *EverestServer) newStorageClient(ctx context.Context, storage *everestv1alpha1.BackupStorage) (storageClient, error) {
+	secret, err := e.kubeClient.GetSecret(ctx, e.kubeClient.Namespace(), storage.Spec.CredentialsSecretName)
+	if err != nil {
+		return nil, err
+	}
+	accessKey := string(secret.Data["AWS_ACCESS_KEY_ID"])
+	secretKey := string(secret.Data["AWS_SECRET_ACCESS_KEY"])
+
+	switch storage.Spec.Type {
+	case everestv1alpha1.BackupStorageTypeS3:
+		svc, err := newS3Client(&storage.Spec.EndpointURL, accessKey, secretKey, storage.Spec.Region)
+		if err != nil {
+			return nil, err
+		}
+		return &s3StorageClient{svc: svc, bucket: storage.Spec.Bucket}, nil
+	case everestv1alpha1.BackupStorageTypeAzure:
+		client, err := newAzureClient(&storage.Spec.EndpointURL, accessKey, secretKey)
+		if err != nil {
+			return nil, err
+		}
+		return &azureStorageClient{client: client, container: storage.Spec.Bucket}, nil
+	default:
+		return nil, fmt.Errorf("unsupported backup storage type %s", storage.Spec.Type)
+	}
+}
+
+// s3StorageClient is the storageClient of S3 compatible backup storages.
+type s3StorageClient struct {
+	svc    *s3.S3
+	bucket string
+}
+
+func (c *s3StorageClient) list(ctx context.Context, prefix string) ([]storageObject, error) {
+	var objects []storageObject
+	err := c.svc.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(c.bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, o := range page.Contents {
+			objects = append(objects, storageObject{
+				key:          aws.StringValue(o.Key),
+				lastModified: aws.TimeValue(o.LastModified),
+				size:         aws.Int64Value(o.Size),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+func (c *s3StorageClient) read(ctx context.Context, key string) (io.ReadCloser, error) {
+	out, err := c.svc.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Body, nil
+}
+
+func (c *s3StorageClient) write(ctx context.Context, key string, body io.Reader) error {
+	// The uploader splits large objects into multipart uploads.
+	_, err := s3manager.NewUploaderWithClient(c.svc).UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+		Body:   body,
+	})
+	return err
+}
+
+func (c *s3StorageClient) delete(ctx context.Context, key string) error {
+	_, err := c.svc.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
+
+// azureStorageClient is the storageClient of Azure Blob Storage backup storages.
+type azureStorageClient struct {
+	client    *azblob.Client
+	container string
+}
+
+func (c *azureStorageClient) list(ctx context.Context, prefix string) ([]storageObject, error) {
+	var objects []storageObject
+	pager := c.client.NewListBlobsFlatPager(c.container, &azblob.ListBlobsFlatOptions{
+		Prefix: pointer.ToString(prefix),
+	})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range page.Segment.BlobItems {
+			o := storageObject{key: pointer.GetString(b.Name)}
+			if b.Properties != nil {
+				o.lastModified = pointer.GetTime(b.Properties.LastModified)
+				o.size = pointer.GetInt64(b.Properties.ContentLength)
+			}
+			objects = append(objects, o)
+		}
+	}
+	return objects, nil
+}
+
+func (c *azureStorageClient) read(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := c.client.DownloadStream(ctx, c.container, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (c *azureStorageClient) write(ctx context.Context, key string, body io.Reader) error {
+	_, err := c.client.UploadStream(ctx, c.container, key, body, nil)
+	return err
+}
+
+func (c *azureStorageClient) delete(ctx context.Context, key string) error {
+	_, err := c.client.DeleteBlob(ctx, c.container, key, nil)
+	return err
+}
@@ -0,0 +1,115 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+)
+
+var (
+	errStorageShrink                = errors.New("shrinking the storage of a database cluster is not supported")
+	errStorageExpansionNotSupported = errors.New("the storage can't grow because the storage class does not allow volume expansion")
+)
+
+// validateStorageResize checks the storage of the database cluster can be resized to the requested size.
+func (e *EverestServer) validateStorageResize(ctx context.Context, dbc *DatabaseCluster, oldDB *everestv1alpha1.DatabaseCluster) error {
+	db := &everestv1alpha1.DatabaseCluster{}
+	if err := roundTrip(dbc, db); err != nil {
+		return err
+	}
+	requested, current := db.Spec.Engine.Storage.Size, oldDB.Spec.Engine.Storage.Size
+	switch requested.Cmp(current) {
+	case 0:
+		return nil
+	case -1:
+		return fmt.Errorf("%w: requested %s, current %s", errStorageShrink, requested.String(), current.String())
+	}
+
+	storageClasses, err := e.kubeClient.GetStorageClasses(ctx)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get storage classes"))
+	}
+	// The existing volumes keep the storage class they were created with.
+	return checkVolumeExpansion(storageClasses, pointer.GetString(oldDB.Spec.Engine.Storage.Class))
+}
+
+// checkVolumeExpansion checks the storage class allows volume expansion.
+// The default storage class is checked if the name is empty.
+func checkVolumeExpansion(storageClasses *storagev1.StorageClassList, name string) error {
+	if name == "" {
+		name = defaultStorageClass(storageClasses)
+		if name == "" {
+			return errors.New("the database cluster uses the default storage class but there is none")
+		}
+	}
+	for _, storageClass := range storageClasses.Items {
+		if storageClass.Name != name {
+			continue
+		}
+		if !pointer.GetBool(storageClass.AllowVolumeExpansion) {
+			return fmt.Errorf("%w: storage class %s", errStorageExpansionNotSupported, name)
+		}
+		return nil
+	}
+	return fmt.Errorf("storage class %s is not found", name)
+}
+
+// volumeResize returns the progress of the resize of the persistent volume claim from its conditions.
+// The status is nil if the volume is not being resized.
+func volumeResize(pvc *corev1.PersistentVolumeClaim) (*DatabaseClusterVolumeHealthResizeStatus, string) {
+	conditions := make(map[corev1.PersistentVolumeClaimConditionType]corev1.PersistentVolumeClaimCondition, len(pvc.Status.Conditions))
+	for _, c := range pvc.Status.Conditions {
+		if c.Status == corev1.ConditionTrue {
+			conditions[c.Type] = c
+		}
+	}
+
+	resizeStatus := pvc.Status.AllocatedResourceStatuses[corev1.ResourceStorage]
+	switch {
+	case resizeStatus == corev1.PersistentVolumeClaimControllerResizeFailed,
+		resizeStatus == corev1.PersistentVolumeClaimNodeResizeFailed:
+		return pointer.To(ResizeFailed), conditions[corev1.PersistentVolumeClaimResizing].Message
+	case hasCondition(conditions, corev1.PersistentVolumeClaimFileSystemResizePending):
+		return pointer.To(FileSystemResizePending), conditions[corev1.PersistentVolumeClaimFileSystemResizePending].Message
+	case hasCondition(conditions, corev1.PersistentVolumeClaimResizing),
+		resizeStatus == corev1.PersistentVolumeClaimControllerResizeInProgress,
+		resizeStatus == corev1.PersistentVolumeClaimNodeResizePending,
+		resizeStatus == corev1.PersistentVolumeClaimNodeResizeInProgress:
+		return pointer.To(Resizing), conditions[corev1.PersistentVolumeClaimResizing].Message
+	}
+
+	requested, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	capacity, bound := pvc.Status.Capacity[corev1.ResourceStorage]
+	if ok && bound && requested.Cmp(capacity) > 0 {
+		return pointer.To(Pending), ""
+	}
+	return nil, ""
+}
+
+func hasCondition(
+	conditions map[corev1.PersistentVolumeClaimConditionType]corev1.PersistentVolumeClaimCondition,
+	t corev1.PersistentVolumeClaimConditionType,
+) bool {
+	_, ok := conditions[t]
+	return ok
+}
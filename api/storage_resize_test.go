@@ -0,0 +1,113 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheckVolumeExpansion(t *testing.T) {
+	t.Parallel()
+	storageClasses := &storagev1.StorageClassList{
+		Items: []storagev1.StorageClass{
+			{ObjectMeta: metav1.ObjectMeta{Name: "local"}},
+			{
+				ObjectMeta:           metav1.ObjectMeta{Name: "gp3", Annotations: map[string]string{annotationStorageClassDefault: "true"}},
+				AllowVolumeExpansion: pointer.ToBool(true),
+			},
+			{ObjectMeta: metav1.ObjectMeta{Name: "gp2"}, AllowVolumeExpansion: pointer.ToBool(false)},
+		},
+	}
+
+	require.NoError(t, checkVolumeExpansion(storageClasses, "gp3"))
+	require.NoError(t, checkVolumeExpansion(storageClasses, ""))
+	require.ErrorIs(t, checkVolumeExpansion(storageClasses, "gp2"), errStorageExpansionNotSupported)
+	require.ErrorIs(t, checkVolumeExpansion(storageClasses, "local"), errStorageExpansionNotSupported)
+	require.EqualError(t, checkVolumeExpansion(storageClasses, "io2"), "storage class io2 is not found")
+
+	assert.Equal(t, []StorageClassInfo{
+		{Name: "gp3", Default: true, AllowVolumeExpansion: true},
+		{Name: "local"},
+		{Name: "gp2"},
+	}, storageClassInfos(storageClasses))
+}
+
+func TestVolumeResize(t *testing.T) {
+	t.Parallel()
+	pvc := func(requested, capacity string, conditions []corev1.PersistentVolumeClaimCondition, status corev1.ClaimResourceStatus) *corev1.PersistentVolumeClaim {
+		p := &corev1.PersistentVolumeClaim{
+			Spec: corev1.PersistentVolumeClaimSpec{
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(requested)},
+				},
+			},
+			Status: corev1.PersistentVolumeClaimStatus{
+				Capacity:   corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(capacity)},
+				Conditions: conditions,
+			},
+		}
+		if status != "" {
+			p.Status.AllocatedResourceStatuses = map[corev1.ResourceName]corev1.ClaimResourceStatus{corev1.ResourceStorage: status}
+		}
+		return p
+	}
+	resizing := []corev1.PersistentVolumeClaimCondition{{
+		Type: corev1.PersistentVolumeClaimResizing, Status: corev1.ConditionTrue, Message: "waiting for the external resizer",
+	}}
+	fsPending := []corev1.PersistentVolumeClaimCondition{{
+		Type: corev1.PersistentVolumeClaimFileSystemResizePending, Status: corev1.ConditionTrue, Message: "waiting for the pod to restart",
+	}}
+
+	cases := []struct {
+		name    string
+		pvc     *corev1.PersistentVolumeClaim
+		status  *DatabaseClusterVolumeHealthResizeStatus
+		message string
+	}{
+		{name: "not resized", pvc: pvc("10Gi", "10Gi", nil, "")},
+		{name: "capacity above the request", pvc: pvc("10Gi", "12Gi", nil, "")},
+		{name: "pending", pvc: pvc("20Gi", "10Gi", nil, ""), status: pointer.To(Pending)},
+		{
+			name: "resizing", pvc: pvc("20Gi", "10Gi", resizing, corev1.PersistentVolumeClaimControllerResizeInProgress),
+			status: pointer.To(Resizing), message: "waiting for the external resizer",
+		},
+		{name: "node resize", pvc: pvc("20Gi", "10Gi", nil, corev1.PersistentVolumeClaimNodeResizePending), status: pointer.To(Resizing)},
+		{
+			name: "file system resize pending", pvc: pvc("20Gi", "10Gi", fsPending, ""),
+			status: pointer.To(FileSystemResizePending), message: "waiting for the pod to restart",
+		},
+		{
+			name: "failed", pvc: pvc("20Gi", "10Gi", resizing, corev1.PersistentVolumeClaimControllerResizeFailed),
+			status: pointer.To(ResizeFailed), message: "waiting for the external resizer",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			status, message := volumeResize(tc.pvc)
+			assert.Equal(t, tc.status, status)
+			assert.Equal(t, tc.message, message)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+// pgMaxStandbys is the maximum number of PostgreSQL standbys accepted without allowUnsafeConfiguration.
+// Every standby streams the WAL from the primary, so it adds load to the primary.
+const pgMaxStandbys = 4
+
+var (
+	errUnsafeTopology    = errors.New("unsafe topology. Set spec.allowUnsafeConfiguration to accept it")
+	errNoEngineNodes     = errors.New("'spec.engine.replicas' should be at least 1")
+	psmdbReplicaSetSizes = []int32{1, 3, 5, 7} //nolint:gochecknoglobals
+)
+
+// topologyIssues holds the findings about the replicas of a database cluster.
+type topologyIssues struct {
+	// unsafe layouts are accepted only if spec.allowUnsafeConfiguration is set.
+	unsafe []string
+	// notRecommended layouts are accepted with a warning.
+	notRecommended []string
+}
+
+// validateTopology checks the number of engine and proxy replicas of the database cluster.
+// The replicas of the existing database cluster are checked only if they change, so the
+// database clusters with an unsafe topology can still be updated.
+// It returns the warnings for the accepted layouts which are not recommended.
+func validateTopology(cluster *DatabaseCluster, existing *everestv1alpha1.DatabaseCluster) ([]string, error) {
+	if cluster.Spec != nil && cluster.Spec.Engine.Replicas != nil && *cluster.Spec.Engine.Replicas < 1 {
+		return nil, errNoEngineNodes
+	}
+	db := &everestv1alpha1.DatabaseCluster{}
+	if err := roundTrip(cluster, db); err != nil {
+		return nil, err
+	}
+	db.Spec.Engine.Replicas = engineReplicas(db)
+	if existing != nil && engineReplicas(existing) == db.Spec.Engine.Replicas &&
+		pointer.GetInt32(existing.Spec.Proxy.Replicas) == pointer.GetInt32(db.Spec.Proxy.Replicas) {
+		return nil, nil
+	}
+
+	issues := databaseClusterTopology(db)
+	if len(issues.unsafe) == 0 {
+		return issues.notRecommended, nil
+	}
+	if !db.Spec.AllowUnsafeConfiguration {
+		return nil, fmt.Errorf("%w: %s", errUnsafeTopology, strings.Join(issues.unsafe, "; "))
+	}
+	warnings := make([]string, 0, len(issues.unsafe)+len(issues.notRecommended))
+	for _, u := range issues.unsafe {
+		warnings = append(warnings, "unsafe configuration: "+u)
+	}
+	return append(warnings, issues.notRecommended...), nil
+}
+
+// databaseClusterTopology applies the topology rules of the engine and the proxy to the database cluster.
+func databaseClusterTopology(db *everestv1alpha1.DatabaseCluster) topologyIssues {
+	issues := topologyIssues{}
+	nodes := db.Spec.Engine.Replicas
+
+	switch db.Spec.Engine.Type {
+	case everestv1alpha1.DatabaseEnginePXC:
+		if nodes%2 == 0 {
+			issues.unsafe = append(issues.unsafe,
+				fmt.Sprintf("%d PXC nodes can't keep the quorum if half of them fail, use an odd number of nodes", nodes))
+		}
+		if nodes == 1 {
+			issues.notRecommended = append(issues.notRecommended, "a single PXC node is not highly available")
+		}
+	case everestv1alpha1.DatabaseEnginePSMDB:
+		if !slices.Contains(psmdbReplicaSetSizes, nodes) {
+			issues.unsafe = append(issues.unsafe,
+				fmt.Sprintf("a MongoDB replica set of %d members can't elect a primary reliably, use 1, 3, 5 or 7 members", nodes))
+		}
+		if nodes == 1 {
+			issues.notRecommended = append(issues.notRecommended, "a MongoDB replica set of a single member is not highly available")
+		}
+	case everestv1alpha1.DatabaseEnginePostgresql:
+		if standbys := nodes - 1; standbys > pgMaxStandbys {
+			issues.unsafe = append(issues.unsafe,
+				fmt.Sprintf("%d PostgreSQL standbys overload the primary, use at most %d", standbys, pgMaxStandbys))
+		}
+		if nodes == 1 {
+			issues.notRecommended = append(issues.notRecommended, "a PostgreSQL cluster without standbys is not highly available")
+		}
+	}
+
+	if db.Spec.Proxy.Replicas == nil {
+		return issues
+	}
+	proxies := *db.Spec.Proxy.Replicas
+	switch {
+	case proxies < 1:
+		issues.unsafe = append(issues.unsafe, "the database cluster has no proxy replicas")
+	case proxies == 1 && nodes > 1:
+		issues.notRecommended = append(issues.notRecommended,
+			fmt.Sprintf("a single proxy is a single point of failure for %d database nodes, use at least 2 proxies", nodes))
+	case proxies > nodes && nodes > 1:
+		issues.notRecommended = append(issues.notRecommended,
+			fmt.Sprintf("%d proxies for %d database nodes do not improve availability", proxies, nodes))
+	}
+	return issues
+}
@@ -0,0 +1,125 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTopology(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		cluster  string
+		existing string
+		warnings []string
+		err      error
+	}{
+		{
+			name:    "pxc",
+			cluster: `{"spec": {"engine": {"type": "pxc", "replicas": 3}, "proxy": {"type": "haproxy", "replicas": 3}}}`,
+		},
+		{
+			name:     "single pxc node",
+			cluster:  `{"spec": {"engine": {"type": "pxc", "replicas": 1}, "proxy": {"type": "haproxy", "replicas": 1}}}`,
+			warnings: []string{"a single PXC node is not highly available"},
+		},
+		{
+			name:    "even pxc nodes",
+			cluster: `{"spec": {"engine": {"type": "pxc", "replicas": 4}, "proxy": {"type": "haproxy", "replicas": 2}}}`,
+			err:     errUnsafeTopology,
+		},
+		{
+			name: "even pxc nodes allowed",
+			cluster: `{"spec": {"allowUnsafeConfiguration": true,
+				"engine": {"type": "pxc", "replicas": 4}, "proxy": {"type": "haproxy", "replicas": 6}}}`,
+			warnings: []string{
+				"unsafe configuration: 4 PXC nodes can't keep the quorum if half of them fail, use an odd number of nodes",
+				"6 proxies for 4 database nodes do not improve availability",
+			},
+		},
+		{
+			name:    "psmdb",
+			cluster: `{"spec": {"engine": {"type": "psmdb", "replicas": 5}}}`,
+		},
+		{
+			name:    "psmdb replica set size",
+			cluster: `{"spec": {"engine": {"type": "psmdb", "replicas": 9}}}`,
+			err:     errUnsafeTopology,
+		},
+		{
+			name:     "single postgresql node",
+			cluster:  `{"spec": {"engine": {"type": "postgresql", "replicas": 1}, "proxy": {"type": "pgbouncer", "replicas": 1}}}`,
+			warnings: []string{"a PostgreSQL cluster without standbys is not highly available"},
+		},
+		{
+			name:    "too many postgresql standbys",
+			cluster: `{"spec": {"engine": {"type": "postgresql", "replicas": 6}, "proxy": {"type": "pgbouncer", "replicas": 3}}}`,
+			err:     errUnsafeTopology,
+		},
+		{
+			name:     "single proxy",
+			cluster:  `{"spec": {"engine": {"type": "postgresql", "replicas": 3}, "proxy": {"type": "pgbouncer", "replicas": 1}}}`,
+			warnings: []string{"a single proxy is a single point of failure for 3 database nodes, use at least 2 proxies"},
+		},
+		{
+			name:    "no engine nodes",
+			cluster: `{"spec": {"engine": {"type": "pxc", "replicas": 0}}}`,
+			err:     errNoEngineNodes,
+		},
+		{
+			name:    "default engine nodes",
+			cluster: `{"spec": {"engine": {"type": "psmdb"}}}`,
+		},
+		{
+			name:     "unchanged unsafe topology",
+			cluster:  `{"spec": {"engine": {"type": "pxc", "replicas": 2}, "proxy": {"type": "haproxy", "replicas": 2}}}`,
+			existing: `{"spec": {"engine": {"type": "pxc", "replicas": 2}, "proxy": {"type": "haproxy", "replicas": 2}}}`,
+		},
+		{
+			name:     "changed unsafe topology",
+			cluster:  `{"spec": {"engine": {"type": "pxc", "replicas": 4}, "proxy": {"type": "haproxy", "replicas": 2}}}`,
+			existing: `{"spec": {"engine": {"type": "pxc", "replicas": 2}, "proxy": {"type": "haproxy", "replicas": 2}}}`,
+			err:      errUnsafeTopology,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cluster := &DatabaseCluster{}
+			require.NoError(t, json.Unmarshal([]byte(tc.cluster), cluster))
+
+			var existing *everestv1alpha1.DatabaseCluster
+			if tc.existing != "" {
+				existing = &everestv1alpha1.DatabaseCluster{}
+				require.NoError(t, json.Unmarshal([]byte(tc.existing), existing))
+			}
+
+			warnings, err := validateTopology(cluster, existing)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.warnings, warnings)
+		})
+	}
+}
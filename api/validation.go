@@ -79,6 +79,7 @@ var (
 	errDataSourceConfig              = errors.New("either DBClusterBackupName or BackupSource must be specified in the DataSource field")
 	errDataSourceNoPitrDateSpecified = errors.New("pitr Date must be specified for type Date")
 	errDataSourceWrongDateFormat     = errors.New("failed to parse .Spec.DataSource.Pitr.Date as 2006-01-02T15:04:05Z")
+	errDataSourcePitrDateOutOfRange  = errors.New(".Spec.DataSource.Pitr.Date is out of the point-in-time recovery ranges of the backup")
 	errDataSourceNoBackupStorageName = errors.New("'backupStorageName' should be specified in .Spec.DataSource.BackupSource")
 	errDataSourceNoPath              = errors.New("'path' should be specified in .Spec.DataSource.BackupSource")
 	errIncorrectDataSourceStruct     = errors.New("incorrect data source struct")
@@ -148,17 +149,14 @@ func validateStorageAccessByCreate(ctx context.Context, params CreateBackupStora
 	case CreateBackupStorageParamsTypeS3:
 		return s3Access(l, params.Url, params.AccessKey, params.SecretKey, params.BucketName, params.Region)
 	case CreateBackupStorageParamsTypeAzure:
-		return azureAccess(ctx, l, params.AccessKey, params.SecretKey, params.BucketName)
+		return azureAccess(ctx, l, params.Url, params.AccessKey, params.SecretKey, params.BucketName)
 	default:
 		return ErrCreateStorageNotSupported(string(params.Type))
 	}
 }
 
-func s3Access(l *zap.SugaredLogger, endpoint *string, accessKey, secretKey, bucketName, region string) error {
-	if config.Debug {
-		return nil
-	}
-
+// newS3Client returns the S3 client of the bucket endpoint.
+func newS3Client(endpoint *string, accessKey, secretKey, region string) (*s3.S3, error) {
 	if endpoint != nil && *endpoint == "" {
 		endpoint = nil
 	}
@@ -168,14 +166,41 @@ func s3Access(l *zap.SugaredLogger, endpoint *string, accessKey, secretKey, buck
 		Endpoint:    endpoint,
 		Region:      aws.String(region),
 		Credentials: credentials.NewStaticCredentials(accessKey, secretKey, ""),
+		// S3 compatible storages like MinIO don't serve the buckets as subdomains of the endpoint.
+		S3ForcePathStyle: aws.Bool(endpoint != nil && *endpoint != ""),
 	})
 	if err != nil {
-		l.Error(err)
-		return errors.New("could not initialize S3 session")
+		return nil, err
 	}
 
 	// Create a new S3 client with the session
-	svc := s3.New(sess)
+	return s3.New(sess), nil
+}
+
+// newAzureClient returns the Azure Blob Storage client of the account.
+// The endpoint overrides the public one of the account, e.g. for Azurite.
+func newAzureClient(endpoint *string, accountName, accountKey string) (*azblob.Client, error) {
+	cred, err := azblob.NewSharedKeyCredential(accountName, accountKey)
+	if err != nil {
+		return nil, err
+	}
+	serviceURL := fmt.Sprintf("https://%s.blob.core.windows.net/", url.PathEscape(accountName))
+	if endpoint != nil && *endpoint != "" {
+		serviceURL = *endpoint
+	}
+	return azblob.NewClientWithSharedKeyCredential(serviceURL, cred, nil)
+}
+
+func s3Access(l *zap.SugaredLogger, endpoint *string, accessKey, secretKey, bucketName, region string) error {
+	if config.Debug {
+		return nil
+	}
+
+	svc, err := newS3Client(endpoint, accessKey, secretKey, region)
+	if err != nil {
+		l.Error(err)
+		return errors.New("could not initialize S3 session")
+	}
 
 	_, err = svc.HeadBucket(&s3.HeadBucketInput{
 		Bucket: aws.String(bucketName),
@@ -217,18 +242,12 @@ func s3Access(l *zap.SugaredLogger, endpoint *string, accessKey, secretKey, buck
 	return nil
 }
 
-func azureAccess(ctx context.Context, l *zap.SugaredLogger, accountName, accountKey, containerName string) error {
+func azureAccess(ctx context.Context, l *zap.SugaredLogger, endpoint *string, accountName, accountKey, containerName string) error {
 	if config.Debug {
 		return nil
 	}
 
-	cred, err := azblob.NewSharedKeyCredential(accountName, accountKey)
-	if err != nil {
-		l.Error(err)
-		return errors.New("could not initialize Azure credentials")
-	}
-
-	client, err := azblob.NewClientWithSharedKeyCredential(fmt.Sprintf("https://%s.blob.core.windows.net/", url.PathEscape(accountName)), cred, nil)
+	client, err := newAzureClient(endpoint, accountName, accountKey)
 	if err != nil {
 		l.Error(err)
 		return errors.New("could not initialize Azure client")
@@ -288,7 +307,7 @@ func validateBackupStorageAccess(ctx echo.Context, sType string, url *string, bu
 			return err
 		}
 	case string(BackupStorageTypeAzure):
-		if err := azureAccess(ctx.Request().Context(), l, accessKey, secretKey, bucketName); err != nil {
+		if err := azureAccess(ctx.Request().Context(), l, url, accessKey, secretKey, bucketName); err != nil {
 			return err
 		}
 	default:
@@ -498,7 +517,13 @@ func nameFromDatabaseCluster(dbc DatabaseCluster) (string, string, error) {
 	return strName, strNS, nil
 }
 
-func (e *EverestServer) validateDatabaseClusterCR(ctx echo.Context, namespace string, databaseCluster *DatabaseCluster) error { //nolint:cyclop
+// validateDatabaseClusterCR validates the database cluster. The existing database cluster is nil unless it is updated.
+func (e *EverestServer) validateDatabaseClusterCR( //nolint:cyclop
+	ctx echo.Context,
+	namespace string,
+	databaseCluster *DatabaseCluster,
+	existing *everestv1alpha1.DatabaseCluster,
+) error {
 	if err := validateCreateDatabaseClusterRequest(*databaseCluster); err != nil {
 		return err
 	}
@@ -524,7 +549,18 @@ func (e *EverestServer) validateDatabaseClusterCR(ctx echo.Context, namespace st
 			return err
 		}
 	}
-	if err := validateBackupSpec(databaseCluster); err != nil {
+	warnings, err := validateEngineConfig(databaseCluster, existing)
+	if err != nil {
+		return err
+	}
+	topologyWarnings, err := validateTopology(databaseCluster, existing)
+	if err != nil {
+		return err
+	}
+	for _, w := range append(warnings, topologyWarnings...) {
+		addWarningHeader(ctx, w)
+	}
+	if err := validateBackupSpec(databaseCluster, existing); err != nil {
 		return err
 	}
 
@@ -533,7 +569,14 @@ func (e *EverestServer) validateDatabaseClusterCR(ctx echo.Context, namespace st
 	}
 
 	if databaseCluster.Spec.DataSource != nil {
-		if err := validateDBDataSource(databaseCluster); err != nil {
+		var pitrRanges []DatabaseClusterPitrRange
+		if databaseCluster.Spec.DataSource.Pitr != nil && databaseCluster.Spec.DataSource.DbClusterBackupName != nil {
+			pitrRanges, err = e.dataSourcePitrRanges(ctx.Request().Context(), namespace, *databaseCluster.Spec.DataSource.DbClusterBackupName)
+			if err != nil {
+				return err
+			}
+		}
+		if err := validateDBDataSource(databaseCluster, pitrRanges); err != nil {
 			return err
 		}
 	}
@@ -681,7 +724,7 @@ func validateProxy(engineType DatabaseClusterSpecEngineType, proxyType string) e
 	return nil
 }
 
-func validateBackupSpec(cluster *DatabaseCluster) error {
+func validateBackupSpec(cluster *DatabaseCluster, existing *everestv1alpha1.DatabaseCluster) error {
 	if cluster.Spec.Backup == nil {
 		return nil
 	}
@@ -696,6 +739,7 @@ func validateBackupSpec(cluster *DatabaseCluster) error {
 		return err
 	}
 
+	schedules := make([]everestv1alpha1.BackupSchedule, 0, len(*cluster.Spec.Backup.Schedules))
 	for _, schedule := range *cluster.Spec.Backup.Schedules {
 		if schedule.Name == "" {
 			return errNoNameInSchedule
@@ -703,8 +747,18 @@ func validateBackupSpec(cluster *DatabaseCluster) error {
 		if schedule.Enabled && schedule.BackupStorageName == "" {
 			return errScheduleNoBackupStorageName
 		}
-	}
-	return nil
+		schedules = append(schedules, everestv1alpha1.BackupSchedule{
+			Enabled:           schedule.Enabled,
+			Name:              schedule.Name,
+			Schedule:          schedule.Schedule,
+			BackupStorageName: schedule.BackupStorageName,
+		})
+	}
+	var existingSchedules []everestv1alpha1.BackupSchedule
+	if existing != nil {
+		existingSchedules = existing.Spec.Backup.Schedules
+	}
+	return validateBackupSchedules(schedules, existingSchedules)
 }
 
 func validatePitrSpec(cluster *DatabaseCluster) error {
@@ -737,33 +791,47 @@ func validateResourceLimits(cluster *DatabaseCluster) error {
 	return validateStorageSize(cluster)
 }
 
-func validateDBDataSource(db *DatabaseCluster) error {
+func validateDBDataSource(db *DatabaseCluster, pitrRanges []DatabaseClusterPitrRange) error {
 	bytes, err := json.Marshal(db.Spec.DataSource)
 	if err != nil {
 		return errIncorrectDataSourceStruct
 	}
-	return validateCommonDataSourceStruct(bytes)
+	return validateCommonDataSourceStruct(bytes, pitrRanges)
 }
 
-func validateRestoreDataSource(restore *DatabaseClusterRestore) error {
+func validateRestoreDataSource(restore *DatabaseClusterRestore, pitrRanges []DatabaseClusterPitrRange) error {
 	bytes, err := json.Marshal(restore.Spec.DataSource)
 	if err != nil {
 		return errIncorrectDataSourceStruct
 	}
-	return validateCommonDataSourceStruct(bytes)
+	return validateCommonDataSourceStruct(bytes, pitrRanges)
+}
+
+// dataSourcePitrRanges returns the point-in-time recovery ranges of the backup the data source refers to.
+// It returns nil if the ranges are unknown.
+func (e *EverestServer) dataSourcePitrRanges(ctx context.Context, namespace, backupName string) ([]DatabaseClusterPitrRange, error) {
+	backup, err := e.kubeClient.GetDatabaseClusterBackup(ctx, namespace, backupName)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("backup %s does not exist", backupName)
+		}
+		return nil, err
+	}
+	return e.backupPitrRanges(ctx, backup)
 }
 
-func validateCommonDataSourceStruct(data []byte) error {
+func validateCommonDataSourceStruct(data []byte, pitrRanges []DatabaseClusterPitrRange) error {
 	// marshal and unmarshal to use the same validation func to validate DataSource for both db and restore
 	ds := &dataSourceStruct{}
 	err := json.Unmarshal(data, ds)
 	if err != nil {
 		return errIncorrectDataSourceStruct
 	}
-	return validateDataSource(*ds)
+	return validateDataSource(*ds, pitrRanges)
 }
 
-func validateDataSource(dataSource dataSourceStruct) error {
+// validateDataSource validates the data source. The point-in-time recovery date is checked against the ranges unless they are nil.
+func validateDataSource(dataSource dataSourceStruct, pitrRanges []DatabaseClusterPitrRange) error {
 	if (dataSource.DbClusterBackupName == nil && dataSource.BackupSource == nil) ||
 		(dataSource.DbClusterBackupName != nil && *dataSource.DbClusterBackupName != "" && dataSource.BackupSource != nil) {
 		return errDataSourceConfig
@@ -785,9 +853,13 @@ func validateDataSource(dataSource dataSourceStruct) error {
 				return errDataSourceNoPitrDateSpecified
 			}
 
-			if _, err := time.Parse(dateFormat, *dataSource.Pitr.Date); err != nil {
+			date, err := time.Parse(dateFormat, *dataSource.Pitr.Date)
+			if err != nil {
 				return errDataSourceWrongDateFormat
 			}
+			if pitrRanges != nil && !pitrRangeContains(pitrRanges, date) {
+				return fmt.Errorf("%w: %s", errDataSourcePitrDateOutOfRange, formatPitrRanges(pitrRanges))
+			}
 		} else {
 			return errUnsupportedPitrType
 		}
@@ -961,7 +1033,13 @@ func validatePGReposForBackup(ctx context.Context, db everestv1alpha1.DatabaseCl
 	return nil
 }
 
-func validateDatabaseClusterRestore(ctx context.Context, namespace string, restore *DatabaseClusterRestore, kubeClient *kubernetes.Kubernetes) error {
+func validateDatabaseClusterRestore(
+	ctx context.Context,
+	namespace string,
+	restore *DatabaseClusterRestore,
+	kubeClient *kubernetes.Kubernetes,
+	pitrRangesFunc func(context.Context, *everestv1alpha1.DatabaseClusterBackup) ([]DatabaseClusterPitrRange, error),
+) error {
 	if restore == nil {
 		return errors.New("restore cannot be empty")
 	}
@@ -1003,7 +1081,14 @@ func validateDatabaseClusterRestore(ctx context.Context, namespace string, resto
 		}
 		return err
 	}
-	if err = validateRestoreDataSource(restore); err != nil {
+	var pitrRanges []DatabaseClusterPitrRange
+	if r.Spec.DataSource.PITR != nil {
+		pitrRanges, err = pitrRangesFunc(ctx, b)
+		if err != nil {
+			return err
+		}
+	}
+	if err = validateRestoreDataSource(restore, pitrRanges); err != nil {
 		return err
 	}
 	return err
@@ -1049,6 +1134,10 @@ func validatePGReposForAPIDB(ctx context.Context, dbc *DatabaseCluster, getBacku
 	}
 
 	for _, backup := range backups.Items {
+		// copies of backups are not restored from the repos of the database cluster
+		if _, ok := backup.Annotations[copiedFromAnnotation]; ok {
+			continue
+		}
 		// repos count is increased only if there wasn't such a BS used
 		if _, ok := bs[backup.Spec.BackupStorageName]; !ok {
 			bs[backup.Spec.BackupStorageName] = true
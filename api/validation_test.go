@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/AlekSi/pointer"
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
@@ -426,7 +427,7 @@ func TestValidateBackupSpec(t *testing.T) {
 		},
 		{
 			name:    "valid spec",
-			cluster: []byte(`{"spec": {"backup": {"enabled": true, "schedules": [{"enabled": true, "name": "name", "backupStorageName": "some"}]}}}`),
+			cluster: []byte(`{"spec": {"backup": {"enabled": true, "schedules": [{"enabled": true, "name": "name", "backupStorageName": "some", "schedule": "0 0 * * *"}]}}}`),
 			err:     nil,
 		},
 	}
@@ -437,7 +438,7 @@ func TestValidateBackupSpec(t *testing.T) {
 			cluster := &DatabaseCluster{}
 			err := json.Unmarshal(tc.cluster, cluster)
 			require.NoError(t, err)
-			err = validateBackupSpec(cluster)
+			err = validateBackupSpec(cluster, nil)
 			if tc.err == nil {
 				require.NoError(t, err)
 				return
@@ -725,7 +726,7 @@ func TestValidateDataSource(t *testing.T) {
 			dsDB := &dataSourceStruct{}
 			err := json.Unmarshal(tc.cluster, dsDB)
 			require.NoError(t, err)
-			err = validateDataSource(*dsDB)
+			err = validateDataSource(*dsDB, nil)
 			if tc.err == nil {
 				require.NoError(t, err)
 				return
@@ -736,6 +737,23 @@ func TestValidateDataSource(t *testing.T) {
 	}
 }
 
+func TestValidateDataSourcePitrRanges(t *testing.T) {
+	t.Parallel()
+	ds := dataSourceStruct{}
+	require.NoError(t, json.Unmarshal([]byte(`{"dbClusterBackupName":"some-backup","pitr":{"date":"2024-01-02T10:00:00Z"}}`), &ds))
+	ranges := []DatabaseClusterPitrRange{{
+		EarliestDate: time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),
+		LatestDate:   time.Date(2024, 1, 2, 11, 0, 0, 0, time.UTC),
+		BackupName:   "some-backup",
+	}}
+
+	require.NoError(t, validateDataSource(ds, nil))
+	require.NoError(t, validateDataSource(ds, ranges))
+	ranges[0].LatestDate = time.Date(2024, 1, 2, 9, 30, 0, 0, time.UTC)
+	require.ErrorIs(t, validateDataSource(ds, ranges), errDataSourcePitrDateOutOfRange)
+	require.ErrorIs(t, validateDataSource(ds, []DatabaseClusterPitrRange{}), errDataSourcePitrDateOutOfRange)
+}
+
 func TestValidatePGReposForAPIDB(t *testing.T) {
 	t.Parallel()
 	cases := []struct {
@@ -19,6 +19,7 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/oapi-codegen/runtime"
+	"gopkg.in/yaml.v2"
 )
 
 // Defines values for BackupStorageType.
@@ -60,12 +61,42 @@ const (
 	Proxysql  DatabaseClusterSpecProxyType = "proxysql"
 )
 
+// Defines values for DatabaseClusterEventSeverity.
+const (
+	Info    DatabaseClusterEventSeverity = "info"
+	Warning DatabaseClusterEventSeverity = "warning"
+)
+
+// Defines values for DatabaseClusterHealthStatus.
+const (
+	Degraded  DatabaseClusterHealthStatus = "degraded"
+	Healthy   DatabaseClusterHealthStatus = "healthy"
+	Paused    DatabaseClusterHealthStatus = "paused"
+	Unhealthy DatabaseClusterHealthStatus = "unhealthy"
+)
+
+// Defines values for DatabaseClusterPodHealthRole.
+const (
+	Backup DatabaseClusterPodHealthRole = "backup"
+	Engine DatabaseClusterPodHealthRole = "engine"
+	Other  DatabaseClusterPodHealthRole = "other"
+	Proxy  DatabaseClusterPodHealthRole = "proxy"
+)
+
 // Defines values for DatabaseClusterRestoreSpecDataSourcePitrType.
 const (
 	DatabaseClusterRestoreSpecDataSourcePitrTypeDate   DatabaseClusterRestoreSpecDataSourcePitrType = "date"
 	DatabaseClusterRestoreSpecDataSourcePitrTypeLatest DatabaseClusterRestoreSpecDataSourcePitrType = "latest"
 )
 
+// Defines values for DatabaseClusterVolumeHealthResizeStatus.
+const (
+	FileSystemResizePending DatabaseClusterVolumeHealthResizeStatus = "fileSystemResizePending"
+	Pending                 DatabaseClusterVolumeHealthResizeStatus = "pending"
+	ResizeFailed            DatabaseClusterVolumeHealthResizeStatus = "resizeFailed"
+	Resizing                DatabaseClusterVolumeHealthResizeStatus = "resizing"
+)
+
 // Defines values for MonitoringInstanceBaseType.
 const (
 	MonitoringInstanceBaseTypePmm MonitoringInstanceBaseType = "pmm"
@@ -86,6 +117,242 @@ const (
 	MonitoringInstanceUpdateParamsTypePmm MonitoringInstanceUpdateParamsType = "pmm"
 )
 
+// Defines values for PowerScheduleStatusLastAction.
+const (
+	Pause  PowerScheduleStatusLastAction = "pause"
+	Resume PowerScheduleStatusLastAction = "resume"
+)
+
+// Defines values for PowerScheduleStatusResult.
+const (
+	Failed    PowerScheduleStatusResult = "failed"
+	Succeeded PowerScheduleStatusResult = "succeeded"
+)
+
+// Defines values for SettingSource.
+const (
+	Cluster   SettingSource = "cluster"
+	Namespace SettingSource = "namespace"
+	None      SettingSource = "none"
+)
+
+// Defines values for ExportDatabaseClusterParamsFormat.
+const (
+	Json ExportDatabaseClusterParamsFormat = "json"
+	Yaml ExportDatabaseClusterParamsFormat = "yaml"
+)
+
+// BackupArtifact backup found in a backup storage
+type BackupArtifact struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// DatabaseClusterBackupName Name of the DatabaseClusterBackup referring to the backup
+	DatabaseClusterBackupName *string `json:"databaseClusterBackupName,omitempty"`
+
+	// DatabaseClusterBackupNamespace Namespace of the DatabaseClusterBackup referring to the backup
+	DatabaseClusterBackupNamespace *string `json:"databaseClusterBackupNamespace,omitempty"`
+
+	// DatabaseClusterName Name of the source database cluster
+	DatabaseClusterName string `json:"databaseClusterName"`
+
+	// DatabaseClusterUID UID of the source database cluster
+	DatabaseClusterUID *string `json:"databaseClusterUID,omitempty"`
+
+	// Destination Location of the backup as set in the status of DatabaseClusterBackup
+	Destination string `json:"destination"`
+
+	// EngineType Engine type of the backup, recognized from the layout
+	EngineType string `json:"engineType"`
+
+	// Name Name of the backup in the backup storage
+	Name string `json:"name"`
+
+	// Orphaned No DatabaseClusterBackup refers to the backup
+	Orphaned  bool   `json:"orphaned"`
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+}
+
+// BackupArtifactImport defines model for BackupArtifactImport.
+type BackupArtifactImport struct {
+	// Destinations Destinations of the backups to import
+	Destinations []string `json:"destinations"`
+
+	// Namespace Namespace of the DatabaseClusterBackup objects
+	Namespace string `json:"namespace"`
+}
+
+// BackupArtifactList defines model for BackupArtifactList.
+type BackupArtifactList = []BackupArtifact
+
+// BackupCopy copy of a backup in another backup storage
+type BackupCopy struct {
+	// BackupStorageName Name of the backup storage the backup is copied to
+	BackupStorageName string `json:"backupStorageName"`
+
+	// BytesCopied Number of the bytes copied to the backup storage
+	BytesCopied *int64 `json:"bytesCopied,omitempty"`
+
+	// DatabaseClusterBackupName Name of the database cluster backup registered for the copy
+	DatabaseClusterBackupName *string `json:"databaseClusterBackupName,omitempty"`
+
+	// Destination Location of the copy in the backup storage
+	Destination *string    `json:"destination,omitempty"`
+	FinishedAt  *time.Time `json:"finishedAt,omitempty"`
+
+	// Message Why the copy failed
+	Message *string `json:"message,omitempty"`
+
+	// ObjectsCopied Number of the objects copied to the backup storage. The objects already stored there are skipped
+	ObjectsCopied *int64    `json:"objectsCopied,omitempty"`
+	StartedAt     time.Time `json:"startedAt"`
+
+	// State State of the copy, one of running, succeeded or failed
+	State string `json:"state"`
+}
+
+// BackupCopyParams parameters of copying a backup
+type BackupCopyParams struct {
+	// BackupStorageName Name of the backup storage to copy the backup to
+	BackupStorageName string `json:"backupStorageName"`
+}
+
+// BackupReplicationPolicy policy copying the new successful backups of a database cluster to other backup storages.
+// The copies are registered as database cluster backups which can be restored
+type BackupReplicationPolicy struct {
+	// BackupStorageNames Names of the backup storages to copy the backups to
+	BackupStorageNames []string `json:"backupStorageNames"`
+
+	// Enabled Copy the new backups
+	Enabled bool `json:"enabled"`
+
+	// EnabledAt The backups completed since then are copied
+	EnabledAt *time.Time `json:"enabledAt,omitempty"`
+}
+
+// BackupRetentionDecision decision of the backup retention policy about a backup
+type BackupRetentionDecision struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Delete The backup is deleted by the policy
+	Delete bool   `json:"delete"`
+	Name   string `json:"name"`
+
+	// Reasons Why the backup is kept
+	Reasons *[]string `json:"reasons,omitempty"`
+	State   *string   `json:"state,omitempty"`
+}
+
+// BackupRetentionPolicy retention policy of the backups of database clusters. A backup is deleted if no rule keeps it.
+// Failed backups are kept only by keepDays. The latest successful backup, the backups needed for
+// the point-in-time recovery, the backups used by running restores, the unfinished backups, the final backups
+// and the backups taken before restores are always kept.
+// The copies of the backups are kept by the same rules, counted separately in each backup storage. The files of
+// the deleted copies are removed from their backup storage, except the PostgreSQL ones which share the repository of the database cluster
+type BackupRetentionPolicy struct {
+	// Enabled Delete the backups which are not kept by any rule
+	Enabled bool `json:"enabled"`
+
+	// KeepDaily Keep the latest successful backup of each of the given number of the latest days with backups
+	KeepDaily *int `json:"keepDaily,omitempty"`
+
+	// KeepDays Keep the successful backups taken in the given number of days
+	KeepDays *int `json:"keepDays,omitempty"`
+
+	// KeepLast Keep the given number of the latest successful backups
+	KeepLast *int `json:"keepLast,omitempty"`
+
+	// KeepMonthly Keep the latest successful backup of each of the given number of the latest months with backups
+	KeepMonthly *int `json:"keepMonthly,omitempty"`
+
+	// KeepWeekly Keep the latest successful backup of each of the given number of the latest weeks with backups
+	KeepWeekly *int `json:"keepWeekly,omitempty"`
+}
+
+// BackupRetentionPreview backups deleted and kept by the backup retention policy in effect, the latest first
+type BackupRetentionPreview struct {
+	Backups []BackupRetentionDecision `json:"backups"`
+
+	// Policy retention policy of the backups of database clusters. A backup is deleted if no rule keeps it.
+	// Failed backups are kept only by keepDays. The latest successful backup, the backups needed for
+	// the point-in-time recovery, the backups used by running restores, the unfinished backups, the final backups
+	// and the backups taken before restores are always kept.
+	// The copies of the backups are kept by the same rules, counted separately in each backup storage. The files of
+	// the deleted copies are removed from their backup storage, except the PostgreSQL ones which share the repository of the database cluster
+	Policy BackupRetentionPolicy `json:"policy"`
+
+	// Source Where the setting in effect is set
+	Source SettingSource `json:"source"`
+}
+
+// BackupSchedulePreview next runs of the backup schedules of a database cluster
+type BackupSchedulePreview struct {
+	Schedules []BackupScheduleRuns `json:"schedules"`
+
+	// Timezone Time zone of the local run times
+	Timezone string `json:"timezone"`
+}
+
+// BackupScheduleRun defines model for BackupScheduleRun.
+type BackupScheduleRun struct {
+	// Local Run time in the requested time zone
+	Local time.Time `json:"local"`
+	Utc   time.Time `json:"utc"`
+}
+
+// BackupScheduleRuns defines model for BackupScheduleRuns.
+type BackupScheduleRuns struct {
+	BackupStorageName *string `json:"backupStorageName,omitempty"`
+	Enabled           bool    `json:"enabled"`
+
+	// Error Reason the schedule is invalid
+	Error *string `json:"error,omitempty"`
+	Name  string  `json:"name"`
+
+	// NextRuns Next run times, empty if the schedule is disabled or invalid
+	NextRuns []BackupScheduleRun `json:"nextRuns"`
+	Schedule string              `json:"schedule"`
+}
+
+// BackupStats backup statistics over a time window. The backups are counted by their creation time
+type BackupStats struct {
+	// AverageDurationSeconds Average duration of the successful backups in the window
+	AverageDurationSeconds *int64 `json:"averageDurationSeconds,omitempty"`
+
+	// Backups Backups created in the window, the most recent first
+	Backups             *[]BackupStatsEntry `json:"backups,omitempty"`
+	DatabaseClusterName *string             `json:"databaseClusterName,omitempty"`
+	Failed              int                 `json:"failed"`
+	InProgress          int                 `json:"inProgress"`
+
+	// LastDurationSeconds Duration of the last successful backup in the window
+	LastDurationSeconds *int64 `json:"lastDurationSeconds,omitempty"`
+
+	// LastSuccessfulBackupAt Completion time of the last successful backup, including the ones before the window
+	LastSuccessfulBackupAt           *time.Time `json:"lastSuccessfulBackupAt,omitempty"`
+	SecondsSinceLastSuccessfulBackup *int64     `json:"secondsSinceLastSuccessfulBackup,omitempty"`
+
+	// Storages Bytes stored in every backup storage, including the point-in-time recovery logs and the backups before the window
+	Storages    []BackupStorageUsage `json:"storages"`
+	Succeeded   int                  `json:"succeeded"`
+	WindowEnd   time.Time            `json:"windowEnd"`
+	WindowStart time.Time            `json:"windowStart"`
+}
+
+// BackupStatsEntry defines model for BackupStatsEntry.
+type BackupStatsEntry struct {
+	BackupStorageName string     `json:"backupStorageName"`
+	CompletedAt       *time.Time `json:"completedAt,omitempty"`
+	CreatedAt         *time.Time `json:"createdAt,omitempty"`
+	DurationSeconds   *int64     `json:"durationSeconds,omitempty"`
+	Name              string     `json:"name"`
+
+	// SizeBytes Size of the backup read from the backup storage. Omitted if the storage could not be read
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+
+	// State State of the backup as reported by the operator
+	State string `json:"state"`
+}
+
 // BackupStorage Backup storage information
 type BackupStorage struct {
 	// AllowedNamespaces List of namespaces allowed to use this backup storage
@@ -101,9 +368,60 @@ type BackupStorage struct {
 // BackupStorageType defines model for BackupStorage.Type.
 type BackupStorageType string
 
+// BackupStorageUsage defines model for BackupStorageUsage.
+type BackupStorageUsage struct {
+	BytesStored int64 `json:"bytesStored"`
+
+	// Error Why the backup storage could not be read. bytesStored is 0 in this case
+	Error *string `json:"error,omitempty"`
+
+	// Name Name of the backup storage
+	Name string `json:"name"`
+}
+
 // BackupStoragesList defines model for BackupStoragesList.
 type BackupStoragesList = []BackupStorage
 
+// BackupVerification verification of a backup by a test restore. It is in progress until finishedAt is set
+type BackupVerification struct {
+	// DatabaseClusterName Name of the temporary database cluster
+	DatabaseClusterName string `json:"databaseClusterName"`
+
+	// DurationSeconds Duration of the verification
+	DurationSeconds *int64     `json:"durationSeconds,omitempty"`
+	FinishedAt      *time.Time `json:"finishedAt,omitempty"`
+
+	// Message Why the verification failed
+	Message *string `json:"message,omitempty"`
+
+	// Passed The backup was restored and the restored database cluster passed the sanity check
+	Passed           *bool     `json:"passed,omitempty"`
+	SandboxNamespace string    `json:"sandboxNamespace"`
+	StartedAt        time.Time `json:"startedAt"`
+}
+
+// BackupVerificationSettings settings of the verification of backups by test restores
+type BackupVerificationSettings struct {
+	// Enabled Verify the latest successful backup of every database cluster on the schedule if it is not verified yet
+	Enabled bool `json:"enabled"`
+
+	// SandboxNamespace Namespace managed by Everest where the temporary database clusters are created.
+	// The backups of the same namespace are restored by name, the backups of other namespaces are restored from their backup storage
+	SandboxNamespace string `json:"sandboxNamespace"`
+
+	// Schedule Cron expression of the scheduled verifications in UTC. Required when the verification is enabled
+	Schedule *string `json:"schedule,omitempty"`
+}
+
+// CostBreakdown Monthly cost by resource
+type CostBreakdown struct {
+	BackupStorage float64 `json:"backupStorage"`
+	Cpu           float64 `json:"cpu"`
+	Memory        float64 `json:"memory"`
+	Storage       float64 `json:"storage"`
+	Total         float64 `json:"total"`
+}
+
 // CreateBackupStorageParams Backup storage parameters
 type CreateBackupStorageParams struct {
 	AccessKey string `json:"accessKey"`
@@ -455,12 +773,132 @@ type DatabaseClusterBackupList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// DatabaseClusterBackupRetention backup retention policy in effect for a database cluster
+type DatabaseClusterBackupRetention struct {
+	// Policy retention policy of the backups of database clusters. A backup is deleted if no rule keeps it.
+	// Failed backups are kept only by keepDays. The latest successful backup, the backups needed for
+	// the point-in-time recovery, the backups used by running restores, the unfinished backups, the final backups
+	// and the backups taken before restores are always kept.
+	// The copies of the backups are kept by the same rules, counted separately in each backup storage. The files of
+	// the deleted copies are removed from their backup storage, except the PostgreSQL ones which share the repository of the database cluster
+	Policy BackupRetentionPolicy `json:"policy"`
+
+	// Source Where the setting in effect is set
+	Source SettingSource `json:"source"`
+}
+
+// DatabaseClusterBundle portable definition of a database cluster
+type DatabaseClusterBundle struct {
+	// Credentials credentials secret of the database cluster
+	Credentials *DatabaseClusterBundleCredentials `json:"credentials,omitempty"`
+
+	// DatabaseCluster DatabaseCluster is the Schema for the databaseclusters API.
+	DatabaseCluster DatabaseCluster `json:"databaseCluster"`
+
+	// PowerSchedule pauses and resumes a database cluster on a schedule
+	PowerSchedule *PowerSchedule `json:"powerSchedule,omitempty"`
+
+	// References objects the database cluster refers to. They must exist in the installation the bundle is imported into
+	References *DatabaseClusterBundleReferences `json:"references,omitempty"`
+
+	// Version Version of the bundle format
+	Version string `json:"version"`
+}
+
+// DatabaseClusterBundleCredentials credentials secret of the database cluster
+type DatabaseClusterBundleCredentials struct {
+	Data       map[string]string `json:"data"`
+	SecretName string            `json:"secretName"`
+}
+
+// DatabaseClusterBundleReferences objects the database cluster refers to. They must exist in the installation the bundle is imported into
+type DatabaseClusterBundleReferences struct {
+	BackupStorages   *[]string `json:"backupStorages,omitempty"`
+	MonitoringConfig *string   `json:"monitoringConfig,omitempty"`
+}
+
+// DatabaseClusterCostEstimate Estimated monthly cost of a database cluster.
+// The compute of paused database clusters is not charged.
+// Backup storage assumes every retained backup is as large as the storage of one database node.
+type DatabaseClusterCostEstimate struct {
+	Currency string `json:"currency"`
+
+	// Monthly Monthly cost by resource
+	Monthly  CostBreakdown `json:"monthly"`
+	Name     string        `json:"name"`
+	Warnings *[]string     `json:"warnings,omitempty"`
+}
+
 // DatabaseClusterCredential kubernetes object
 type DatabaseClusterCredential struct {
 	Password *string `json:"password,omitempty"`
 	Username *string `json:"username,omitempty"`
 }
 
+// DatabaseClusterDeletionProtection deletion protection in effect for a database cluster
+type DatabaseClusterDeletionProtection struct {
+	// Protection protection of database clusters from deletion
+	Protection DeletionProtection `json:"protection"`
+
+	// Source Where the setting in effect is set
+	Source SettingSource `json:"source"`
+}
+
+// DatabaseClusterEvent Kubernetes event related to a database cluster
+type DatabaseClusterEvent struct {
+	// Count Number of occurrences of the event
+	Count int32 `json:"count"`
+
+	// FirstTime Time of the first occurrence of the event
+	FirstTime time.Time `json:"firstTime"`
+	Message   string    `json:"message"`
+
+	// Object Kubernetes object the event is about
+	Object   DatabaseClusterEventObject   `json:"object"`
+	Reason   string                       `json:"reason"`
+	Severity DatabaseClusterEventSeverity `json:"severity"`
+
+	// Source Component reporting the event
+	Source *string `json:"source,omitempty"`
+
+	// Time Time of the last occurrence of the event
+	Time time.Time `json:"time"`
+}
+
+// DatabaseClusterEventSeverity defines model for DatabaseClusterEvent.Severity.
+type DatabaseClusterEventSeverity string
+
+// DatabaseClusterEventList defines model for DatabaseClusterEventList.
+type DatabaseClusterEventList = []DatabaseClusterEvent
+
+// DatabaseClusterEventObject Kubernetes object the event is about
+type DatabaseClusterEventObject struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// DatabaseClusterFromTemplateParams defines model for DatabaseClusterFromTemplateParams.
+type DatabaseClusterFromTemplateParams struct {
+	// DatabaseCluster DatabaseCluster object merged on top of the template. Its metadata.name is required
+	DatabaseCluster map[string]interface{} `json:"databaseCluster"`
+	TemplateName    string                 `json:"templateName"`
+}
+
+// DatabaseClusterHealth health of a database cluster and its components
+type DatabaseClusterHealth struct {
+	Pods []DatabaseClusterPodHealth `json:"pods"`
+
+	// Reasons Human readable reasons of the status
+	Reasons []string `json:"reasons"`
+
+	// Status Rolled-up health of the database cluster
+	Status  DatabaseClusterHealthStatus   `json:"status"`
+	Volumes []DatabaseClusterVolumeHealth `json:"volumes"`
+}
+
+// DatabaseClusterHealthStatus Rolled-up health of the database cluster
+type DatabaseClusterHealthStatus string
+
 // DatabaseClusterList DatabaseClusterList is an object that contains the list of the existing database clusters.
 type DatabaseClusterList struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
@@ -480,8 +918,36 @@ type DatabaseClusterPitr struct {
 	Gaps             *bool      `json:"gaps,omitempty"`
 	LatestBackupName *string    `json:"latestBackupName,omitempty"`
 	LatestDate       *time.Time `json:"latestDate,omitempty"`
+
+	// Ranges contiguous ranges of the point-in-time recovery logs stored after a backup, oldest first. Omitted if the logs could not be listed, the dates are estimated from the latest backup then
+	Ranges *[]DatabaseClusterPitrRange `json:"ranges,omitempty"`
+}
+
+// DatabaseClusterPitrRange range of dates the database cluster can be recovered to
+type DatabaseClusterPitrRange struct {
+	// BackupName Name of the backup the range starts from
+	BackupName   string    `json:"backupName"`
+	EarliestDate time.Time `json:"earliestDate"`
+	LatestDate   time.Time `json:"latestDate"`
+}
+
+// DatabaseClusterPodHealth defines model for DatabaseClusterPodHealth.
+type DatabaseClusterPodHealth struct {
+	// LastTerminationReason Reason of the last container termination, e.g. OOMKilled or Error
+	LastTerminationReason *string `json:"lastTerminationReason,omitempty"`
+	Name                  string  `json:"name"`
+	Node                  *string `json:"node,omitempty"`
+	Phase                 string  `json:"phase"`
+	Ready                 bool    `json:"ready"`
+
+	// Restarts Sum of the restarts of all containers of the pod
+	Restarts int32                        `json:"restarts"`
+	Role     DatabaseClusterPodHealthRole `json:"role"`
 }
 
+// DatabaseClusterPodHealthRole defines model for DatabaseClusterPodHealth.Role.
+type DatabaseClusterPodHealthRole string
+
 // DatabaseClusterRestore DatabaseClusterRestore is the Schema for the databaseclusterrestores API.
 type DatabaseClusterRestore struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
@@ -545,6 +1011,67 @@ type DatabaseClusterRestoreList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// DatabaseClusterRestorePhase defines model for DatabaseClusterRestorePhase.
+type DatabaseClusterRestorePhase struct {
+	// ElapsedSeconds Time spent in the phase, until now for the current phase
+	ElapsedSeconds int64      `json:"elapsedSeconds"`
+	FinishedAt     *time.Time `json:"finishedAt,omitempty"`
+
+	// Phase Phase of a restore, one of preparing, downloadingBaseBackup, applyingLogs, restarting, ready or failed
+	Phase     RestorePhase `json:"phase"`
+	StartedAt time.Time    `json:"startedAt"`
+}
+
+// DatabaseClusterRestoreProgress progress of a database cluster restore
+type DatabaseClusterRestoreProgress struct {
+	// DatabaseClusterStatus Status of the restored database cluster
+	DatabaseClusterStatus *string `json:"databaseClusterStatus,omitempty"`
+	FailureReason         *string `json:"failureReason,omitempty"`
+
+	// Phase Phase of a restore, one of preparing, downloadingBaseBackup, applyingLogs, restarting, ready or failed
+	Phase RestorePhase `json:"phase"`
+
+	// Phases Phases the restore went through, oldest first
+	Phases []DatabaseClusterRestorePhase `json:"phases"`
+
+	// State State of the restore reported by the operator
+	State *string `json:"state,omitempty"`
+}
+
+// DatabaseClusterTemplate named, partial database cluster spec used as a starting point for new database clusters
+type DatabaseClusterTemplate struct {
+	// AllowedNamespaces List of namespaces allowed to use the template. The template is allowed in any namespace if empty
+	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
+	Description       *string   `json:"description,omitempty"`
+	Name              string    `json:"name"`
+
+	// Spec Partial spec of the DatabaseCluster object
+	Spec map[string]interface{} `json:"spec"`
+}
+
+// DatabaseClusterTemplateList defines model for DatabaseClusterTemplateList.
+type DatabaseClusterTemplateList = []DatabaseClusterTemplate
+
+// DatabaseClusterVolumeHealth defines model for DatabaseClusterVolumeHealth.
+type DatabaseClusterVolumeHealth struct {
+	Capacity *string `json:"capacity,omitempty"`
+	Name     string  `json:"name"`
+
+	// Phase Binding status of the persistent volume claim
+	Phase string `json:"phase"`
+
+	// RequestedCapacity Requested size of the persistent volume claim if it differs from the capacity
+	RequestedCapacity *string `json:"requestedCapacity,omitempty"`
+	ResizeMessage     *string `json:"resizeMessage,omitempty"`
+
+	// ResizeStatus Progress of the resize of the persistent volume claim. Omitted if the volume is not being resized
+	ResizeStatus *DatabaseClusterVolumeHealthResizeStatus `json:"resizeStatus,omitempty"`
+	StorageClass *string                                  `json:"storageClass,omitempty"`
+}
+
+// DatabaseClusterVolumeHealthResizeStatus Progress of the resize of the persistent volume claim. Omitted if the volume is not being resized
+type DatabaseClusterVolumeHealthResizeStatus string
+
 // DatabaseEngine DatabaseEngine is the Schema for the databaseengines API.
 type DatabaseEngine struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
@@ -609,15 +1136,51 @@ type DatabaseEngineList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// DeletionProtection protection of database clusters from deletion
+type DeletionProtection struct {
+	// Enabled Refuse to delete the database cluster
+	Enabled bool `json:"enabled"`
+
+	// Reason Reason of the last change. Required to weaken the protection of a namespace
+	Reason *string `json:"reason,omitempty"`
+
+	// RequireConfirmation Require the confirmName parameter set to the database cluster name to delete it
+	RequireConfirmation *bool `json:"requireConfirmation,omitempty"`
+
+	// UpdatedAt Time of the last change. Ignored on update
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// DeletionProtectionRemoval defines model for DeletionProtectionRemoval.
+type DeletionProtectionRemoval struct {
+	// Reason Reason to remove the protection. It is logged for audit
+	Reason string `json:"reason"`
+}
+
 // Error Error response
 type Error struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// FinalBackup backup taken before the deletion of a database cluster. The database cluster is deleted once the backup succeeds. It is in progress until finishedAt is set
+type FinalBackup struct {
+	// BackupName Name of the database cluster backup
+	BackupName        string `json:"backupName"`
+	BackupStorageName string `json:"backupStorageName"`
+
+	// FinishedAt Time the final backup or the deletion failed at
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// Message Why the final backup or the deletion failed
+	Message   *string   `json:"message,omitempty"`
+	StartedAt time.Time `json:"startedAt"`
+}
+
 // KubernetesClusterInfo kubernetes cluster info
 type KubernetesClusterInfo struct {
-	ClusterType       string   `json:"clusterType"`
-	StorageClassNames []string `json:"storageClassNames"`
+	ClusterType       string             `json:"clusterType"`
+	StorageClassNames []string           `json:"storageClassNames"`
+	StorageClasses    []StorageClassInfo `json:"storageClasses"`
 }
 
 // KubernetesClusterResources kubernetes cluster resources
@@ -710,9 +1273,205 @@ type MonitoringInstanceUpdateParamsType string
 // MonitoringInstancesList defines model for MonitoringInstancesList.
 type MonitoringInstancesList = []MonitoringInstance
 
+// NamespaceBackupStats defines model for NamespaceBackupStats.
+type NamespaceBackupStats struct {
+	DatabaseClusters []BackupStats `json:"databaseClusters"`
+	Namespace        string        `json:"namespace"`
+
+	// Total backup statistics over a time window. The backups are counted by their creation time
+	Total BackupStats `json:"total"`
+}
+
+// NamespaceCostEstimate defines model for NamespaceCostEstimate.
+type NamespaceCostEstimate struct {
+	Clusters []DatabaseClusterCostEstimate `json:"clusters"`
+	Currency string                        `json:"currency"`
+
+	// Monthly Monthly cost by resource
+	Monthly   CostBreakdown `json:"monthly"`
+	Namespace string        `json:"namespace"`
+}
+
+// NamespaceCostEstimateList defines model for NamespaceCostEstimateList.
+type NamespaceCostEstimateList = []NamespaceCostEstimate
+
 // NamespaceList defines model for NamespaceList.
 type NamespaceList = []string
 
+// NamespaceQuota limits of the resources requested by the database clusters of a namespace. Omitted limits are not enforced
+type NamespaceQuota struct {
+	// Clusters Maximum number of database clusters
+	Clusters *int `json:"clusters,omitempty"`
+
+	// Cpu Total CPU of all database clusters
+	Cpu *string `json:"cpu,omitempty"`
+
+	// Memory Total memory of all database clusters
+	Memory *string `json:"memory,omitempty"`
+
+	// PerCluster resource limits. Omitted limits are not enforced
+	PerCluster *ResourceQuota `json:"perCluster,omitempty"`
+
+	// Storage Total storage of all database clusters
+	Storage *string `json:"storage,omitempty"`
+}
+
+// NamespaceQuotaUsage defines model for NamespaceQuotaUsage.
+type NamespaceQuotaUsage struct {
+	Namespace string `json:"namespace"`
+
+	// Quota limits of the resources requested by the database clusters of a namespace. Omitted limits are not enforced
+	Quota *NamespaceQuota `json:"quota,omitempty"`
+
+	// Usage resources requested by the database clusters of a namespace
+	Usage NamespaceResourceUsage `json:"usage"`
+}
+
+// NamespaceQuotaUsageList defines model for NamespaceQuotaUsageList.
+type NamespaceQuotaUsageList = []NamespaceQuotaUsage
+
+// NamespaceResourceUsage resources requested by the database clusters of a namespace
+type NamespaceResourceUsage struct {
+	Clusters int    `json:"clusters"`
+	Cpu      string `json:"cpu"`
+	Memory   string `json:"memory"`
+	Storage  string `json:"storage"`
+}
+
+// PowerSchedule pauses and resumes a database cluster on a schedule
+type PowerSchedule struct {
+	Enabled bool `json:"enabled"`
+
+	// PauseSchedule cron expression of the time to pause the database cluster
+	PauseSchedule *string `json:"pauseSchedule,omitempty"`
+
+	// ResumeSchedule cron expression of the time to resume the database cluster
+	ResumeSchedule *string `json:"resumeSchedule,omitempty"`
+
+	// Status result of the last action taken by the power schedule. Ignored on update
+	Status *PowerScheduleStatus `json:"status,omitempty"`
+
+	// Timezone IANA time zone the schedules are evaluated in. Defaults to UTC
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// PowerScheduleStatus result of the last action taken by the power schedule. Ignored on update
+type PowerScheduleStatus struct {
+	LastAction     *PowerScheduleStatusLastAction `json:"lastAction,omitempty"`
+	LastActionTime *time.Time                     `json:"lastActionTime,omitempty"`
+	Message        *string                        `json:"message,omitempty"`
+	Result         *PowerScheduleStatusResult     `json:"result,omitempty"`
+}
+
+// PowerScheduleStatusLastAction defines model for PowerScheduleStatus.LastAction.
+type PowerScheduleStatusLastAction string
+
+// PowerScheduleStatusResult defines model for PowerScheduleStatus.Result.
+type PowerScheduleStatusResult string
+
+// PreRestoreBackup backup taken before a restore of a database cluster. The restore is created once the backup succeeds. It is in progress until finishedAt is set
+type PreRestoreBackup struct {
+	// BackupName Name of the database cluster backup
+	BackupName        string `json:"backupName"`
+	BackupStorageName string `json:"backupStorageName"`
+
+	// FinishedAt Time the restore was created at or the pre-restore backup or the restore creation failed at
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// Message Why the pre-restore backup or the restore creation failed
+	Message *string `json:"message,omitempty"`
+
+	// RestoreName Name of the restore. A generated name is set once the restore is created
+	RestoreName *string   `json:"restoreName,omitempty"`
+	StartedAt   time.Time `json:"startedAt"`
+
+	// TimeoutMinutes How long the backup may take before the restore is abandoned
+	TimeoutMinutes int `json:"timeoutMinutes"`
+}
+
+// PreRestoreBackupSettings settings of the backups taken of database clusters before they are restored. The restore is created only
+// after the backup succeeds. The pre-restore backup is enabled if the namespace has no settings
+type PreRestoreBackupSettings struct {
+	// BackupStorageName Name of the backup storage to take the backups to. By default, the storage of the backup schedules of the
+	// database cluster is used or the storage of the restored backup if it has no schedules
+	BackupStorageName *string `json:"backupStorageName,omitempty"`
+
+	// Enabled Take a backup of the database cluster before restoring it
+	Enabled bool `json:"enabled"`
+
+	// TimeoutMinutes How long to wait for the backup to succeed before the restore is abandoned
+	TimeoutMinutes *int `json:"timeoutMinutes,omitempty"`
+}
+
+// PriceTable Prices used to estimate the cost of database clusters.
+// Storage of the database clusters is priced per storage class. The default storage class is used for database clusters without a storage class.
+type PriceTable struct {
+	// BackupStorageGiBMonth Price of one GiB of backup storage per month
+	BackupStorageGiBMonth *float64 `json:"backupStorageGiBMonth,omitempty"`
+
+	// CpuHour Price of one CPU per hour
+	CpuHour  float64 `json:"cpuHour"`
+	Currency *string `json:"currency,omitempty"`
+
+	// MemoryGiBHour Price of one GiB of memory per hour
+	MemoryGiBHour float64 `json:"memoryGiBHour"`
+
+	// StorageClassGiBMonth Price of one GiB of storage per month for each storage class
+	StorageClassGiBMonth map[string]float64 `json:"storageClassGiBMonth"`
+}
+
+// ResourceQuota resource limits. Omitted limits are not enforced
+type ResourceQuota struct {
+	Cpu     *string `json:"cpu,omitempty"`
+	Memory  *string `json:"memory,omitempty"`
+	Storage *string `json:"storage,omitempty"`
+}
+
+// RestorePhase Phase of a restore, one of preparing, downloadingBaseBackup, applyingLogs, restarting, ready or failed
+type RestorePhase = string
+
+// RestoreToNewClusterParams The database cluster to create from a backup. Omitted fields are copied from the source database cluster.
+// If the source database cluster does not exist anymore, cpu, memory and storageSize are required and the database cluster has a single replica by default
+type RestoreToNewClusterParams struct {
+	// Cpu CPU of each engine replica, e.g. "1" or "500m"
+	Cpu *string `json:"cpu,omitempty"`
+
+	// EngineType Engine type of the backup, one of pxc, psmdb and postgresql. Only used if the source database cluster does not exist anymore and the backup does not record its engine type
+	EngineType *string `json:"engineType,omitempty"`
+
+	// EngineVersion Engine version of the new database cluster. It should be of the same major version as the source database cluster and not older.
+	// Required if the source database cluster does not exist anymore and the backup does not record its engine version
+	EngineVersion *string `json:"engineVersion,omitempty"`
+
+	// Memory Memory of each engine replica, e.g. "2G"
+	Memory *string `json:"memory,omitempty"`
+
+	// Name Name of the new database cluster
+	Name string `json:"name"`
+
+	// Namespace Namespace of the new database cluster. Defaults to the namespace of the backup
+	Namespace *string `json:"namespace,omitempty"`
+
+	// PitrDate UTC date to recover to. The accepted format: "2006-01-02T15:04:05Z". If omitted, the backup is restored as is
+	PitrDate     *string `json:"pitrDate,omitempty"`
+	Replicas     *int32  `json:"replicas,omitempty"`
+	StorageClass *string `json:"storageClass,omitempty"`
+
+	// StorageSize Storage size of each engine replica. It can not be smaller than the storage of the source database cluster
+	StorageSize *string `json:"storageSize,omitempty"`
+}
+
+// SettingSource Where the setting in effect is set
+type SettingSource string
+
+// StorageClassInfo capabilities of a storage class
+type StorageClassInfo struct {
+	// AllowVolumeExpansion Volumes of the storage class can be expanded, so the storage of database clusters using it can grow
+	AllowVolumeExpansion bool   `json:"allowVolumeExpansion"`
+	Default              bool   `json:"default"`
+	Name                 string `json:"name"`
+}
+
 // UpdateBackupStorageParams Backup storage parameters
 type UpdateBackupStorageParams struct {
 	AccessKey *string `json:"accessKey,omitempty"`
@@ -816,36 +1575,222 @@ type IoK8sApimachineryPkgApisMetaV1StatusV2 struct {
 	Status *string `json:"status,omitempty"`
 }
 
-// CreateBackupStorageJSONRequestBody defines body for CreateBackupStorage for application/json ContentType.
-type CreateBackupStorageJSONRequestBody = CreateBackupStorageParams
+// ListAllDatabaseClustersParams defines parameters for ListAllDatabaseClusters.
+type ListAllDatabaseClustersParams struct {
+	// Search Case-insensitive text to search in the names, label keys and label values of the database clusters
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
 
-// UpdateBackupStorageJSONRequestBody defines body for UpdateBackupStorage for application/json ContentType.
-type UpdateBackupStorageJSONRequestBody = UpdateBackupStorageParams
+	// Namespace Only list the database clusters of the namespace
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
 
-// CreateMonitoringInstanceJSONRequestBody defines body for CreateMonitoringInstance for application/json ContentType.
-type CreateMonitoringInstanceJSONRequestBody = MonitoringInstanceCreateParams
+	// Engine Only list the database clusters of the engine type
+	Engine *string `form:"engine,omitempty" json:"engine,omitempty"`
 
-// UpdateMonitoringInstanceJSONRequestBody defines body for UpdateMonitoringInstance for application/json ContentType.
-type UpdateMonitoringInstanceJSONRequestBody = MonitoringInstanceUpdateParams
+	// Version Only list the database clusters of the engine version
+	Version *string `form:"version,omitempty" json:"version,omitempty"`
 
-// CreateDatabaseClusterBackupJSONRequestBody defines body for CreateDatabaseClusterBackup for application/json ContentType.
-type CreateDatabaseClusterBackupJSONRequestBody = DatabaseClusterBackup
+	// Status Only list the database clusters in the status
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
 
-// CreateDatabaseClusterRestoreJSONRequestBody defines body for CreateDatabaseClusterRestore for application/json ContentType.
-type CreateDatabaseClusterRestoreJSONRequestBody = DatabaseClusterRestore
+	// StorageClass Only list the database clusters using the storage class. Database clusters without a storage class use the default one
+	StorageClass *string `form:"storageClass,omitempty" json:"storageClass,omitempty"`
 
-// UpdateDatabaseClusterRestoreJSONRequestBody defines body for UpdateDatabaseClusterRestore for application/json ContentType.
-type UpdateDatabaseClusterRestoreJSONRequestBody = DatabaseClusterRestore
+	// BackupStorage Only list the database clusters with backup schedules or point-in-time recovery using the backup storage
+	BackupStorage *string `form:"backupStorage,omitempty" json:"backupStorage,omitempty"`
 
-// CreateDatabaseClusterJSONRequestBody defines body for CreateDatabaseCluster for application/json ContentType.
-type CreateDatabaseClusterJSONRequestBody = DatabaseCluster
+	// MonitoringInstance Only list the database clusters monitored by the monitoring instance
+	MonitoringInstance *string `form:"monitoringInstance,omitempty" json:"monitoringInstance,omitempty"`
+}
 
-// UpdateDatabaseClusterJSONRequestBody defines body for UpdateDatabaseCluster for application/json ContentType.
+// GetNamespaceBackupStatsParams defines parameters for GetNamespaceBackupStats.
+type GetNamespaceBackupStatsParams struct {
+	// Days Number of days before now the backups are counted over. Defaults to 30
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+}
+
+// RestoreDatabaseClusterBackupToNewClusterParams defines parameters for RestoreDatabaseClusterBackupToNewCluster.
+type RestoreDatabaseClusterBackupToNewClusterParams struct {
+	// Force Proceed even if the Kubernetes cluster does not have enough available resources. A Warning header is returned instead of an error
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// CreateDatabaseClusterParams defines parameters for CreateDatabaseCluster.
+type CreateDatabaseClusterParams struct {
+	// Force Proceed even if the Kubernetes cluster does not have enough available resources. A Warning header is returned instead of an error
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// CreateDatabaseClusterFromTemplateParams defines parameters for CreateDatabaseClusterFromTemplate.
+type CreateDatabaseClusterFromTemplateParams struct {
+	// Force Proceed even if the Kubernetes cluster does not have enough available resources. A Warning header is returned instead of an error
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// ImportDatabaseClusterParams defines parameters for ImportDatabaseCluster.
+type ImportDatabaseClusterParams struct {
+	// Force Proceed even if the Kubernetes cluster does not have enough available resources. A Warning header is returned instead of an error
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// DeleteDatabaseClusterParams defines parameters for DeleteDatabaseCluster.
+type DeleteDatabaseClusterParams struct {
+	// ConfirmName Name of the database cluster to confirm the deletion. Required if the deletion protection of the database cluster requires confirmation
+	ConfirmName *string `form:"confirmName,omitempty" json:"confirmName,omitempty"`
+
+	// FinalBackup Take a backup of the database cluster before deleting it. The request is accepted right away and the database cluster is deleted in the background once the backup succeeds. The progress is available in the final backup of the database cluster. The backup is kept after the deletion
+	FinalBackup *bool `form:"finalBackup,omitempty" json:"finalBackup,omitempty"`
+
+	// FinalBackupStorageName Name of the backup storage to take the final backup to. Defaults to the active backup storage of the database cluster
+	FinalBackupStorageName *string `form:"finalBackupStorageName,omitempty" json:"finalBackupStorageName,omitempty"`
+}
+
+// UpdateDatabaseClusterParams defines parameters for UpdateDatabaseCluster.
+type UpdateDatabaseClusterParams struct {
+	// Force Proceed even if the Kubernetes cluster does not have enough available resources. A Warning header is returned instead of an error
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// PreviewDatabaseClusterBackupSchedulesParams defines parameters for PreviewDatabaseClusterBackupSchedules.
+type PreviewDatabaseClusterBackupSchedulesParams struct {
+	// Count Number of next runs to return for every schedule. Defaults to 5
+	Count *int `form:"count,omitempty" json:"count,omitempty"`
+
+	// Timezone IANA time zone to show the next runs in. Defaults to UTC
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty"`
+}
+
+// GetDatabaseClusterBackupStatsParams defines parameters for GetDatabaseClusterBackupStats.
+type GetDatabaseClusterBackupStatsParams struct {
+	// Days Number of days before now the backups are counted over. Defaults to 30
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+}
+
+// ExportDatabaseClusterParams defines parameters for ExportDatabaseCluster.
+type ExportDatabaseClusterParams struct {
+	// Format Format of the bundle. Defaults to json
+	Format *ExportDatabaseClusterParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// IncludeCredentials Include the credentials secret of the database cluster in the bundle
+	IncludeCredentials *bool `form:"includeCredentials,omitempty" json:"includeCredentials,omitempty"`
+}
+
+// ExportDatabaseClusterParamsFormat defines parameters for ExportDatabaseCluster.
+type ExportDatabaseClusterParamsFormat string
+
+// GetDatabaseClusterLogsParams defines parameters for GetDatabaseClusterLogs.
+type GetDatabaseClusterLogsParams struct {
+	// Pod Return the logs of the specified pod only
+	Pod *string `form:"pod,omitempty" json:"pod,omitempty"`
+
+	// Container Return the logs of the specified container only
+	Container *string `form:"container,omitempty" json:"container,omitempty"`
+
+	// TailLines Number of lines from the end of the logs of every container to return
+	TailLines *int64 `form:"tailLines,omitempty" json:"tailLines,omitempty"`
+
+	// SinceSeconds Return the logs newer than the specified number of seconds
+	SinceSeconds *int64 `form:"sinceSeconds,omitempty" json:"sinceSeconds,omitempty"`
+
+	// Previous Return the logs of the previously terminated containers
+	Previous *bool `form:"previous,omitempty" json:"previous,omitempty"`
+
+	// Follow Keep the connection open and stream new log lines
+	Follow *bool `form:"follow,omitempty" json:"follow,omitempty"`
+}
+
+// ListDatabaseClusterTemplatesParams defines parameters for ListDatabaseClusterTemplates.
+type ListDatabaseClusterTemplatesParams struct {
+	// Namespace Return only the templates allowed in the namespace
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// CreateBackupStorageJSONRequestBody defines body for CreateBackupStorage for application/json ContentType.
+type CreateBackupStorageJSONRequestBody = CreateBackupStorageParams
+
+// UpdateBackupStorageJSONRequestBody defines body for UpdateBackupStorage for application/json ContentType.
+type UpdateBackupStorageJSONRequestBody = UpdateBackupStorageParams
+
+// ImportBackupArtifactsJSONRequestBody defines body for ImportBackupArtifacts for application/json ContentType.
+type ImportBackupArtifactsJSONRequestBody = BackupArtifactImport
+
+// UpdateBackupVerificationSettingsJSONRequestBody defines body for UpdateBackupVerificationSettings for application/json ContentType.
+type UpdateBackupVerificationSettingsJSONRequestBody = BackupVerificationSettings
+
+// EstimateDatabaseClusterCostJSONRequestBody defines body for EstimateDatabaseClusterCost for application/json ContentType.
+type EstimateDatabaseClusterCostJSONRequestBody = DatabaseCluster
+
+// CreateMonitoringInstanceJSONRequestBody defines body for CreateMonitoringInstance for application/json ContentType.
+type CreateMonitoringInstanceJSONRequestBody = MonitoringInstanceCreateParams
+
+// UpdateMonitoringInstanceJSONRequestBody defines body for UpdateMonitoringInstance for application/json ContentType.
+type UpdateMonitoringInstanceJSONRequestBody = MonitoringInstanceUpdateParams
+
+// UpdateNamespaceBackupRetentionJSONRequestBody defines body for UpdateNamespaceBackupRetention for application/json ContentType.
+type UpdateNamespaceBackupRetentionJSONRequestBody = BackupRetentionPolicy
+
+// CreateDatabaseClusterBackupJSONRequestBody defines body for CreateDatabaseClusterBackup for application/json ContentType.
+type CreateDatabaseClusterBackupJSONRequestBody = DatabaseClusterBackup
+
+// CopyDatabaseClusterBackupJSONRequestBody defines body for CopyDatabaseClusterBackup for application/json ContentType.
+type CopyDatabaseClusterBackupJSONRequestBody = BackupCopyParams
+
+// RestoreDatabaseClusterBackupToNewClusterJSONRequestBody defines body for RestoreDatabaseClusterBackupToNewCluster for application/json ContentType.
+type RestoreDatabaseClusterBackupToNewClusterJSONRequestBody = RestoreToNewClusterParams
+
+// CreateDatabaseClusterRestoreJSONRequestBody defines body for CreateDatabaseClusterRestore for application/json ContentType.
+type CreateDatabaseClusterRestoreJSONRequestBody = DatabaseClusterRestore
+
+// UpdateDatabaseClusterRestoreJSONRequestBody defines body for UpdateDatabaseClusterRestore for application/json ContentType.
+type UpdateDatabaseClusterRestoreJSONRequestBody = DatabaseClusterRestore
+
+// CreateDatabaseClusterJSONRequestBody defines body for CreateDatabaseCluster for application/json ContentType.
+type CreateDatabaseClusterJSONRequestBody = DatabaseCluster
+
+// CreateDatabaseClusterFromTemplateJSONRequestBody defines body for CreateDatabaseClusterFromTemplate for application/json ContentType.
+type CreateDatabaseClusterFromTemplateJSONRequestBody = DatabaseClusterFromTemplateParams
+
+// ImportDatabaseClusterJSONRequestBody defines body for ImportDatabaseCluster for application/json ContentType.
+type ImportDatabaseClusterJSONRequestBody = DatabaseClusterBundle
+
+// UpdateDatabaseClusterJSONRequestBody defines body for UpdateDatabaseCluster for application/json ContentType.
 type UpdateDatabaseClusterJSONRequestBody = DatabaseCluster
 
+// UpdateDatabaseClusterBackupReplicationJSONRequestBody defines body for UpdateDatabaseClusterBackupReplication for application/json ContentType.
+type UpdateDatabaseClusterBackupReplicationJSONRequestBody = BackupReplicationPolicy
+
+// UpdateDatabaseClusterBackupRetentionJSONRequestBody defines body for UpdateDatabaseClusterBackupRetention for application/json ContentType.
+type UpdateDatabaseClusterBackupRetentionJSONRequestBody = BackupRetentionPolicy
+
+// UpdateDatabaseClusterDeletionProtectionJSONRequestBody defines body for UpdateDatabaseClusterDeletionProtection for application/json ContentType.
+type UpdateDatabaseClusterDeletionProtectionJSONRequestBody = DeletionProtection
+
+// UnprotectDatabaseClusterJSONRequestBody defines body for UnprotectDatabaseCluster for application/json ContentType.
+type UnprotectDatabaseClusterJSONRequestBody = DeletionProtectionRemoval
+
+// UpdateDatabaseClusterPowerScheduleJSONRequestBody defines body for UpdateDatabaseClusterPowerSchedule for application/json ContentType.
+type UpdateDatabaseClusterPowerScheduleJSONRequestBody = PowerSchedule
+
 // UpdateDatabaseEngineJSONRequestBody defines body for UpdateDatabaseEngine for application/json ContentType.
 type UpdateDatabaseEngineJSONRequestBody = DatabaseEngine
 
+// UpdateNamespaceDeletionProtectionJSONRequestBody defines body for UpdateNamespaceDeletionProtection for application/json ContentType.
+type UpdateNamespaceDeletionProtectionJSONRequestBody = DeletionProtection
+
+// UpdateNamespacePreRestoreBackupJSONRequestBody defines body for UpdateNamespacePreRestoreBackup for application/json ContentType.
+type UpdateNamespacePreRestoreBackupJSONRequestBody = PreRestoreBackupSettings
+
+// UpdateNamespaceQuotaJSONRequestBody defines body for UpdateNamespaceQuota for application/json ContentType.
+type UpdateNamespaceQuotaJSONRequestBody = NamespaceQuota
+
+// UpdatePriceTableJSONRequestBody defines body for UpdatePriceTable for application/json ContentType.
+type UpdatePriceTableJSONRequestBody = PriceTable
+
+// CreateDatabaseClusterTemplateJSONRequestBody defines body for CreateDatabaseClusterTemplate for application/json ContentType.
+type CreateDatabaseClusterTemplateJSONRequestBody = DatabaseClusterTemplate
+
+// UpdateDatabaseClusterTemplateJSONRequestBody defines body for UpdateDatabaseClusterTemplate for application/json ContentType.
+type UpdateDatabaseClusterTemplateJSONRequestBody = DatabaseClusterTemplate
+
 // AsDatabaseClusterSpecEngineResourcesCpu0 returns the union data inside the DatabaseCluster_Spec_Engine_Resources_Cpu as a DatabaseClusterSpecEngineResourcesCpu0
 func (t DatabaseCluster_Spec_Engine_Resources_Cpu) AsDatabaseClusterSpecEngineResourcesCpu0() (DatabaseClusterSpecEngineResourcesCpu0, error) {
 	var body DatabaseClusterSpecEngineResourcesCpu0
@@ -1372,9 +2317,36 @@ type ClientInterface interface {
 
 	UpdateBackupStorage(ctx context.Context, name string, body UpdateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListBackupArtifacts request
+	ListBackupArtifacts(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportBackupArtifactsWithBody request with any body
+	ImportBackupArtifactsWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ImportBackupArtifacts(ctx context.Context, name string, body ImportBackupArtifactsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBackupVerificationSettings request
+	GetBackupVerificationSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateBackupVerificationSettingsWithBody request with any body
+	UpdateBackupVerificationSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateBackupVerificationSettings(ctx context.Context, body UpdateBackupVerificationSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetKubernetesClusterInfo request
 	GetKubernetesClusterInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListNamespaceCostEstimates request
+	ListNamespaceCostEstimates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EstimateDatabaseClusterCostWithBody request with any body
+	EstimateDatabaseClusterCostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EstimateDatabaseClusterCost(ctx context.Context, body EstimateDatabaseClusterCostJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAllDatabaseClusters request
+	ListAllDatabaseClusters(ctx context.Context, params *ListAllDatabaseClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListMonitoringInstances request
 	ListMonitoringInstances(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1397,6 +2369,23 @@ type ClientInterface interface {
 	// ListNamespaces request
 	ListNamespaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteNamespaceBackupRetention request
+	DeleteNamespaceBackupRetention(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNamespaceBackupRetention request
+	GetNamespaceBackupRetention(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateNamespaceBackupRetentionWithBody request with any body
+	UpdateNamespaceBackupRetentionWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateNamespaceBackupRetention(ctx context.Context, namespace string, body UpdateNamespaceBackupRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNamespaceBackupStats request
+	GetNamespaceBackupStats(ctx context.Context, namespace string, params *GetNamespaceBackupStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNamespaceCostEstimate request
+	GetNamespaceCostEstimate(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateDatabaseClusterBackupWithBody request with any body
 	CreateDatabaseClusterBackupWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1408,6 +2397,25 @@ type ClientInterface interface {
 	// GetDatabaseClusterBackup request
 	GetDatabaseClusterBackup(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListDatabaseClusterBackupCopies request
+	ListDatabaseClusterBackupCopies(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CopyDatabaseClusterBackupWithBody request with any body
+	CopyDatabaseClusterBackupWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CopyDatabaseClusterBackup(ctx context.Context, namespace string, name string, body CopyDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreDatabaseClusterBackupToNewClusterWithBody request with any body
+	RestoreDatabaseClusterBackupToNewClusterWithBody(ctx context.Context, namespace string, name string, params *RestoreDatabaseClusterBackupToNewClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RestoreDatabaseClusterBackupToNewCluster(ctx context.Context, namespace string, name string, params *RestoreDatabaseClusterBackupToNewClusterParams, body RestoreDatabaseClusterBackupToNewClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDatabaseClusterBackupVerification request
+	GetDatabaseClusterBackupVerification(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyDatabaseClusterBackup request
+	VerifyDatabaseClusterBackup(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateDatabaseClusterRestoreWithBody request with any body
 	CreateDatabaseClusterRestoreWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1424,37 +2432,129 @@ type ClientInterface interface {
 
 	UpdateDatabaseClusterRestore(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetDatabaseClusterRestoreProgress request
+	GetDatabaseClusterRestoreProgress(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListDatabaseClusters request
 	ListDatabaseClusters(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateDatabaseClusterWithBody request with any body
-	CreateDatabaseClusterWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateDatabaseClusterWithBody(ctx context.Context, namespace string, params *CreateDatabaseClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateDatabaseCluster(ctx context.Context, namespace string, params *CreateDatabaseClusterParams, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateDatabaseClusterFromTemplateWithBody request with any body
+	CreateDatabaseClusterFromTemplateWithBody(ctx context.Context, namespace string, params *CreateDatabaseClusterFromTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	CreateDatabaseCluster(ctx context.Context, namespace string, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateDatabaseClusterFromTemplate(ctx context.Context, namespace string, params *CreateDatabaseClusterFromTemplateParams, body CreateDatabaseClusterFromTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportDatabaseClusterWithBody request with any body
+	ImportDatabaseClusterWithBody(ctx context.Context, namespace string, params *ImportDatabaseClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ImportDatabaseCluster(ctx context.Context, namespace string, params *ImportDatabaseClusterParams, body ImportDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteDatabaseCluster request
-	DeleteDatabaseCluster(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteDatabaseCluster(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetDatabaseCluster request
 	GetDatabaseCluster(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateDatabaseClusterWithBody request with any body
-	UpdateDatabaseClusterWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateDatabaseClusterWithBody(ctx context.Context, namespace string, name string, params *UpdateDatabaseClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDatabaseCluster(ctx context.Context, namespace string, name string, params *UpdateDatabaseClusterParams, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteDatabaseClusterBackupReplication request
+	DeleteDatabaseClusterBackupReplication(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDatabaseClusterBackupReplication request
+	GetDatabaseClusterBackupReplication(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateDatabaseClusterBackupReplicationWithBody request with any body
+	UpdateDatabaseClusterBackupReplicationWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDatabaseClusterBackupReplication(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterBackupReplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteDatabaseClusterBackupRetention request
+	DeleteDatabaseClusterBackupRetention(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdateDatabaseCluster(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetDatabaseClusterBackupRetention request
+	GetDatabaseClusterBackupRetention(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateDatabaseClusterBackupRetentionWithBody request with any body
+	UpdateDatabaseClusterBackupRetentionWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDatabaseClusterBackupRetention(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterBackupRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreviewDatabaseClusterBackupRetention request
+	PreviewDatabaseClusterBackupRetention(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreviewDatabaseClusterBackupSchedules request
+	PreviewDatabaseClusterBackupSchedules(ctx context.Context, namespace string, name string, params *PreviewDatabaseClusterBackupSchedulesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListDatabaseClusterBackups request
 	ListDatabaseClusterBackups(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetDatabaseClusterBackupStats request
+	GetDatabaseClusterBackupStats(ctx context.Context, namespace string, name string, params *GetDatabaseClusterBackupStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetDatabaseClusterCredentials request
 	GetDatabaseClusterCredentials(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetDatabaseClusterDeletionProtection request
+	GetDatabaseClusterDeletionProtection(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateDatabaseClusterDeletionProtectionWithBody request with any body
+	UpdateDatabaseClusterDeletionProtectionWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDatabaseClusterDeletionProtection(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterDeletionProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnprotectDatabaseClusterWithBody request with any body
+	UnprotectDatabaseClusterWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UnprotectDatabaseCluster(ctx context.Context, namespace string, name string, body UnprotectDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDatabaseClusterEvents request
+	GetDatabaseClusterEvents(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportDatabaseCluster request
+	ExportDatabaseCluster(ctx context.Context, namespace string, name string, params *ExportDatabaseClusterParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDatabaseClusterFinalBackup request
+	GetDatabaseClusterFinalBackup(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDatabaseClusterHealth request
+	GetDatabaseClusterHealth(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDatabaseClusterLogs request
+	GetDatabaseClusterLogs(ctx context.Context, namespace string, name string, params *GetDatabaseClusterLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PauseDatabaseCluster request
+	PauseDatabaseCluster(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetDatabaseClusterPitr request
 	GetDatabaseClusterPitr(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteDatabaseClusterPowerSchedule request
+	DeleteDatabaseClusterPowerSchedule(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDatabaseClusterPowerSchedule request
+	GetDatabaseClusterPowerSchedule(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateDatabaseClusterPowerScheduleWithBody request with any body
+	UpdateDatabaseClusterPowerScheduleWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDatabaseClusterPowerSchedule(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterPowerScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDatabaseClusterPreRestoreBackup request
+	GetDatabaseClusterPreRestoreBackup(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListDatabaseClusterRestores request
 	ListDatabaseClusterRestores(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ResumeDatabaseCluster request
+	ResumeDatabaseCluster(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListDatabaseEngines request
 	ListDatabaseEngines(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1466,9 +2566,66 @@ type ClientInterface interface {
 
 	UpdateDatabaseEngine(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetNamespaceDeletionProtection request
+	GetNamespaceDeletionProtection(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateNamespaceDeletionProtectionWithBody request with any body
+	UpdateNamespaceDeletionProtectionWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateNamespaceDeletionProtection(ctx context.Context, namespace string, body UpdateNamespaceDeletionProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNamespacePreRestoreBackup request
+	GetNamespacePreRestoreBackup(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateNamespacePreRestoreBackupWithBody request with any body
+	UpdateNamespacePreRestoreBackupWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateNamespacePreRestoreBackup(ctx context.Context, namespace string, body UpdateNamespacePreRestoreBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteNamespaceQuota request
+	DeleteNamespaceQuota(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNamespaceQuota request
+	GetNamespaceQuota(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateNamespaceQuotaWithBody request with any body
+	UpdateNamespaceQuotaWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateNamespaceQuota(ctx context.Context, namespace string, body UpdateNamespaceQuotaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPriceTable request
+	GetPriceTable(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdatePriceTableWithBody request with any body
+	UpdatePriceTableWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdatePriceTable(ctx context.Context, body UpdatePriceTableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListNamespaceQuotas request
+	ListNamespaceQuotas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetKubernetesClusterResources request
 	GetKubernetesClusterResources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListDatabaseClusterTemplates request
+	ListDatabaseClusterTemplates(ctx context.Context, params *ListDatabaseClusterTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateDatabaseClusterTemplateWithBody request with any body
+	CreateDatabaseClusterTemplateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateDatabaseClusterTemplate(ctx context.Context, body CreateDatabaseClusterTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteDatabaseClusterTemplate request
+	DeleteDatabaseClusterTemplate(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDatabaseClusterTemplate request
+	GetDatabaseClusterTemplate(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateDatabaseClusterTemplateWithBody request with any body
+	UpdateDatabaseClusterTemplateWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDatabaseClusterTemplate(ctx context.Context, name string, body UpdateDatabaseClusterTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// VersionInfo request
 	VersionInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
@@ -1557,8 +2714,8 @@ func (c *Client) UpdateBackupStorage(ctx context.Context, name string, body Upda
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetKubernetesClusterInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetKubernetesClusterInfoRequest(c.Server)
+func (c *Client) ListBackupArtifacts(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBackupArtifactsRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
@@ -1569,8 +2726,8 @@ func (c *Client) GetKubernetesClusterInfo(ctx context.Context, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListMonitoringInstances(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListMonitoringInstancesRequest(c.Server)
+func (c *Client) ImportBackupArtifactsWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportBackupArtifactsRequestWithBody(c.Server, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1581,8 +2738,8 @@ func (c *Client) ListMonitoringInstances(ctx context.Context, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMonitoringInstanceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMonitoringInstanceRequestWithBody(c.Server, contentType, body)
+func (c *Client) ImportBackupArtifacts(ctx context.Context, name string, body ImportBackupArtifactsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportBackupArtifactsRequest(c.Server, name, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1593,8 +2750,8 @@ func (c *Client) CreateMonitoringInstanceWithBody(ctx context.Context, contentTy
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMonitoringInstance(ctx context.Context, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMonitoringInstanceRequest(c.Server, body)
+func (c *Client) GetBackupVerificationSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBackupVerificationSettingsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1605,8 +2762,8 @@ func (c *Client) CreateMonitoringInstance(ctx context.Context, body CreateMonito
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteMonitoringInstance(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteMonitoringInstanceRequest(c.Server, name)
+func (c *Client) UpdateBackupVerificationSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBackupVerificationSettingsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1617,8 +2774,8 @@ func (c *Client) DeleteMonitoringInstance(ctx context.Context, name string, reqE
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMonitoringInstance(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMonitoringInstanceRequest(c.Server, name)
+func (c *Client) UpdateBackupVerificationSettings(ctx context.Context, body UpdateBackupVerificationSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBackupVerificationSettingsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1629,8 +2786,8 @@ func (c *Client) GetMonitoringInstance(ctx context.Context, name string, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMonitoringInstanceWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMonitoringInstanceRequestWithBody(c.Server, name, contentType, body)
+func (c *Client) GetKubernetesClusterInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetKubernetesClusterInfoRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1641,8 +2798,8 @@ func (c *Client) UpdateMonitoringInstanceWithBody(ctx context.Context, name stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMonitoringInstance(ctx context.Context, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMonitoringInstanceRequest(c.Server, name, body)
+func (c *Client) ListNamespaceCostEstimates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListNamespaceCostEstimatesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1653,8 +2810,8 @@ func (c *Client) UpdateMonitoringInstance(ctx context.Context, name string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListNamespaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListNamespacesRequest(c.Server)
+func (c *Client) EstimateDatabaseClusterCostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEstimateDatabaseClusterCostRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1665,8 +2822,8 @@ func (c *Client) ListNamespaces(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabaseClusterBackupWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseClusterBackupRequestWithBody(c.Server, namespace, contentType, body)
+func (c *Client) EstimateDatabaseClusterCost(ctx context.Context, body EstimateDatabaseClusterCostJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEstimateDatabaseClusterCostRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1677,8 +2834,8 @@ func (c *Client) CreateDatabaseClusterBackupWithBody(ctx context.Context, namesp
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabaseClusterBackup(ctx context.Context, namespace string, body CreateDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseClusterBackupRequest(c.Server, namespace, body)
+func (c *Client) ListAllDatabaseClusters(ctx context.Context, params *ListAllDatabaseClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAllDatabaseClustersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1689,8 +2846,8 @@ func (c *Client) CreateDatabaseClusterBackup(ctx context.Context, namespace stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteDatabaseClusterBackup(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteDatabaseClusterBackupRequest(c.Server, namespace, name)
+func (c *Client) ListMonitoringInstances(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMonitoringInstancesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1701,8 +2858,8 @@ func (c *Client) DeleteDatabaseClusterBackup(ctx context.Context, namespace stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabaseClusterBackup(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabaseClusterBackupRequest(c.Server, namespace, name)
+func (c *Client) CreateMonitoringInstanceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMonitoringInstanceRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1713,8 +2870,8 @@ func (c *Client) GetDatabaseClusterBackup(ctx context.Context, namespace string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabaseClusterRestoreWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseClusterRestoreRequestWithBody(c.Server, namespace, contentType, body)
+func (c *Client) CreateMonitoringInstance(ctx context.Context, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMonitoringInstanceRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1725,8 +2882,8 @@ func (c *Client) CreateDatabaseClusterRestoreWithBody(ctx context.Context, names
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabaseClusterRestore(ctx context.Context, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseClusterRestoreRequest(c.Server, namespace, body)
+func (c *Client) DeleteMonitoringInstance(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMonitoringInstanceRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
@@ -1737,8 +2894,8 @@ func (c *Client) CreateDatabaseClusterRestore(ctx context.Context, namespace str
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteDatabaseClusterRestore(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteDatabaseClusterRestoreRequest(c.Server, namespace, name)
+func (c *Client) GetMonitoringInstance(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMonitoringInstanceRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
@@ -1749,8 +2906,8 @@ func (c *Client) DeleteDatabaseClusterRestore(ctx context.Context, namespace str
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabaseClusterRestore(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabaseClusterRestoreRequest(c.Server, namespace, name)
+func (c *Client) UpdateMonitoringInstanceWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMonitoringInstanceRequestWithBody(c.Server, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1761,8 +2918,8 @@ func (c *Client) GetDatabaseClusterRestore(ctx context.Context, namespace string
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabaseClusterRestoreWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseClusterRestoreRequestWithBody(c.Server, namespace, name, contentType, body)
+func (c *Client) UpdateMonitoringInstance(ctx context.Context, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMonitoringInstanceRequest(c.Server, name, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1773,8 +2930,8 @@ func (c *Client) UpdateDatabaseClusterRestoreWithBody(ctx context.Context, names
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabaseClusterRestore(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseClusterRestoreRequest(c.Server, namespace, name, body)
+func (c *Client) ListNamespaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListNamespacesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1785,8 +2942,8 @@ func (c *Client) UpdateDatabaseClusterRestore(ctx context.Context, namespace str
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDatabaseClusters(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDatabaseClustersRequest(c.Server, namespace)
+func (c *Client) DeleteNamespaceBackupRetention(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteNamespaceBackupRetentionRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -1797,8 +2954,8 @@ func (c *Client) ListDatabaseClusters(ctx context.Context, namespace string, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabaseClusterWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseClusterRequestWithBody(c.Server, namespace, contentType, body)
+func (c *Client) GetNamespaceBackupRetention(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNamespaceBackupRetentionRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -1809,8 +2966,8 @@ func (c *Client) CreateDatabaseClusterWithBody(ctx context.Context, namespace st
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabaseCluster(ctx context.Context, namespace string, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseClusterRequest(c.Server, namespace, body)
+func (c *Client) UpdateNamespaceBackupRetentionWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNamespaceBackupRetentionRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1821,8 +2978,8 @@ func (c *Client) CreateDatabaseCluster(ctx context.Context, namespace string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteDatabaseCluster(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteDatabaseClusterRequest(c.Server, namespace, name)
+func (c *Client) UpdateNamespaceBackupRetention(ctx context.Context, namespace string, body UpdateNamespaceBackupRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNamespaceBackupRetentionRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1833,8 +2990,8 @@ func (c *Client) DeleteDatabaseCluster(ctx context.Context, namespace string, na
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabaseCluster(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabaseClusterRequest(c.Server, namespace, name)
+func (c *Client) GetNamespaceBackupStats(ctx context.Context, namespace string, params *GetNamespaceBackupStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNamespaceBackupStatsRequest(c.Server, namespace, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1845,8 +3002,8 @@ func (c *Client) GetDatabaseCluster(ctx context.Context, namespace string, name
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabaseClusterWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseClusterRequestWithBody(c.Server, namespace, name, contentType, body)
+func (c *Client) GetNamespaceCostEstimate(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNamespaceCostEstimateRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -1857,8 +3014,8 @@ func (c *Client) UpdateDatabaseClusterWithBody(ctx context.Context, namespace st
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabaseCluster(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseClusterRequest(c.Server, namespace, name, body)
+func (c *Client) CreateDatabaseClusterBackupWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterBackupRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1869,8 +3026,8 @@ func (c *Client) UpdateDatabaseCluster(ctx context.Context, namespace string, na
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDatabaseClusterBackups(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDatabaseClusterBackupsRequest(c.Server, namespace, name)
+func (c *Client) CreateDatabaseClusterBackup(ctx context.Context, namespace string, body CreateDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterBackupRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1881,8 +3038,8 @@ func (c *Client) ListDatabaseClusterBackups(ctx context.Context, namespace strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabaseClusterCredentials(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabaseClusterCredentialsRequest(c.Server, namespace, name)
+func (c *Client) DeleteDatabaseClusterBackup(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDatabaseClusterBackupRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -1893,8 +3050,8 @@ func (c *Client) GetDatabaseClusterCredentials(ctx context.Context, namespace st
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabaseClusterPitr(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabaseClusterPitrRequest(c.Server, namespace, name)
+func (c *Client) GetDatabaseClusterBackup(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterBackupRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -1905,8 +3062,8 @@ func (c *Client) GetDatabaseClusterPitr(ctx context.Context, namespace string, n
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDatabaseClusterRestores(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDatabaseClusterRestoresRequest(c.Server, namespace, name)
+func (c *Client) ListDatabaseClusterBackupCopies(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabaseClusterBackupCopiesRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -1917,8 +3074,8 @@ func (c *Client) ListDatabaseClusterRestores(ctx context.Context, namespace stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDatabaseEngines(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDatabaseEnginesRequest(c.Server, namespace)
+func (c *Client) CopyDatabaseClusterBackupWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCopyDatabaseClusterBackupRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1929,8 +3086,8 @@ func (c *Client) ListDatabaseEngines(ctx context.Context, namespace string, reqE
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabaseEngine(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabaseEngineRequest(c.Server, namespace, name)
+func (c *Client) CopyDatabaseClusterBackup(ctx context.Context, namespace string, name string, body CopyDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCopyDatabaseClusterBackupRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1941,8 +3098,8 @@ func (c *Client) GetDatabaseEngine(ctx context.Context, namespace string, name s
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabaseEngineWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseEngineRequestWithBody(c.Server, namespace, name, contentType, body)
+func (c *Client) RestoreDatabaseClusterBackupToNewClusterWithBody(ctx context.Context, namespace string, name string, params *RestoreDatabaseClusterBackupToNewClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreDatabaseClusterBackupToNewClusterRequestWithBody(c.Server, namespace, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1953,8 +3110,8 @@ func (c *Client) UpdateDatabaseEngineWithBody(ctx context.Context, namespace str
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabaseEngine(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseEngineRequest(c.Server, namespace, name, body)
+func (c *Client) RestoreDatabaseClusterBackupToNewCluster(ctx context.Context, namespace string, name string, params *RestoreDatabaseClusterBackupToNewClusterParams, body RestoreDatabaseClusterBackupToNewClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreDatabaseClusterBackupToNewClusterRequest(c.Server, namespace, name, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1965,8 +3122,8 @@ func (c *Client) UpdateDatabaseEngine(ctx context.Context, namespace string, nam
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetKubernetesClusterResources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetKubernetesClusterResourcesRequest(c.Server)
+func (c *Client) GetDatabaseClusterBackupVerification(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterBackupVerificationRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -1977,8 +3134,8 @@ func (c *Client) GetKubernetesClusterResources(ctx context.Context, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) VersionInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVersionInfoRequest(c.Server)
+func (c *Client) VerifyDatabaseClusterBackup(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyDatabaseClusterBackupRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -1989,977 +3146,940 @@ func (c *Client) VersionInfo(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-// NewListBackupStoragesRequest generates requests for ListBackupStorages
-func NewListBackupStoragesRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateDatabaseClusterRestoreWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterRestoreRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/backup-storages")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateDatabaseClusterRestore(ctx context.Context, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterRestoreRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateBackupStorageRequest calls the generic CreateBackupStorage builder with application/json body
-func NewCreateBackupStorageRequest(server string, body CreateBackupStorageJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteDatabaseClusterRestore(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDatabaseClusterRestoreRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateBackupStorageRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateBackupStorageRequestWithBody generates requests for CreateBackupStorage with any type of body
-func NewCreateBackupStorageRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetDatabaseClusterRestore(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterRestoreRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/backup-storages")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateDatabaseClusterRestoreWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterRestoreRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteBackupStorageRequest generates requests for DeleteBackupStorage
-func NewDeleteBackupStorageRequest(server string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) UpdateDatabaseClusterRestore(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterRestoreRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetDatabaseClusterRestoreProgress(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterRestoreProgressRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/backup-storages/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListDatabaseClusters(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabaseClustersRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetBackupStorageRequest generates requests for GetBackupStorage
-func NewGetBackupStorageRequest(server string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) CreateDatabaseClusterWithBody(ctx context.Context, namespace string, params *CreateDatabaseClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterRequestWithBody(c.Server, namespace, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateDatabaseCluster(ctx context.Context, namespace string, params *CreateDatabaseClusterParams, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterRequest(c.Server, namespace, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/backup-storages/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateDatabaseClusterFromTemplateWithBody(ctx context.Context, namespace string, params *CreateDatabaseClusterFromTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterFromTemplateRequestWithBody(c.Server, namespace, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateBackupStorageRequest calls the generic UpdateBackupStorage builder with application/json body
-func NewUpdateBackupStorageRequest(server string, name string, body UpdateBackupStorageJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CreateDatabaseClusterFromTemplate(ctx context.Context, namespace string, params *CreateDatabaseClusterFromTemplateParams, body CreateDatabaseClusterFromTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterFromTemplateRequest(c.Server, namespace, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateBackupStorageRequestWithBody(server, name, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateBackupStorageRequestWithBody generates requests for UpdateBackupStorage with any type of body
-func NewUpdateBackupStorageRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) ImportDatabaseClusterWithBody(ctx context.Context, namespace string, params *ImportDatabaseClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportDatabaseClusterRequestWithBody(c.Server, namespace, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ImportDatabaseCluster(ctx context.Context, namespace string, params *ImportDatabaseClusterParams, body ImportDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportDatabaseClusterRequest(c.Server, namespace, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/backup-storages/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteDatabaseCluster(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDatabaseClusterRequest(c.Server, namespace, name, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetKubernetesClusterInfoRequest generates requests for GetKubernetesClusterInfo
-func NewGetKubernetesClusterInfoRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetDatabaseCluster(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/cluster-info")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateDatabaseClusterWithBody(ctx context.Context, namespace string, name string, params *UpdateDatabaseClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterRequestWithBody(c.Server, namespace, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListMonitoringInstancesRequest generates requests for ListMonitoringInstances
-func NewListMonitoringInstancesRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateDatabaseCluster(ctx context.Context, namespace string, name string, params *UpdateDatabaseClusterParams, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterRequest(c.Server, namespace, name, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/monitoring-instances")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteDatabaseClusterBackupReplication(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDatabaseClusterBackupReplicationRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateMonitoringInstanceRequest calls the generic CreateMonitoringInstance builder with application/json body
-func NewCreateMonitoringInstanceRequest(server string, body CreateMonitoringInstanceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetDatabaseClusterBackupReplication(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterBackupReplicationRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateMonitoringInstanceRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateMonitoringInstanceRequestWithBody generates requests for CreateMonitoringInstance with any type of body
-func NewCreateMonitoringInstanceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateDatabaseClusterBackupReplicationWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterBackupReplicationRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/monitoring-instances")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateDatabaseClusterBackupReplication(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterBackupReplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterBackupReplicationRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteMonitoringInstanceRequest generates requests for DeleteMonitoringInstance
-func NewDeleteMonitoringInstanceRequest(server string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) DeleteDatabaseClusterBackupRetention(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDatabaseClusterBackupRetentionRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetDatabaseClusterBackupRetention(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterBackupRetentionRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/monitoring-instances/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateDatabaseClusterBackupRetentionWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterBackupRetentionRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetMonitoringInstanceRequest generates requests for GetMonitoringInstance
-func NewGetMonitoringInstanceRequest(server string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) UpdateDatabaseClusterBackupRetention(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterBackupRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterBackupRetentionRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PreviewDatabaseClusterBackupRetention(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewDatabaseClusterBackupRetentionRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/monitoring-instances/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PreviewDatabaseClusterBackupSchedules(ctx context.Context, namespace string, name string, params *PreviewDatabaseClusterBackupSchedulesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewDatabaseClusterBackupSchedulesRequest(c.Server, namespace, name, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateMonitoringInstanceRequest calls the generic UpdateMonitoringInstance builder with application/json body
-func NewUpdateMonitoringInstanceRequest(server string, name string, body UpdateMonitoringInstanceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListDatabaseClusterBackups(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabaseClusterBackupsRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateMonitoringInstanceRequestWithBody(server, name, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateMonitoringInstanceRequestWithBody generates requests for UpdateMonitoringInstance with any type of body
-func NewUpdateMonitoringInstanceRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) GetDatabaseClusterBackupStats(ctx context.Context, namespace string, name string, params *GetDatabaseClusterBackupStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterBackupStatsRequest(c.Server, namespace, name, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetDatabaseClusterCredentials(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterCredentialsRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/monitoring-instances/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetDatabaseClusterDeletionProtection(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterDeletionProtectionRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListNamespacesRequest generates requests for ListNamespaces
-func NewListNamespacesRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateDatabaseClusterDeletionProtectionWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterDeletionProtectionRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateDatabaseClusterDeletionProtection(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterDeletionProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterDeletionProtectionRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateDatabaseClusterBackupRequest calls the generic CreateDatabaseClusterBackup builder with application/json body
-func NewCreateDatabaseClusterBackupRequest(server string, namespace string, body CreateDatabaseClusterBackupJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UnprotectDatabaseClusterWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnprotectDatabaseClusterRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateDatabaseClusterBackupRequestWithBody(server, namespace, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateDatabaseClusterBackupRequestWithBody generates requests for CreateDatabaseClusterBackup with any type of body
-func NewCreateDatabaseClusterBackupRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) UnprotectDatabaseCluster(ctx context.Context, namespace string, name string, body UnprotectDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnprotectDatabaseClusterRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetDatabaseClusterEvents(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterEventsRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ExportDatabaseCluster(ctx context.Context, namespace string, name string, params *ExportDatabaseClusterParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportDatabaseClusterRequest(c.Server, namespace, name, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteDatabaseClusterBackupRequest generates requests for DeleteDatabaseClusterBackup
-func NewDeleteDatabaseClusterBackupRequest(server string, namespace string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) GetDatabaseClusterFinalBackup(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterFinalBackupRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetDatabaseClusterHealth(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterHealthRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetDatabaseClusterLogs(ctx context.Context, namespace string, name string, params *GetDatabaseClusterLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterLogsRequest(c.Server, namespace, name, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetDatabaseClusterBackupRequest generates requests for GetDatabaseClusterBackup
-func NewGetDatabaseClusterBackupRequest(server string, namespace string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) PauseDatabaseCluster(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPauseDatabaseClusterRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetDatabaseClusterPitr(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterPitrRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteDatabaseClusterPowerSchedule(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDatabaseClusterPowerScheduleRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateDatabaseClusterRestoreRequest calls the generic CreateDatabaseClusterRestore builder with application/json body
-func NewCreateDatabaseClusterRestoreRequest(server string, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetDatabaseClusterPowerSchedule(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterPowerScheduleRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateDatabaseClusterRestoreRequestWithBody(server, namespace, "application/json", bodyReader)
-}
-
-// NewCreateDatabaseClusterRestoreRequestWithBody generates requests for CreateDatabaseClusterRestore with any type of body
-func NewCreateDatabaseClusterRestoreRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateDatabaseClusterPowerScheduleWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterPowerScheduleRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateDatabaseClusterPowerSchedule(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterPowerScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterPowerScheduleRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteDatabaseClusterRestoreRequest generates requests for DeleteDatabaseClusterRestore
-func NewDeleteDatabaseClusterRestoreRequest(server string, namespace string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) GetDatabaseClusterPreRestoreBackup(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterPreRestoreBackupRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListDatabaseClusterRestores(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabaseClusterRestoresRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ResumeDatabaseCluster(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResumeDatabaseClusterRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetDatabaseClusterRestoreRequest generates requests for GetDatabaseClusterRestore
-func NewGetDatabaseClusterRestoreRequest(server string, namespace string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) ListDatabaseEngines(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabaseEnginesRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetDatabaseEngine(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseEngineRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateDatabaseEngineWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseEngineRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateDatabaseClusterRestoreRequest calls the generic UpdateDatabaseClusterRestore builder with application/json body
-func NewUpdateDatabaseClusterRestoreRequest(server string, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateDatabaseEngine(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseEngineRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDatabaseClusterRestoreRequestWithBody(server, namespace, name, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateDatabaseClusterRestoreRequestWithBody generates requests for UpdateDatabaseClusterRestore with any type of body
-func NewUpdateDatabaseClusterRestoreRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) GetNamespaceDeletionProtection(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNamespaceDeletionProtectionRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateNamespaceDeletionProtectionWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNamespaceDeletionProtectionRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateNamespaceDeletionProtection(ctx context.Context, namespace string, body UpdateNamespaceDeletionProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNamespaceDeletionProtectionRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListDatabaseClustersRequest generates requests for ListDatabaseClusters
-func NewListDatabaseClustersRequest(server string, namespace string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) GetNamespacePreRestoreBackup(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNamespacePreRestoreBackupRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateNamespacePreRestoreBackupWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNamespacePreRestoreBackupRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateNamespacePreRestoreBackup(ctx context.Context, namespace string, body UpdateNamespacePreRestoreBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNamespacePreRestoreBackupRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateDatabaseClusterRequest calls the generic CreateDatabaseCluster builder with application/json body
-func NewCreateDatabaseClusterRequest(server string, namespace string, body CreateDatabaseClusterJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteNamespaceQuota(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteNamespaceQuotaRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateDatabaseClusterRequestWithBody(server, namespace, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateDatabaseClusterRequestWithBody generates requests for CreateDatabaseCluster with any type of body
-func NewCreateDatabaseClusterRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) GetNamespaceQuota(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNamespaceQuotaRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateNamespaceQuotaWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNamespaceQuotaRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateNamespaceQuota(ctx context.Context, namespace string, body UpdateNamespaceQuotaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNamespaceQuotaRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteDatabaseClusterRequest generates requests for DeleteDatabaseCluster
-func NewDeleteDatabaseClusterRequest(server string, namespace string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) GetPriceTable(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPriceTableRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdatePriceTableWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePriceTableRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdatePriceTable(ctx context.Context, body UpdatePriceTableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePriceTableRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetDatabaseClusterRequest generates requests for GetDatabaseCluster
-func NewGetDatabaseClusterRequest(server string, namespace string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) ListNamespaceQuotas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListNamespaceQuotasRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetKubernetesClusterResources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetKubernetesClusterResourcesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListDatabaseClusterTemplates(ctx context.Context, params *ListDatabaseClusterTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabaseClusterTemplatesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateDatabaseClusterRequest calls the generic UpdateDatabaseCluster builder with application/json body
-func NewUpdateDatabaseClusterRequest(server string, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CreateDatabaseClusterTemplateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterTemplateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDatabaseClusterRequestWithBody(server, namespace, name, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateDatabaseClusterRequestWithBody generates requests for UpdateDatabaseCluster with any type of body
-func NewUpdateDatabaseClusterRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) CreateDatabaseClusterTemplate(ctx context.Context, body CreateDatabaseClusterTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterTemplateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteDatabaseClusterTemplate(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDatabaseClusterTemplateRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetDatabaseClusterTemplate(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterTemplateRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) UpdateDatabaseClusterTemplateWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterTemplateRequestWithBody(c.Server, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListDatabaseClusterBackupsRequest generates requests for ListDatabaseClusterBackups
-func NewListDatabaseClusterBackupsRequest(server string, namespace string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) UpdateDatabaseClusterTemplate(ctx context.Context, name string, body UpdateDatabaseClusterTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterTemplateRequest(c.Server, name, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) VersionInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVersionInfoRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListBackupStoragesRequest generates requests for ListBackupStorages
+func NewListBackupStoragesRequest(server string) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/backups", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/backup-storages")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2977,30 +4097,27 @@ func NewListDatabaseClusterBackupsRequest(server string, namespace string, name
 	return req, nil
 }
 
-// NewGetDatabaseClusterCredentialsRequest generates requests for GetDatabaseClusterCredentials
-func NewGetDatabaseClusterCredentialsRequest(server string, namespace string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+// NewCreateBackupStorageRequest calls the generic CreateBackupStorage builder with application/json body
+func NewCreateBackupStorageRequest(server string, body CreateBackupStorageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBackupStorageRequestWithBody(server, "application/json", bodyReader)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
-		return nil, err
-	}
+// NewCreateBackupStorageRequestWithBody generates requests for CreateBackupStorage with any type of body
+func NewCreateBackupStorageRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/credentials", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/backup-storages")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3010,28 +4127,23 @@ func NewGetDatabaseClusterCredentialsRequest(server string, namespace string, na
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetDatabaseClusterPitrRequest generates requests for GetDatabaseClusterPitr
-func NewGetDatabaseClusterPitrRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewDeleteBackupStorageRequest generates requests for DeleteBackupStorage
+func NewDeleteBackupStorageRequest(server string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -3041,7 +4153,7 @@ func NewGetDatabaseClusterPitrRequest(server string, namespace string, name stri
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/pitr", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/backup-storages/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3051,7 +4163,7 @@ func NewGetDatabaseClusterPitrRequest(server string, namespace string, name stri
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3059,20 +4171,13 @@ func NewGetDatabaseClusterPitrRequest(server string, namespace string, name stri
 	return req, nil
 }
 
-// NewListDatabaseClusterRestoresRequest generates requests for ListDatabaseClusterRestores
-func NewListDatabaseClusterRestoresRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewGetBackupStorageRequest generates requests for GetBackupStorage
+func NewGetBackupStorageRequest(server string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -3082,7 +4187,7 @@ func NewListDatabaseClusterRestoresRequest(server string, namespace string, name
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/restores", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/backup-storages/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3100,13 +4205,24 @@ func NewListDatabaseClusterRestoresRequest(server string, namespace string, name
 	return req, nil
 }
 
-// NewListDatabaseEnginesRequest generates requests for ListDatabaseEngines
-func NewListDatabaseEnginesRequest(server string, namespace string) (*http.Request, error) {
+// NewUpdateBackupStorageRequest calls the generic UpdateBackupStorage builder with application/json body
+func NewUpdateBackupStorageRequest(server string, name string, body UpdateBackupStorageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateBackupStorageRequestWithBody(server, name, "application/json", bodyReader)
+}
+
+// NewUpdateBackupStorageRequestWithBody generates requests for UpdateBackupStorage with any type of body
+func NewUpdateBackupStorageRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -3116,7 +4232,7 @@ func NewListDatabaseEnginesRequest(server string, namespace string) (*http.Reque
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-engines", pathParam0)
+	operationPath := fmt.Sprintf("/backup-storages/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3126,28 +4242,23 @@ func NewListDatabaseEnginesRequest(server string, namespace string) (*http.Reque
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetDatabaseEngineRequest generates requests for GetDatabaseEngine
-func NewGetDatabaseEngineRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewListBackupArtifactsRequest generates requests for ListBackupArtifacts
+func NewListBackupArtifactsRequest(server string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -3157,7 +4268,7 @@ func NewGetDatabaseEngineRequest(server string, namespace string, name string) (
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/backup-storages/%s/artifacts", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3175,31 +4286,24 @@ func NewGetDatabaseEngineRequest(server string, namespace string, name string) (
 	return req, nil
 }
 
-// NewUpdateDatabaseEngineRequest calls the generic UpdateDatabaseEngine builder with application/json body
-func NewUpdateDatabaseEngineRequest(server string, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody) (*http.Request, error) {
+// NewImportBackupArtifactsRequest calls the generic ImportBackupArtifacts builder with application/json body
+func NewImportBackupArtifactsRequest(server string, name string, body ImportBackupArtifactsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDatabaseEngineRequestWithBody(server, namespace, name, "application/json", bodyReader)
+	return NewImportBackupArtifactsRequestWithBody(server, name, "application/json", bodyReader)
 }
 
-// NewUpdateDatabaseEngineRequestWithBody generates requests for UpdateDatabaseEngine with any type of body
-func NewUpdateDatabaseEngineRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+// NewImportBackupArtifactsRequestWithBody generates requests for ImportBackupArtifacts with any type of body
+func NewImportBackupArtifactsRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -3209,7 +4313,7 @@ func NewUpdateDatabaseEngineRequestWithBody(server string, namespace string, nam
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/backup-storages/%s/artifacts/import", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3219,7 +4323,7 @@ func NewUpdateDatabaseEngineRequestWithBody(server string, namespace string, nam
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3229,8 +4333,8 @@ func NewUpdateDatabaseEngineRequestWithBody(server string, namespace string, nam
 	return req, nil
 }
 
-// NewGetKubernetesClusterResourcesRequest generates requests for GetKubernetesClusterResources
-func NewGetKubernetesClusterResourcesRequest(server string) (*http.Request, error) {
+// NewGetBackupVerificationSettingsRequest generates requests for GetBackupVerificationSettings
+func NewGetBackupVerificationSettingsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3238,7 +4342,7 @@ func NewGetKubernetesClusterResourcesRequest(server string) (*http.Request, erro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/resources")
+	operationPath := fmt.Sprintf("/backup-verification")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3256,16 +4360,27 @@ func NewGetKubernetesClusterResourcesRequest(server string) (*http.Request, erro
 	return req, nil
 }
 
-// NewVersionInfoRequest generates requests for VersionInfo
-func NewVersionInfoRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+// NewUpdateBackupVerificationSettingsRequest calls the generic UpdateBackupVerificationSettings builder with application/json body
+func NewUpdateBackupVerificationSettingsRequest(server string, body UpdateBackupVerificationSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateBackupVerificationSettingsRequestWithBody(server, "application/json", bodyReader)
+}
 
-	operationPath := fmt.Sprintf("/version")
+// NewUpdateBackupVerificationSettingsRequestWithBody generates requests for UpdateBackupVerificationSettings with any type of body
+func NewUpdateBackupVerificationSettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/backup-verification")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3275,1366 +4390,10071 @@ func NewVersionInfoRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewGetKubernetesClusterInfoRequest generates requests for GetKubernetesClusterInfo
+func NewGetKubernetesClusterInfoRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/cluster-info")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
+
+	return req, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ListBackupStoragesWithResponse request
-	ListBackupStoragesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListBackupStoragesResponse, error)
+// NewListNamespaceCostEstimatesRequest generates requests for ListNamespaceCostEstimates
+func NewListNamespaceCostEstimatesRequest(server string) (*http.Request, error) {
+	var err error
 
-	// CreateBackupStorageWithBodyWithResponse request with any body
-	CreateBackupStorageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateBackupStorageWithResponse(ctx context.Context, body CreateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error)
+	operationPath := fmt.Sprintf("/cost-estimates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DeleteBackupStorageWithResponse request
-	DeleteBackupStorageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteBackupStorageResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetBackupStorageWithResponse request
-	GetBackupStorageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetBackupStorageResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateBackupStorageWithBodyWithResponse request with any body
-	UpdateBackupStorageWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error)
+	return req, nil
+}
 
-	UpdateBackupStorageWithResponse(ctx context.Context, name string, body UpdateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error)
+// NewEstimateDatabaseClusterCostRequest calls the generic EstimateDatabaseClusterCost builder with application/json body
+func NewEstimateDatabaseClusterCostRequest(server string, body EstimateDatabaseClusterCostJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEstimateDatabaseClusterCostRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// GetKubernetesClusterInfoWithResponse request
-	GetKubernetesClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterInfoResponse, error)
+// NewEstimateDatabaseClusterCostRequestWithBody generates requests for EstimateDatabaseClusterCost with any type of body
+func NewEstimateDatabaseClusterCostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// ListMonitoringInstancesWithResponse request
-	ListMonitoringInstancesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMonitoringInstancesResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateMonitoringInstanceWithBodyWithResponse request with any body
-	CreateMonitoringInstanceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error)
+	operationPath := fmt.Sprintf("/cost-estimates/database-cluster")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreateMonitoringInstanceWithResponse(ctx context.Context, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteMonitoringInstanceWithResponse request
-	DeleteMonitoringInstanceWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteMonitoringInstanceResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetMonitoringInstanceWithResponse request
-	GetMonitoringInstanceWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetMonitoringInstanceResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// UpdateMonitoringInstanceWithBodyWithResponse request with any body
-	UpdateMonitoringInstanceWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error)
+	return req, nil
+}
 
-	UpdateMonitoringInstanceWithResponse(ctx context.Context, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error)
+// NewListAllDatabaseClustersRequest generates requests for ListAllDatabaseClusters
+func NewListAllDatabaseClustersRequest(server string, params *ListAllDatabaseClustersParams) (*http.Request, error) {
+	var err error
 
-	// ListNamespacesWithResponse request
-	ListNamespacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespacesResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateDatabaseClusterBackupWithBodyWithResponse request with any body
-	CreateDatabaseClusterBackupWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error)
+	operationPath := fmt.Sprintf("/database-clusters")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreateDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteDatabaseClusterBackupWithResponse request
-	DeleteDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterBackupResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// GetDatabaseClusterBackupWithResponse request
-	GetDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupResponse, error)
+		if params.Search != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
 
-	// CreateDatabaseClusterRestoreWithBodyWithResponse request with any body
-	CreateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error)
+		if params.Namespace != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "namespace", runtime.ParamLocationQuery, *params.Namespace); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
 
-	CreateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error)
+		if params.Engine != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "engine", runtime.ParamLocationQuery, *params.Engine); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
 
-	// DeleteDatabaseClusterRestoreWithResponse request
-	DeleteDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterRestoreResponse, error)
+		if params.Version != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
 
-	// GetDatabaseClusterRestoreWithResponse request
-	GetDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterRestoreResponse, error)
+		if params.Status != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
 
-	// UpdateDatabaseClusterRestoreWithBodyWithResponse request with any body
-	UpdateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error)
+		if params.StorageClass != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "storageClass", runtime.ParamLocationQuery, *params.StorageClass); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
 
-	UpdateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error)
+		if params.BackupStorage != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "backupStorage", runtime.ParamLocationQuery, *params.BackupStorage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
 
-	// ListDatabaseClustersWithResponse request
-	ListDatabaseClustersWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseClustersResponse, error)
+		if params.MonitoringInstance != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "monitoringInstance", runtime.ParamLocationQuery, *params.MonitoringInstance); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
 
-	// CreateDatabaseClusterWithBodyWithResponse request with any body
-	CreateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	CreateDatabaseClusterWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteDatabaseClusterWithResponse request
-	DeleteDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterResponse, error)
+	return req, nil
+}
 
-	// GetDatabaseClusterWithResponse request
-	GetDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterResponse, error)
+// NewListMonitoringInstancesRequest generates requests for ListMonitoringInstances
+func NewListMonitoringInstancesRequest(server string) (*http.Request, error) {
+	var err error
 
-	// UpdateDatabaseClusterWithBodyWithResponse request with any body
-	UpdateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error)
+	operationPath := fmt.Sprintf("/monitoring-instances")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ListDatabaseClusterBackupsWithResponse request
-	ListDatabaseClusterBackupsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDatabaseClusterCredentialsWithResponse request
-	GetDatabaseClusterCredentialsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterCredentialsResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDatabaseClusterPitrWithResponse request
-	GetDatabaseClusterPitrWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterPitrResponse, error)
+	return req, nil
+}
 
-	// ListDatabaseClusterRestoresWithResponse request
-	ListDatabaseClusterRestoresWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterRestoresResponse, error)
+// NewCreateMonitoringInstanceRequest calls the generic CreateMonitoringInstance builder with application/json body
+func NewCreateMonitoringInstanceRequest(server string, body CreateMonitoringInstanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateMonitoringInstanceRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// ListDatabaseEnginesWithResponse request
-	ListDatabaseEnginesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseEnginesResponse, error)
+// NewCreateMonitoringInstanceRequestWithBody generates requests for CreateMonitoringInstance with any type of body
+func NewCreateMonitoringInstanceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetDatabaseEngineWithResponse request
-	GetDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseEngineResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateDatabaseEngineWithBodyWithResponse request with any body
-	UpdateDatabaseEngineWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error)
+	operationPath := fmt.Sprintf("/monitoring-instances")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	UpdateDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetKubernetesClusterResourcesWithResponse request
-	GetKubernetesClusterResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterResourcesResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// VersionInfoWithResponse request
-	VersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VersionInfoResponse, error)
-}
+	req.Header.Add("Content-Type", contentType)
 
-type ListBackupStoragesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupStoragesList
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListBackupStoragesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewDeleteMonitoringInstanceRequest generates requests for DeleteMonitoringInstance
+func NewDeleteMonitoringInstanceRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListBackupStoragesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateBackupStorageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupStorage
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/monitoring-instances/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateBackupStorageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateBackupStorageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteBackupStorageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteBackupStorageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetMonitoringInstanceRequest generates requests for GetMonitoringInstance
+func NewGetMonitoringInstanceRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteBackupStorageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetBackupStorageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupStorage
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/monitoring-instances/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetBackupStorageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBackupStorageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateBackupStorageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupStorage
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateBackupStorageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateMonitoringInstanceRequest calls the generic UpdateMonitoringInstance builder with application/json body
+func NewUpdateMonitoringInstanceRequest(server string, name string, body UpdateMonitoringInstanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMonitoringInstanceRequestWithBody(server, name, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateBackupStorageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewUpdateMonitoringInstanceRequestWithBody generates requests for UpdateMonitoringInstance with any type of body
+func NewUpdateMonitoringInstanceRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetKubernetesClusterInfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *KubernetesClusterInfo
-	JSON400      *Error
-	JSON500      *Error
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetKubernetesClusterInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetKubernetesClusterInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListMonitoringInstancesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MonitoringInstancesList
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/monitoring-instances/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListMonitoringInstancesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListMonitoringInstancesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateMonitoringInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MonitoringInstance
-	JSON400      *Error
-	JSON500      *Error
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r CreateMonitoringInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateMonitoringInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewListNamespacesRequest generates requests for ListNamespaces
+func NewListNamespacesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteMonitoringInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteMonitoringInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteMonitoringInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetMonitoringInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MonitoringInstance
-	JSON400      *Error
-	JSON404      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetMonitoringInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewDeleteNamespaceBackupRetentionRequest generates requests for DeleteNamespaceBackupRetention
+func NewDeleteNamespaceBackupRetentionRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMonitoringInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateMonitoringInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MonitoringInstance
-	JSON400      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-retention", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateMonitoringInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateMonitoringInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListNamespacesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *NamespaceList
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListNamespacesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetNamespaceBackupRetentionRequest generates requests for GetNamespaceBackupRetention
+func NewGetNamespaceBackupRetentionRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListNamespacesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateDatabaseClusterBackupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterBackup
-	JSON201      *DatabaseClusterBackup
-	JSON202      *DatabaseClusterBackup
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-retention", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateDatabaseClusterBackupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDatabaseClusterBackupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteDatabaseClusterBackupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteDatabaseClusterBackupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateNamespaceBackupRetentionRequest calls the generic UpdateNamespaceBackupRetention builder with application/json body
+func NewUpdateNamespaceBackupRetentionRequest(server string, namespace string, body UpdateNamespaceBackupRetentionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateNamespaceBackupRetentionRequestWithBody(server, namespace, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDatabaseClusterBackupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewUpdateNamespaceBackupRetentionRequestWithBody generates requests for UpdateNamespaceBackupRetention with any type of body
+func NewUpdateNamespaceBackupRetentionRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetDatabaseClusterBackupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterBackup
-	JSON400      *Error
-	JSON500      *Error
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseClusterBackupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseClusterBackupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateDatabaseClusterRestoreResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterRestore
-	JSON201      *DatabaseClusterRestore
-	JSON202      *DatabaseClusterRestore
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-retention", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateDatabaseClusterRestoreResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDatabaseClusterRestoreResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteDatabaseClusterRestoreResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
-	JSON400      *Error
-	JSON500      *Error
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r DeleteDatabaseClusterRestoreResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDatabaseClusterRestoreResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewGetNamespaceBackupStatsRequest generates requests for GetNamespaceBackupStats
+func NewGetNamespaceBackupStatsRequest(server string, namespace string, params *GetNamespaceBackupStatsParams) (*http.Request, error) {
+	var err error
 
-type GetDatabaseClusterRestoreResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterRestore
-	JSON400      *Error
-	JSON500      *Error
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseClusterRestoreResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseClusterRestoreResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateDatabaseClusterRestoreResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterRestore
-	JSON201      *DatabaseClusterRestore
-	JSON400      *Error
-	JSON500      *Error
-}
-
-// Status returns HTTPResponse.Status
-func (r UpdateDatabaseClusterRestoreResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-stats", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDatabaseClusterRestoreResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListDatabaseClustersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterList
-	JSON400      *Error
-	JSON500      *Error
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r ListDatabaseClustersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if params.Days != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "days", runtime.ParamLocationQuery, *params.Days); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDatabaseClustersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateDatabaseClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseCluster
-	JSON201      *DatabaseCluster
-	JSON202      *DatabaseCluster
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateDatabaseClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetNamespaceCostEstimateRequest generates requests for GetNamespaceCostEstimate
+func NewGetNamespaceCostEstimateRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDatabaseClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteDatabaseClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/cost-estimate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteDatabaseClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDatabaseClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetDatabaseClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseCluster
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCreateDatabaseClusterBackupRequest calls the generic CreateDatabaseClusterBackup builder with application/json body
+func NewCreateDatabaseClusterBackupRequest(server string, namespace string, body CreateDatabaseClusterBackupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDatabaseClusterBackupRequestWithBody(server, namespace, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewCreateDatabaseClusterBackupRequestWithBody generates requests for CreateDatabaseClusterBackup with any type of body
+func NewCreateDatabaseClusterBackupRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type UpdateDatabaseClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseCluster
-	JSON400      *Error
-	JSON500      *Error
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r UpdateDatabaseClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDatabaseClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListDatabaseClusterBackupsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterBackupList
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListDatabaseClusterBackupsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDatabaseClusterBackupsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetDatabaseClusterCredentialsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterCredential
-	JSON400      *Error
-	JSON500      *Error
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseClusterCredentialsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewDeleteDatabaseClusterBackupRequest generates requests for DeleteDatabaseClusterBackup
+func NewDeleteDatabaseClusterBackupRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseClusterCredentialsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetDatabaseClusterPitrResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterPitr
-	JSON400      *Error
-	JSON500      *Error
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseClusterPitrResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseClusterPitrResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListDatabaseClusterRestoresResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterRestoreList
-	JSON400      *Error
-	JSON500      *Error
-}
-
-// Status returns HTTPResponse.Status
-func (r ListDatabaseClusterRestoresResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDatabaseClusterRestoresResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return req, nil
 }
 
-type ListDatabaseEnginesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseEngineList
-	JSON400      *Error
-	JSON500      *Error
-}
+// NewGetDatabaseClusterBackupRequest generates requests for GetDatabaseClusterBackup
+func NewGetDatabaseClusterBackupRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r ListDatabaseEnginesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDatabaseEnginesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type GetDatabaseEngineResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseEngine
-	JSON400      *Error
-	JSON500      *Error
-}
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseEngineResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam1 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseEngineResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type UpdateDatabaseEngineResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseEngine
-	JSON400      *Error
-	JSON500      *Error
-}
 
-// Status returns HTTPResponse.Status
-func (r UpdateDatabaseEngineResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDatabaseEngineResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type GetKubernetesClusterResourcesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *KubernetesClusterResources
-	JSON400      *Error
-	JSON500      *Error
-}
 
-// Status returns HTTPResponse.Status
-func (r GetKubernetesClusterResourcesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetKubernetesClusterResourcesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type VersionInfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Version
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r VersionInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewListDatabaseClusterBackupCopiesRequest generates requests for ListDatabaseClusterBackupCopies
+func NewListDatabaseClusterBackupCopiesRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r VersionInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	var pathParam0 string
 
-// ListBackupStoragesWithResponse request returning *ListBackupStoragesResponse
-func (c *ClientWithResponses) ListBackupStoragesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListBackupStoragesResponse, error) {
-	rsp, err := c.ListBackupStorages(ctx, reqEditors...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListBackupStoragesResponse(rsp)
-}
 
-// CreateBackupStorageWithBodyWithResponse request with arbitrary body returning *CreateBackupStorageResponse
-func (c *ClientWithResponses) CreateBackupStorageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error) {
-	rsp, err := c.CreateBackupStorageWithBody(ctx, contentType, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateBackupStorageResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateBackupStorageWithResponse(ctx context.Context, body CreateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error) {
-	rsp, err := c.CreateBackupStorage(ctx, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateBackupStorageResponse(rsp)
-}
 
-// DeleteBackupStorageWithResponse request returning *DeleteBackupStorageResponse
-func (c *ClientWithResponses) DeleteBackupStorageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteBackupStorageResponse, error) {
-	rsp, err := c.DeleteBackupStorage(ctx, name, reqEditors...)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups/%s/copies", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteBackupStorageResponse(rsp)
-}
 
-// GetBackupStorageWithResponse request returning *GetBackupStorageResponse
-func (c *ClientWithResponses) GetBackupStorageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetBackupStorageResponse, error) {
-	rsp, err := c.GetBackupStorage(ctx, name, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetBackupStorageResponse(rsp)
+
+	return req, nil
 }
 
-// UpdateBackupStorageWithBodyWithResponse request with arbitrary body returning *UpdateBackupStorageResponse
-func (c *ClientWithResponses) UpdateBackupStorageWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error) {
-	rsp, err := c.UpdateBackupStorageWithBody(ctx, name, contentType, body, reqEditors...)
+// NewCopyDatabaseClusterBackupRequest calls the generic CopyDatabaseClusterBackup builder with application/json body
+func NewCopyDatabaseClusterBackupRequest(server string, namespace string, name string, body CopyDatabaseClusterBackupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateBackupStorageResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewCopyDatabaseClusterBackupRequestWithBody(server, namespace, name, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) UpdateBackupStorageWithResponse(ctx context.Context, name string, body UpdateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error) {
-	rsp, err := c.UpdateBackupStorage(ctx, name, body, reqEditors...)
+// NewCopyDatabaseClusterBackupRequestWithBody generates requests for CopyDatabaseClusterBackup with any type of body
+func NewCopyDatabaseClusterBackupRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateBackupStorageResponse(rsp)
-}
 
-// GetKubernetesClusterInfoWithResponse request returning *GetKubernetesClusterInfoResponse
-func (c *ClientWithResponses) GetKubernetesClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterInfoResponse, error) {
-	rsp, err := c.GetKubernetesClusterInfo(ctx, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetKubernetesClusterInfoResponse(rsp)
-}
 
-// ListMonitoringInstancesWithResponse request returning *ListMonitoringInstancesResponse
-func (c *ClientWithResponses) ListMonitoringInstancesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMonitoringInstancesResponse, error) {
-	rsp, err := c.ListMonitoringInstances(ctx, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListMonitoringInstancesResponse(rsp)
-}
 
-// CreateMonitoringInstanceWithBodyWithResponse request with arbitrary body returning *CreateMonitoringInstanceResponse
-func (c *ClientWithResponses) CreateMonitoringInstanceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error) {
-	rsp, err := c.CreateMonitoringInstanceWithBody(ctx, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups/%s/copies", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateMonitoringInstanceResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateMonitoringInstanceWithResponse(ctx context.Context, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error) {
-	rsp, err := c.CreateMonitoringInstance(ctx, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateMonitoringInstanceResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// DeleteMonitoringInstanceWithResponse request returning *DeleteMonitoringInstanceResponse
-func (c *ClientWithResponses) DeleteMonitoringInstanceWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteMonitoringInstanceResponse, error) {
-	rsp, err := c.DeleteMonitoringInstance(ctx, name, reqEditors...)
+// NewRestoreDatabaseClusterBackupToNewClusterRequest calls the generic RestoreDatabaseClusterBackupToNewCluster builder with application/json body
+func NewRestoreDatabaseClusterBackupToNewClusterRequest(server string, namespace string, name string, params *RestoreDatabaseClusterBackupToNewClusterParams, body RestoreDatabaseClusterBackupToNewClusterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteMonitoringInstanceResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewRestoreDatabaseClusterBackupToNewClusterRequestWithBody(server, namespace, name, params, "application/json", bodyReader)
 }
 
-// GetMonitoringInstanceWithResponse request returning *GetMonitoringInstanceResponse
-func (c *ClientWithResponses) GetMonitoringInstanceWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetMonitoringInstanceResponse, error) {
-	rsp, err := c.GetMonitoringInstance(ctx, name, reqEditors...)
+// NewRestoreDatabaseClusterBackupToNewClusterRequestWithBody generates requests for RestoreDatabaseClusterBackupToNewCluster with any type of body
+func NewRestoreDatabaseClusterBackupToNewClusterRequestWithBody(server string, namespace string, name string, params *RestoreDatabaseClusterBackupToNewClusterParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetMonitoringInstanceResponse(rsp)
-}
 
-// UpdateMonitoringInstanceWithBodyWithResponse request with arbitrary body returning *UpdateMonitoringInstanceResponse
-func (c *ClientWithResponses) UpdateMonitoringInstanceWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error) {
-	rsp, err := c.UpdateMonitoringInstanceWithBody(ctx, name, contentType, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups/%s/restore-to-new-cluster", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Force != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDatabaseClusterBackupVerificationRequest generates requests for GetDatabaseClusterBackupVerification
+func NewGetDatabaseClusterBackupVerificationRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups/%s/verification", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewVerifyDatabaseClusterBackupRequest generates requests for VerifyDatabaseClusterBackup
+func NewVerifyDatabaseClusterBackupRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups/%s/verification", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateDatabaseClusterRestoreRequest calls the generic CreateDatabaseClusterRestore builder with application/json body
+func NewCreateDatabaseClusterRestoreRequest(server string, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDatabaseClusterRestoreRequestWithBody(server, namespace, "application/json", bodyReader)
+}
+
+// NewCreateDatabaseClusterRestoreRequestWithBody generates requests for CreateDatabaseClusterRestore with any type of body
+func NewCreateDatabaseClusterRestoreRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteDatabaseClusterRestoreRequest generates requests for DeleteDatabaseClusterRestore
+func NewDeleteDatabaseClusterRestoreRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabaseClusterRestoreRequest generates requests for GetDatabaseClusterRestore
+func NewGetDatabaseClusterRestoreRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDatabaseClusterRestoreRequest calls the generic UpdateDatabaseClusterRestore builder with application/json body
+func NewUpdateDatabaseClusterRestoreRequest(server string, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDatabaseClusterRestoreRequestWithBody(server, namespace, name, "application/json", bodyReader)
+}
+
+// NewUpdateDatabaseClusterRestoreRequestWithBody generates requests for UpdateDatabaseClusterRestore with any type of body
+func NewUpdateDatabaseClusterRestoreRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDatabaseClusterRestoreProgressRequest generates requests for GetDatabaseClusterRestoreProgress
+func NewGetDatabaseClusterRestoreProgressRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s/progress", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListDatabaseClustersRequest generates requests for ListDatabaseClusters
+func NewListDatabaseClustersRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateDatabaseClusterRequest calls the generic CreateDatabaseCluster builder with application/json body
+func NewCreateDatabaseClusterRequest(server string, namespace string, params *CreateDatabaseClusterParams, body CreateDatabaseClusterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDatabaseClusterRequestWithBody(server, namespace, params, "application/json", bodyReader)
+}
+
+// NewCreateDatabaseClusterRequestWithBody generates requests for CreateDatabaseCluster with any type of body
+func NewCreateDatabaseClusterRequestWithBody(server string, namespace string, params *CreateDatabaseClusterParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Force != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateDatabaseClusterFromTemplateRequest calls the generic CreateDatabaseClusterFromTemplate builder with application/json body
+func NewCreateDatabaseClusterFromTemplateRequest(server string, namespace string, params *CreateDatabaseClusterFromTemplateParams, body CreateDatabaseClusterFromTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDatabaseClusterFromTemplateRequestWithBody(server, namespace, params, "application/json", bodyReader)
+}
+
+// NewCreateDatabaseClusterFromTemplateRequestWithBody generates requests for CreateDatabaseClusterFromTemplate with any type of body
+func NewCreateDatabaseClusterFromTemplateRequestWithBody(server string, namespace string, params *CreateDatabaseClusterFromTemplateParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/from-template", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Force != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewImportDatabaseClusterRequest calls the generic ImportDatabaseCluster builder with application/json body
+func NewImportDatabaseClusterRequest(server string, namespace string, params *ImportDatabaseClusterParams, body ImportDatabaseClusterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewImportDatabaseClusterRequestWithBody(server, namespace, params, "application/json", bodyReader)
+}
+
+// NewImportDatabaseClusterRequestWithBody generates requests for ImportDatabaseCluster with any type of body
+func NewImportDatabaseClusterRequestWithBody(server string, namespace string, params *ImportDatabaseClusterParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/import", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Force != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteDatabaseClusterRequest generates requests for DeleteDatabaseCluster
+func NewDeleteDatabaseClusterRequest(server string, namespace string, name string, params *DeleteDatabaseClusterParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ConfirmName != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "confirmName", runtime.ParamLocationQuery, *params.ConfirmName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.FinalBackup != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finalBackup", runtime.ParamLocationQuery, *params.FinalBackup); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.FinalBackupStorageName != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finalBackupStorageName", runtime.ParamLocationQuery, *params.FinalBackupStorageName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabaseClusterRequest generates requests for GetDatabaseCluster
+func NewGetDatabaseClusterRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDatabaseClusterRequest calls the generic UpdateDatabaseCluster builder with application/json body
+func NewUpdateDatabaseClusterRequest(server string, namespace string, name string, params *UpdateDatabaseClusterParams, body UpdateDatabaseClusterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDatabaseClusterRequestWithBody(server, namespace, name, params, "application/json", bodyReader)
+}
+
+// NewUpdateDatabaseClusterRequestWithBody generates requests for UpdateDatabaseCluster with any type of body
+func NewUpdateDatabaseClusterRequestWithBody(server string, namespace string, name string, params *UpdateDatabaseClusterParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Force != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteDatabaseClusterBackupReplicationRequest generates requests for DeleteDatabaseClusterBackupReplication
+func NewDeleteDatabaseClusterBackupReplicationRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/backup-replication", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabaseClusterBackupReplicationRequest generates requests for GetDatabaseClusterBackupReplication
+func NewGetDatabaseClusterBackupReplicationRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/backup-replication", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDatabaseClusterBackupReplicationRequest calls the generic UpdateDatabaseClusterBackupReplication builder with application/json body
+func NewUpdateDatabaseClusterBackupReplicationRequest(server string, namespace string, name string, body UpdateDatabaseClusterBackupReplicationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDatabaseClusterBackupReplicationRequestWithBody(server, namespace, name, "application/json", bodyReader)
+}
+
+// NewUpdateDatabaseClusterBackupReplicationRequestWithBody generates requests for UpdateDatabaseClusterBackupReplication with any type of body
+func NewUpdateDatabaseClusterBackupReplicationRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/backup-replication", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteDatabaseClusterBackupRetentionRequest generates requests for DeleteDatabaseClusterBackupRetention
+func NewDeleteDatabaseClusterBackupRetentionRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/backup-retention", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabaseClusterBackupRetentionRequest generates requests for GetDatabaseClusterBackupRetention
+func NewGetDatabaseClusterBackupRetentionRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/backup-retention", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDatabaseClusterBackupRetentionRequest calls the generic UpdateDatabaseClusterBackupRetention builder with application/json body
+func NewUpdateDatabaseClusterBackupRetentionRequest(server string, namespace string, name string, body UpdateDatabaseClusterBackupRetentionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDatabaseClusterBackupRetentionRequestWithBody(server, namespace, name, "application/json", bodyReader)
+}
+
+// NewUpdateDatabaseClusterBackupRetentionRequestWithBody generates requests for UpdateDatabaseClusterBackupRetention with any type of body
+func NewUpdateDatabaseClusterBackupRetentionRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/backup-retention", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPreviewDatabaseClusterBackupRetentionRequest generates requests for PreviewDatabaseClusterBackupRetention
+func NewPreviewDatabaseClusterBackupRetentionRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/backup-retention/preview", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPreviewDatabaseClusterBackupSchedulesRequest generates requests for PreviewDatabaseClusterBackupSchedules
+func NewPreviewDatabaseClusterBackupSchedulesRequest(server string, namespace string, name string, params *PreviewDatabaseClusterBackupSchedulesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/backup-schedules/preview", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Count != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, *params.Count); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.Timezone != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timezone", runtime.ParamLocationQuery, *params.Timezone); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListDatabaseClusterBackupsRequest generates requests for ListDatabaseClusterBackups
+func NewListDatabaseClusterBackupsRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/backups", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabaseClusterBackupStatsRequest generates requests for GetDatabaseClusterBackupStats
+func NewGetDatabaseClusterBackupStatsRequest(server string, namespace string, name string, params *GetDatabaseClusterBackupStatsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/backups/stats", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Days != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "days", runtime.ParamLocationQuery, *params.Days); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabaseClusterCredentialsRequest generates requests for GetDatabaseClusterCredentials
+func NewGetDatabaseClusterCredentialsRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/credentials", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabaseClusterDeletionProtectionRequest generates requests for GetDatabaseClusterDeletionProtection
+func NewGetDatabaseClusterDeletionProtectionRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/deletion-protection", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDatabaseClusterDeletionProtectionRequest calls the generic UpdateDatabaseClusterDeletionProtection builder with application/json body
+func NewUpdateDatabaseClusterDeletionProtectionRequest(server string, namespace string, name string, body UpdateDatabaseClusterDeletionProtectionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDatabaseClusterDeletionProtectionRequestWithBody(server, namespace, name, "application/json", bodyReader)
+}
+
+// NewUpdateDatabaseClusterDeletionProtectionRequestWithBody generates requests for UpdateDatabaseClusterDeletionProtection with any type of body
+func NewUpdateDatabaseClusterDeletionProtectionRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/deletion-protection", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUnprotectDatabaseClusterRequest calls the generic UnprotectDatabaseCluster builder with application/json body
+func NewUnprotectDatabaseClusterRequest(server string, namespace string, name string, body UnprotectDatabaseClusterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUnprotectDatabaseClusterRequestWithBody(server, namespace, name, "application/json", bodyReader)
+}
+
+// NewUnprotectDatabaseClusterRequestWithBody generates requests for UnprotectDatabaseCluster with any type of body
+func NewUnprotectDatabaseClusterRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/deletion-protection/unprotect", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDatabaseClusterEventsRequest generates requests for GetDatabaseClusterEvents
+func NewGetDatabaseClusterEventsRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/events", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportDatabaseClusterRequest generates requests for ExportDatabaseCluster
+func NewExportDatabaseClusterRequest(server string, namespace string, name string, params *ExportDatabaseClusterParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/export", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.IncludeCredentials != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeCredentials", runtime.ParamLocationQuery, *params.IncludeCredentials); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabaseClusterFinalBackupRequest generates requests for GetDatabaseClusterFinalBackup
+func NewGetDatabaseClusterFinalBackupRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/final-backup", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabaseClusterHealthRequest generates requests for GetDatabaseClusterHealth
+func NewGetDatabaseClusterHealthRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/health", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabaseClusterLogsRequest generates requests for GetDatabaseClusterLogs
+func NewGetDatabaseClusterLogsRequest(server string, namespace string, name string, params *GetDatabaseClusterLogsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/logs", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Pod != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pod", runtime.ParamLocationQuery, *params.Pod); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.Container != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "container", runtime.ParamLocationQuery, *params.Container); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.TailLines != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tailLines", runtime.ParamLocationQuery, *params.TailLines); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.SinceSeconds != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sinceSeconds", runtime.ParamLocationQuery, *params.SinceSeconds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.Previous != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "previous", runtime.ParamLocationQuery, *params.Previous); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.Follow != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "follow", runtime.ParamLocationQuery, *params.Follow); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPauseDatabaseClusterRequest generates requests for PauseDatabaseCluster
+func NewPauseDatabaseClusterRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/pause", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabaseClusterPitrRequest generates requests for GetDatabaseClusterPitr
+func NewGetDatabaseClusterPitrRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/pitr", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteDatabaseClusterPowerScheduleRequest generates requests for DeleteDatabaseClusterPowerSchedule
+func NewDeleteDatabaseClusterPowerScheduleRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/power-schedule", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabaseClusterPowerScheduleRequest generates requests for GetDatabaseClusterPowerSchedule
+func NewGetDatabaseClusterPowerScheduleRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/power-schedule", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDatabaseClusterPowerScheduleRequest calls the generic UpdateDatabaseClusterPowerSchedule builder with application/json body
+func NewUpdateDatabaseClusterPowerScheduleRequest(server string, namespace string, name string, body UpdateDatabaseClusterPowerScheduleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDatabaseClusterPowerScheduleRequestWithBody(server, namespace, name, "application/json", bodyReader)
+}
+
+// NewUpdateDatabaseClusterPowerScheduleRequestWithBody generates requests for UpdateDatabaseClusterPowerSchedule with any type of body
+func NewUpdateDatabaseClusterPowerScheduleRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/power-schedule", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDatabaseClusterPreRestoreBackupRequest generates requests for GetDatabaseClusterPreRestoreBackup
+func NewGetDatabaseClusterPreRestoreBackupRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/pre-restore-backup", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListDatabaseClusterRestoresRequest generates requests for ListDatabaseClusterRestores
+func NewListDatabaseClusterRestoresRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/restores", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewResumeDatabaseClusterRequest generates requests for ResumeDatabaseCluster
+func NewResumeDatabaseClusterRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/resume", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListDatabaseEnginesRequest generates requests for ListDatabaseEngines
+func NewListDatabaseEnginesRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-engines", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabaseEngineRequest generates requests for GetDatabaseEngine
+func NewGetDatabaseEngineRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDatabaseEngineRequest calls the generic UpdateDatabaseEngine builder with application/json body
+func NewUpdateDatabaseEngineRequest(server string, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDatabaseEngineRequestWithBody(server, namespace, name, "application/json", bodyReader)
+}
+
+// NewUpdateDatabaseEngineRequestWithBody generates requests for UpdateDatabaseEngine with any type of body
+func NewUpdateDatabaseEngineRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetNamespaceDeletionProtectionRequest generates requests for GetNamespaceDeletionProtection
+func NewGetNamespaceDeletionProtectionRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/deletion-protection", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateNamespaceDeletionProtectionRequest calls the generic UpdateNamespaceDeletionProtection builder with application/json body
+func NewUpdateNamespaceDeletionProtectionRequest(server string, namespace string, body UpdateNamespaceDeletionProtectionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateNamespaceDeletionProtectionRequestWithBody(server, namespace, "application/json", bodyReader)
+}
+
+// NewUpdateNamespaceDeletionProtectionRequestWithBody generates requests for UpdateNamespaceDeletionProtection with any type of body
+func NewUpdateNamespaceDeletionProtectionRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/deletion-protection", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetNamespacePreRestoreBackupRequest generates requests for GetNamespacePreRestoreBackup
+func NewGetNamespacePreRestoreBackupRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/pre-restore-backup", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateNamespacePreRestoreBackupRequest calls the generic UpdateNamespacePreRestoreBackup builder with application/json body
+func NewUpdateNamespacePreRestoreBackupRequest(server string, namespace string, body UpdateNamespacePreRestoreBackupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateNamespacePreRestoreBackupRequestWithBody(server, namespace, "application/json", bodyReader)
+}
+
+// NewUpdateNamespacePreRestoreBackupRequestWithBody generates requests for UpdateNamespacePreRestoreBackup with any type of body
+func NewUpdateNamespacePreRestoreBackupRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/pre-restore-backup", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteNamespaceQuotaRequest generates requests for DeleteNamespaceQuota
+func NewDeleteNamespaceQuotaRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/quota", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetNamespaceQuotaRequest generates requests for GetNamespaceQuota
+func NewGetNamespaceQuotaRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/quota", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateNamespaceQuotaRequest calls the generic UpdateNamespaceQuota builder with application/json body
+func NewUpdateNamespaceQuotaRequest(server string, namespace string, body UpdateNamespaceQuotaJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateNamespaceQuotaRequestWithBody(server, namespace, "application/json", bodyReader)
+}
+
+// NewUpdateNamespaceQuotaRequestWithBody generates requests for UpdateNamespaceQuota with any type of body
+func NewUpdateNamespaceQuotaRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/quota", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPriceTableRequest generates requests for GetPriceTable
+func NewGetPriceTableRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/price-table")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePriceTableRequest calls the generic UpdatePriceTable builder with application/json body
+func NewUpdatePriceTableRequest(server string, body UpdatePriceTableJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePriceTableRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdatePriceTableRequestWithBody generates requests for UpdatePriceTable with any type of body
+func NewUpdatePriceTableRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/price-table")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListNamespaceQuotasRequest generates requests for ListNamespaceQuotas
+func NewListNamespaceQuotasRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/quotas")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetKubernetesClusterResourcesRequest generates requests for GetKubernetesClusterResources
+func NewGetKubernetesClusterResourcesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/resources")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListDatabaseClusterTemplatesRequest generates requests for ListDatabaseClusterTemplates
+func NewListDatabaseClusterTemplatesRequest(server string, params *ListDatabaseClusterTemplatesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Namespace != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "namespace", runtime.ParamLocationQuery, *params.Namespace); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateDatabaseClusterTemplateRequest calls the generic CreateDatabaseClusterTemplate builder with application/json body
+func NewCreateDatabaseClusterTemplateRequest(server string, body CreateDatabaseClusterTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDatabaseClusterTemplateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateDatabaseClusterTemplateRequestWithBody generates requests for CreateDatabaseClusterTemplate with any type of body
+func NewCreateDatabaseClusterTemplateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteDatabaseClusterTemplateRequest generates requests for DeleteDatabaseClusterTemplate
+func NewDeleteDatabaseClusterTemplateRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabaseClusterTemplateRequest generates requests for GetDatabaseClusterTemplate
+func NewGetDatabaseClusterTemplateRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDatabaseClusterTemplateRequest calls the generic UpdateDatabaseClusterTemplate builder with application/json body
+func NewUpdateDatabaseClusterTemplateRequest(server string, name string, body UpdateDatabaseClusterTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDatabaseClusterTemplateRequestWithBody(server, name, "application/json", bodyReader)
+}
+
+// NewUpdateDatabaseClusterTemplateRequestWithBody generates requests for UpdateDatabaseClusterTemplate with any type of body
+func NewUpdateDatabaseClusterTemplateRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVersionInfoRequest generates requests for VersionInfo
+func NewVersionInfoRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/version")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListBackupStoragesWithResponse request
+	ListBackupStoragesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListBackupStoragesResponse, error)
+
+	// CreateBackupStorageWithBodyWithResponse request with any body
+	CreateBackupStorageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error)
+
+	CreateBackupStorageWithResponse(ctx context.Context, body CreateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error)
+
+	// DeleteBackupStorageWithResponse request
+	DeleteBackupStorageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteBackupStorageResponse, error)
+
+	// GetBackupStorageWithResponse request
+	GetBackupStorageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetBackupStorageResponse, error)
+
+	// UpdateBackupStorageWithBodyWithResponse request with any body
+	UpdateBackupStorageWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error)
+
+	UpdateBackupStorageWithResponse(ctx context.Context, name string, body UpdateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error)
+
+	// ListBackupArtifactsWithResponse request
+	ListBackupArtifactsWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*ListBackupArtifactsResponse, error)
+
+	// ImportBackupArtifactsWithBodyWithResponse request with any body
+	ImportBackupArtifactsWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportBackupArtifactsResponse, error)
+
+	ImportBackupArtifactsWithResponse(ctx context.Context, name string, body ImportBackupArtifactsJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportBackupArtifactsResponse, error)
+
+	// GetBackupVerificationSettingsWithResponse request
+	GetBackupVerificationSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBackupVerificationSettingsResponse, error)
+
+	// UpdateBackupVerificationSettingsWithBodyWithResponse request with any body
+	UpdateBackupVerificationSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBackupVerificationSettingsResponse, error)
+
+	UpdateBackupVerificationSettingsWithResponse(ctx context.Context, body UpdateBackupVerificationSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBackupVerificationSettingsResponse, error)
+
+	// GetKubernetesClusterInfoWithResponse request
+	GetKubernetesClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterInfoResponse, error)
+
+	// ListNamespaceCostEstimatesWithResponse request
+	ListNamespaceCostEstimatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespaceCostEstimatesResponse, error)
+
+	// EstimateDatabaseClusterCostWithBodyWithResponse request with any body
+	EstimateDatabaseClusterCostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EstimateDatabaseClusterCostResponse, error)
+
+	EstimateDatabaseClusterCostWithResponse(ctx context.Context, body EstimateDatabaseClusterCostJSONRequestBody, reqEditors ...RequestEditorFn) (*EstimateDatabaseClusterCostResponse, error)
+
+	// ListAllDatabaseClustersWithResponse request
+	ListAllDatabaseClustersWithResponse(ctx context.Context, params *ListAllDatabaseClustersParams, reqEditors ...RequestEditorFn) (*ListAllDatabaseClustersResponse, error)
+
+	// ListMonitoringInstancesWithResponse request
+	ListMonitoringInstancesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMonitoringInstancesResponse, error)
+
+	// CreateMonitoringInstanceWithBodyWithResponse request with any body
+	CreateMonitoringInstanceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error)
+
+	CreateMonitoringInstanceWithResponse(ctx context.Context, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error)
+
+	// DeleteMonitoringInstanceWithResponse request
+	DeleteMonitoringInstanceWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteMonitoringInstanceResponse, error)
+
+	// GetMonitoringInstanceWithResponse request
+	GetMonitoringInstanceWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetMonitoringInstanceResponse, error)
+
+	// UpdateMonitoringInstanceWithBodyWithResponse request with any body
+	UpdateMonitoringInstanceWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error)
+
+	UpdateMonitoringInstanceWithResponse(ctx context.Context, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error)
+
+	// ListNamespacesWithResponse request
+	ListNamespacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespacesResponse, error)
+
+	// DeleteNamespaceBackupRetentionWithResponse request
+	DeleteNamespaceBackupRetentionWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*DeleteNamespaceBackupRetentionResponse, error)
+
+	// GetNamespaceBackupRetentionWithResponse request
+	GetNamespaceBackupRetentionWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetNamespaceBackupRetentionResponse, error)
+
+	// UpdateNamespaceBackupRetentionWithBodyWithResponse request with any body
+	UpdateNamespaceBackupRetentionWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNamespaceBackupRetentionResponse, error)
+
+	UpdateNamespaceBackupRetentionWithResponse(ctx context.Context, namespace string, body UpdateNamespaceBackupRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNamespaceBackupRetentionResponse, error)
+
+	// GetNamespaceBackupStatsWithResponse request
+	GetNamespaceBackupStatsWithResponse(ctx context.Context, namespace string, params *GetNamespaceBackupStatsParams, reqEditors ...RequestEditorFn) (*GetNamespaceBackupStatsResponse, error)
+
+	// GetNamespaceCostEstimateWithResponse request
+	GetNamespaceCostEstimateWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetNamespaceCostEstimateResponse, error)
+
+	// CreateDatabaseClusterBackupWithBodyWithResponse request with any body
+	CreateDatabaseClusterBackupWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error)
+
+	CreateDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error)
+
+	// DeleteDatabaseClusterBackupWithResponse request
+	DeleteDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterBackupResponse, error)
+
+	// GetDatabaseClusterBackupWithResponse request
+	GetDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupResponse, error)
+
+	// ListDatabaseClusterBackupCopiesWithResponse request
+	ListDatabaseClusterBackupCopiesWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupCopiesResponse, error)
+
+	// CopyDatabaseClusterBackupWithBodyWithResponse request with any body
+	CopyDatabaseClusterBackupWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CopyDatabaseClusterBackupResponse, error)
+
+	CopyDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, body CopyDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CopyDatabaseClusterBackupResponse, error)
+
+	// RestoreDatabaseClusterBackupToNewClusterWithBodyWithResponse request with any body
+	RestoreDatabaseClusterBackupToNewClusterWithBodyWithResponse(ctx context.Context, namespace string, name string, params *RestoreDatabaseClusterBackupToNewClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestoreDatabaseClusterBackupToNewClusterResponse, error)
+
+	RestoreDatabaseClusterBackupToNewClusterWithResponse(ctx context.Context, namespace string, name string, params *RestoreDatabaseClusterBackupToNewClusterParams, body RestoreDatabaseClusterBackupToNewClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*RestoreDatabaseClusterBackupToNewClusterResponse, error)
+
+	// GetDatabaseClusterBackupVerificationWithResponse request
+	GetDatabaseClusterBackupVerificationWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupVerificationResponse, error)
+
+	// VerifyDatabaseClusterBackupWithResponse request
+	VerifyDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*VerifyDatabaseClusterBackupResponse, error)
+
+	// CreateDatabaseClusterRestoreWithBodyWithResponse request with any body
+	CreateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error)
+
+	CreateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error)
+
+	// DeleteDatabaseClusterRestoreWithResponse request
+	DeleteDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterRestoreResponse, error)
+
+	// GetDatabaseClusterRestoreWithResponse request
+	GetDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterRestoreResponse, error)
+
+	// UpdateDatabaseClusterRestoreWithBodyWithResponse request with any body
+	UpdateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error)
+
+	UpdateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error)
+
+	// GetDatabaseClusterRestoreProgressWithResponse request
+	GetDatabaseClusterRestoreProgressWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterRestoreProgressResponse, error)
+
+	// ListDatabaseClustersWithResponse request
+	ListDatabaseClustersWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseClustersResponse, error)
+
+	// CreateDatabaseClusterWithBodyWithResponse request with any body
+	CreateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, params *CreateDatabaseClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error)
+
+	CreateDatabaseClusterWithResponse(ctx context.Context, namespace string, params *CreateDatabaseClusterParams, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error)
+
+	// CreateDatabaseClusterFromTemplateWithBodyWithResponse request with any body
+	CreateDatabaseClusterFromTemplateWithBodyWithResponse(ctx context.Context, namespace string, params *CreateDatabaseClusterFromTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterFromTemplateResponse, error)
+
+	CreateDatabaseClusterFromTemplateWithResponse(ctx context.Context, namespace string, params *CreateDatabaseClusterFromTemplateParams, body CreateDatabaseClusterFromTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterFromTemplateResponse, error)
+
+	// ImportDatabaseClusterWithBodyWithResponse request with any body
+	ImportDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, params *ImportDatabaseClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportDatabaseClusterResponse, error)
+
+	ImportDatabaseClusterWithResponse(ctx context.Context, namespace string, params *ImportDatabaseClusterParams, body ImportDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportDatabaseClusterResponse, error)
+
+	// DeleteDatabaseClusterWithResponse request
+	DeleteDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterResponse, error)
+
+	// GetDatabaseClusterWithResponse request
+	GetDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterResponse, error)
+
+	// UpdateDatabaseClusterWithBodyWithResponse request with any body
+	UpdateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, name string, params *UpdateDatabaseClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error)
+
+	UpdateDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, params *UpdateDatabaseClusterParams, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error)
+
+	// DeleteDatabaseClusterBackupReplicationWithResponse request
+	DeleteDatabaseClusterBackupReplicationWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterBackupReplicationResponse, error)
+
+	// GetDatabaseClusterBackupReplicationWithResponse request
+	GetDatabaseClusterBackupReplicationWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupReplicationResponse, error)
+
+	// UpdateDatabaseClusterBackupReplicationWithBodyWithResponse request with any body
+	UpdateDatabaseClusterBackupReplicationWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterBackupReplicationResponse, error)
+
+	UpdateDatabaseClusterBackupReplicationWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterBackupReplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterBackupReplicationResponse, error)
+
+	// DeleteDatabaseClusterBackupRetentionWithResponse request
+	DeleteDatabaseClusterBackupRetentionWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterBackupRetentionResponse, error)
+
+	// GetDatabaseClusterBackupRetentionWithResponse request
+	GetDatabaseClusterBackupRetentionWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupRetentionResponse, error)
+
+	// UpdateDatabaseClusterBackupRetentionWithBodyWithResponse request with any body
+	UpdateDatabaseClusterBackupRetentionWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterBackupRetentionResponse, error)
+
+	UpdateDatabaseClusterBackupRetentionWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterBackupRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterBackupRetentionResponse, error)
+
+	// PreviewDatabaseClusterBackupRetentionWithResponse request
+	PreviewDatabaseClusterBackupRetentionWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*PreviewDatabaseClusterBackupRetentionResponse, error)
+
+	// PreviewDatabaseClusterBackupSchedulesWithResponse request
+	PreviewDatabaseClusterBackupSchedulesWithResponse(ctx context.Context, namespace string, name string, params *PreviewDatabaseClusterBackupSchedulesParams, reqEditors ...RequestEditorFn) (*PreviewDatabaseClusterBackupSchedulesResponse, error)
+
+	// ListDatabaseClusterBackupsWithResponse request
+	ListDatabaseClusterBackupsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupsResponse, error)
+
+	// GetDatabaseClusterBackupStatsWithResponse request
+	GetDatabaseClusterBackupStatsWithResponse(ctx context.Context, namespace string, name string, params *GetDatabaseClusterBackupStatsParams, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupStatsResponse, error)
+
+	// GetDatabaseClusterCredentialsWithResponse request
+	GetDatabaseClusterCredentialsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterCredentialsResponse, error)
+
+	// GetDatabaseClusterDeletionProtectionWithResponse request
+	GetDatabaseClusterDeletionProtectionWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterDeletionProtectionResponse, error)
+
+	// UpdateDatabaseClusterDeletionProtectionWithBodyWithResponse request with any body
+	UpdateDatabaseClusterDeletionProtectionWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterDeletionProtectionResponse, error)
+
+	UpdateDatabaseClusterDeletionProtectionWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterDeletionProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterDeletionProtectionResponse, error)
+
+	// UnprotectDatabaseClusterWithBodyWithResponse request with any body
+	UnprotectDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnprotectDatabaseClusterResponse, error)
+
+	UnprotectDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, body UnprotectDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UnprotectDatabaseClusterResponse, error)
+
+	// GetDatabaseClusterEventsWithResponse request
+	GetDatabaseClusterEventsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterEventsResponse, error)
+
+	// ExportDatabaseClusterWithResponse request
+	ExportDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, params *ExportDatabaseClusterParams, reqEditors ...RequestEditorFn) (*ExportDatabaseClusterResponse, error)
+
+	// GetDatabaseClusterFinalBackupWithResponse request
+	GetDatabaseClusterFinalBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterFinalBackupResponse, error)
+
+	// GetDatabaseClusterHealthWithResponse request
+	GetDatabaseClusterHealthWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterHealthResponse, error)
+
+	// GetDatabaseClusterLogsWithResponse request
+	GetDatabaseClusterLogsWithResponse(ctx context.Context, namespace string, name string, params *GetDatabaseClusterLogsParams, reqEditors ...RequestEditorFn) (*GetDatabaseClusterLogsResponse, error)
+
+	// PauseDatabaseClusterWithResponse request
+	PauseDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*PauseDatabaseClusterResponse, error)
+
+	// GetDatabaseClusterPitrWithResponse request
+	GetDatabaseClusterPitrWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterPitrResponse, error)
+
+	// DeleteDatabaseClusterPowerScheduleWithResponse request
+	DeleteDatabaseClusterPowerScheduleWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterPowerScheduleResponse, error)
+
+	// GetDatabaseClusterPowerScheduleWithResponse request
+	GetDatabaseClusterPowerScheduleWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterPowerScheduleResponse, error)
+
+	// UpdateDatabaseClusterPowerScheduleWithBodyWithResponse request with any body
+	UpdateDatabaseClusterPowerScheduleWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterPowerScheduleResponse, error)
+
+	UpdateDatabaseClusterPowerScheduleWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterPowerScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterPowerScheduleResponse, error)
+
+	// GetDatabaseClusterPreRestoreBackupWithResponse request
+	GetDatabaseClusterPreRestoreBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterPreRestoreBackupResponse, error)
+
+	// ListDatabaseClusterRestoresWithResponse request
+	ListDatabaseClusterRestoresWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterRestoresResponse, error)
+
+	// ResumeDatabaseClusterWithResponse request
+	ResumeDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*ResumeDatabaseClusterResponse, error)
+
+	// ListDatabaseEnginesWithResponse request
+	ListDatabaseEnginesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseEnginesResponse, error)
+
+	// GetDatabaseEngineWithResponse request
+	GetDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseEngineResponse, error)
+
+	// UpdateDatabaseEngineWithBodyWithResponse request with any body
+	UpdateDatabaseEngineWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error)
+
+	UpdateDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error)
+
+	// GetNamespaceDeletionProtectionWithResponse request
+	GetNamespaceDeletionProtectionWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetNamespaceDeletionProtectionResponse, error)
+
+	// UpdateNamespaceDeletionProtectionWithBodyWithResponse request with any body
+	UpdateNamespaceDeletionProtectionWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNamespaceDeletionProtectionResponse, error)
+
+	UpdateNamespaceDeletionProtectionWithResponse(ctx context.Context, namespace string, body UpdateNamespaceDeletionProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNamespaceDeletionProtectionResponse, error)
+
+	// GetNamespacePreRestoreBackupWithResponse request
+	GetNamespacePreRestoreBackupWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetNamespacePreRestoreBackupResponse, error)
+
+	// UpdateNamespacePreRestoreBackupWithBodyWithResponse request with any body
+	UpdateNamespacePreRestoreBackupWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNamespacePreRestoreBackupResponse, error)
+
+	UpdateNamespacePreRestoreBackupWithResponse(ctx context.Context, namespace string, body UpdateNamespacePreRestoreBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNamespacePreRestoreBackupResponse, error)
+
+	// DeleteNamespaceQuotaWithResponse request
+	DeleteNamespaceQuotaWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*DeleteNamespaceQuotaResponse, error)
+
+	// GetNamespaceQuotaWithResponse request
+	GetNamespaceQuotaWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetNamespaceQuotaResponse, error)
+
+	// UpdateNamespaceQuotaWithBodyWithResponse request with any body
+	UpdateNamespaceQuotaWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNamespaceQuotaResponse, error)
+
+	UpdateNamespaceQuotaWithResponse(ctx context.Context, namespace string, body UpdateNamespaceQuotaJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNamespaceQuotaResponse, error)
+
+	// GetPriceTableWithResponse request
+	GetPriceTableWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPriceTableResponse, error)
+
+	// UpdatePriceTableWithBodyWithResponse request with any body
+	UpdatePriceTableWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePriceTableResponse, error)
+
+	UpdatePriceTableWithResponse(ctx context.Context, body UpdatePriceTableJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePriceTableResponse, error)
+
+	// ListNamespaceQuotasWithResponse request
+	ListNamespaceQuotasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespaceQuotasResponse, error)
+
+	// GetKubernetesClusterResourcesWithResponse request
+	GetKubernetesClusterResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterResourcesResponse, error)
+
+	// ListDatabaseClusterTemplatesWithResponse request
+	ListDatabaseClusterTemplatesWithResponse(ctx context.Context, params *ListDatabaseClusterTemplatesParams, reqEditors ...RequestEditorFn) (*ListDatabaseClusterTemplatesResponse, error)
+
+	// CreateDatabaseClusterTemplateWithBodyWithResponse request with any body
+	CreateDatabaseClusterTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterTemplateResponse, error)
+
+	CreateDatabaseClusterTemplateWithResponse(ctx context.Context, body CreateDatabaseClusterTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterTemplateResponse, error)
+
+	// DeleteDatabaseClusterTemplateWithResponse request
+	DeleteDatabaseClusterTemplateWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterTemplateResponse, error)
+
+	// GetDatabaseClusterTemplateWithResponse request
+	GetDatabaseClusterTemplateWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterTemplateResponse, error)
+
+	// UpdateDatabaseClusterTemplateWithBodyWithResponse request with any body
+	UpdateDatabaseClusterTemplateWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterTemplateResponse, error)
+
+	UpdateDatabaseClusterTemplateWithResponse(ctx context.Context, name string, body UpdateDatabaseClusterTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterTemplateResponse, error)
+
+	// VersionInfoWithResponse request
+	VersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VersionInfoResponse, error)
+}
+
+type ListBackupStoragesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupStoragesList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBackupStoragesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBackupStoragesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBackupStorageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupStorage
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBackupStorageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBackupStorageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBackupStorageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBackupStorageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBackupStorageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBackupStorageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupStorage
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBackupStorageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBackupStorageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateBackupStorageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupStorage
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateBackupStorageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateBackupStorageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBackupArtifactsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupArtifactList
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBackupArtifactsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBackupArtifactsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportBackupArtifactsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupArtifactList
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportBackupArtifactsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportBackupArtifactsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBackupVerificationSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupVerificationSettings
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBackupVerificationSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBackupVerificationSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateBackupVerificationSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupVerificationSettings
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateBackupVerificationSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateBackupVerificationSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetKubernetesClusterInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesClusterInfo
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetKubernetesClusterInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetKubernetesClusterInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListNamespaceCostEstimatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NamespaceCostEstimateList
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListNamespaceCostEstimatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListNamespaceCostEstimatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EstimateDatabaseClusterCostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterCostEstimate
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r EstimateDatabaseClusterCostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EstimateDatabaseClusterCostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAllDatabaseClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAllDatabaseClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAllDatabaseClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMonitoringInstancesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MonitoringInstancesList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMonitoringInstancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMonitoringInstancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateMonitoringInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MonitoringInstance
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateMonitoringInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateMonitoringInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMonitoringInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMonitoringInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMonitoringInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMonitoringInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MonitoringInstance
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMonitoringInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMonitoringInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMonitoringInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MonitoringInstance
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMonitoringInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMonitoringInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListNamespacesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NamespaceList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListNamespacesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListNamespacesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteNamespaceBackupRetentionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteNamespaceBackupRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteNamespaceBackupRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNamespaceBackupRetentionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupRetentionPolicy
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNamespaceBackupRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNamespaceBackupRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateNamespaceBackupRetentionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupRetentionPolicy
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateNamespaceBackupRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateNamespaceBackupRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNamespaceBackupStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NamespaceBackupStats
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNamespaceBackupStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNamespaceBackupStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNamespaceCostEstimateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NamespaceCostEstimate
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNamespaceCostEstimateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNamespaceCostEstimateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDatabaseClusterBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterBackup
+	JSON201      *DatabaseClusterBackup
+	JSON202      *DatabaseClusterBackup
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDatabaseClusterBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDatabaseClusterBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseClusterBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseClusterBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseClusterBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterBackup
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabaseClusterBackupCopiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BackupCopy
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabaseClusterBackupCopiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabaseClusterBackupCopiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CopyDatabaseClusterBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *BackupCopy
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CopyDatabaseClusterBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CopyDatabaseClusterBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreDatabaseClusterBackupToNewClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *DatabaseCluster
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreDatabaseClusterBackupToNewClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreDatabaseClusterBackupToNewClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterBackupVerificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupVerification
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterBackupVerificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterBackupVerificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyDatabaseClusterBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *BackupVerification
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyDatabaseClusterBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyDatabaseClusterBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDatabaseClusterRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterRestore
+	JSON201      *DatabaseClusterRestore
+	JSON202      *PreRestoreBackup
+	JSON400      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDatabaseClusterRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDatabaseClusterRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseClusterRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseClusterRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseClusterRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterRestore
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatabaseClusterRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterRestore
+	JSON201      *DatabaseClusterRestore
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatabaseClusterRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatabaseClusterRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterRestoreProgressResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterRestoreProgress
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterRestoreProgressResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterRestoreProgressResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabaseClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabaseClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabaseClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDatabaseClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseCluster
+	JSON201      *DatabaseCluster
+	JSON202      *DatabaseCluster
+	JSON400      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDatabaseClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDatabaseClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDatabaseClusterFromTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *DatabaseCluster
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDatabaseClusterFromTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDatabaseClusterFromTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportDatabaseClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *DatabaseCluster
+	JSON400      *Error
+	JSON403      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportDatabaseClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportDatabaseClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
+	JSON202      *FinalBackup
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON423      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseCluster
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatabaseClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseCluster
+	JSON400      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatabaseClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatabaseClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseClusterBackupReplicationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseClusterBackupReplicationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseClusterBackupReplicationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterBackupReplicationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupReplicationPolicy
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterBackupReplicationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterBackupReplicationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatabaseClusterBackupReplicationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupReplicationPolicy
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatabaseClusterBackupReplicationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatabaseClusterBackupReplicationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseClusterBackupRetentionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseClusterBackupRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseClusterBackupRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterBackupRetentionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterBackupRetention
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterBackupRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterBackupRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatabaseClusterBackupRetentionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterBackupRetention
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatabaseClusterBackupRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatabaseClusterBackupRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PreviewDatabaseClusterBackupRetentionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupRetentionPreview
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PreviewDatabaseClusterBackupRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreviewDatabaseClusterBackupRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PreviewDatabaseClusterBackupSchedulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupSchedulePreview
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PreviewDatabaseClusterBackupSchedulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreviewDatabaseClusterBackupSchedulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabaseClusterBackupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterBackupList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabaseClusterBackupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabaseClusterBackupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterBackupStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupStats
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterBackupStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterBackupStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterCredentialsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterCredential
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterCredentialsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterCredentialsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterDeletionProtectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterDeletionProtection
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterDeletionProtectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterDeletionProtectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatabaseClusterDeletionProtectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterDeletionProtection
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatabaseClusterDeletionProtectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatabaseClusterDeletionProtectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnprotectDatabaseClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterDeletionProtection
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UnprotectDatabaseClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnprotectDatabaseClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterEventList
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportDatabaseClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterBundle
+	YAML200      *DatabaseClusterBundle
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportDatabaseClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportDatabaseClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterFinalBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FinalBackup
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterFinalBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterFinalBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterHealth
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PauseDatabaseClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseCluster
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PauseDatabaseClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PauseDatabaseClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterPitrResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterPitr
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterPitrResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterPitrResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseClusterPowerScheduleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseClusterPowerScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseClusterPowerScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterPowerScheduleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PowerSchedule
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterPowerScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterPowerScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatabaseClusterPowerScheduleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PowerSchedule
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatabaseClusterPowerScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatabaseClusterPowerScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterPreRestoreBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PreRestoreBackup
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterPreRestoreBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterPreRestoreBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabaseClusterRestoresResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterRestoreList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabaseClusterRestoresResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabaseClusterRestoresResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResumeDatabaseClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseCluster
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ResumeDatabaseClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResumeDatabaseClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabaseEnginesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseEngineList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabaseEnginesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabaseEnginesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseEngineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseEngine
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseEngineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseEngineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatabaseEngineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseEngine
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatabaseEngineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatabaseEngineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNamespaceDeletionProtectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeletionProtection
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNamespaceDeletionProtectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNamespaceDeletionProtectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateNamespaceDeletionProtectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeletionProtection
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateNamespaceDeletionProtectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateNamespaceDeletionProtectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNamespacePreRestoreBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PreRestoreBackupSettings
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNamespacePreRestoreBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNamespacePreRestoreBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateNamespacePreRestoreBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PreRestoreBackupSettings
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateNamespacePreRestoreBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateNamespacePreRestoreBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteNamespaceQuotaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteNamespaceQuotaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteNamespaceQuotaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNamespaceQuotaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NamespaceQuotaUsage
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNamespaceQuotaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNamespaceQuotaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateNamespaceQuotaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NamespaceQuota
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateNamespaceQuotaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateNamespaceQuotaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPriceTableResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PriceTable
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPriceTableResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPriceTableResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePriceTableResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PriceTable
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePriceTableResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePriceTableResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListNamespaceQuotasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NamespaceQuotaUsageList
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListNamespaceQuotasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListNamespaceQuotasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetKubernetesClusterResourcesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesClusterResources
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetKubernetesClusterResourcesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetKubernetesClusterResourcesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabaseClusterTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterTemplateList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabaseClusterTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabaseClusterTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDatabaseClusterTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *DatabaseClusterTemplate
+	JSON400      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDatabaseClusterTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDatabaseClusterTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseClusterTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseClusterTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseClusterTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterTemplate
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatabaseClusterTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterTemplate
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatabaseClusterTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatabaseClusterTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VersionInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Version
+}
+
+// Status returns HTTPResponse.Status
+func (r VersionInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VersionInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListBackupStoragesWithResponse request returning *ListBackupStoragesResponse
+func (c *ClientWithResponses) ListBackupStoragesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListBackupStoragesResponse, error) {
+	rsp, err := c.ListBackupStorages(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBackupStoragesResponse(rsp)
+}
+
+// CreateBackupStorageWithBodyWithResponse request with arbitrary body returning *CreateBackupStorageResponse
+func (c *ClientWithResponses) CreateBackupStorageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error) {
+	rsp, err := c.CreateBackupStorageWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBackupStorageResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBackupStorageWithResponse(ctx context.Context, body CreateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error) {
+	rsp, err := c.CreateBackupStorage(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBackupStorageResponse(rsp)
+}
+
+// DeleteBackupStorageWithResponse request returning *DeleteBackupStorageResponse
+func (c *ClientWithResponses) DeleteBackupStorageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteBackupStorageResponse, error) {
+	rsp, err := c.DeleteBackupStorage(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBackupStorageResponse(rsp)
+}
+
+// GetBackupStorageWithResponse request returning *GetBackupStorageResponse
+func (c *ClientWithResponses) GetBackupStorageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetBackupStorageResponse, error) {
+	rsp, err := c.GetBackupStorage(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBackupStorageResponse(rsp)
+}
+
+// UpdateBackupStorageWithBodyWithResponse request with arbitrary body returning *UpdateBackupStorageResponse
+func (c *ClientWithResponses) UpdateBackupStorageWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error) {
+	rsp, err := c.UpdateBackupStorageWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBackupStorageResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateBackupStorageWithResponse(ctx context.Context, name string, body UpdateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error) {
+	rsp, err := c.UpdateBackupStorage(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBackupStorageResponse(rsp)
+}
+
+// ListBackupArtifactsWithResponse request returning *ListBackupArtifactsResponse
+func (c *ClientWithResponses) ListBackupArtifactsWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*ListBackupArtifactsResponse, error) {
+	rsp, err := c.ListBackupArtifacts(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBackupArtifactsResponse(rsp)
+}
+
+// ImportBackupArtifactsWithBodyWithResponse request with arbitrary body returning *ImportBackupArtifactsResponse
+func (c *ClientWithResponses) ImportBackupArtifactsWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportBackupArtifactsResponse, error) {
+	rsp, err := c.ImportBackupArtifactsWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportBackupArtifactsResponse(rsp)
+}
+
+func (c *ClientWithResponses) ImportBackupArtifactsWithResponse(ctx context.Context, name string, body ImportBackupArtifactsJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportBackupArtifactsResponse, error) {
+	rsp, err := c.ImportBackupArtifacts(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportBackupArtifactsResponse(rsp)
+}
+
+// GetBackupVerificationSettingsWithResponse request returning *GetBackupVerificationSettingsResponse
+func (c *ClientWithResponses) GetBackupVerificationSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBackupVerificationSettingsResponse, error) {
+	rsp, err := c.GetBackupVerificationSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBackupVerificationSettingsResponse(rsp)
+}
+
+// UpdateBackupVerificationSettingsWithBodyWithResponse request with arbitrary body returning *UpdateBackupVerificationSettingsResponse
+func (c *ClientWithResponses) UpdateBackupVerificationSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBackupVerificationSettingsResponse, error) {
+	rsp, err := c.UpdateBackupVerificationSettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBackupVerificationSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateBackupVerificationSettingsWithResponse(ctx context.Context, body UpdateBackupVerificationSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBackupVerificationSettingsResponse, error) {
+	rsp, err := c.UpdateBackupVerificationSettings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBackupVerificationSettingsResponse(rsp)
+}
+
+// GetKubernetesClusterInfoWithResponse request returning *GetKubernetesClusterInfoResponse
+func (c *ClientWithResponses) GetKubernetesClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterInfoResponse, error) {
+	rsp, err := c.GetKubernetesClusterInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetKubernetesClusterInfoResponse(rsp)
+}
+
+// ListNamespaceCostEstimatesWithResponse request returning *ListNamespaceCostEstimatesResponse
+func (c *ClientWithResponses) ListNamespaceCostEstimatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespaceCostEstimatesResponse, error) {
+	rsp, err := c.ListNamespaceCostEstimates(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListNamespaceCostEstimatesResponse(rsp)
+}
+
+// EstimateDatabaseClusterCostWithBodyWithResponse request with arbitrary body returning *EstimateDatabaseClusterCostResponse
+func (c *ClientWithResponses) EstimateDatabaseClusterCostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EstimateDatabaseClusterCostResponse, error) {
+	rsp, err := c.EstimateDatabaseClusterCostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEstimateDatabaseClusterCostResponse(rsp)
+}
+
+func (c *ClientWithResponses) EstimateDatabaseClusterCostWithResponse(ctx context.Context, body EstimateDatabaseClusterCostJSONRequestBody, reqEditors ...RequestEditorFn) (*EstimateDatabaseClusterCostResponse, error) {
+	rsp, err := c.EstimateDatabaseClusterCost(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEstimateDatabaseClusterCostResponse(rsp)
+}
+
+// ListAllDatabaseClustersWithResponse request returning *ListAllDatabaseClustersResponse
+func (c *ClientWithResponses) ListAllDatabaseClustersWithResponse(ctx context.Context, params *ListAllDatabaseClustersParams, reqEditors ...RequestEditorFn) (*ListAllDatabaseClustersResponse, error) {
+	rsp, err := c.ListAllDatabaseClusters(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAllDatabaseClustersResponse(rsp)
+}
+
+// ListMonitoringInstancesWithResponse request returning *ListMonitoringInstancesResponse
+func (c *ClientWithResponses) ListMonitoringInstancesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMonitoringInstancesResponse, error) {
+	rsp, err := c.ListMonitoringInstances(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMonitoringInstancesResponse(rsp)
+}
+
+// CreateMonitoringInstanceWithBodyWithResponse request with arbitrary body returning *CreateMonitoringInstanceResponse
+func (c *ClientWithResponses) CreateMonitoringInstanceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error) {
+	rsp, err := c.CreateMonitoringInstanceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMonitoringInstanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateMonitoringInstanceWithResponse(ctx context.Context, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error) {
+	rsp, err := c.CreateMonitoringInstance(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMonitoringInstanceResponse(rsp)
+}
+
+// DeleteMonitoringInstanceWithResponse request returning *DeleteMonitoringInstanceResponse
+func (c *ClientWithResponses) DeleteMonitoringInstanceWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteMonitoringInstanceResponse, error) {
+	rsp, err := c.DeleteMonitoringInstance(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMonitoringInstanceResponse(rsp)
+}
+
+// GetMonitoringInstanceWithResponse request returning *GetMonitoringInstanceResponse
+func (c *ClientWithResponses) GetMonitoringInstanceWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetMonitoringInstanceResponse, error) {
+	rsp, err := c.GetMonitoringInstance(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMonitoringInstanceResponse(rsp)
+}
+
+// UpdateMonitoringInstanceWithBodyWithResponse request with arbitrary body returning *UpdateMonitoringInstanceResponse
+func (c *ClientWithResponses) UpdateMonitoringInstanceWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error) {
+	rsp, err := c.UpdateMonitoringInstanceWithBody(ctx, name, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseUpdateMonitoringInstanceResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdateMonitoringInstanceWithResponse(ctx context.Context, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error) {
-	rsp, err := c.UpdateMonitoringInstance(ctx, name, body, reqEditors...)
-	if err != nil {
-		return nil, err
+func (c *ClientWithResponses) UpdateMonitoringInstanceWithResponse(ctx context.Context, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error) {
+	rsp, err := c.UpdateMonitoringInstance(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMonitoringInstanceResponse(rsp)
+}
+
+// ListNamespacesWithResponse request returning *ListNamespacesResponse
+func (c *ClientWithResponses) ListNamespacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespacesResponse, error) {
+	rsp, err := c.ListNamespaces(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListNamespacesResponse(rsp)
+}
+
+// DeleteNamespaceBackupRetentionWithResponse request returning *DeleteNamespaceBackupRetentionResponse
+func (c *ClientWithResponses) DeleteNamespaceBackupRetentionWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*DeleteNamespaceBackupRetentionResponse, error) {
+	rsp, err := c.DeleteNamespaceBackupRetention(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteNamespaceBackupRetentionResponse(rsp)
+}
+
+// GetNamespaceBackupRetentionWithResponse request returning *GetNamespaceBackupRetentionResponse
+func (c *ClientWithResponses) GetNamespaceBackupRetentionWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetNamespaceBackupRetentionResponse, error) {
+	rsp, err := c.GetNamespaceBackupRetention(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNamespaceBackupRetentionResponse(rsp)
+}
+
+// UpdateNamespaceBackupRetentionWithBodyWithResponse request with arbitrary body returning *UpdateNamespaceBackupRetentionResponse
+func (c *ClientWithResponses) UpdateNamespaceBackupRetentionWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNamespaceBackupRetentionResponse, error) {
+	rsp, err := c.UpdateNamespaceBackupRetentionWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNamespaceBackupRetentionResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateNamespaceBackupRetentionWithResponse(ctx context.Context, namespace string, body UpdateNamespaceBackupRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNamespaceBackupRetentionResponse, error) {
+	rsp, err := c.UpdateNamespaceBackupRetention(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNamespaceBackupRetentionResponse(rsp)
+}
+
+// GetNamespaceBackupStatsWithResponse request returning *GetNamespaceBackupStatsResponse
+func (c *ClientWithResponses) GetNamespaceBackupStatsWithResponse(ctx context.Context, namespace string, params *GetNamespaceBackupStatsParams, reqEditors ...RequestEditorFn) (*GetNamespaceBackupStatsResponse, error) {
+	rsp, err := c.GetNamespaceBackupStats(ctx, namespace, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNamespaceBackupStatsResponse(rsp)
+}
+
+// GetNamespaceCostEstimateWithResponse request returning *GetNamespaceCostEstimateResponse
+func (c *ClientWithResponses) GetNamespaceCostEstimateWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetNamespaceCostEstimateResponse, error) {
+	rsp, err := c.GetNamespaceCostEstimate(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNamespaceCostEstimateResponse(rsp)
+}
+
+// CreateDatabaseClusterBackupWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterBackupResponse
+func (c *ClientWithResponses) CreateDatabaseClusterBackupWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error) {
+	rsp, err := c.CreateDatabaseClusterBackupWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterBackupResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error) {
+	rsp, err := c.CreateDatabaseClusterBackup(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterBackupResponse(rsp)
+}
+
+// DeleteDatabaseClusterBackupWithResponse request returning *DeleteDatabaseClusterBackupResponse
+func (c *ClientWithResponses) DeleteDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterBackupResponse, error) {
+	rsp, err := c.DeleteDatabaseClusterBackup(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatabaseClusterBackupResponse(rsp)
+}
+
+// GetDatabaseClusterBackupWithResponse request returning *GetDatabaseClusterBackupResponse
+func (c *ClientWithResponses) GetDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupResponse, error) {
+	rsp, err := c.GetDatabaseClusterBackup(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterBackupResponse(rsp)
+}
+
+// ListDatabaseClusterBackupCopiesWithResponse request returning *ListDatabaseClusterBackupCopiesResponse
+func (c *ClientWithResponses) ListDatabaseClusterBackupCopiesWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupCopiesResponse, error) {
+	rsp, err := c.ListDatabaseClusterBackupCopies(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabaseClusterBackupCopiesResponse(rsp)
+}
+
+// CopyDatabaseClusterBackupWithBodyWithResponse request with arbitrary body returning *CopyDatabaseClusterBackupResponse
+func (c *ClientWithResponses) CopyDatabaseClusterBackupWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CopyDatabaseClusterBackupResponse, error) {
+	rsp, err := c.CopyDatabaseClusterBackupWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCopyDatabaseClusterBackupResponse(rsp)
+}
+
+func (c *ClientWithResponses) CopyDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, body CopyDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CopyDatabaseClusterBackupResponse, error) {
+	rsp, err := c.CopyDatabaseClusterBackup(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCopyDatabaseClusterBackupResponse(rsp)
+}
+
+// RestoreDatabaseClusterBackupToNewClusterWithBodyWithResponse request with arbitrary body returning *RestoreDatabaseClusterBackupToNewClusterResponse
+func (c *ClientWithResponses) RestoreDatabaseClusterBackupToNewClusterWithBodyWithResponse(ctx context.Context, namespace string, name string, params *RestoreDatabaseClusterBackupToNewClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestoreDatabaseClusterBackupToNewClusterResponse, error) {
+	rsp, err := c.RestoreDatabaseClusterBackupToNewClusterWithBody(ctx, namespace, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreDatabaseClusterBackupToNewClusterResponse(rsp)
+}
+
+func (c *ClientWithResponses) RestoreDatabaseClusterBackupToNewClusterWithResponse(ctx context.Context, namespace string, name string, params *RestoreDatabaseClusterBackupToNewClusterParams, body RestoreDatabaseClusterBackupToNewClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*RestoreDatabaseClusterBackupToNewClusterResponse, error) {
+	rsp, err := c.RestoreDatabaseClusterBackupToNewCluster(ctx, namespace, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreDatabaseClusterBackupToNewClusterResponse(rsp)
+}
+
+// GetDatabaseClusterBackupVerificationWithResponse request returning *GetDatabaseClusterBackupVerificationResponse
+func (c *ClientWithResponses) GetDatabaseClusterBackupVerificationWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupVerificationResponse, error) {
+	rsp, err := c.GetDatabaseClusterBackupVerification(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterBackupVerificationResponse(rsp)
+}
+
+// VerifyDatabaseClusterBackupWithResponse request returning *VerifyDatabaseClusterBackupResponse
+func (c *ClientWithResponses) VerifyDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*VerifyDatabaseClusterBackupResponse, error) {
+	rsp, err := c.VerifyDatabaseClusterBackup(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyDatabaseClusterBackupResponse(rsp)
+}
+
+// CreateDatabaseClusterRestoreWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterRestoreResponse
+func (c *ClientWithResponses) CreateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.CreateDatabaseClusterRestoreWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterRestoreResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.CreateDatabaseClusterRestore(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterRestoreResponse(rsp)
+}
+
+// DeleteDatabaseClusterRestoreWithResponse request returning *DeleteDatabaseClusterRestoreResponse
+func (c *ClientWithResponses) DeleteDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.DeleteDatabaseClusterRestore(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatabaseClusterRestoreResponse(rsp)
+}
+
+// GetDatabaseClusterRestoreWithResponse request returning *GetDatabaseClusterRestoreResponse
+func (c *ClientWithResponses) GetDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.GetDatabaseClusterRestore(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterRestoreResponse(rsp)
+}
+
+// UpdateDatabaseClusterRestoreWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseClusterRestoreResponse
+func (c *ClientWithResponses) UpdateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterRestoreWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterRestoreResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterRestore(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterRestoreResponse(rsp)
+}
+
+// GetDatabaseClusterRestoreProgressWithResponse request returning *GetDatabaseClusterRestoreProgressResponse
+func (c *ClientWithResponses) GetDatabaseClusterRestoreProgressWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterRestoreProgressResponse, error) {
+	rsp, err := c.GetDatabaseClusterRestoreProgress(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterRestoreProgressResponse(rsp)
+}
+
+// ListDatabaseClustersWithResponse request returning *ListDatabaseClustersResponse
+func (c *ClientWithResponses) ListDatabaseClustersWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseClustersResponse, error) {
+	rsp, err := c.ListDatabaseClusters(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabaseClustersResponse(rsp)
+}
+
+// CreateDatabaseClusterWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterResponse
+func (c *ClientWithResponses) CreateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, params *CreateDatabaseClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error) {
+	rsp, err := c.CreateDatabaseClusterWithBody(ctx, namespace, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDatabaseClusterWithResponse(ctx context.Context, namespace string, params *CreateDatabaseClusterParams, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error) {
+	rsp, err := c.CreateDatabaseCluster(ctx, namespace, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterResponse(rsp)
+}
+
+// CreateDatabaseClusterFromTemplateWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterFromTemplateResponse
+func (c *ClientWithResponses) CreateDatabaseClusterFromTemplateWithBodyWithResponse(ctx context.Context, namespace string, params *CreateDatabaseClusterFromTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterFromTemplateResponse, error) {
+	rsp, err := c.CreateDatabaseClusterFromTemplateWithBody(ctx, namespace, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterFromTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDatabaseClusterFromTemplateWithResponse(ctx context.Context, namespace string, params *CreateDatabaseClusterFromTemplateParams, body CreateDatabaseClusterFromTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterFromTemplateResponse, error) {
+	rsp, err := c.CreateDatabaseClusterFromTemplate(ctx, namespace, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterFromTemplateResponse(rsp)
+}
+
+// ImportDatabaseClusterWithBodyWithResponse request with arbitrary body returning *ImportDatabaseClusterResponse
+func (c *ClientWithResponses) ImportDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, params *ImportDatabaseClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportDatabaseClusterResponse, error) {
+	rsp, err := c.ImportDatabaseClusterWithBody(ctx, namespace, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportDatabaseClusterResponse(rsp)
+}
+
+func (c *ClientWithResponses) ImportDatabaseClusterWithResponse(ctx context.Context, namespace string, params *ImportDatabaseClusterParams, body ImportDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportDatabaseClusterResponse, error) {
+	rsp, err := c.ImportDatabaseCluster(ctx, namespace, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportDatabaseClusterResponse(rsp)
+}
+
+// DeleteDatabaseClusterWithResponse request returning *DeleteDatabaseClusterResponse
+func (c *ClientWithResponses) DeleteDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterResponse, error) {
+	rsp, err := c.DeleteDatabaseCluster(ctx, namespace, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatabaseClusterResponse(rsp)
+}
+
+// GetDatabaseClusterWithResponse request returning *GetDatabaseClusterResponse
+func (c *ClientWithResponses) GetDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterResponse, error) {
+	rsp, err := c.GetDatabaseCluster(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterResponse(rsp)
+}
+
+// UpdateDatabaseClusterWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseClusterResponse
+func (c *ClientWithResponses) UpdateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, name string, params *UpdateDatabaseClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterWithBody(ctx, namespace, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, params *UpdateDatabaseClusterParams, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error) {
+	rsp, err := c.UpdateDatabaseCluster(ctx, namespace, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterResponse(rsp)
+}
+
+// DeleteDatabaseClusterBackupReplicationWithResponse request returning *DeleteDatabaseClusterBackupReplicationResponse
+func (c *ClientWithResponses) DeleteDatabaseClusterBackupReplicationWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterBackupReplicationResponse, error) {
+	rsp, err := c.DeleteDatabaseClusterBackupReplication(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatabaseClusterBackupReplicationResponse(rsp)
+}
+
+// GetDatabaseClusterBackupReplicationWithResponse request returning *GetDatabaseClusterBackupReplicationResponse
+func (c *ClientWithResponses) GetDatabaseClusterBackupReplicationWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupReplicationResponse, error) {
+	rsp, err := c.GetDatabaseClusterBackupReplication(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterBackupReplicationResponse(rsp)
+}
+
+// UpdateDatabaseClusterBackupReplicationWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseClusterBackupReplicationResponse
+func (c *ClientWithResponses) UpdateDatabaseClusterBackupReplicationWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterBackupReplicationResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterBackupReplicationWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterBackupReplicationResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDatabaseClusterBackupReplicationWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterBackupReplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterBackupReplicationResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterBackupReplication(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterBackupReplicationResponse(rsp)
+}
+
+// DeleteDatabaseClusterBackupRetentionWithResponse request returning *DeleteDatabaseClusterBackupRetentionResponse
+func (c *ClientWithResponses) DeleteDatabaseClusterBackupRetentionWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterBackupRetentionResponse, error) {
+	rsp, err := c.DeleteDatabaseClusterBackupRetention(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatabaseClusterBackupRetentionResponse(rsp)
+}
+
+// GetDatabaseClusterBackupRetentionWithResponse request returning *GetDatabaseClusterBackupRetentionResponse
+func (c *ClientWithResponses) GetDatabaseClusterBackupRetentionWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupRetentionResponse, error) {
+	rsp, err := c.GetDatabaseClusterBackupRetention(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterBackupRetentionResponse(rsp)
+}
+
+// UpdateDatabaseClusterBackupRetentionWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseClusterBackupRetentionResponse
+func (c *ClientWithResponses) UpdateDatabaseClusterBackupRetentionWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterBackupRetentionResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterBackupRetentionWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterBackupRetentionResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDatabaseClusterBackupRetentionWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterBackupRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterBackupRetentionResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterBackupRetention(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterBackupRetentionResponse(rsp)
+}
+
+// PreviewDatabaseClusterBackupRetentionWithResponse request returning *PreviewDatabaseClusterBackupRetentionResponse
+func (c *ClientWithResponses) PreviewDatabaseClusterBackupRetentionWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*PreviewDatabaseClusterBackupRetentionResponse, error) {
+	rsp, err := c.PreviewDatabaseClusterBackupRetention(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewDatabaseClusterBackupRetentionResponse(rsp)
+}
+
+// PreviewDatabaseClusterBackupSchedulesWithResponse request returning *PreviewDatabaseClusterBackupSchedulesResponse
+func (c *ClientWithResponses) PreviewDatabaseClusterBackupSchedulesWithResponse(ctx context.Context, namespace string, name string, params *PreviewDatabaseClusterBackupSchedulesParams, reqEditors ...RequestEditorFn) (*PreviewDatabaseClusterBackupSchedulesResponse, error) {
+	rsp, err := c.PreviewDatabaseClusterBackupSchedules(ctx, namespace, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewDatabaseClusterBackupSchedulesResponse(rsp)
+}
+
+// ListDatabaseClusterBackupsWithResponse request returning *ListDatabaseClusterBackupsResponse
+func (c *ClientWithResponses) ListDatabaseClusterBackupsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupsResponse, error) {
+	rsp, err := c.ListDatabaseClusterBackups(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabaseClusterBackupsResponse(rsp)
+}
+
+// GetDatabaseClusterBackupStatsWithResponse request returning *GetDatabaseClusterBackupStatsResponse
+func (c *ClientWithResponses) GetDatabaseClusterBackupStatsWithResponse(ctx context.Context, namespace string, name string, params *GetDatabaseClusterBackupStatsParams, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupStatsResponse, error) {
+	rsp, err := c.GetDatabaseClusterBackupStats(ctx, namespace, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterBackupStatsResponse(rsp)
+}
+
+// GetDatabaseClusterCredentialsWithResponse request returning *GetDatabaseClusterCredentialsResponse
+func (c *ClientWithResponses) GetDatabaseClusterCredentialsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterCredentialsResponse, error) {
+	rsp, err := c.GetDatabaseClusterCredentials(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterCredentialsResponse(rsp)
+}
+
+// GetDatabaseClusterDeletionProtectionWithResponse request returning *GetDatabaseClusterDeletionProtectionResponse
+func (c *ClientWithResponses) GetDatabaseClusterDeletionProtectionWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterDeletionProtectionResponse, error) {
+	rsp, err := c.GetDatabaseClusterDeletionProtection(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterDeletionProtectionResponse(rsp)
+}
+
+// UpdateDatabaseClusterDeletionProtectionWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseClusterDeletionProtectionResponse
+func (c *ClientWithResponses) UpdateDatabaseClusterDeletionProtectionWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterDeletionProtectionResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterDeletionProtectionWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterDeletionProtectionResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDatabaseClusterDeletionProtectionWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterDeletionProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterDeletionProtectionResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterDeletionProtection(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterDeletionProtectionResponse(rsp)
+}
+
+// UnprotectDatabaseClusterWithBodyWithResponse request with arbitrary body returning *UnprotectDatabaseClusterResponse
+func (c *ClientWithResponses) UnprotectDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnprotectDatabaseClusterResponse, error) {
+	rsp, err := c.UnprotectDatabaseClusterWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnprotectDatabaseClusterResponse(rsp)
+}
+
+func (c *ClientWithResponses) UnprotectDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, body UnprotectDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UnprotectDatabaseClusterResponse, error) {
+	rsp, err := c.UnprotectDatabaseCluster(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnprotectDatabaseClusterResponse(rsp)
+}
+
+// GetDatabaseClusterEventsWithResponse request returning *GetDatabaseClusterEventsResponse
+func (c *ClientWithResponses) GetDatabaseClusterEventsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterEventsResponse, error) {
+	rsp, err := c.GetDatabaseClusterEvents(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterEventsResponse(rsp)
+}
+
+// ExportDatabaseClusterWithResponse request returning *ExportDatabaseClusterResponse
+func (c *ClientWithResponses) ExportDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, params *ExportDatabaseClusterParams, reqEditors ...RequestEditorFn) (*ExportDatabaseClusterResponse, error) {
+	rsp, err := c.ExportDatabaseCluster(ctx, namespace, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportDatabaseClusterResponse(rsp)
+}
+
+// GetDatabaseClusterFinalBackupWithResponse request returning *GetDatabaseClusterFinalBackupResponse
+func (c *ClientWithResponses) GetDatabaseClusterFinalBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterFinalBackupResponse, error) {
+	rsp, err := c.GetDatabaseClusterFinalBackup(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterFinalBackupResponse(rsp)
+}
+
+// GetDatabaseClusterHealthWithResponse request returning *GetDatabaseClusterHealthResponse
+func (c *ClientWithResponses) GetDatabaseClusterHealthWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterHealthResponse, error) {
+	rsp, err := c.GetDatabaseClusterHealth(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterHealthResponse(rsp)
+}
+
+// GetDatabaseClusterLogsWithResponse request returning *GetDatabaseClusterLogsResponse
+func (c *ClientWithResponses) GetDatabaseClusterLogsWithResponse(ctx context.Context, namespace string, name string, params *GetDatabaseClusterLogsParams, reqEditors ...RequestEditorFn) (*GetDatabaseClusterLogsResponse, error) {
+	rsp, err := c.GetDatabaseClusterLogs(ctx, namespace, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterLogsResponse(rsp)
+}
+
+// PauseDatabaseClusterWithResponse request returning *PauseDatabaseClusterResponse
+func (c *ClientWithResponses) PauseDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*PauseDatabaseClusterResponse, error) {
+	rsp, err := c.PauseDatabaseCluster(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePauseDatabaseClusterResponse(rsp)
+}
+
+// GetDatabaseClusterPitrWithResponse request returning *GetDatabaseClusterPitrResponse
+func (c *ClientWithResponses) GetDatabaseClusterPitrWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterPitrResponse, error) {
+	rsp, err := c.GetDatabaseClusterPitr(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterPitrResponse(rsp)
+}
+
+// DeleteDatabaseClusterPowerScheduleWithResponse request returning *DeleteDatabaseClusterPowerScheduleResponse
+func (c *ClientWithResponses) DeleteDatabaseClusterPowerScheduleWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterPowerScheduleResponse, error) {
+	rsp, err := c.DeleteDatabaseClusterPowerSchedule(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatabaseClusterPowerScheduleResponse(rsp)
+}
+
+// GetDatabaseClusterPowerScheduleWithResponse request returning *GetDatabaseClusterPowerScheduleResponse
+func (c *ClientWithResponses) GetDatabaseClusterPowerScheduleWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterPowerScheduleResponse, error) {
+	rsp, err := c.GetDatabaseClusterPowerSchedule(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterPowerScheduleResponse(rsp)
+}
+
+// UpdateDatabaseClusterPowerScheduleWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseClusterPowerScheduleResponse
+func (c *ClientWithResponses) UpdateDatabaseClusterPowerScheduleWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterPowerScheduleResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterPowerScheduleWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterPowerScheduleResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDatabaseClusterPowerScheduleWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterPowerScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterPowerScheduleResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterPowerSchedule(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterPowerScheduleResponse(rsp)
+}
+
+// GetDatabaseClusterPreRestoreBackupWithResponse request returning *GetDatabaseClusterPreRestoreBackupResponse
+func (c *ClientWithResponses) GetDatabaseClusterPreRestoreBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterPreRestoreBackupResponse, error) {
+	rsp, err := c.GetDatabaseClusterPreRestoreBackup(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterPreRestoreBackupResponse(rsp)
+}
+
+// ListDatabaseClusterRestoresWithResponse request returning *ListDatabaseClusterRestoresResponse
+func (c *ClientWithResponses) ListDatabaseClusterRestoresWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterRestoresResponse, error) {
+	rsp, err := c.ListDatabaseClusterRestores(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabaseClusterRestoresResponse(rsp)
+}
+
+// ResumeDatabaseClusterWithResponse request returning *ResumeDatabaseClusterResponse
+func (c *ClientWithResponses) ResumeDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*ResumeDatabaseClusterResponse, error) {
+	rsp, err := c.ResumeDatabaseCluster(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResumeDatabaseClusterResponse(rsp)
+}
+
+// ListDatabaseEnginesWithResponse request returning *ListDatabaseEnginesResponse
+func (c *ClientWithResponses) ListDatabaseEnginesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseEnginesResponse, error) {
+	rsp, err := c.ListDatabaseEngines(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabaseEnginesResponse(rsp)
+}
+
+// GetDatabaseEngineWithResponse request returning *GetDatabaseEngineResponse
+func (c *ClientWithResponses) GetDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseEngineResponse, error) {
+	rsp, err := c.GetDatabaseEngine(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseEngineResponse(rsp)
+}
+
+// UpdateDatabaseEngineWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseEngineResponse
+func (c *ClientWithResponses) UpdateDatabaseEngineWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error) {
+	rsp, err := c.UpdateDatabaseEngineWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseEngineResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error) {
+	rsp, err := c.UpdateDatabaseEngine(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseEngineResponse(rsp)
+}
+
+// GetNamespaceDeletionProtectionWithResponse request returning *GetNamespaceDeletionProtectionResponse
+func (c *ClientWithResponses) GetNamespaceDeletionProtectionWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetNamespaceDeletionProtectionResponse, error) {
+	rsp, err := c.GetNamespaceDeletionProtection(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNamespaceDeletionProtectionResponse(rsp)
+}
+
+// UpdateNamespaceDeletionProtectionWithBodyWithResponse request with arbitrary body returning *UpdateNamespaceDeletionProtectionResponse
+func (c *ClientWithResponses) UpdateNamespaceDeletionProtectionWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNamespaceDeletionProtectionResponse, error) {
+	rsp, err := c.UpdateNamespaceDeletionProtectionWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNamespaceDeletionProtectionResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateNamespaceDeletionProtectionWithResponse(ctx context.Context, namespace string, body UpdateNamespaceDeletionProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNamespaceDeletionProtectionResponse, error) {
+	rsp, err := c.UpdateNamespaceDeletionProtection(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNamespaceDeletionProtectionResponse(rsp)
+}
+
+// GetNamespacePreRestoreBackupWithResponse request returning *GetNamespacePreRestoreBackupResponse
+func (c *ClientWithResponses) GetNamespacePreRestoreBackupWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetNamespacePreRestoreBackupResponse, error) {
+	rsp, err := c.GetNamespacePreRestoreBackup(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNamespacePreRestoreBackupResponse(rsp)
+}
+
+// UpdateNamespacePreRestoreBackupWithBodyWithResponse request with arbitrary body returning *UpdateNamespacePreRestoreBackupResponse
+func (c *ClientWithResponses) UpdateNamespacePreRestoreBackupWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNamespacePreRestoreBackupResponse, error) {
+	rsp, err := c.UpdateNamespacePreRestoreBackupWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNamespacePreRestoreBackupResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateNamespacePreRestoreBackupWithResponse(ctx context.Context, namespace string, body UpdateNamespacePreRestoreBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNamespacePreRestoreBackupResponse, error) {
+	rsp, err := c.UpdateNamespacePreRestoreBackup(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNamespacePreRestoreBackupResponse(rsp)
+}
+
+// DeleteNamespaceQuotaWithResponse request returning *DeleteNamespaceQuotaResponse
+func (c *ClientWithResponses) DeleteNamespaceQuotaWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*DeleteNamespaceQuotaResponse, error) {
+	rsp, err := c.DeleteNamespaceQuota(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteNamespaceQuotaResponse(rsp)
+}
+
+// GetNamespaceQuotaWithResponse request returning *GetNamespaceQuotaResponse
+func (c *ClientWithResponses) GetNamespaceQuotaWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetNamespaceQuotaResponse, error) {
+	rsp, err := c.GetNamespaceQuota(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNamespaceQuotaResponse(rsp)
+}
+
+// UpdateNamespaceQuotaWithBodyWithResponse request with arbitrary body returning *UpdateNamespaceQuotaResponse
+func (c *ClientWithResponses) UpdateNamespaceQuotaWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNamespaceQuotaResponse, error) {
+	rsp, err := c.UpdateNamespaceQuotaWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNamespaceQuotaResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateNamespaceQuotaWithResponse(ctx context.Context, namespace string, body UpdateNamespaceQuotaJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNamespaceQuotaResponse, error) {
+	rsp, err := c.UpdateNamespaceQuota(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNamespaceQuotaResponse(rsp)
+}
+
+// GetPriceTableWithResponse request returning *GetPriceTableResponse
+func (c *ClientWithResponses) GetPriceTableWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPriceTableResponse, error) {
+	rsp, err := c.GetPriceTable(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPriceTableResponse(rsp)
+}
+
+// UpdatePriceTableWithBodyWithResponse request with arbitrary body returning *UpdatePriceTableResponse
+func (c *ClientWithResponses) UpdatePriceTableWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePriceTableResponse, error) {
+	rsp, err := c.UpdatePriceTableWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePriceTableResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePriceTableWithResponse(ctx context.Context, body UpdatePriceTableJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePriceTableResponse, error) {
+	rsp, err := c.UpdatePriceTable(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePriceTableResponse(rsp)
+}
+
+// ListNamespaceQuotasWithResponse request returning *ListNamespaceQuotasResponse
+func (c *ClientWithResponses) ListNamespaceQuotasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespaceQuotasResponse, error) {
+	rsp, err := c.ListNamespaceQuotas(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListNamespaceQuotasResponse(rsp)
+}
+
+// GetKubernetesClusterResourcesWithResponse request returning *GetKubernetesClusterResourcesResponse
+func (c *ClientWithResponses) GetKubernetesClusterResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterResourcesResponse, error) {
+	rsp, err := c.GetKubernetesClusterResources(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetKubernetesClusterResourcesResponse(rsp)
+}
+
+// ListDatabaseClusterTemplatesWithResponse request returning *ListDatabaseClusterTemplatesResponse
+func (c *ClientWithResponses) ListDatabaseClusterTemplatesWithResponse(ctx context.Context, params *ListDatabaseClusterTemplatesParams, reqEditors ...RequestEditorFn) (*ListDatabaseClusterTemplatesResponse, error) {
+	rsp, err := c.ListDatabaseClusterTemplates(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabaseClusterTemplatesResponse(rsp)
+}
+
+// CreateDatabaseClusterTemplateWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterTemplateResponse
+func (c *ClientWithResponses) CreateDatabaseClusterTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterTemplateResponse, error) {
+	rsp, err := c.CreateDatabaseClusterTemplateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDatabaseClusterTemplateWithResponse(ctx context.Context, body CreateDatabaseClusterTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterTemplateResponse, error) {
+	rsp, err := c.CreateDatabaseClusterTemplate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterTemplateResponse(rsp)
+}
+
+// DeleteDatabaseClusterTemplateWithResponse request returning *DeleteDatabaseClusterTemplateResponse
+func (c *ClientWithResponses) DeleteDatabaseClusterTemplateWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterTemplateResponse, error) {
+	rsp, err := c.DeleteDatabaseClusterTemplate(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatabaseClusterTemplateResponse(rsp)
+}
+
+// GetDatabaseClusterTemplateWithResponse request returning *GetDatabaseClusterTemplateResponse
+func (c *ClientWithResponses) GetDatabaseClusterTemplateWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterTemplateResponse, error) {
+	rsp, err := c.GetDatabaseClusterTemplate(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterTemplateResponse(rsp)
+}
+
+// UpdateDatabaseClusterTemplateWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseClusterTemplateResponse
+func (c *ClientWithResponses) UpdateDatabaseClusterTemplateWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterTemplateResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterTemplateWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDatabaseClusterTemplateWithResponse(ctx context.Context, name string, body UpdateDatabaseClusterTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterTemplateResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterTemplate(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterTemplateResponse(rsp)
+}
+
+// VersionInfoWithResponse request returning *VersionInfoResponse
+func (c *ClientWithResponses) VersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VersionInfoResponse, error) {
+	rsp, err := c.VersionInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVersionInfoResponse(rsp)
+}
+
+// ParseListBackupStoragesResponse parses an HTTP response from a ListBackupStoragesWithResponse call
+func ParseListBackupStoragesResponse(rsp *http.Response) (*ListBackupStoragesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBackupStoragesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupStoragesList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBackupStorageResponse parses an HTTP response from a CreateBackupStorageWithResponse call
+func ParseCreateBackupStorageResponse(rsp *http.Response) (*CreateBackupStorageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBackupStorageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupStorage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBackupStorageResponse parses an HTTP response from a DeleteBackupStorageWithResponse call
+func ParseDeleteBackupStorageResponse(rsp *http.Response) (*DeleteBackupStorageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBackupStorageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBackupStorageResponse parses an HTTP response from a GetBackupStorageWithResponse call
+func ParseGetBackupStorageResponse(rsp *http.Response) (*GetBackupStorageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBackupStorageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupStorage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateBackupStorageResponse parses an HTTP response from a UpdateBackupStorageWithResponse call
+func ParseUpdateBackupStorageResponse(rsp *http.Response) (*UpdateBackupStorageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateBackupStorageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupStorage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBackupArtifactsResponse parses an HTTP response from a ListBackupArtifactsWithResponse call
+func ParseListBackupArtifactsResponse(rsp *http.Response) (*ListBackupArtifactsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBackupArtifactsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupArtifactList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportBackupArtifactsResponse parses an HTTP response from a ImportBackupArtifactsWithResponse call
+func ParseImportBackupArtifactsResponse(rsp *http.Response) (*ImportBackupArtifactsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportBackupArtifactsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupArtifactList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBackupVerificationSettingsResponse parses an HTTP response from a GetBackupVerificationSettingsWithResponse call
+func ParseGetBackupVerificationSettingsResponse(rsp *http.Response) (*GetBackupVerificationSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBackupVerificationSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupVerificationSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateBackupVerificationSettingsResponse parses an HTTP response from a UpdateBackupVerificationSettingsWithResponse call
+func ParseUpdateBackupVerificationSettingsResponse(rsp *http.Response) (*UpdateBackupVerificationSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateBackupVerificationSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupVerificationSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetKubernetesClusterInfoResponse parses an HTTP response from a GetKubernetesClusterInfoWithResponse call
+func ParseGetKubernetesClusterInfoResponse(rsp *http.Response) (*GetKubernetesClusterInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetKubernetesClusterInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KubernetesClusterInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListNamespaceCostEstimatesResponse parses an HTTP response from a ListNamespaceCostEstimatesWithResponse call
+func ParseListNamespaceCostEstimatesResponse(rsp *http.Response) (*ListNamespaceCostEstimatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListNamespaceCostEstimatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NamespaceCostEstimateList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEstimateDatabaseClusterCostResponse parses an HTTP response from a EstimateDatabaseClusterCostWithResponse call
+func ParseEstimateDatabaseClusterCostResponse(rsp *http.Response) (*EstimateDatabaseClusterCostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EstimateDatabaseClusterCostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterCostEstimate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAllDatabaseClustersResponse parses an HTTP response from a ListAllDatabaseClustersWithResponse call
+func ParseListAllDatabaseClustersResponse(rsp *http.Response) (*ListAllDatabaseClustersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAllDatabaseClustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListMonitoringInstancesResponse parses an HTTP response from a ListMonitoringInstancesWithResponse call
+func ParseListMonitoringInstancesResponse(rsp *http.Response) (*ListMonitoringInstancesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListMonitoringInstancesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MonitoringInstancesList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateMonitoringInstanceResponse parses an HTTP response from a CreateMonitoringInstanceWithResponse call
+func ParseCreateMonitoringInstanceResponse(rsp *http.Response) (*CreateMonitoringInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateMonitoringInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MonitoringInstance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMonitoringInstanceResponse parses an HTTP response from a DeleteMonitoringInstanceWithResponse call
+func ParseDeleteMonitoringInstanceResponse(rsp *http.Response) (*DeleteMonitoringInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMonitoringInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMonitoringInstanceResponse parses an HTTP response from a GetMonitoringInstanceWithResponse call
+func ParseGetMonitoringInstanceResponse(rsp *http.Response) (*GetMonitoringInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMonitoringInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MonitoringInstance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateMonitoringInstanceResponse parses an HTTP response from a UpdateMonitoringInstanceWithResponse call
+func ParseUpdateMonitoringInstanceResponse(rsp *http.Response) (*UpdateMonitoringInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateMonitoringInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MonitoringInstance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListNamespacesResponse parses an HTTP response from a ListNamespacesWithResponse call
+func ParseListNamespacesResponse(rsp *http.Response) (*ListNamespacesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListNamespacesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NamespaceList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
+	return response, nil
+}
+
+// ParseDeleteNamespaceBackupRetentionResponse parses an HTTP response from a DeleteNamespaceBackupRetentionWithResponse call
+func ParseDeleteNamespaceBackupRetentionResponse(rsp *http.Response) (*DeleteNamespaceBackupRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteNamespaceBackupRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNamespaceBackupRetentionResponse parses an HTTP response from a GetNamespaceBackupRetentionWithResponse call
+func ParseGetNamespaceBackupRetentionResponse(rsp *http.Response) (*GetNamespaceBackupRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNamespaceBackupRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupRetentionPolicy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateNamespaceBackupRetentionResponse parses an HTTP response from a UpdateNamespaceBackupRetentionWithResponse call
+func ParseUpdateNamespaceBackupRetentionResponse(rsp *http.Response) (*UpdateNamespaceBackupRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateNamespaceBackupRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupRetentionPolicy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNamespaceBackupStatsResponse parses an HTTP response from a GetNamespaceBackupStatsWithResponse call
+func ParseGetNamespaceBackupStatsResponse(rsp *http.Response) (*GetNamespaceBackupStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNamespaceBackupStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NamespaceBackupStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNamespaceCostEstimateResponse parses an HTTP response from a GetNamespaceCostEstimateWithResponse call
+func ParseGetNamespaceCostEstimateResponse(rsp *http.Response) (*GetNamespaceCostEstimateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNamespaceCostEstimateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NamespaceCostEstimate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDatabaseClusterBackupResponse parses an HTTP response from a CreateDatabaseClusterBackupWithResponse call
+func ParseCreateDatabaseClusterBackupResponse(rsp *http.Response) (*CreateDatabaseClusterBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDatabaseClusterBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseClusterBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest DatabaseClusterBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDatabaseClusterBackupResponse parses an HTTP response from a DeleteDatabaseClusterBackupWithResponse call
+func ParseDeleteDatabaseClusterBackupResponse(rsp *http.Response) (*DeleteDatabaseClusterBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDatabaseClusterBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDatabaseClusterBackupResponse parses an HTTP response from a GetDatabaseClusterBackupWithResponse call
+func ParseGetDatabaseClusterBackupResponse(rsp *http.Response) (*GetDatabaseClusterBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDatabaseClusterBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListDatabaseClusterBackupCopiesResponse parses an HTTP response from a ListDatabaseClusterBackupCopiesWithResponse call
+func ParseListDatabaseClusterBackupCopiesResponse(rsp *http.Response) (*ListDatabaseClusterBackupCopiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDatabaseClusterBackupCopiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BackupCopy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCopyDatabaseClusterBackupResponse parses an HTTP response from a CopyDatabaseClusterBackupWithResponse call
+func ParseCopyDatabaseClusterBackupResponse(rsp *http.Response) (*CopyDatabaseClusterBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CopyDatabaseClusterBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest BackupCopy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreDatabaseClusterBackupToNewClusterResponse parses an HTTP response from a RestoreDatabaseClusterBackupToNewClusterWithResponse call
+func ParseRestoreDatabaseClusterBackupToNewClusterResponse(rsp *http.Response) (*RestoreDatabaseClusterBackupToNewClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreDatabaseClusterBackupToNewClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDatabaseClusterBackupVerificationResponse parses an HTTP response from a GetDatabaseClusterBackupVerificationWithResponse call
+func ParseGetDatabaseClusterBackupVerificationResponse(rsp *http.Response) (*GetDatabaseClusterBackupVerificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDatabaseClusterBackupVerificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupVerification
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyDatabaseClusterBackupResponse parses an HTTP response from a VerifyDatabaseClusterBackupWithResponse call
+func ParseVerifyDatabaseClusterBackupResponse(rsp *http.Response) (*VerifyDatabaseClusterBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyDatabaseClusterBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest BackupVerification
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDatabaseClusterRestoreResponse parses an HTTP response from a CreateDatabaseClusterRestoreWithResponse call
+func ParseCreateDatabaseClusterRestoreResponse(rsp *http.Response) (*CreateDatabaseClusterRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDatabaseClusterRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterRestore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseClusterRestore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest PreRestoreBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDatabaseClusterRestoreResponse parses an HTTP response from a DeleteDatabaseClusterRestoreWithResponse call
+func ParseDeleteDatabaseClusterRestoreResponse(rsp *http.Response) (*DeleteDatabaseClusterRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDatabaseClusterRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDatabaseClusterRestoreResponse parses an HTTP response from a GetDatabaseClusterRestoreWithResponse call
+func ParseGetDatabaseClusterRestoreResponse(rsp *http.Response) (*GetDatabaseClusterRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDatabaseClusterRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterRestore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateDatabaseClusterRestoreResponse parses an HTTP response from a UpdateDatabaseClusterRestoreWithResponse call
+func ParseUpdateDatabaseClusterRestoreResponse(rsp *http.Response) (*UpdateDatabaseClusterRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateDatabaseClusterRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterRestore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseClusterRestore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDatabaseClusterRestoreProgressResponse parses an HTTP response from a GetDatabaseClusterRestoreProgressWithResponse call
+func ParseGetDatabaseClusterRestoreProgressResponse(rsp *http.Response) (*GetDatabaseClusterRestoreProgressResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDatabaseClusterRestoreProgressResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterRestoreProgress
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListDatabaseClustersResponse parses an HTTP response from a ListDatabaseClustersWithResponse call
+func ParseListDatabaseClustersResponse(rsp *http.Response) (*ListDatabaseClustersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDatabaseClustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDatabaseClusterResponse parses an HTTP response from a CreateDatabaseClusterWithResponse call
+func ParseCreateDatabaseClusterResponse(rsp *http.Response) (*CreateDatabaseClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDatabaseClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest DatabaseCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDatabaseClusterFromTemplateResponse parses an HTTP response from a CreateDatabaseClusterFromTemplateWithResponse call
+func ParseCreateDatabaseClusterFromTemplateResponse(rsp *http.Response) (*CreateDatabaseClusterFromTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDatabaseClusterFromTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportDatabaseClusterResponse parses an HTTP response from a ImportDatabaseClusterWithResponse call
+func ParseImportDatabaseClusterResponse(rsp *http.Response) (*ImportDatabaseClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportDatabaseClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDatabaseClusterResponse parses an HTTP response from a DeleteDatabaseClusterWithResponse call
+func ParseDeleteDatabaseClusterResponse(rsp *http.Response) (*DeleteDatabaseClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDatabaseClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest FinalBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 423:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON423 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDatabaseClusterResponse parses an HTTP response from a GetDatabaseClusterWithResponse call
+func ParseGetDatabaseClusterResponse(rsp *http.Response) (*GetDatabaseClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDatabaseClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateDatabaseClusterResponse parses an HTTP response from a UpdateDatabaseClusterWithResponse call
+func ParseUpdateDatabaseClusterResponse(rsp *http.Response) (*UpdateDatabaseClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateDatabaseClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDatabaseClusterBackupReplicationResponse parses an HTTP response from a DeleteDatabaseClusterBackupReplicationWithResponse call
+func ParseDeleteDatabaseClusterBackupReplicationResponse(rsp *http.Response) (*DeleteDatabaseClusterBackupReplicationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDatabaseClusterBackupReplicationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDatabaseClusterBackupReplicationResponse parses an HTTP response from a GetDatabaseClusterBackupReplicationWithResponse call
+func ParseGetDatabaseClusterBackupReplicationResponse(rsp *http.Response) (*GetDatabaseClusterBackupReplicationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDatabaseClusterBackupReplicationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupReplicationPolicy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateDatabaseClusterBackupReplicationResponse parses an HTTP response from a UpdateDatabaseClusterBackupReplicationWithResponse call
+func ParseUpdateDatabaseClusterBackupReplicationResponse(rsp *http.Response) (*UpdateDatabaseClusterBackupReplicationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateDatabaseClusterBackupReplicationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupReplicationPolicy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDatabaseClusterBackupRetentionResponse parses an HTTP response from a DeleteDatabaseClusterBackupRetentionWithResponse call
+func ParseDeleteDatabaseClusterBackupRetentionResponse(rsp *http.Response) (*DeleteDatabaseClusterBackupRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDatabaseClusterBackupRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDatabaseClusterBackupRetentionResponse parses an HTTP response from a GetDatabaseClusterBackupRetentionWithResponse call
+func ParseGetDatabaseClusterBackupRetentionResponse(rsp *http.Response) (*GetDatabaseClusterBackupRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDatabaseClusterBackupRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterBackupRetention
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateDatabaseClusterBackupRetentionResponse parses an HTTP response from a UpdateDatabaseClusterBackupRetentionWithResponse call
+func ParseUpdateDatabaseClusterBackupRetentionResponse(rsp *http.Response) (*UpdateDatabaseClusterBackupRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateDatabaseClusterBackupRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterBackupRetention
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUpdateMonitoringInstanceResponse(rsp)
-}
 
-// ListNamespacesWithResponse request returning *ListNamespacesResponse
-func (c *ClientWithResponses) ListNamespacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespacesResponse, error) {
-	rsp, err := c.ListNamespaces(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListNamespacesResponse(rsp)
+	return response, nil
 }
 
-// CreateDatabaseClusterBackupWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterBackupResponse
-func (c *ClientWithResponses) CreateDatabaseClusterBackupWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error) {
-	rsp, err := c.CreateDatabaseClusterBackupWithBody(ctx, namespace, contentType, body, reqEditors...)
+// ParsePreviewDatabaseClusterBackupRetentionResponse parses an HTTP response from a PreviewDatabaseClusterBackupRetentionWithResponse call
+func ParsePreviewDatabaseClusterBackupRetentionResponse(rsp *http.Response) (*PreviewDatabaseClusterBackupRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDatabaseClusterBackupResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error) {
-	rsp, err := c.CreateDatabaseClusterBackup(ctx, namespace, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PreviewDatabaseClusterBackupRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateDatabaseClusterBackupResponse(rsp)
-}
 
-// DeleteDatabaseClusterBackupWithResponse request returning *DeleteDatabaseClusterBackupResponse
-func (c *ClientWithResponses) DeleteDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterBackupResponse, error) {
-	rsp, err := c.DeleteDatabaseClusterBackup(ctx, namespace, name, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteDatabaseClusterBackupResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupRetentionPreview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// GetDatabaseClusterBackupWithResponse request returning *GetDatabaseClusterBackupResponse
-func (c *ClientWithResponses) GetDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupResponse, error) {
-	rsp, err := c.GetDatabaseClusterBackup(ctx, namespace, name, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetDatabaseClusterBackupResponse(rsp)
+
+	return response, nil
 }
 
-// CreateDatabaseClusterRestoreWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterRestoreResponse
-func (c *ClientWithResponses) CreateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.CreateDatabaseClusterRestoreWithBody(ctx, namespace, contentType, body, reqEditors...)
+// ParsePreviewDatabaseClusterBackupSchedulesResponse parses an HTTP response from a PreviewDatabaseClusterBackupSchedulesWithResponse call
+func ParsePreviewDatabaseClusterBackupSchedulesResponse(rsp *http.Response) (*PreviewDatabaseClusterBackupSchedulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDatabaseClusterRestoreResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.CreateDatabaseClusterRestore(ctx, namespace, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PreviewDatabaseClusterBackupSchedulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateDatabaseClusterRestoreResponse(rsp)
-}
 
-// DeleteDatabaseClusterRestoreWithResponse request returning *DeleteDatabaseClusterRestoreResponse
-func (c *ClientWithResponses) DeleteDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.DeleteDatabaseClusterRestore(ctx, namespace, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupSchedulePreview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseDeleteDatabaseClusterRestoreResponse(rsp)
+
+	return response, nil
 }
 
-// GetDatabaseClusterRestoreWithResponse request returning *GetDatabaseClusterRestoreResponse
-func (c *ClientWithResponses) GetDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.GetDatabaseClusterRestore(ctx, namespace, name, reqEditors...)
+// ParseListDatabaseClusterBackupsResponse parses an HTTP response from a ListDatabaseClusterBackupsWithResponse call
+func ParseListDatabaseClusterBackupsResponse(rsp *http.Response) (*ListDatabaseClusterBackupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDatabaseClusterRestoreResponse(rsp)
-}
 
-// UpdateDatabaseClusterRestoreWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseClusterRestoreResponse
-func (c *ClientWithResponses) UpdateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.UpdateDatabaseClusterRestoreWithBody(ctx, namespace, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListDatabaseClusterBackupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateDatabaseClusterRestoreResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.UpdateDatabaseClusterRestore(ctx, namespace, name, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterBackupList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUpdateDatabaseClusterRestoreResponse(rsp)
+
+	return response, nil
 }
 
-// ListDatabaseClustersWithResponse request returning *ListDatabaseClustersResponse
-func (c *ClientWithResponses) ListDatabaseClustersWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseClustersResponse, error) {
-	rsp, err := c.ListDatabaseClusters(ctx, namespace, reqEditors...)
+// ParseGetDatabaseClusterBackupStatsResponse parses an HTTP response from a GetDatabaseClusterBackupStatsWithResponse call
+func ParseGetDatabaseClusterBackupStatsResponse(rsp *http.Response) (*GetDatabaseClusterBackupStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListDatabaseClustersResponse(rsp)
-}
 
-// CreateDatabaseClusterWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterResponse
-func (c *ClientWithResponses) CreateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error) {
-	rsp, err := c.CreateDatabaseClusterWithBody(ctx, namespace, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetDatabaseClusterBackupStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateDatabaseClusterResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateDatabaseClusterWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error) {
-	rsp, err := c.CreateDatabaseCluster(ctx, namespace, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseCreateDatabaseClusterResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteDatabaseClusterWithResponse request returning *DeleteDatabaseClusterResponse
-func (c *ClientWithResponses) DeleteDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterResponse, error) {
-	rsp, err := c.DeleteDatabaseCluster(ctx, namespace, name, reqEditors...)
+// ParseGetDatabaseClusterCredentialsResponse parses an HTTP response from a GetDatabaseClusterCredentialsWithResponse call
+func ParseGetDatabaseClusterCredentialsResponse(rsp *http.Response) (*GetDatabaseClusterCredentialsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteDatabaseClusterResponse(rsp)
-}
 
-// GetDatabaseClusterWithResponse request returning *GetDatabaseClusterResponse
-func (c *ClientWithResponses) GetDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterResponse, error) {
-	rsp, err := c.GetDatabaseCluster(ctx, namespace, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetDatabaseClusterCredentialsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetDatabaseClusterResponse(rsp)
-}
 
-// UpdateDatabaseClusterWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseClusterResponse
-func (c *ClientWithResponses) UpdateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error) {
-	rsp, err := c.UpdateDatabaseClusterWithBody(ctx, namespace, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterCredential
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUpdateDatabaseClusterResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error) {
-	rsp, err := c.UpdateDatabaseCluster(ctx, namespace, name, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateDatabaseClusterResponse(rsp)
+	return response, nil
 }
 
-// ListDatabaseClusterBackupsWithResponse request returning *ListDatabaseClusterBackupsResponse
-func (c *ClientWithResponses) ListDatabaseClusterBackupsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupsResponse, error) {
-	rsp, err := c.ListDatabaseClusterBackups(ctx, namespace, name, reqEditors...)
+// ParseGetDatabaseClusterDeletionProtectionResponse parses an HTTP response from a GetDatabaseClusterDeletionProtectionWithResponse call
+func ParseGetDatabaseClusterDeletionProtectionResponse(rsp *http.Response) (*GetDatabaseClusterDeletionProtectionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListDatabaseClusterBackupsResponse(rsp)
-}
 
-// GetDatabaseClusterCredentialsWithResponse request returning *GetDatabaseClusterCredentialsResponse
-func (c *ClientWithResponses) GetDatabaseClusterCredentialsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterCredentialsResponse, error) {
-	rsp, err := c.GetDatabaseClusterCredentials(ctx, namespace, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetDatabaseClusterDeletionProtectionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetDatabaseClusterCredentialsResponse(rsp)
-}
 
-// GetDatabaseClusterPitrWithResponse request returning *GetDatabaseClusterPitrResponse
-func (c *ClientWithResponses) GetDatabaseClusterPitrWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterPitrResponse, error) {
-	rsp, err := c.GetDatabaseClusterPitr(ctx, namespace, name, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetDatabaseClusterPitrResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterDeletionProtection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// ListDatabaseClusterRestoresWithResponse request returning *ListDatabaseClusterRestoresResponse
-func (c *ClientWithResponses) ListDatabaseClusterRestoresWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterRestoresResponse, error) {
-	rsp, err := c.ListDatabaseClusterRestores(ctx, namespace, name, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseListDatabaseClusterRestoresResponse(rsp)
+
+	return response, nil
 }
 
-// ListDatabaseEnginesWithResponse request returning *ListDatabaseEnginesResponse
-func (c *ClientWithResponses) ListDatabaseEnginesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseEnginesResponse, error) {
-	rsp, err := c.ListDatabaseEngines(ctx, namespace, reqEditors...)
+// ParseUpdateDatabaseClusterDeletionProtectionResponse parses an HTTP response from a UpdateDatabaseClusterDeletionProtectionWithResponse call
+func ParseUpdateDatabaseClusterDeletionProtectionResponse(rsp *http.Response) (*UpdateDatabaseClusterDeletionProtectionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListDatabaseEnginesResponse(rsp)
-}
 
-// GetDatabaseEngineWithResponse request returning *GetDatabaseEngineResponse
-func (c *ClientWithResponses) GetDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseEngineResponse, error) {
-	rsp, err := c.GetDatabaseEngine(ctx, namespace, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateDatabaseClusterDeletionProtectionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetDatabaseEngineResponse(rsp)
-}
 
-// UpdateDatabaseEngineWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseEngineResponse
-func (c *ClientWithResponses) UpdateDatabaseEngineWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error) {
-	rsp, err := c.UpdateDatabaseEngineWithBody(ctx, namespace, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterDeletionProtection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUpdateDatabaseEngineResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error) {
-	rsp, err := c.UpdateDatabaseEngine(ctx, namespace, name, body, reqEditors...)
+// ParseUnprotectDatabaseClusterResponse parses an HTTP response from a UnprotectDatabaseClusterWithResponse call
+func ParseUnprotectDatabaseClusterResponse(rsp *http.Response) (*UnprotectDatabaseClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateDatabaseEngineResponse(rsp)
-}
 
-// GetKubernetesClusterResourcesWithResponse request returning *GetKubernetesClusterResourcesResponse
-func (c *ClientWithResponses) GetKubernetesClusterResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterResourcesResponse, error) {
-	rsp, err := c.GetKubernetesClusterResources(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UnprotectDatabaseClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetKubernetesClusterResourcesResponse(rsp)
-}
 
-// VersionInfoWithResponse request returning *VersionInfoResponse
-func (c *ClientWithResponses) VersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VersionInfoResponse, error) {
-	rsp, err := c.VersionInfo(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterDeletionProtection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseVersionInfoResponse(rsp)
+
+	return response, nil
 }
 
-// ParseListBackupStoragesResponse parses an HTTP response from a ListBackupStoragesWithResponse call
-func ParseListBackupStoragesResponse(rsp *http.Response) (*ListBackupStoragesResponse, error) {
+// ParseGetDatabaseClusterEventsResponse parses an HTTP response from a GetDatabaseClusterEventsWithResponse call
+func ParseGetDatabaseClusterEventsResponse(rsp *http.Response) (*GetDatabaseClusterEventsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListBackupStoragesResponse{
+	response := &GetDatabaseClusterEventsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupStoragesList
+		var dest DatabaseClusterEventList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4647,6 +14467,13 @@ func ParseListBackupStoragesResponse(rsp *http.Response) (*ListBackupStoragesRes
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4659,22 +14486,22 @@ func ParseListBackupStoragesResponse(rsp *http.Response) (*ListBackupStoragesRes
 	return response, nil
 }
 
-// ParseCreateBackupStorageResponse parses an HTTP response from a CreateBackupStorageWithResponse call
-func ParseCreateBackupStorageResponse(rsp *http.Response) (*CreateBackupStorageResponse, error) {
+// ParseExportDatabaseClusterResponse parses an HTTP response from a ExportDatabaseClusterWithResponse call
+func ParseExportDatabaseClusterResponse(rsp *http.Response) (*ExportDatabaseClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateBackupStorageResponse{
+	response := &ExportDatabaseClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupStorage
+		var dest DatabaseClusterBundle
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4687,6 +14514,13 @@ func ParseCreateBackupStorageResponse(rsp *http.Response) (*CreateBackupStorageR
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4694,31 +14528,45 @@ func ParseCreateBackupStorageResponse(rsp *http.Response) (*CreateBackupStorageR
 		}
 		response.JSON500 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest DatabaseClusterBundle
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDeleteBackupStorageResponse parses an HTTP response from a DeleteBackupStorageWithResponse call
-func ParseDeleteBackupStorageResponse(rsp *http.Response) (*DeleteBackupStorageResponse, error) {
+// ParseGetDatabaseClusterFinalBackupResponse parses an HTTP response from a GetDatabaseClusterFinalBackupWithResponse call
+func ParseGetDatabaseClusterFinalBackupResponse(rsp *http.Response) (*GetDatabaseClusterFinalBackupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteBackupStorageResponse{
+	response := &GetDatabaseClusterFinalBackupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FinalBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -4732,22 +14580,22 @@ func ParseDeleteBackupStorageResponse(rsp *http.Response) (*DeleteBackupStorageR
 	return response, nil
 }
 
-// ParseGetBackupStorageResponse parses an HTTP response from a GetBackupStorageWithResponse call
-func ParseGetBackupStorageResponse(rsp *http.Response) (*GetBackupStorageResponse, error) {
+// ParseGetDatabaseClusterHealthResponse parses an HTTP response from a GetDatabaseClusterHealthWithResponse call
+func ParseGetDatabaseClusterHealthResponse(rsp *http.Response) (*GetDatabaseClusterHealthResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBackupStorageResponse{
+	response := &GetDatabaseClusterHealthResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupStorage
+		var dest DatabaseClusterHealth
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4760,6 +14608,13 @@ func ParseGetBackupStorageResponse(rsp *http.Response) (*GetBackupStorageRespons
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4772,33 +14627,33 @@ func ParseGetBackupStorageResponse(rsp *http.Response) (*GetBackupStorageRespons
 	return response, nil
 }
 
-// ParseUpdateBackupStorageResponse parses an HTTP response from a UpdateBackupStorageWithResponse call
-func ParseUpdateBackupStorageResponse(rsp *http.Response) (*UpdateBackupStorageResponse, error) {
+// ParseGetDatabaseClusterLogsResponse parses an HTTP response from a GetDatabaseClusterLogsWithResponse call
+func ParseGetDatabaseClusterLogsResponse(rsp *http.Response) (*GetDatabaseClusterLogsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateBackupStorageResponse{
+	response := &GetDatabaseClusterLogsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupStorage
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -4812,22 +14667,22 @@ func ParseUpdateBackupStorageResponse(rsp *http.Response) (*UpdateBackupStorageR
 	return response, nil
 }
 
-// ParseGetKubernetesClusterInfoResponse parses an HTTP response from a GetKubernetesClusterInfoWithResponse call
-func ParseGetKubernetesClusterInfoResponse(rsp *http.Response) (*GetKubernetesClusterInfoResponse, error) {
+// ParsePauseDatabaseClusterResponse parses an HTTP response from a PauseDatabaseClusterWithResponse call
+func ParsePauseDatabaseClusterResponse(rsp *http.Response) (*PauseDatabaseClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetKubernetesClusterInfoResponse{
+	response := &PauseDatabaseClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest KubernetesClusterInfo
+		var dest DatabaseCluster
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4840,6 +14695,13 @@ func ParseGetKubernetesClusterInfoResponse(rsp *http.Response) (*GetKubernetesCl
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4852,22 +14714,22 @@ func ParseGetKubernetesClusterInfoResponse(rsp *http.Response) (*GetKubernetesCl
 	return response, nil
 }
 
-// ParseListMonitoringInstancesResponse parses an HTTP response from a ListMonitoringInstancesWithResponse call
-func ParseListMonitoringInstancesResponse(rsp *http.Response) (*ListMonitoringInstancesResponse, error) {
+// ParseGetDatabaseClusterPitrResponse parses an HTTP response from a GetDatabaseClusterPitrWithResponse call
+func ParseGetDatabaseClusterPitrResponse(rsp *http.Response) (*GetDatabaseClusterPitrResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListMonitoringInstancesResponse{
+	response := &GetDatabaseClusterPitrResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MonitoringInstancesList
+		var dest DatabaseClusterPitr
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4892,27 +14754,20 @@ func ParseListMonitoringInstancesResponse(rsp *http.Response) (*ListMonitoringIn
 	return response, nil
 }
 
-// ParseCreateMonitoringInstanceResponse parses an HTTP response from a CreateMonitoringInstanceWithResponse call
-func ParseCreateMonitoringInstanceResponse(rsp *http.Response) (*CreateMonitoringInstanceResponse, error) {
+// ParseDeleteDatabaseClusterPowerScheduleResponse parses an HTTP response from a DeleteDatabaseClusterPowerScheduleWithResponse call
+func ParseDeleteDatabaseClusterPowerScheduleResponse(rsp *http.Response) (*DeleteDatabaseClusterPowerScheduleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateMonitoringInstanceResponse{
+	response := &DeleteDatabaseClusterPowerScheduleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MonitoringInstance
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4932,20 +14787,27 @@ func ParseCreateMonitoringInstanceResponse(rsp *http.Response) (*CreateMonitorin
 	return response, nil
 }
 
-// ParseDeleteMonitoringInstanceResponse parses an HTTP response from a DeleteMonitoringInstanceWithResponse call
-func ParseDeleteMonitoringInstanceResponse(rsp *http.Response) (*DeleteMonitoringInstanceResponse, error) {
+// ParseGetDatabaseClusterPowerScheduleResponse parses an HTTP response from a GetDatabaseClusterPowerScheduleWithResponse call
+func ParseGetDatabaseClusterPowerScheduleResponse(rsp *http.Response) (*GetDatabaseClusterPowerScheduleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteMonitoringInstanceResponse{
+	response := &GetDatabaseClusterPowerScheduleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PowerSchedule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4972,22 +14834,22 @@ func ParseDeleteMonitoringInstanceResponse(rsp *http.Response) (*DeleteMonitorin
 	return response, nil
 }
 
-// ParseGetMonitoringInstanceResponse parses an HTTP response from a GetMonitoringInstanceWithResponse call
-func ParseGetMonitoringInstanceResponse(rsp *http.Response) (*GetMonitoringInstanceResponse, error) {
+// ParseUpdateDatabaseClusterPowerScheduleResponse parses an HTTP response from a UpdateDatabaseClusterPowerScheduleWithResponse call
+func ParseUpdateDatabaseClusterPowerScheduleResponse(rsp *http.Response) (*UpdateDatabaseClusterPowerScheduleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetMonitoringInstanceResponse{
+	response := &UpdateDatabaseClusterPowerScheduleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MonitoringInstance
+		var dest PowerSchedule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5019,34 +14881,27 @@ func ParseGetMonitoringInstanceResponse(rsp *http.Response) (*GetMonitoringInsta
 	return response, nil
 }
 
-// ParseUpdateMonitoringInstanceResponse parses an HTTP response from a UpdateMonitoringInstanceWithResponse call
-func ParseUpdateMonitoringInstanceResponse(rsp *http.Response) (*UpdateMonitoringInstanceResponse, error) {
+// ParseGetDatabaseClusterPreRestoreBackupResponse parses an HTTP response from a GetDatabaseClusterPreRestoreBackupWithResponse call
+func ParseGetDatabaseClusterPreRestoreBackupResponse(rsp *http.Response) (*GetDatabaseClusterPreRestoreBackupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateMonitoringInstanceResponse{
+	response := &GetDatabaseClusterPreRestoreBackupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MonitoringInstance
+		var dest PreRestoreBackup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5066,72 +14921,80 @@ func ParseUpdateMonitoringInstanceResponse(rsp *http.Response) (*UpdateMonitorin
 	return response, nil
 }
 
-// ParseListNamespacesResponse parses an HTTP response from a ListNamespacesWithResponse call
-func ParseListNamespacesResponse(rsp *http.Response) (*ListNamespacesResponse, error) {
+// ParseListDatabaseClusterRestoresResponse parses an HTTP response from a ListDatabaseClusterRestoresWithResponse call
+func ParseListDatabaseClusterRestoresResponse(rsp *http.Response) (*ListDatabaseClusterRestoresResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListNamespacesResponse{
+	response := &ListDatabaseClusterRestoresResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NamespaceList
+		var dest DatabaseClusterRestoreList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseCreateDatabaseClusterBackupResponse parses an HTTP response from a CreateDatabaseClusterBackupWithResponse call
-func ParseCreateDatabaseClusterBackupResponse(rsp *http.Response) (*CreateDatabaseClusterBackupResponse, error) {
+// ParseResumeDatabaseClusterResponse parses an HTTP response from a ResumeDatabaseClusterWithResponse call
+func ParseResumeDatabaseClusterResponse(rsp *http.Response) (*ResumeDatabaseClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDatabaseClusterBackupResponse{
+	response := &ResumeDatabaseClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterBackup
+		var dest DatabaseCluster
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest DatabaseClusterBackup
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest DatabaseClusterBackup
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON202 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -5145,22 +15008,22 @@ func ParseCreateDatabaseClusterBackupResponse(rsp *http.Response) (*CreateDataba
 	return response, nil
 }
 
-// ParseDeleteDatabaseClusterBackupResponse parses an HTTP response from a DeleteDatabaseClusterBackupWithResponse call
-func ParseDeleteDatabaseClusterBackupResponse(rsp *http.Response) (*DeleteDatabaseClusterBackupResponse, error) {
+// ParseListDatabaseEnginesResponse parses an HTTP response from a ListDatabaseEnginesWithResponse call
+func ParseListDatabaseEnginesResponse(rsp *http.Response) (*ListDatabaseEnginesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDatabaseClusterBackupResponse{
+	response := &ListDatabaseEnginesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
+		var dest DatabaseEngineList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5185,22 +15048,22 @@ func ParseDeleteDatabaseClusterBackupResponse(rsp *http.Response) (*DeleteDataba
 	return response, nil
 }
 
-// ParseGetDatabaseClusterBackupResponse parses an HTTP response from a GetDatabaseClusterBackupWithResponse call
-func ParseGetDatabaseClusterBackupResponse(rsp *http.Response) (*GetDatabaseClusterBackupResponse, error) {
+// ParseGetDatabaseEngineResponse parses an HTTP response from a GetDatabaseEngineWithResponse call
+func ParseGetDatabaseEngineResponse(rsp *http.Response) (*GetDatabaseEngineResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseClusterBackupResponse{
+	response := &GetDatabaseEngineResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterBackup
+		var dest DatabaseEngine
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5225,41 +15088,27 @@ func ParseGetDatabaseClusterBackupResponse(rsp *http.Response) (*GetDatabaseClus
 	return response, nil
 }
 
-// ParseCreateDatabaseClusterRestoreResponse parses an HTTP response from a CreateDatabaseClusterRestoreWithResponse call
-func ParseCreateDatabaseClusterRestoreResponse(rsp *http.Response) (*CreateDatabaseClusterRestoreResponse, error) {
+// ParseUpdateDatabaseEngineResponse parses an HTTP response from a UpdateDatabaseEngineWithResponse call
+func ParseUpdateDatabaseEngineResponse(rsp *http.Response) (*UpdateDatabaseEngineResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDatabaseClusterRestoreResponse{
+	response := &UpdateDatabaseEngineResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterRestore
+		var dest DatabaseEngine
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest DatabaseClusterRestore
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest DatabaseClusterRestore
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON202 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5279,22 +15128,22 @@ func ParseCreateDatabaseClusterRestoreResponse(rsp *http.Response) (*CreateDatab
 	return response, nil
 }
 
-// ParseDeleteDatabaseClusterRestoreResponse parses an HTTP response from a DeleteDatabaseClusterRestoreWithResponse call
-func ParseDeleteDatabaseClusterRestoreResponse(rsp *http.Response) (*DeleteDatabaseClusterRestoreResponse, error) {
+// ParseGetNamespaceDeletionProtectionResponse parses an HTTP response from a GetNamespaceDeletionProtectionWithResponse call
+func ParseGetNamespaceDeletionProtectionResponse(rsp *http.Response) (*GetNamespaceDeletionProtectionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDatabaseClusterRestoreResponse{
+	response := &GetNamespaceDeletionProtectionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
+		var dest DeletionProtection
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5319,22 +15168,22 @@ func ParseDeleteDatabaseClusterRestoreResponse(rsp *http.Response) (*DeleteDatab
 	return response, nil
 }
 
-// ParseGetDatabaseClusterRestoreResponse parses an HTTP response from a GetDatabaseClusterRestoreWithResponse call
-func ParseGetDatabaseClusterRestoreResponse(rsp *http.Response) (*GetDatabaseClusterRestoreResponse, error) {
+// ParseUpdateNamespaceDeletionProtectionResponse parses an HTTP response from a UpdateNamespaceDeletionProtectionWithResponse call
+func ParseUpdateNamespaceDeletionProtectionResponse(rsp *http.Response) (*UpdateNamespaceDeletionProtectionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseClusterRestoreResponse{
+	response := &UpdateNamespaceDeletionProtectionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterRestore
+		var dest DeletionProtection
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5359,34 +15208,27 @@ func ParseGetDatabaseClusterRestoreResponse(rsp *http.Response) (*GetDatabaseClu
 	return response, nil
 }
 
-// ParseUpdateDatabaseClusterRestoreResponse parses an HTTP response from a UpdateDatabaseClusterRestoreWithResponse call
-func ParseUpdateDatabaseClusterRestoreResponse(rsp *http.Response) (*UpdateDatabaseClusterRestoreResponse, error) {
+// ParseGetNamespacePreRestoreBackupResponse parses an HTTP response from a GetNamespacePreRestoreBackupWithResponse call
+func ParseGetNamespacePreRestoreBackupResponse(rsp *http.Response) (*GetNamespacePreRestoreBackupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDatabaseClusterRestoreResponse{
+	response := &GetNamespacePreRestoreBackupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterRestore
+		var dest PreRestoreBackupSettings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest DatabaseClusterRestore
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5406,22 +15248,22 @@ func ParseUpdateDatabaseClusterRestoreResponse(rsp *http.Response) (*UpdateDatab
 	return response, nil
 }
 
-// ParseListDatabaseClustersResponse parses an HTTP response from a ListDatabaseClustersWithResponse call
-func ParseListDatabaseClustersResponse(rsp *http.Response) (*ListDatabaseClustersResponse, error) {
+// ParseUpdateNamespacePreRestoreBackupResponse parses an HTTP response from a UpdateNamespacePreRestoreBackupWithResponse call
+func ParseUpdateNamespacePreRestoreBackupResponse(rsp *http.Response) (*UpdateNamespacePreRestoreBackupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDatabaseClustersResponse{
+	response := &UpdateNamespacePreRestoreBackupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterList
+		var dest PreRestoreBackupSettings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5446,41 +15288,20 @@ func ParseListDatabaseClustersResponse(rsp *http.Response) (*ListDatabaseCluster
 	return response, nil
 }
 
-// ParseCreateDatabaseClusterResponse parses an HTTP response from a CreateDatabaseClusterWithResponse call
-func ParseCreateDatabaseClusterResponse(rsp *http.Response) (*CreateDatabaseClusterResponse, error) {
+// ParseDeleteNamespaceQuotaResponse parses an HTTP response from a DeleteNamespaceQuotaWithResponse call
+func ParseDeleteNamespaceQuotaResponse(rsp *http.Response) (*DeleteNamespaceQuotaResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDatabaseClusterResponse{
+	response := &DeleteNamespaceQuotaResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseCluster
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest DatabaseCluster
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest DatabaseCluster
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON202 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5500,22 +15321,22 @@ func ParseCreateDatabaseClusterResponse(rsp *http.Response) (*CreateDatabaseClus
 	return response, nil
 }
 
-// ParseDeleteDatabaseClusterResponse parses an HTTP response from a DeleteDatabaseClusterWithResponse call
-func ParseDeleteDatabaseClusterResponse(rsp *http.Response) (*DeleteDatabaseClusterResponse, error) {
+// ParseGetNamespaceQuotaResponse parses an HTTP response from a GetNamespaceQuotaWithResponse call
+func ParseGetNamespaceQuotaResponse(rsp *http.Response) (*GetNamespaceQuotaResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDatabaseClusterResponse{
+	response := &GetNamespaceQuotaResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
+		var dest NamespaceQuotaUsage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5540,22 +15361,22 @@ func ParseDeleteDatabaseClusterResponse(rsp *http.Response) (*DeleteDatabaseClus
 	return response, nil
 }
 
-// ParseGetDatabaseClusterResponse parses an HTTP response from a GetDatabaseClusterWithResponse call
-func ParseGetDatabaseClusterResponse(rsp *http.Response) (*GetDatabaseClusterResponse, error) {
+// ParseUpdateNamespaceQuotaResponse parses an HTTP response from a UpdateNamespaceQuotaWithResponse call
+func ParseUpdateNamespaceQuotaResponse(rsp *http.Response) (*UpdateNamespaceQuotaResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseClusterResponse{
+	response := &UpdateNamespaceQuotaResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseCluster
+		var dest NamespaceQuota
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5580,33 +15401,33 @@ func ParseGetDatabaseClusterResponse(rsp *http.Response) (*GetDatabaseClusterRes
 	return response, nil
 }
 
-// ParseUpdateDatabaseClusterResponse parses an HTTP response from a UpdateDatabaseClusterWithResponse call
-func ParseUpdateDatabaseClusterResponse(rsp *http.Response) (*UpdateDatabaseClusterResponse, error) {
+// ParseGetPriceTableResponse parses an HTTP response from a GetPriceTableWithResponse call
+func ParseGetPriceTableResponse(rsp *http.Response) (*GetPriceTableResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDatabaseClusterResponse{
+	response := &GetPriceTableResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseCluster
+		var dest PriceTable
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -5620,22 +15441,22 @@ func ParseUpdateDatabaseClusterResponse(rsp *http.Response) (*UpdateDatabaseClus
 	return response, nil
 }
 
-// ParseListDatabaseClusterBackupsResponse parses an HTTP response from a ListDatabaseClusterBackupsWithResponse call
-func ParseListDatabaseClusterBackupsResponse(rsp *http.Response) (*ListDatabaseClusterBackupsResponse, error) {
+// ParseUpdatePriceTableResponse parses an HTTP response from a UpdatePriceTableWithResponse call
+func ParseUpdatePriceTableResponse(rsp *http.Response) (*UpdatePriceTableResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDatabaseClusterBackupsResponse{
+	response := &UpdatePriceTableResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterBackupList
+		var dest PriceTable
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5660,34 +15481,27 @@ func ParseListDatabaseClusterBackupsResponse(rsp *http.Response) (*ListDatabaseC
 	return response, nil
 }
 
-// ParseGetDatabaseClusterCredentialsResponse parses an HTTP response from a GetDatabaseClusterCredentialsWithResponse call
-func ParseGetDatabaseClusterCredentialsResponse(rsp *http.Response) (*GetDatabaseClusterCredentialsResponse, error) {
+// ParseListNamespaceQuotasResponse parses an HTTP response from a ListNamespaceQuotasWithResponse call
+func ParseListNamespaceQuotasResponse(rsp *http.Response) (*ListNamespaceQuotasResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseClusterCredentialsResponse{
+	response := &ListNamespaceQuotasResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterCredential
+		var dest NamespaceQuotaUsageList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5700,22 +15514,22 @@ func ParseGetDatabaseClusterCredentialsResponse(rsp *http.Response) (*GetDatabas
 	return response, nil
 }
 
-// ParseGetDatabaseClusterPitrResponse parses an HTTP response from a GetDatabaseClusterPitrWithResponse call
-func ParseGetDatabaseClusterPitrResponse(rsp *http.Response) (*GetDatabaseClusterPitrResponse, error) {
+// ParseGetKubernetesClusterResourcesResponse parses an HTTP response from a GetKubernetesClusterResourcesWithResponse call
+func ParseGetKubernetesClusterResourcesResponse(rsp *http.Response) (*GetKubernetesClusterResourcesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseClusterPitrResponse{
+	response := &GetKubernetesClusterResourcesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterPitr
+		var dest KubernetesClusterResources
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5740,22 +15554,22 @@ func ParseGetDatabaseClusterPitrResponse(rsp *http.Response) (*GetDatabaseCluste
 	return response, nil
 }
 
-// ParseListDatabaseClusterRestoresResponse parses an HTTP response from a ListDatabaseClusterRestoresWithResponse call
-func ParseListDatabaseClusterRestoresResponse(rsp *http.Response) (*ListDatabaseClusterRestoresResponse, error) {
+// ParseListDatabaseClusterTemplatesResponse parses an HTTP response from a ListDatabaseClusterTemplatesWithResponse call
+func ParseListDatabaseClusterTemplatesResponse(rsp *http.Response) (*ListDatabaseClusterTemplatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDatabaseClusterRestoresResponse{
+	response := &ListDatabaseClusterTemplatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterRestoreList
+		var dest DatabaseClusterTemplateList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5780,26 +15594,26 @@ func ParseListDatabaseClusterRestoresResponse(rsp *http.Response) (*ListDatabase
 	return response, nil
 }
 
-// ParseListDatabaseEnginesResponse parses an HTTP response from a ListDatabaseEnginesWithResponse call
-func ParseListDatabaseEnginesResponse(rsp *http.Response) (*ListDatabaseEnginesResponse, error) {
+// ParseCreateDatabaseClusterTemplateResponse parses an HTTP response from a CreateDatabaseClusterTemplateWithResponse call
+func ParseCreateDatabaseClusterTemplateResponse(rsp *http.Response) (*CreateDatabaseClusterTemplateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDatabaseEnginesResponse{
+	response := &CreateDatabaseClusterTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseEngineList
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseClusterTemplate
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
@@ -5808,6 +15622,13 @@ func ParseListDatabaseEnginesResponse(rsp *http.Response) (*ListDatabaseEnginesR
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5820,33 +15641,33 @@ func ParseListDatabaseEnginesResponse(rsp *http.Response) (*ListDatabaseEnginesR
 	return response, nil
 }
 
-// ParseGetDatabaseEngineResponse parses an HTTP response from a GetDatabaseEngineWithResponse call
-func ParseGetDatabaseEngineResponse(rsp *http.Response) (*GetDatabaseEngineResponse, error) {
+// ParseDeleteDatabaseClusterTemplateResponse parses an HTTP response from a DeleteDatabaseClusterTemplateWithResponse call
+func ParseDeleteDatabaseClusterTemplateResponse(rsp *http.Response) (*DeleteDatabaseClusterTemplateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseEngineResponse{
+	response := &DeleteDatabaseClusterTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseEngine
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -5860,22 +15681,22 @@ func ParseGetDatabaseEngineResponse(rsp *http.Response) (*GetDatabaseEngineRespo
 	return response, nil
 }
 
-// ParseUpdateDatabaseEngineResponse parses an HTTP response from a UpdateDatabaseEngineWithResponse call
-func ParseUpdateDatabaseEngineResponse(rsp *http.Response) (*UpdateDatabaseEngineResponse, error) {
+// ParseGetDatabaseClusterTemplateResponse parses an HTTP response from a GetDatabaseClusterTemplateWithResponse call
+func ParseGetDatabaseClusterTemplateResponse(rsp *http.Response) (*GetDatabaseClusterTemplateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDatabaseEngineResponse{
+	response := &GetDatabaseClusterTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseEngine
+		var dest DatabaseClusterTemplate
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5888,6 +15709,13 @@ func ParseUpdateDatabaseEngineResponse(rsp *http.Response) (*UpdateDatabaseEngin
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5900,22 +15728,22 @@ func ParseUpdateDatabaseEngineResponse(rsp *http.Response) (*UpdateDatabaseEngin
 	return response, nil
 }
 
-// ParseGetKubernetesClusterResourcesResponse parses an HTTP response from a GetKubernetesClusterResourcesWithResponse call
-func ParseGetKubernetesClusterResourcesResponse(rsp *http.Response) (*GetKubernetesClusterResourcesResponse, error) {
+// ParseUpdateDatabaseClusterTemplateResponse parses an HTTP response from a UpdateDatabaseClusterTemplateWithResponse call
+func ParseUpdateDatabaseClusterTemplateResponse(rsp *http.Response) (*UpdateDatabaseClusterTemplateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetKubernetesClusterResourcesResponse{
+	response := &UpdateDatabaseClusterTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest KubernetesClusterResources
+		var dest DatabaseClusterTemplate
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5928,6 +15756,13 @@ func ParseGetKubernetesClusterResourcesResponse(rsp *http.Response) (*GetKuberne
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5967,139 +15802,318 @@ func ParseVersionInfoResponse(rsp *http.Response) (*VersionInfoResponse, error)
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-	"H4sIAAAAAAAC/+x9e3PbNvboV8Gov5kmXYm2k3Zn1//s2I639W3demxn79yNcxuIhCSsSYAFQNlKmu/+",
-	"GxwAfIIS9bBjb/hPYpEgHud9Dg4OPg1CnqScEabk4PDTQIYzkmD48xiHt1l6pbjAU6If4CiiinKG4wvB",
-	"UyIUJXJwOMGxJMNBRGQoaKrfDw7tt0iajxFlEy4SDC+Hg7T09acBjmN+R6JfcUJkikPzsNrbL1QqxCeI",
-	"5W2Q/QopjjJJkJpRicaVQQfDAVUkge7UIiWDw4FUgrLp4PPQPcBC4IX+Pc7CW6L0HLzNK9PxvGdtHwoy",
-	"9X4zHNyPpnykH47kLU1HPDWQHaWcMkXE4FCJjOQz/TQgLEsGh+8G8vVgOMAfM0EG74fNATMReyYCM/kj",
-	"o4JEug+YbmXRtqehBxvFKHz8HxIqPUqFNKRGjx40B/f/CDIZHA6+2Stoa88S1l6VqjyoOBEEK1JpdoEF",
-	"Nj1vToKp7oMoImSTAsOQSPkzWXhR+ATpszr69YygMOZZlK/VtN4LOVOYMiIQK+F4E7quDniklyRQRCaU",
-	"ET2qbg5jaCCoGSnxPfx88+uVeW2kAJoplcrDvb3bbEwEI4rIgPK9iIdSzzkkqZJ7fE7EnJK7vTsubimb",
-	"ju6omo0MCco9gPTeNxGToxiPSTyCB4PhgNzjJI0BdndyFJG5b9nbc6UkoSCqjWQei2cLwi3PaE1efoMV",
-	"HmNJTuJMwhLr6K41QFQCUq+AoTVK4WdkW4WmlURHF2dBk9VS+i8ipIV+jawuzuw7S1pmnLl5pgnNjAg0",
-	"RiUSJBVEEqZAr+jHmCGzrgBdEaE/RHLGszhCIWdzIhQSJORTRj/mvUnNoXqYGCsiFQI0MxyjOY4zMkSY",
-	"RSjBCySI7hdlrNQDNJEBOufCqLjDnLKnVAW3fwOyDnmSZIyqBfCjoONMcSH3IjIn8Z6k0xEW4YwqEqpM",
-	"kD2c0hFMlulFySCJvhFE8kyEQN4N2rmlLGqC8mfKIo0n7JgTplpATD/Si748vbpGrn8DVQPAoqksYKnh",
-	"QNmECNNyIngCvRAWAX/AjzCmhCkks3FClUbSHxmRSoM5QCeYMa7QmKAsjbAiUYDOGDrBCYlPsCQPDkkN",
-	"PTnSIPPCMiEKazIuMWPBJjIl4UreuEpJWCHeiEjNwEgqrEA61j4I/ObQWybxhJxwNqHTTGDl55eWlmhC",
-	"SRxpGQ3qhzCZCY1cbBAEsjvEDIWgaDVrFN9KlLEJVcDVqeBRFkKPmSRBAbEx5zHBDPQSqLTm3KzytaLC",
-	"Kb6UhHRCQ78dSBgex8RDzKfmhaHnSYynZlX6oe1ZeueWUuWRZhdn15duXpWlO91lSFlrLpoQEBhzIhaN",
-	"6Y7LBopfMR/Xm7hxy6qy0gjdzQjgiiA3TwcWD71uBDHdrxdcWRpzHJ1p+TfH8ZWP2t/WmyCWJWMi9Fok",
-	"CTmLJBoTdUeI0ftjymI+lch0XcKSlrJTIhpKzq3Ip6e0vI6y2Gd/XblXZsWxNccc2eUfliwuL6ZswzrZ",
-	"uscVcgkeiSJOLg3rlqWKM69invPSbogDOrfL9RKJ3yBsW0mzq7INpoxkPuEp9SH1stog7z+nOIue0LxW",
-	"HAmizd3BcGDMTENnr195yK6gpnZiyoWE4GzJSmoU3CSCAhVDZ8TlvfnovGr6r8EgWnVdgSb36ynzLick",
-	"DCYbsrpfC/wx50oqgVNtHmDEyB2y1lwbrbeMdlx6W2cma2pobGkyJmBGPBIvgUqElRq1EfgIM8Vq5lEb",
-	"WM3cALqFMxvtsiY0JnsRFSRUXCyCjcgEBvYidmytBbMaPzjeHDca+QDy5tjh1E29iYomSFZqUlCaI8pG",
-	"FaVZlZgNJGsT0Euq+czfXp9oKrX0Ap2CIaldXu38pMogNMHqEN0MXu3v/3W0fzDaf3V98MPh/veH+z/8",
-	"+2bgxbJz0SIywVmsZQXMph5EuF6k+WT0JxqMbnUBsLbx8OzHxonwOHmfG2j97EE0YVPKiE9k6+duHs7T",
-	"Qqb5CrPKoKDZpzEZXZ+2qzq+PFI7jWmIveLavGnKadt3/qlHPieU0URD8sAnqwsHyDOqfYWwtZtybyam",
-	"4IBodic4nNWmEaCzCdLOiCRq2PhId6Zf0iTlEiR3DahpBrY6W/w2GRy++9ScdMOdf18nrZOLtw5W+s98",
-	"ClZMJBCRBamgPdLB4eD/v7i5+cufo5f/ePHi3f7o7+//8uLmJoC/vnv5j5d/5r/+8vLlixfvfj7/8fri",
-	"9D19+ec7liW35tefL96R0/fd+3n58h//A1GRIlIz0ozOxciuywVEEpJwsdgaKOfQjYOL6fR5g8bH57KI",
-	"qddsDxc3q3ClM/eWS9MwxtLDISf6sesw7wke2tiki+CkREgqtdGF5jzOEmhGvQpB0o9ka1xf0Y/5SnWH",
-	"uQPWOo/ngvCypgdQtdt5n5YoHIt+G81zqia9DzUouFRTQeQfsf4hk2jsDy1KIq4gMij9ZsPbagOvFQ+v",
-	"kY0mu9ARhBHMK28wZd4W5nMxvuoiXfNVhlMRPId2PsAmnFHFDUbqg5/n73IZUzxZzl9FQ6M6/fA897Sq",
-	"AxWjel/o5DLwq9sOms8Z9FUlZsM5jrmLEQOf5KCJX3TQRII7XSxAGhPIDj7MdwEoA0MkcK/Mx0PjvGp9",
-	"Csb3eGFih/nWRIBuGLrWj7Q/yhCO0xm2ESzMIifybRzEEd+bBcMJDR0MjmLnCaMJwSoTBE2xIkXfpj89",
-	"SJJkSrtQATpTEAXjLF6gsaZyE/XKZwbeQUu84LK8SCTIhAjCNC440xSttApj6IJHVxokldayCf8lTnWS",
-	"SYUSrMJZhYIqw6Q8Cjygd+x7waM8rFQGhcYHQCHBtxBXwKogITzHNIaQAGWSRgThEspWMiksaKVvW5Ol",
-	"msxGCU5Ht2Qhy700W9luEpzqTo3N1r47uLaaeiYmV30PEixX83BsA0UJvtd2NcIJzxjExEKepJkqzOR8",
-	"p9IbfF+2QVeRlnsJZnhKRnm3o4KP9gYeSnD7Al872i7d/kgNccYhWoo4x3HgyuT9UIl4QpV1jMt8O0QU",
-	"woja3wXjz5IMnRjmpxKRe+0cURUvnFdJoiHiakbEHZXghmOmvaIYjHBA/chpANhjCoqZhGa3h9yHhER2",
-	"sEelsm5Od4q1JPRFfEBjVcKkUvHU7nK5uJhn30Hw+4WnP/04j5fAj4rnXvVItSpMtZoQFCtve3RH41hr",
-	"LpymMbXo1n1P6Zwwa1cF6EhTTmL2cFCIrb0vibKbgGWVoDhQi+CxMc3u7V6o2Wd2Ia88/hC27WF1izmY",
-	"Na0MOZB77YN7giLwvNqZabvCkKM2MnmJ2dRnWZ1dlN+7AdymwtmFi2EK8/7FydmbS404GO0l8IgWqQ5q",
-	"E8GTKm4VaGMqEeNlW63d3KjMqLQ1qyeDo0gQKfVEGapMBXGB7qia8UxBNFclWN4uCYYV2SbN4JjbFl8a",
-	"ILPQ118PwbYak2I/nYucnkrOTKnf/G2X6NlmkShDJF86EFWZRR+H6uNQXywOtToEYWi1FoFIOJtyvfAZ",
-	"NrrO6jwbjJiOecZCIrqGwav7WxAB9+7/KqwyuToFA5pVtkv5WBIxXy8LI1R0Tq7a4nRH5df14JoxG1i+",
-	"z/ICwjPgaL70Sd8Zl8rvAv5k37gRXMtSmoAbxIpboSWMP1sgIVJ6F3NuXhj7TwlcztZFeKzVh9fkKe0P",
-	"caE8Bg8XqtgfEqrLrDvs3AqCo4VPAONo0RT50Fq7yLLjvrCNbLaHKhVXOC4rle59t1CwJdmcjOCX9d5b",
-	"od7NuK0R+nFLuo63WbdEP7uV2qf79el+X126n80uWDfpz3wWPKWkhzzFYEVyQXlILuiUat6pO4Qwmc1y",
-	"IKrz2MIMcDBY3xhow07IkzQmyhcqOHGvch1BjZI2aXD/4WN0hyXKewjK+kJzBqRN+PBicjR9Q5oX5QGl",
-	"wknqaCBLpRIEJxbr30qT7mkT17oNHhGpKGvJPn1TvHSTmGRx7EmO8RLcFKceJP6IU4lopHl4AjlfE+ss",
-	"YwjppxqVmuGNgZWnScZ86k8BBRz7FW5Oxg79+XkRrDoQL8z//eY62J2Z6UDEcLrE7I5YaQ3hOhv6qkYn",
-	"jBtOJYj8Bl+WJECvpx9UT+eBnE5novxWmicw06v/R1H/Hbj4RBAQUzhu4qPwxC18G/yWYinvuABcFieW",
-	"BOdq0LKJ7xzEVa07TL2T6NmZ0OmlzROXNr2cecpy5sKbetuSbitIDEYhDNc4X4NFTIlUb6xJVEiSV/uv",
-	"Xo8OXo1eH1y/en34w98Pf/j7vzsbiX5DjrKIhpqZqiZcSpUAa61mzOGJcvi3WcnaXlb4ljCvXWf4tJoO",
-	"3ZiZabTT5XZA2KXJpV4pYG27bkEWm6DdR1n6KMvXF2WxnLJ2mMV+F/jOHWx3UMaw4/JjYP3RmP5oTH80",
-	"ZmdHY9YKUJalRDkmWULoajosSYkdxiWdMNsgMNkqzyqRyW5WW2kzsJnc7w+alWZeyUHJp1uTirvYr7Jj",
-	"dvJYS213Ey1zRldvcD1tB9ZZ3L0f+xT92NOWM43V9yvcIJMW0rs/vfvzFbk/hjPA7TFg13+ZnO7aEeCg",
-	"raiepf2qaF0j/bN5CBmsPqkwi4qzRTJLUy5c4Kk0LxmgSzqdKcT4HaLqW2lO26T3IfAA5EUF6Cd+R+Y2",
-	"Pd0mBKVyiNIpNMJsYRLQrX+02nBrPRi2ykSzAF/HNDttg787P1PGgPccnDagRFbhjtLpm7lrxCeNc9+F",
-	"ZmxzQpcdrmjuYENfhaFUzgKztlLrDIIcIOi09sqhtPbtsHhgcgw1LXEeS0QTU65PzTyWrqCKhrhcLq0U",
-	"FYQvf8Jy5qVyeHthPViv0ZtJfxm2tmNMvoP7PbgfAdz5CYvWk0M9Fh4eC80Heik9Wp4WWnxN9DKw4qJk",
-	"Ni+ZhM8MaI8CWHRQhjC6/ZssHxLaKiJgxl0eCSjabBcBcNZL72o8Tcff+pS9w/+UHP5TIbgnFA6PNVBT",
-	"ziRpVlVoDUT6xvg5z6yxsZ8zNuFLE3BcME9D0VP4AF5eW3/HIwNhEwJKqEAV3cpG8rvBNH01GA6m6Wvt",
-	"bnT1r2reSnkOvhHfdwHDZfvBNA8syhzZ4rZAklL9qNk5jWNaXqI5BFEumzw4HGSUqb9+DzF7Km+v7HmK",
-	"bl+Yg1bHC0U6D9Mgk3IRZ5M1VRzOO8rX93k4CHGKQ6oW/6VrPXHLa1CcezEs4dtHZkUtkzOmXX6zn4nj",
-	"2J6rWyaqm98eY0n+L1Uz2EHxnLgrVWaxX9SuDWj4+KZytq/Ota20+N67CD2R5ZVh/OM/1LUFSXPktWrD",
-	"16uNp0nS3GbrXtrcViNPKPuFsKk2UQ827exzJ6KqEMaWBAaHO7tUV3nKNewfBvQbcFwH5JlzCKULGnYi",
-	"HYbrfn5xft5xhbbq9cOIFj2NhjbR/Nh4iFNqbw/YBbaHlYzijTlfmvL/O6I4j3K6OD9vAu0qJeGgo6x4",
-	"C4bybsjtQcnMWPQVMvMuaL3LUzwa2aMQcmpt9L1Slxj49pevPIXLV9puKVl5/UjrfSINBmsNZpzOiSBS",
-	"ueiF332aZHF8wpOEqm2kTiq4no4/jbd7N/O2WNYa8qusBsrTKnoflhfts5kpB/ccpzTB4UzjfxGkt1P9",
-	"QAbaow7mB4Em2XNiPOsmMes3pXJQzg03USy5YGpGFA1LhaCgSNwMz8kQURbGWaQlkanah1mE5lhQnsn8",
-	"YLkxPwJ0VIQ6EryADsz+HGdgBH36DVrq6QyRm9hnb7UfRVnmYQ73Bvq3NfbopFw+UkG5/oQqxFnt5D7w",
-	"JxJEZYKRyISyirTy/DoL2JkTaIa1IS1MbLfYQDP5cCbcQyXiKf4jI3lUbEzyWzKolPDCbDXaMI0LrpUi",
-	"OhoFZi8QpArEEU3peUHJ3FQ3YOQe6rlATC/fysvhfmKgYoqsh5y5YqfQl56WDQqlXEoKJfgm5ZVWL63Q",
-	"6w5nmE1JhLgwIFAzzBBGE3KHEsoyDS5ArrYRSGRA4lDvQpamBpSDtjm7msm8RFSOSQNKV3rKHNUMcewg",
-	"ZSFtcDmhQqo89DNEGYuJlGjBMzMfQUJCc1AqfkuYiaJhhgiEjWx0qKU2ZmLKkZ4pkpzwjHkCw802zQoR",
-	"MhtLjW79DkjOzh7QcTej4awowwPc5Q6fOvS7BUIpn/xLR0JOD0QIjHyNJANrSWJIiYUamYQ16lbYmbtJ",
-	"SZSxW8bvGFCvAa/uxqEiJhOFMgYsxaK8BlyUgV0iiaA4ph+LSmP5RGlxMBm9IBTof0xCrDUnVaZMlULh",
-	"LGPahdHzd2+VLdtp4s/SNnpZrMee+mDc0GV9TWYheQGyjVbigrE8jiAQixmaHwQHP6CIu7JKpTEM7Wup",
-	"zzQa9SKst+enlO+IVFSbb2z6XaVOsWbcWOMPJnECQd48Wq/HFQQEaVvfijt5CHtN+ge5x6EKapVE/vr9",
-	"YFkhqlb9fWX8WVPXrzhPXYiRb2Vpr8DKALczUdk1MTtmrnhraFeqOBzeEQll9qC7FW+Gs61ECtC/QB6A",
-	"ghoTpOyhdZxL4lKXkBECEgplLOERFB8Es80JFzPzAF3wNDMHnaCcLEFyIRVJAnRJcDTSKuzBQ+faxc+E",
-	"ICxcjGzJvBFm0SgX5+HCWzebxJNfKLttIsy9MdsUby9/qe9O5HjptP4bdsPenF5cnp4cXZ++QUXA2HAZ",
-	"1DHUWhxPcaMOIEMHwat9TcEES1ITN1SiNMaMGa0JtXsSPifuswP3WdDt8FQnc8lk5JxomdNWPAde6hXN",
-	"aUSsJdAsYwRFFantD00wjTNRMZpCLDWIND0nWaxoGhOjiUwpNsJCzb1EmBIONWtYw8fvIBjQ5ZIm31/C",
-	"yuhvU2kScACjDTWHaLcBMEyVRP/n6rdf66LvHPafQCOhiBthmXKpJvS+qAE44QIxIoHrlKF0om0/7duY",
-	"RX0kgo8oi8i9Zlj0Tz1Xs7mF05Tgsk3BTYgI4Kg7gLqkevISRRlk10/M1zM81+CswTBAv1nTG+jz1Gyk",
-	"yMMbhtANhFVuBmhUIrb8oRWkhuWKMsXmQ1Am7/bfBx16MCaJmXxeQNl2cTNYq2zWEZplCWYjQXAEBl7p",
-	"dV69CZdUDAAhQOWK1NYItYwOknFk6nBiqFzl3TeHEljSuwWNLBetPakzK/pzS5kkqVpUKlVW2Cm3r3fO",
-	"5m+IwjSWv89ftfG6bWE3dK2ZnYcmUMGVhsPOj/6f07VOXBpDWnEnMMqfe6RGycLT3HwJ0C+YGqOrsmeV",
-	"7/7fQeXznOly+0YSVZgMoBrplMEZI8M85rovY74Upb9dpNodtYFSknnvxj2y9geWMkusfMFsUbRy9AbI",
-	"1XJvjmMaDbUNkrGoCId7fDzgcr90OzESwDCVFUjOGbOowlLykILKuqPKLMgAzQHTyOIA/aoFWRxX3hpp",
-	"5HBl+iSRlTyVKu3LYmdrqxpPJGgquK98moYCvCqBui7tfSCwHnl5rUH3hGw9qn6zg0HRbwxJnhBkMoOo",
-	"g3lEJxMiitQG69SQqBjiZ8qiL52pwFpDc7BjszV80Iu7wqMxYoeyaWy7Nz6iSy2zcZvoZYvkVmJxNFFw",
-	"6QbXy2kW+52Ua2/n1aQoK12xOOG2kGKOL8f7YJEpQbUuv9IYteaLSVYx0ZNyYgrIH4Vvibl8ATwCRRA2",
-	"936ObI43l3lHqqq98j5n/A7FnEGZ7DtMVT5LfOvSa+rdB93KJmbUQ/xvz97UsRm0oinHdxuq6vTr39fL",
-	"JBGjaUYjspf7VEJ+k1EfVW6pBpfoP7M0E6qxChsursBxnCsP9q1yLUxEy0Wf+pS2h05pC3nkc1Oy6dRI",
-	"zp+ury8cbnRby2LUBWiHaB/RvCZ0Rx6xinaHOrBkh/V5dTvOq9vCoyhXh4WANmk9cVvN4NuaLPJNi60c",
-	"kLvZojZzuJbHeGc3g38aO/BmYBe6hWeCjpylHsZYmPgXZob9LBSB/caZFpjEhDn5nAihrUyqvBBdUcLX",
-	"IqnACvoN9lIO0c3gKoOdTu2LivJKH5wctTUBwSk7+S6J2FpZeVMrv0FHmZqZqL9+dMOO4rjMfshtHR5d",
-	"nLlC6eiD/ogLG7o4RMcECyLQTba//zqEwD/8ST6gGXi9xhrDCPwTuzNAGUpjTNlIkXsFAYRrqMas31mN",
-	"zsc21D5e2M2LD8TMJlSxbarFjfpgLQH44co667cQQxFUe2Y03/6RoSCEBTeQEkMVFBG6ICLkDOerNaxU",
-	"2ik8HBwE+8G+TbdnOKWDw8HrYD94ZetZABXtmW3pkd08hmdTotp3uc2NxCaMWt3S1ojNCe8sst9UtvIl",
-	"pLoYXxaGerW/73bwiNk/gctEDGr3/mN53K5thRCpjgSpB0BHdT0IXDDJ4oJLNIy+3+FMTCayZ/C3TLYM",
-	"/8NjDH/mLBkbgCC24XAgsyTBYtEZzwpPZaNWCuQkpdx3QMJkadk7navdOftMM9R337mY3HffQVTuw4cP",
-	"+r9P+p8iRqelmXztaPZmMHSvtRRxr0uPi/wJ89L8Pii1yJNATAPz8/db/Ttvk+c82BHgZ62NSZkwDUg2",
-	"CglTAsejg5uBbvE5X9LyteGPmSBLlwctlqwwT/5Yskjb/+84hKDy72b81uXWWhfrLlbVEAAG7RXGHORX",
-	"bx1zU0Z/JzTvGcnmDXn44LpU86hChHZLwRU9Lidk2CyPx5FeveBaX3CtFjFL5NbnYUMT7n3SDPHZyLKY",
-	"eMshwXOjol3EpJnnVWUJ802dJUr5aYfvvBcxWpHczCKDcoxYzVxi6KG75L9Ku8MSDurm1/sGXX/vcyB7",
-	"+ltGf92IoV1xeq2uH4laj7x+JOqp01YvM58MzXYgryWWHlahtxqfUBTHLgHZhQ1aRgiQyfi1lTmqTc1+",
-	"VNAgck+S8NOg893bNe350N3sGgCKDNBvbdDNdwVdqKq3ep4TB6/HbSssIHs+c+QiL0tVkjvMCfvBsPlb",
-	"vtvfXNONUfP0p09l+Y/VPiDd+Qfs6W9jDbIFNTiKvP2btHRYHEgcufMs6wWmPCca/dEpz3mYhyS7tuM3",
-	"PeHtJE7VgnZHYIkH2e0hqyNfd8W2EWhIiT5ogv9QpNMGN+wYSxK5fC/33mwTpwTubUS3ZGEizNVcekZI",
-	"JCt9XWXhDGE5RHRiujpEaZJ8sBnOH/Tf0Fn5S5unErkYdmWMoDVK4zna9TAmzYoznC12zXk7Mr5c0MZ3",
-	"HK5n5a0iN+1Mt5KT21THppGcc++5eF84x8s7nf2RlvP3X3lg53sz14cd3idVGFdowjMWPf3wkp9CV+m7",
-	"jpGmpAP5/0jUdrR//oi038v9nrG6xMCSjbiqJRxmAjgbaBbz4ZPWLI9hG1YKLrTYhskq2/CLxLZ6IfHf",
-	"IyTW4OLVNiqrFJNo1cY4jss1JRLM8NSkFtmcH29Eo1Ip5sFou1rhozNZNwTv6jXWIGZsefj7856rlzly",
-	"gUt7dxBUu1meh9JyNW2Lb+y/H3UNQZxPul38utdfXAa3XAbrF70tcPzyrnnnVbQJ4Ff7B48/GXerthXL",
-	"Zh6vHn8eR/YCpz5M4QlTtMsOJ/sjL5zfbyLLNg1erJBr5punKdeGy0ZsAT4k3WtZA7aDPU14btPP37nk",
-	"uvf5VWu+hbtMxGfggK55kKcPSu4m3rI2w7cEWy7h9I1cj2V/JKrn12fKr1tbIz1bGrbsyDm7VMTuGr5N",
-	"vAr7bTe34jJv/DX4FfmteR0dC3cR6lPzLJas4wu4Fktm87i+xZKJ9M7FOs5FIUJahJqD9GZSbVv/ok3C",
-	"eR2MpyLh1rNY3I29W5kslxXx1fsYPdN3ZqyVfL+Rl9HGuE03o+fa5+tpbGCd9NzZxdVYiz3TzMueaYzD",
-	"dfWq2YnqOfQROPR5uEB2b7t3gdZ3gSZZ3Au8ssDrJpB26Yesl9df5wh/Un+NHuTTCKg8Div2xwl2d5zA",
-	"R20ttN+l9EW9u25Bwa8kGthZBz618N8TUXrdtF28eOCoXx/u2zLct1TErKNXN43rrZRS3sDe8/I8tvM4",
-	"+hBez8jLQ3hrMXXn8xgrObMZuevZ8jnE6Hq+28WpjTWZbo2Q3ErG88bket574tG3zVyOJxBu6+XFrmJb",
-	"D21975UOJGwc5EKukw6xruO8aS97nkkWYB+xe7iIXYl1dpgRmHN3KAhcw4BjubJcUrvQQeVuVtv0J5XW",
-	"PZs/eTYvENaz+UMY+jX+2a0CT6kSK3n7glOmRpSNrmlCkCBxLozs3RpbuvEXehI9rz8DXgdM9Vy+MZdv",
-	"y0m7Zf5y5v/m5nveSwf7/bJo23P7c0mu6y34B7TgS9yzk7wTwqaUdWDp4t78fEru02V8fJq3+e9POTFr",
-	"7al/e+pfSmx1sjdgX4/cS7vB63qppodlVuqpa/EcVFa+nOeiayx0ew7bpeuYU0Erc7XsEHkLAa3iler+",
-	"0FfOLg+3r9POKU97W6fn8J2W5VqDybUGLe5SXVnxHqc4pGoB9c0LlZ13sFXF+8vSla6PV/a+GLWnvs1r",
-	"329OF83a9/PiLuRWYnR3Ph7j8JawyN2HDFd4NojNXqD80DcquDucN68Ft3RV0K3BhdGWmYgHh4O9+cFA",
-	"qxULxzqwdJcLNaNsmseS7JWaBSeg0jEBq9g0Npr6sb0zJ8g9XdUDURt1WziVtV6d5thirqgUcvLPOT9P",
-	"s80oxca0fxBXEWWNMY7rV5vYnqs3m3x+//l/AwAA//9WYswOY/wAAA==",
+	"H4sIAAAAAAAC/+y9e3PjNpYo/lVQmls16awkuzvJ1Iz/2ep2dxLfSSce2z1zd+P8NjAJSViTAAOAtpVM",
+	"f/dfAQcAQRKUqIfdcsLarUlbxBvnhfP8bZTwvOCMMCVHJ7+NZLIgOTb/fIOT27J4LRSd4UTpX1IiE0EL",
+	"RTkbnYxuzHc04yVLEWUII/uLVFzgORmNR4XgBRGKEjNgIghWJH1txppxkWM1OhmlWJGJorlur5YFGZ2M",
+	"pBKUzUcfx/ojvsGSnGalVETAkr7HOWkvR/+K+AypBUFvY92QIDMi9MhIcdMM1rvRvLLAScfk5tNjrWD9",
+	"niUvRUKQ64cS6Nhj7A9nb9tDfzh7u83IRCrKMIzRHPI7npgvblwLLlgiSZSGIDOZwqqUukn0CGOTEjan",
+	"jFyZn5tzvjPfkO5Tn3aMBEn4nNFfSYpmgufmW4aXvFSxSdjaC7DboSz8q8KF1ohcFAvMSBoZla+CH9kF",
+	"PDecZwQzPbakv5I3S0VkDdUoU3/5smpPmSJzIkYfP45HgvxSUqEX8yNstXas9YuNA2ewoZ/8FPzmf0mi",
+	"9Irq1OQsL7gwdKBOIoJpZPtc3gZf66duzoTCoOMRVSQ33VtnnlN2Bh9f+jViIfDSXfFu+A3ble3bjpww",
+	"zDSub3n9yX1HpTk3v8f/I8hsdDL601FFyI8sFT+qdx199KP7PUOLU14s25tOeLHU+8UBaGPG1YKIdaQe",
+	"Pl/C1+/7oo4dLfyJSpTwgpIUKR5DohsN5KemRWSKMr8hwk+im1ajxbF0La5szZOa5NPNLcic6r81GeLC",
+	"NNUHvzN1NbfXmxjNKKNysRlzzomUerjWUv61WFZLmGGakTRKAAFb+t2ebbzy/qboKmiJM0FwujQfdY8F",
+	"EQRhQZC8pUVhltTjtqXCYkOhRbOwyKlc6p/D2xkjzswPomSMsvkYyTJJCElJirjoPLgGLWmjmltBuPhu",
+	"wqJx/xwLnEcIbqF/J4oIQ271mrXsgivOs1es5wAwwSfFt9h+91YvSJFRwJJzntEkQvMK87vfql4LI/dw",
+	"MVLOyswzHEMYW0itOIoRSDm9Zldw7ZRIA4UB3mPZRR0kul/QZIESzNCN7gPAfM3Wn73s4GLx05eR45dw",
+	"/t3ctMlMCMM3WQyVT93A+izt4FG5xY7wOvLcuArWpVldRhRJkaQsMQyDmUMF6jAaxzFV04MfWLYcnShR",
+	"knWQ5XYzjp3tKiBThOk1vyUJlVFSndovjasQrieyUIhveKm60W2b9xTRx7bqcBGVCFql6AYuDVYTvS8n",
+	"FbcmEgTLqAzneEM12y0p1EZg5unreiFr5Lfc48K6aELrXhqiJ5+10FdO0evIgdIZYhyJMiPolpBCIqqm",
+	"1+xrQ+j9cBqO9ZkgzrKlvgPd9C1eSmBvGVZEqjZFGtfWxICNzLi4ZnCHlKkJZQYyzNPnjohlvU8p4c4t",
+	"O3LURkKrkjkZwXWA32eUYU8VrxlmaW1QhW+Jpl0zLjz9gj3i7B4v4frr1LFxvP48LDhKzUL0GcoxSnjJ",
+	"DBkgmlUpkhmRh+BkERUNZjQz48OZuFupEeWc3wUPQtok5GNEHhK9GD3AOZdqLsjlP77TnNzRarnQI+nv",
+	"ghRcUsXFsksIjBDyTiL61iy3djQwoZ6OceUPCbOlOZ8oxgIw0SwC538npEBqBYjpXZiztbuZ0zvCEKvJ",
+	"abZvqq/2nqpFQO5zymhe5qOT45ig5aB8xboiXBjAi7LoevQies37HZZqxbwr9tleUq8J33OmFo99B7me",
+	"ZItb+Bcht4+9tntCbjdaWgdz7kPWBbmj5L5LgVlRZ026QkLTxZYpQ2Q2I4kahzuaUSFVh1gmN3yxt2WI",
+	"CBssPLvaYEDL4zQXNVq9dd0viVKUzS+hcfMW7Bh+LWO/3+57uUwWJC0z0nktjDwozYNakqrt2CF6t47e",
+	"t9/w8N0CL0omY+euOeivnMWkKM1bf7WPOgMaPMGZ3gvSndbrhfzQ42D164/yomRtVZqZu73GC7sYRzP1",
+	"9ERq8Fdu9aPxiDxgLWGPTkavjl99OTl+OTl+eXV8fGL+/z+OX54cH3cJ2S3xrVSJXkZtyC8mL19Nvnh5",
+	"9eoLGPK/e47WOC899NjutNcxyfY5RR+tER2z58qRV4sQXESO2sjAwLnsEhCViLI7nNF0lZK5/YE8KLf8",
+	"xpvOYgtA2BiRvFBLLWg2p02pNFtAXARL2A4volK5/Vy/62P0Bfpc/18vnWgA96Nx8ADz219xyQor2Wmk",
+	"kgorKhVNJNKCL8IA7feUpfweZMNQ3HRiJTACKpB5amkWYMGyDkL4jmjgeVsK0+iSJJylkcW8hnYotQ29",
+	"gaUt11jshAX201QFrKY+7Rs7pn0v1scGHpZzqZAgCWEVI9sENPThv2NKLGOQ0WHKausgQeF18ltkc5Sd",
+	"Cz4XRMr49wxLtfb83zbOXXdqH/42Z69HuvQDWbW7iilCjOLCQdLqhYwRZUlWpk4RZd4Y9iUVX+BqtSQc",
+	"yyVlCfkust5epqLxyCmMInBmFOxW3UoZIvqJ2Xo/1fcUf5eijM8laj4lY1vfCEjNCj4YnXWMgDnlaxzC",
+	"YMp3LO2vbYEulwqL3iqaBlEMRwiXEK7WI04NS4KLWkM0AW+35IteE7eJFmobxVUbt3vAaic/rdlHG2p6",
+	"+itpKeZwYCNuKhZ+yKmyyh21IO53zUOy1LzLjeIW97c3rDUeVIZzQQouAlWdvkCsuOjNba2hYBMtum1l",
+	"GF+aUr1AnJ0HsDPDmSTjKBPyp0MZnAXYkxvsNMv4PUm9zTVySdoKqk/DG1Ilsr2Q4qiUmkpQ2bZ79Vcy",
+	"3pTJLVGdcF9bzm8bSHKCzKN9xqOHyZxP9I8TbaCa8AJOdmJIJBGgtPYr/W1EmH4q/ziSX4zGI/xrKcJL",
+	"qyYsRdZbVxps2o40jtzGWtD44MyCDYqiEe7SsIee+NshWDd0yJ0YN0XBlIhKdAy8nUqUYEl2cvPotKV2",
+	"nGyw97XnJ7cw8tuu3Tb+fxJBZ9b61d7hXfC1ZvPX+kRkFBxWeTtFZwqeMaiwzAaVTNEMVTZk/V2StjZk",
+	"Y6cmRbRPBxbLft5HmwqA4a77kedHsZPXDr/bXl5gKWOK4cB4c4+lu6fUy0/+h+YZIhjRatUZVUuULEhy",
+	"G3cpwiy94Q81J7iY6Xsza3lToeT7RyaMvyS6sSmEeKvHisCEtF9iMKF/89LnsoYGsr/i3qxjuV5zaiTf",
+	"1h21NAgzRA2CaUIHiyUpWhLV+9a6XJtyzPAcZIl3d0QYNa1xmliNifbJDDKdteQEpjFvsvG82rT3QHmz",
+	"NF/GTZMamNFDBh/26jTQGItKTtl3hM3VIvTzCqA0UFU03meCM0QeCkFkaKB1HdIadBga+OHqdIouLAjr",
+	"82JtKKISVbqMvkbn1s3FAP2US/VGEHyb8vsIVbdmBpRwabTaglQa225hv467vLwJTUmgzddzJ0XZs2VO",
+	"ci6WPRvLjRahuMJZr7aNg9ar9yurZm2IwiM3Q/TwDcjX2G/lSLO9aFw53bQlY0M2/k6WUeJ7gHJzm1El",
+	"GS9Tv1dofZRwpjBlFt87XN96ydsNhZvekkApmVFGUgTNzRwes/17xPz59vtL+AzghBZKFfLk6Oi2vCGC",
+	"EUXklPKjlCdSrzkhhZJHWmOhLRlH91zcUjafaKvWBMBEHpmTPvpTyuQkwzckm5gfaip2fC8nKbkbjR/j",
+	"tSBJIojqApmnektUgBuuaMM3RsPnNiLe1RsgKs2lXhpB2XtXOv7l2dfr87NpG9UK+k8i4v47r8/P7DcL",
+	"WtLRfP2bBjSY0cAYNa90QSRhqhKvmXVTnKJLInRHJBfm+ZJwdkeECr3TYTTv9m1lCHPN2vXiDmclGRtp",
+	"L8eaxutxUcmCEUwTOUXvuYCn94mH7DlV09u/GrBOeJ6XWgo0+CjoTam4kEcpuSPZkaTzCRbJgiqSqFKQ",
+	"I1zQiVmssSvKaZ7+ybEXGQPlW8oistHfKTMvM+yQ0yy1OjGnJ7x4d3nl2RecKhxg1VRWZ6nPgbIZEdDS",
+	"q24ISw1+mD+SjBKmZbGbnCrpDGH6mKfoFDP7jiyL1Mg16IyhU5yT7BRL8ugnqU9PTvSRyfiLQmENxgEy",
+	"VmgiC5KsxY3LgiQ14E2JNBKMdIqmRodpXE3zgUk8I6eczejcPq4i+NLREs0oyVLwO1IcESZLI21iuCBD",
+	"uxPMrGyJkrCvNA5JymB1IXhaJmbEUpJpVBC+8TruKPO1pMIxvoIk4cOwp6D/Dj4APM8yPIdd6R9Xej0W",
+	"VEWo2fnZ1YVbV23rjncBKFNrSnC68228ct80m7h5Q1ZZaxS8DNw6O0Xc8XYnpseNHldZZBynZ0wRcYez",
+	"yxi0f2g2CbxQrB0E3RB1T6y4fkOZMTnA0HK0k/9JzfGgocZ1n2DHmRXHmh4OocQVvanArhvxkKiDy/SJ",
+	"IOL0AlA3pCpOvMq4x6X9AEf4KlvpmRrRLUV20h4qlMGs74wJVJAxO3+tgR/fQ5y9HutnqDgSRIu7DY3T",
+	"F6/iBoHOx2oTChL9eO3eSY+4gcDi3rDGx+C8LvpvgCCadV1696M2n7q0AYcWkMDXx4UhaoJ/w7mSSuAC",
+	"whG0V3mXF5DdZsdsb4KvTWSCH81taTAmRox4IlwyLNHs1Pwsp3F9oFpE2AZWCzeBbtEIldEusEcpFSRR",
+	"XCynW4GJmTh6sTc9gqLevmk1ih3I2zfNSKn2VbSPZC0n7TA41yhmTG8dB1W/8g9XpxpKLbyYQY0gqZ+8",
+	"+vFTKLjQHKsTdD16dXz8F+NV9erq5Vcnx1+eHH/139ej6C0rH9c6w2Xm1KmjphJBx2m6xbhoV7e76Wjs",
+	"X3i2MzwiIo+8j61r/Ri5aIgN7Qy3tevwmkJovkasgiuI+Uzo392YdqjmfUWoton1iZJr+NKm03Zs3zVC",
+	"n72L6ssYra4eQJFZ7SdUeYfDLyij5gGi0d14z9aXMUVnM6PrlUSNW52c77eOfZUkbR8qKOkwW/4wG538",
+	"GHFsaD3nf2qC1un5B3dW+p9+CZZM5ISZkNcCK0WE7vD/fXZ9/R//nrz4z88++/F48ref/uOz6+up+dfn",
+	"L/7zxb/9X//x4sVnn/349/ffXJ2/+4m++PePrMxv4a9/f/YjefdT/3FevPjP/2O0IpWmZqIRnYuJ3ZdT",
+	"iFTKyJ0O5b0Zxp0LDPq8jyaG54EytumPYD40sLKyxK6ipkmGZQRDTvXPbkA/kvnR6iadBqcgQlKpCFPo",
+	"jmdlbprRKEPQjh4737VxCXELC9xDutfxXC68ZnvTR9Ut5/22guGQKstCwGqKh0QfBQTJyF8y/YfM05u4",
+	"alEScWk0gzIuNnyoN4hK8eYzstpkpzrSI9tPUWXKXZeaz+n46pt0zdebMmsOArGDzTmjisONREw39pun",
+	"MdUvq/GragisM36e7yOtmoeKUXMsdHoxjbPbHpzPCfR1JmbVOQ65qxmnMcpB8zjpoLk0z+lqAxJEIDv5",
+	"2FsBKDOCyNR9gs5jeLxiQXwcHDiKONPEFF0zdKV/ohJhhnBWLLDVYGndq717qwdxwPd2yXBOE3cGWhPm",
+	"7PwEq1IQNMeKVGPDeHqSPC+VfkIZfwutBYNwQIIkAa2XX5mcdusLLsJNQv4QwvRdcEYQYUqYcJZznmqF",
+	"4LTWWrbPf8WjOi+lQjlWyaIGQbVpCp5OI0fv0Pecp16tFB6Fvg9zCjm+NXoFrCoQwneYZvqcEGWSpgTh",
+	"4Mr6eeesfds2aKkGs0mOi8ktWcpwlHYrO0yOCz0oyGzd1sGN2dQzEbmaNkgjucKPN1ZRlOMHLVcjnGvX",
+	"e8g2kBelqsRkb6mMKt9XGehq1PIIvBwmfthJhUdHowgkOLvAH/3aLuw5NC+OsrUX5zDOPGX8OFQibl1n",
+	"NTkL8HaMqEL2vWuEPwsyxsMWG+cX8qAfR1RlS/eqJOkYfEbuqTTPcMz0qygzQri5+onjAMbGNK1WkoC1",
+	"R0f2ktRO9qRQ1u/RXeAy6v91bn6vq0ml4oW1cjXc5UK7g+APkTjPc/2z15eYP2ov9/qLVLPCQrMJQbGK",
+	"tkf3NMs058JFkdEgzw3Eh4JcpQPmtdkMbDjGP9O0s+5ZDZZgklUwJXhmBiIP1hYKdman8mp6Kk231DnA",
+	"ntaqHMhDwWVMKWJ+rw8GbdcIctRqJi8wiwZcnJ2H390Ezqhwdu50mAK+f3Z69vZCX5yZ7YXBEU1S3alp",
+	"pVr9biFnjvE1C2W1bnGjtqLANKsXg9NUECmJcZuqLQVxYUKBIdsFIyrH8naFMiyIxWwpx5xZfKWCzJ6+",
+	"7j12uVVcR70YB0/BYyYY13/toz3bThMFQPKpFVG1VQx6qEEP9cn0UOtVEACrDQ1Eztmc640vsPk+sjzP",
+	"KiPmN7xkCRF91eB1+5bRgEftvyaF5XoXDNOsZi7lN5KIu828MBJF78hll57udfi5qVwDsYF5O8tnRj1j",
+	"HpovYtR3waWKPwG/tV/cDK5l4CbgJvGprLC2LW7kL/8ePoD8pwQOo4hssqSoyFMN7bJONgQeLlRlHxKq",
+	"z6p7WG5N+rloWHi6bJN801o/kWW/0Z1ms1tVaTxXQ6bSf+wOCLYg68HIpWpdeer9hNt4ttd1OFT33lnt",
+	"6Od8ywd3v8Hd74/m7me9CzZ1+oNu00NyevAuBmucC8IpuaBzk6WsFVyjF7OdD0R9HTuIAVUk9qbCQNft",
+	"+JjszvwDoC3Qk0AaDBe18r/8xoSQ+RGmvbML2PifyJTwIZxQKpwXDgbKQipBcG5v/c/SJ9voP/nKxLhB",
+	"1mi3iFmZZRHnmCjAzXEsp8Y3uJCIphqHZ5RY1ZRLMKu7oJRohK/SbRgnFO1kGI/TWhX2bVftrv9NldOk",
+	"B/Ca9f+0PQ92sag9gFg3tdYRGBTUdVb1VddOwDOcSkPyu7KfDnz60fm0V+T0ijWOXntMMTOw/ydh/72x",
+	"2PuodqZI6k5zZ9XzaxOuHWBCuh5hRG9Klsa8a/VD0NgVDS2hVWD82nNIBDGMAWcb45NZy2nQv50+acMh",
+	"4dV7T8Rl4Ee8qv95rbE5X2c03mo3F1X3Ps4WLsuC6Ws9JmvRcncv17K8ykWjeXa94eG0fof1tQYX7HxO",
+	"OvK7RjMgrLIkdmm8q+XChB2JSZroULWFs+h/ABe1W6/vH7rK6I6rGiXG+XUJjgGG0ztJiDKpcJYB1w0u",
+	"23g92Hw2lCneOryaHF5nWmstBU1HlvjhrTsbHW/9TiqaR6U19yVFeRh4HaUaPuUxGEu1egbMeM2G0sX6",
+	"Jwss5ibCvhEwjKUscyJtAgGILfBZmnVvLFGm++p/hPo/65/hZ2Q8JdNISuKkFBoU4iGkeZXKdhVtqEeq",
+	"r8qQc48Fc6ka+t5vPATVr7taZQ/4r1C/fcOV6toKJG0miKW85yKtp0EUnEerCpWSCHcQ61r3AE+TIloz",
+	"VcEVSVRH8nlogwrfaAtWX5tgJU9oL2nvvL4ausf1vtOSV0Q0rW6W6BZIkMygsuK9eL72flhVw4QnFhqr",
+	"BOdmnn4KWZOTUaeX7Ug6a0c0zYKpOmfqm6+mo2bLhoKAOfIfAoMolpzVQR5y4FuxAyLcW3NLTeCoWoYB",
+	"61ooH3maEfUslR2xP6du3TaLmnt8uMNqDaTWnn+Gdz7+SEbgUXj/wTH4k6yubGwB0V9UX4TYKONUbIAY",
+	"z10BBqvwz+sO7MkZFnYDddnqWOdemRUcnfNNEus2DtsMN+52Cmzs52vB8yuSF5pOVIk/Vma86hb9oCLJ",
+	"6nwG9mByouUAkxiIF2GmrMy4kp4pidyTceqMcn6bkW25rv1kylrrrSTsbwnOYpFrC/N7R2EdrUehSqIK",
+	"HCPvz3RrTcY5T+2yInDcWczk2zLHzFjuzEvRtqtyixgF8KalTWI64wueZSSdlAWqDqnjveEoIzTUVCIl",
+	"c4EhU2jJqp+tO1mMZEI0w9aH+U/Tves82ym/4JTcKY9H1lDpVtEDpHopKfemnhz0kgeulxw0koeskTyP",
+	"Bul2BOY6MdxM18Q6gkVGiVRv7XO8q8bAV387+epv/91bAI6bfChLaYJV09hTUCUgX3Xd7INnyt1/kJzR",
+	"1KuJWoAAT+uB062VQaM9b1d0uJdq2KHzkpfSeZCGiUc6kna73JNm99hnMudZ6ouktFImm4617K2aDJN0",
+	"7HicdVYkXr8SFOw11M2esFqYw91KAKBKGK/VKMPqA9LQu3WK5uhsjTDSoTPz3kXmJF2x05jyq3dVRf1P",
+	"mNrk1JSdIfOPhEOPAKlND7xw4bUJx+Fh9ZAeKuGvXUUFS3VFRG4NyBf+6Rot9RE+A6ucdqrqP0ZkOp+i",
+	"H354/3ea2WIc74TgYrNqIDyNfygWWDbO+wKKuUXx3vmntYmRIAA1EYt0mbttukb6b5xl1Y4DQtHXVY5n",
+	"tTx01rvShSi4Cx2NRybEY/TTOuCwGkAzrjsXt+Ngez2A4wJSTqyVLm27fr5ovhDe4Iw2OKP90ZzRLKZs",
+	"7I1m+02jRrWd8gkBOq7OljVkEBoyCA0ZhPaWQWgjP86QSoSum8GFrofDgErs0X3TEbMt/Dc76VnNgXN3",
+	"m02Hb2Gw8lqonl9ugyruw63fztlLXRe03Y9ToRO6BoHrsLV39uIHJd4hK/HsJZ27F2cdoUiGC0nSzkIs",
+	"xmgqC8K8U5B5oo1tJRnG7/3LCYypCrk33CMVafFP51WAWtv0HsqduD1V44ybR9f/hRoWSGyoVu2XDtOa",
+	"pYvrqvVcrg4KC5QC0WIzsUPXtW5KQSqtyn6uxXSKrNQ0kOEy0T0xiCx4OV/UtZRb6hGba4lZ+NbVd3OL",
+	"27q6m4MrexA9YMjZsdsr03JWOkYFForGQmhkQRJ4bWCgwBhcKYBGaizW6Vmb3eRjVX0LLeFXwV+IVo0p",
+	"MzXy/TBaD23q5m5kq+1bkqLSxckcZ9kkt+HHrQ7u8dzfQeDcXoq5A/fqiToNhC60vwXpO6vkBC8bWQNM",
+	"xP7oZVUk5WT06ptGTkCIQR29+uqb4IB02rcwuUhtCtvGxWOvj7N2iYj12WwAx7v4s7gxeri01CzdLS6Y",
+	"4AIn1mGpv3bXk7yGDkDbn9i8EXXbnYKwArs3vGRpXAFsa3+fBguNZv0haZ/Eh7Y2VUpnxgXYS0z+HKJr",
+	"0AO/X/F8gRZd3Oc84G2Wdq5faMsCZT9aT9sbog8aRkrDhIbEXMHIrgn+qdUhl0upSH5hOpzXGxHwbIt7",
+	"pgEmnbp8mL1U2gAeq1DhXUdy3vr3NYpqIBGDgnpQUP+BFNSAGUYxDceu/9VwibbpqrrkFwv7G0YnxPOb",
+	"wHKMXk4qzNIqSaYsCysaNtYlp+iCzhfKPKGo+rOEtJHFQ2JwwCT4mKJv+T25s3nWrO25kGNUzE0jLRtB",
+	"9T2AqPWqtc4Mp+uUaPbAN1Geves6f5cIMryBaIyR1OhU1rAjSCN55xqByT483MD5sMtMsCq2p8v92Kuy",
+	"wnQmTQe/5gqm/kDQu8Ynd6WNvuPqB0iWo2GJ80wimkPdObWYRoLZqKIJzuJ2YtPzWywXUSg3X8+xin+t",
+	"YKNHoMOKDPTDcT/BcXtpvuu0h1t4glto/6C3MlzLYV1LrIlT3QRi84pFxMSAbjuNvQ7KEEa3f5Vhtsud",
+	"bDYw72pbTdVmNxuNk16Gp8ZhmmbgngeTzGGZZHqEewZRnqFA64OLzRm6mND+pQ4vyKyENMumL+mKRom5",
+	"FvZ3oVxgNidBeW3F0T3RHtQu5WOwNVzpc7t0XVRA/UnL1+LaLuoy/0FL43/gSzIjSVRXdmHvkWBPhKqO",
+	"6oUp9jajNaGE7gDO5szYVDizcLulx+yKmmxtWLogOb8DZlsHijU3aHwycn5HGpdkUvhTqb295y7nd5lS",
+	"ta5ee2MTdvbYHsCTts0/9c9IEFlwJttmrm7XiRjOfU0ZzrqyEzo3IwOiN2TGLSw5/OpIBWAMFc1f9VGZ",
+	"fubeE1LzLCuThJBUuiOlJpob9KBgQa1MoPq7JGprf/KO3EwxHIt6srVa1a2zEQyASGbNUu12nWrSneLM",
+	"qFQR3iqkuT7hvxbLvvONohrc3QzAwSWMo45y1QQxgK+CZq094kyHQ6/KWuCBC+Kmm+VVwP5hlVEr9dV6",
+	"fbJm3vpxNC+0d/e8+EIvdpOgx2rYDYIOL4NuZt/rIg3D7cU201pJryO/6E61HTn3UDTr0F9F7EhF+Z5m",
+	"GQ2PE9K6hoXgRyejEnwitHGSyttLmyG2Xw+w8r1ZKtJ7mhZ9DJpNwL5VpRt/7fenswUGBqff4V69Pa0F",
+	"gpUlrLrvGJhV1ZnOmFSYgesxzjKbKXwVYrT7vsGS/IuqhSEqkRzivgPk5tHcJnj/jyLK3vGoFFm0cr+L",
+	"n49u4k3UtLl+/kdxFaAS5e2ZN/IBcHp7byHM87alL4QUeUuLCS9AJTMxjxYifE54faYnv60Uh/oO9rEX",
+	"UNUAY0cAM+nq+9SLeg0l2Vw1FthYvZCby1cOgv7b7y/hM4BEr3Is2m35jpL7o3subimbT3SBiQmchTwy",
+	"YHH0p5TJSYZvSGYwWI7Gj3T0W2Bcj8uDzKpV5on9UIfxpt3P37/vuUNbx/9xSIteRoubaHxs/YgL+ney",
+	"3BeijWspn7bGfEnE9v37MKfz9+/bh6ZNnaOetOJDke4N3B4VzOCJXAOz6IbkRk5C7f4xhuChtUrrvD4r",
+	"TH+ZNxw1MnulAYkyL65wttEMEQ8Ur2Axg43bW4lJM/5Umvnzou+PrR3Ha6NHjufR8th1nfmK84vkphuP",
+	"ko0PcSMQjl/DKihuDb9WIvJd/1FyUGTWEdbWC6q8tYIacUQGzq5tVWVdyVf5cDVKEBGN90msBlEAXg0q",
+	"YqsPVrUyYv6qvuTScczp3FY4augzNJaYakU2Rjo2buWp9/IvceWFqxQUGxy+9hv/L19+E5ugIKJncln3",
+	"woHLXVUgGhYXZJrssfsr2i/rYR3GPjjNTlv27KaFvzjo7IUvfrulm6tXN3dasMJV1ADG/anfXrfD+ar/",
+	"SrStr7l1rTsg7Ep87MSnFdjQpZ9aT4j12H6kqt8aAnzeTKPcsLTgUhJpawFDRtZIsAVnCCPpBllhb4mU",
+	"a9QTdM+fCM50ZTtBZJhJ2USpKg7pZTuThnkkPEavjtHn6HP0cvJVh79umW+/CujeZxl/XbWKylTeO5W1",
+	"dSC2eRx/5TEP2bPX37+GpervZpXuqoC/EG3GhHIWbIreBiVKP1yd1jbwrtQXe/SGiIyynSwzsV3E8LLM",
+	"VM14hME0Zo0RSxu7fE+E31PcsNROgPLa2xa9nkMD08hBQ9S5uero8pbuHskKuwwXYi0hUJKry9M6xkPO",
+	"BbGhOpsYc7CPzVlhyRFVJhBbFeUPaMDxAVa4OgWsnGmlEGTiWtSNLu5XVwTmMYw9G8/eQQh10/Xnbxvq",
+	"ArdzwogwR+HyckqiKuBoA85ebE5A8Xip3lNWqpi29Ft+jzJuXTLsiWhfCw37oR0zWCC+wSzlLFyiZ97b",
+	"G7laS/2pB+raNNGRfdniwbKeG8tmYYv7Q1S7XRqKb7ecrkDtbHnNXLa3CIZfxSGOSmSpvgtCqeLRFtiU",
+	"3HXLj6Rk75E0JJIUzInjisPV1s6ET9GbpSu7PW4mig9H8SwRfr9mMdu1CQnkIjaOO1N/FCZsyG3ajX4d",
+	"L7Lc5ZFypTeEPT530T64XliB0RrFvTRiGGOzZ/zleNyJPhzdY6q8m36VdsSCQw9kspXwRycvv/zyeHWd",
+	"302ECEETcuXMi80QKpoQe2GK+yx71gsG7CjtFKjX7LJ+q9HCBYUeO0UFER4IEm1dtT4PcKb1Tx549Cm2",
+	"B60KRdcHXIck39A377WipWP/rhjCN/SN/mcDYfT6jZqmxoR4eWNk+IhiwNoS4R3zLS/Fmmm1ikBPstBN",
+	"N58jUGx5OB19uHzbrU74hr7psSx7GtBlhwWGhvXwIrrckzccfv0OWhdZVbmugdFoXTyqu87mOXbsMYaL",
+	"dSVK5xPb6rW20HPBy7m/Wmmtgih4WG+hrmmmiogE5YM4bSni2FycqYJOCqzHHSOtbc041pGVb3wJvDHC",
+	"RZEtKZt/x+dy7BISmg5QTpeLSoKrVh4dLLZvu/Ir/j25d7kivf2jwX5i3EZxZ/MybpaOO1VXOqMkS+FK",
+	"E17QMLOpBYJI3Ziz2aoGKOUEwlih6A5my9wcalKUY4fJWklhL9VUDAZJB6DcfIwyzwVE+FM2z4grC69f",
+	"lpbkXLMuUGzXceczQD7ru24Hs+kxr0cvr0f67q5HXx0f59ejuCDgguO6POZ9FquKFVeg9ZCMIRrObLfg",
+	"Us0Fkb9kU/SDDpgrZSWXbXbQ/vhgxqqVIAkXkMSeVOvr3lqn27vd3V29YlYsy4J5XlqH9ht/FFILhjn+",
+	"Xy78GFiu3Knekt4Cz1IDgd4h97FPyK4vLgp26cXfe434KhB79U0crthaYTp20l0jeQ10ezjzafXthQqm",
+	"+guhBtVdeezeRhN67CfRHDqbIQ5UbBxeJpWVeI8lojL+dnYpIX5rZ4ddJfGujZkfjwKyFkuzYj76ZAYR",
+	"ADE4k2DmUlGbpB3mbYdZ7DHTAfujXhlqY+JBvepRRInhatPb52FQvclripx6rFpMqIZnnMV1di2Xytbs",
+	"2oHthmZUUWL1+03xKeKqBVky3j0UmHUU/zMNZNP9xwzpIsiI7p5qaJO8eQ/tV0Ip4XFnes8Fv4++8rys",
+	"HFO396sZw2wZFDvSOL7j2D2DP0Uta2fgXBEVjGc4k60kMI1qcD5WIXIZSUKktL4vrcvfn1dd/fG0kUPd",
+	"TZncVtUN2yJWkvHSiy4IWh9VqbbtbcQKRK/M1SPIvOsT1FDsOjTrsddDCu5m53dEEKk8L466ZuuK1ac8",
+	"z6naxcuoEFwvJ67Z7T/MXVcQ4wb+SiEOhcuqRh+Hm44hEOUmLgsXNMfJQt//clrczvUPcpoThad3L6ca",
+	"ZN+T2DvLfUHw8w2pyiZB+KJcMrUgiiaBm4KpZrnAd2SMKEuy0qTkyahUYPi7w4LyUvokPWatUqt+3RBG",
+	"r6oHgMQMVhz9Dcpi6eWMkVvYx1hmUKYoK0lHsQZWwvg3hjk46cx4W+q/MTwhkS27WXk7GPxEgqhSME1h",
+	"9VaqchfmMIDhiDv7DDBynDkq78cMEgTE+VGJeIF/KYkPh7whXsFEpTQfIMeEtSM7EScI5cMKZkyBqmQU",
+	"WgmiBCU2vIiRB2X2xmfVSqpzP4VT0ZeEUcKZSwZkxtLLsky+4FJS3dMJtLDTWk5fs2+IyDKKzRwUeZgh",
+	"jGbkHuWgK4TLLbCUgdLYXL2LVTWPPn/aUH0f+JXZp79JOMp7mmV6iVBsPsGZOyn4bF1kofKfi3HSeRQz",
+	"IiVa8hLWI0hCqD9KxbX+G96kDBETH2WtJtO4vJZjyrTrmyL5abzUYbuNz8ns4UyWN1JfN1MW5OzqzXXc",
+	"L2iy8NoNwC5XPt9dv9ugET99TwdCjg+kyDj1mte/OWtJMpOtWhpRtQn9fuVuUdoGd8v4PfMvFRjGXUVG",
+	"ZgqVzKAUS50YjNJSnxeSRFCc0V+xDXoLFkqhfpkJD/yMUAP/NyQxlnmqqkKvJdMuy4hXX80R2PO0oYEl",
+	"u31R7cdWo2Ec4LK5J9gIlbvsxEXhmlcgQP7dy+nLr1DKzbr1KNUcAPuUKaKlNiMcePV/DFI+t3pnyuaf",
+	"m2ZORteIm2UuhvDURPf6MG14NhpC2jW24o4ecmH/IA84UdN+yUQb2Bt7UwjAXawsks4okQEZ+bMMgsTD",
+	"RzuV9XB5zDyZvFnaOGYJEaVQQ4QAsYBOltJYijRF/zT0wDCoG4KUs7l6ShwMaVQehkKhkuU81SsGa4wj",
+	"LrDyKTrnRQkFmKwHgTSpznRMLk4nmoU9esy0dum3+u2JGYJnE8zSiSfnyTJeLTSbfUfZbcwqCF8gPv3D",
+	"xXfNsHR/L732f82u2dt35xfvTl9fvXuLgkKWBsuk4gXSXBzPcTU+oCFl6OX01bGGYIIlaZAbKlGRYcaA",
+	"a94QG1brur103ab91LC9xCXwLznVNKcr06v5qHd0R1NiJYEwW4gp1an5Ci6oHQ/ZRK+h0JRgSSTAc15m",
+	"ihYZAU5kPW2YqWRKtOm1LQ3r84k/EMynpnsp4Jfh31Bk29yBmW2sMcTkONU3TJVE//fyh++bpO89Xtql",
+	"E5Ry5XWGM/qgSZD1FTC5To1LHFYA6UTLfvptA5v6lQg+oSwlDxph0deg/dVyCC4KgkOZgkNIiDlHPYDe",
+	"UgIeZmlpdDdWd7zAd/o4G2c4RT9Y0dvA5zvQfcuTa4bQtXm0Xo/QJAA2/6MlpC7tsjtC6GiYyY/HP017",
+	"jAAiCSyeMCX0Cboh4qq3TseN12ihK21OfKXN4LO7a+CT9g9zCFOEripcs0KoRXRDGSdGFDKGB5xGE6Z0",
+	"pyt4jSwWbbyoM0v6vaRsUs9aHm5EgDo6efl672j+lihMM/k/d6+6cN22AErpxGyvmkAVVgKGvX/9X47X",
+	"3iwDPqJP2RKMsHuEagQSnsZmm1LAIzVGl+HLyqd9udezV0jn5RtJVCUyGNZIjcebQx6zaiu+5FglCxv4",
+	"DZFpzn5gtIR+dHgeWfkDau/DOJgtq1YO3szlarp3hzOajhEXqGRpFf4WeeMZLI9TN0N7pUUqS5DcY8xe",
+	"FZaSJ9SwLG0qhxyf5tDcYQItnqLvNSHLstpXoEburmBMklrKM+2bE3tjVhPRBM0FL4v4KZhPwVE3qX3s",
+	"COyLPNzrtH8mTj2r/rKHSdEPDEmeu2ze1J05ZM2t7H+Vf7WfQifV+dQpalinak5/2f180Gf31YuGBvZG",
+	"Mzy8EV1OMau3SV90UG4llq9niojOMgRnM5Pi04i/48pPmTIkoUvor+PvK7CogS4inaJLnlsC77IUgfYk",
+	"zEhk6I/xu9JMPTMvAkWcn+7Eeg1x6QdSde7lx1w0/Y3cKvGty6vUHH7arw5fSSPA/+HsbfM2p53X5O+7",
+	"66qa8BuP4y0lEZN5SVNy5N9UQv6ppDGo3JENruB/sDVQ1ViGrW8pwVnmmQf7s3ItQKPltE9DLrPHzmWW",
+	"2EKYjasr53OgnN9eXZ27u9FtLYpRp6Ado2Ot8bPKi544YhntHnlgIIcNCdX2nFBthxdFmGmfyor+T9el",
+	"btsZLLzRYqcHyP1i2Vi5DTXQm7sefQ1y4PXIbnSHlwl67ST1JMMC9F+YAfrZUzTod1OqyttDOx4ImhJE",
+	"1XR1jM+qejPVraAfjC1F+yxclsbS6ZyI/E4fHRxlQRKjnLKL75OBUzOrqK39T+h1qRag9VfGC+Z1loXo",
+	"h5zp8PX5mXPfQj/rTlxY1cUJekOwIAJdl8fHXyRG8W/+SX5GC/PqBWkMI/M+sZYByrTmSZc4JA/KKBBM",
+	"KRXzzXJ0fmNV7TdLa7z4mcBqEpXZpoJIon62koD5A5gafDU6FEGZkoh6849MBCEMnHkVVcZd75yIhDPs",
+	"dwuoFFgKT0Yvp8fTY5tnleGCjk5GX0yPp69sqUkDRUdglp5Y47H5bU5Ut5Xb0D6rRq2btPXFesA7S22f",
+	"milfQnSUecuaqV4dHzsLHgH7iXZetFd79L8Wx+3eegbpw0x6boCjJh80WDArswpL9Bl9uceVQMq9yOQf",
+	"mOyY/qunmP7MSTJWAUFsw/FIlnmOTTmbfves8Fy2ypiaHCQFj2XGhawsCBuXrvpwTj7TCPX5504n9/nn",
+	"Riv3888/6//8pv+n0tFpaia/cDB7PRq7z5qKuM/Bz5X/BHyEv18GLbwTCDSAP//nliyDNt7nwc5g/my0",
+	"AZcJaEDKSUKYEjibvLwe6RYf/ZZW7w3/WgqycnumxYodeuePFZu04/8PToxS+X9g/s7tNlpX+6521SIA",
+	"cO01xBz5CjtvOBRa3wvMR2ayfkMRPLhakDgQWpNCFWdWOWRYL4+noV4D4dqccK0nMSvo1sdxixMe/aYR",
+	"4iPQsoxEKxVXWX69xqTt51VHCejTRInAP+3kx+Y03TFrIy0lmSh7E4Rjkxq5Mv812B0Hd9AUv35qwfWX",
+	"sQfkAH+r4K8fMHQzzqjU9Q1Rm4HXN0QdOmwNNPNgYLYHeK2Q9LBKooXyofyiTTjGZytnmCLw+LUlmepN",
+	"wR41bQF5xEn4MOB8/3JNtz90P7nGHIq08Tux0/VWQaeqGqSe54TBm2HbVhLQkZ5ihhO1RjkQxs3PeMlS",
+	"p1WDx8l6SqDB90EJbH//7Pz/nb4Yo/M379Fn55fv3755AdqRuQYaHYSIPjuHCLXLf3z3AmV4yUsbCVpp",
+	"5KfojV2SC5FupMeDz6bXLMPzufUOE8UCQ+h5l0rjtT+V3z+LdXt9ZkqVL4+/fPzpG5EmjCuA/sPT6oQI",
+	"StlKZNyRUBzRvODCbHilPiiOi86T09d1NI7Kfo0yjkw2AmrpdLE+4I4LlGRce5WAxhbWFgyHBXgih0b4",
+	"+lRUdEZzgkuaCpOf2LP18UARxciZWcNBEpL9yzD1bcLWV4svRo9vYejpxZF11C4GRAO1OyBqByBWCSM+",
+	"e8BO1O6OaCNe4qskrX6wN5IshZ2rVCrSeAQSqRy1kt0P+n8GI/jkTo+OC9FZBzl965e2BbwaOMjqNh0Y",
+	"pjHGCK/uMgJ1l/uGuvDZ2Ql4j8Up+sLc1frzfGrWMaDLftDlcj/ooqm3FdQmzp1gJdm2jcHJOcgHA1H3",
+	"Lrq/XcIoRrbjdageEQTjEw7QtzWx3gEaHGTe/lU6OORSTVwauW5Vyrsw0ZxNgu8TznVnlM6yMP9Ajhme",
+	"gxuK9Q+JKjKiqe8fVajoTte/EZg+gaALCSppQpAybmQuDNUGP5PDkngfD2ocIOvBopB85IaeJFW6/Pir",
+	"f+UqsQmc4VJH2nYkqKw9s1sQ7UaP1L94JHGlMVMXGEWy4DydXLKyGsigyfudovwKZIrjdBOJe+j6tyMp",
+	"U3DYnNHMdMGCIJcPxbDYhOc3lLnIE8htDs2kVbgsqwlMD/3XNKJj0wt9nWVvm7V41mjZtIf2hDJJmKSK",
+	"6owaOpuG4kgSLJJFTbs3tjkVbskS4sfhT4gC6CS9Tlv3S0lMpQWrroPxR6sUdOPmYo1ZL1t5IzVVZMfU",
+	"4ff9z15P7BebH1rUJq8SUxYPyWi878VU+XRi66m+7vE0nMrdOUFHYcB9jB2Eyd65j6NweVVIM/3w276J",
+	"hZEvm2GTFXNGOrcU5Kbb64HqtUXSbwtkojQm2lOb5sSl81sG245rzxsLv2n4F+xx5bbCZpVAoaPkZmRV",
+	"ebvi2qeyDTYo6+BxvaNtbmcZvaEGsay9gpiJg63N3PwjwBn39Y9UE3zMx2RX8cIBBvfi9d9x7Q7Y8shl",
+	"dwcAvI4NVwXhmdeQRD9r8vVzlZxoes10LurUZc9w30EyLEhiBLRbsgReUM9MxghJZW2syzJZICzHOrzR",
+	"DHWCijz/2eaL+ln/2wwW9rRR/6mLCKrNMe30eX8fI9OP8QZdUwG345nzvvsyPp0LfOTMBlTezQ++G+nW",
+	"YnIX69jWL/59VMSJOcdHcae3Z8QKUeoP7Cb/JAqUGFU5TA+BDSB0Hb/r6bef9wD/b4jaDfbfPyHsD3R/",
+	"QKw+EQX5VljVEVwAfglbcBboeNCc5Slkw1q5+g7ZMF8nG36SSIGBSPx+iMQGWLxeRmW11Pyd3HhHC/nT",
+	"WMU3U1+0CO/6PTZO7Og3/++Pzs1REAW5O3pK+E4DDN2R744KntFk2TJBVBEZnaZpp3Tm934ULPQ7v9DQ",
+	"LHRKjY6ngz9JmOPC72UDMt8ylrSJu/s8hNh+Kql9U6gLSEn121rxvWt0s3/IQNzP7hYB6Zj0/4zAd9+e",
+	"k36v53A6g+Zna9l7b7ix0tf4SXEDJIbDRo/HcofugRlX3ffxCZygB1Tem//znlB5vdQnFVZyrXu0t5tj",
+	"RaWiiUFmYozr7WrXjSg4l8efCiR4lk1Mhb51HPDSLOtTo3fLvv+9L5OS6iz21j2R8fta7CAUMC1NXnV+",
+	"1yxd+MVxh4VfD1mz6fvi11/85as1ta9/eopXSng1A27vGgpUR6bVrkP+sRxD+Lj1vwPtaz68e3ZG715q",
+	"N7rX/FJ/z9JufMeDKusP53y/HqED99wOLG567U4s61kbeI8jPvauam7MnSEaqP97Fbvjm+3p3O/I+if3",
+	"pui9iy5C8+r45dMvBsAtRZb8wDpePf06Xtuaz4PgEvEs6aYdfQIyN6Rl2/qbrKFr0Ocw6dp41Ywdh2+y",
+	"zmtaA6mXoJzOe5t//UeXXfYnN8qqzCvTZ+AzsGEli+GZsh8XmY0RvkPBfmHKT8jNUPYbogZ8fab4urM0",
+	"MqAloGVPzHk8RnyU8IKSHvGB0K4z7R91lYH6VBGIgs8pLOQ5Yv+BYGyvunX+sJftinSD2iKSHOvAUwCu",
+	"xst+SYiiuoVLhYXSwy99wTWX2q6TCijua97H0oHqwZAp7DWn0tS9RVha3/aul3etxprLAtjWa/Bi+fuR",
+	"Jp5DAkB94hvlLlYc7j9QliveQ4fy6hEW3rVkB6BSwz5J/8iU7svjvz2NZtgJEBLhzMREA0kzmT5viKY9",
+	"9m/rglAHq8NSqTj47k8YH1e4s/RyoviEkfv1KV1q0UZt4sS5kkrgoiBpdxrGsc8AkS1dtDbcHoZQbmP/",
+	"ornlCV2ZWKlEGZkpVDLFSx0OPr1mZ5btdHTxZdfIg+aOmC1zLsgYkel8arPNgM7JwJaqwZ7LAAqJKqLb",
+	"D3LCNivY+qr2QXIEs3eRVuGOMFkkxvACbinKv6749+T+1Of6+MPystbU54InhKTaX4EhCkv4eyt3VwUT",
+	"C3ynr4eX80VV1c9XkdQ17NG/sGCa3tiicUZQ0c8zkwRYKoJTW4MUcD9u759xEQ/iv+E8I5g9Hl+2YBRC",
+	"zGoGHYPyHgz55afOuBRg4h/JhFoRq0/DqFvXwAWiylyFKV+Ms4qFGwp8YJoWgx1d2gvDnjoQ4hEZ9EZZ",
+	"iCF9kqO3GZaqlRI2urubJcK1DLG9dbFhAtRBM/NUqWYHRUwv4gf0B91jiZj2WrTIQA4zhLIPpu6srek7",
+	"EYje1Zn66gqGDCqihWG8wg1UYpbe8IfQ69uoaTRjXpDkVit2WOqlbTxTRNxjkbbVwQbuB9XNeoLz6okJ",
+	"zlUTlAa1yCdWi4Ay5CCJHGDxtjRtE/nJ57jfwifO9p2iDywjEoxuhSBuzODIUyr187Bd/GWMsGsGdOCa",
+	"xV4nCuvq3zMqAtWAUxXoG7XuSECG3fTtmjN6prkAXscSAvPZ6Q1mkFSOqyo3rF1RhjIjotuy5dMCyoxP",
+	"E54fBTu3UinCjHFlgGds6+9cM7c6r4G/I6K19HtMlQbOWUuvYvmKVYE0z8pMU8sDfM0Ms0DYjK15Gp/5",
+	"quiKc5i3EHwu9BUa3lX4VKIdN9pBsnXy0taPFSfTeA+YF3JIQecLhfA9XgbZFCNz6mvHTqVwzdo6hc5k",
+	"Uw1meOFl9j+Ce6bbbd83qDv1Q3PQXLGPT+ChuWI1TRfN8QhA1RyQBd82ke1Sy3icCTDbo84qfFmZ9vLj",
+	"nv1GzwWxJ7LaGzjOHKwUFBD2Fv12ZcQckT4IiemJBBd3HDVVkEnkijegzUYnzzyhfyaes5V+pUPmcYi4",
+	"ndCzq/Nsl/on6j17KHxns1eYl/F28ce7qDGVwYF28NTrjVhr8X4rF9r+etsBa5+vG+0WMuOAnX38aDdC",
+	"z2iajAtSZDjZlK9CnosBQ58AQ5/Hw9Tm2hsepps/TGdlNhC8kOD1I0iP+Q458q+zdZbrYoFlzEm5E2tc",
+	"3aDQ0wq+QE2VcZxKhR/JnT5t/0rPeFVIdWNx6txt9FkS7WcrCfljHwzi9Ud+F9ocdhZhr7XvTQf2TMA2",
+	"K5QSq/S1NpZJ/q7TnQw1gh6tPksM2rpzAY03NX72szUdHn8b3Hz35TR7aBayA3mB9Ht6ZMuehjH7QSJ8",
+	"o01dcaO3CUgAU1nC85yw1LheFTzjcwpF70om8YwgzkjlLoBuSIJLaTBQ884pzjJ+/8G0PA0LGT2pMa3H",
+	"eR5Q+pUvj794/Ol9VjD0S8kVRuRB07AD89lZxSq2SkLXkraO9ONtokheZDYt3aYeO3oA65Coh5hesyhp",
+	"a4XjUIlyIubW8YR7K6MbyKCPrSD5fy9/+B5aI1OmAkmSY6ZoIsfXTHJT+FEiCcXG2gUqRfAQN9jamGp6",
+	"za7Z55+/g7qfn39+cs0Q+vnnn/V/ftP/g9D1yDX+3qjPTtD1SOY4yyb5Uv6SXY/Grl3jPnRTO4b+mls9",
+	"HPw8Yn4wM8zk5fXo47hqrY/AtoSoJfuH3hBNsNR/fvHxI3Qw//nol95Pmvha8PzK3f4gWTw3ySK8vtWB",
+	"RB6tnLJFx/wJmnZXTd5YDPn0/H/gXU/k7e+A6en9blt6lboPyzNxQmkwzf2xc4iR3YGP35QszQgiD9Zj",
+	"0/JtCt5BNj7aOXtqiomzDCpzQrwCdA8caBFlwL+5QP/1+v130xZjOjNrHp65z50ZvTF3b3AgHHOJ82z3",
+	"MaNMzUNpO9ID+g1M65kwrU/BOSKxsZIkgqhD5ilALB/1SbilB+NadWbUhfF5met2863Ys1PFRiuH3D5s",
+	"RgU8w83VGrZ9YSd0PM190bYgRZIwRjFt24BMX+mGdqqtGJ+yTb6HbW6wrSt8S5oxPRGKDyUhYPW6pJ1y",
+	"8S+tmJ4wQMQ9h2LOzS42sjvUBzUifdqhLxXnt6PMqMbwNVEvjdhPU4cNgm7CG+oSCPQMbzpd9iuxYCUA",
+	"tVND6Yip9hYUr1fZ0A0wlIlvDNGx2fW7uIQB1kLO8/Te3aei9+vg6jtkptrlRUIlViHCQcZMfApj/idL",
+	"8NGHeoQBqY34jC9fffFpTsvyEveg9BTs4N3HNxKzetcmXysrtd2cBkHpOXhFDa4W+6hgviHSbeAOvhbx",
+	"ov7gA+4NSeEO3FvkANzWe9O+35ObxuArcdDe9o+tJatquPtN91Sc+QKjvmOjxGj3JkA9YJSTPveu9AXb",
+	"Nym8cxEse+Bx+5Mvh1Lzu7y+dkCNXZ5ndo4wnb7ONumKy66dW3PifgU2unI5Duj4PDJBBvf07CpufxqF",
+	"2WFHvTwewVlZ0P/RCE5oP9C2mbwA/a21kQRzU4kIgzxq2AYnQSr/fg/jgWwdfIz0BhTrahUqPOnLciCz",
+	"vycye/nIZHand5s+my1ebbbbhm+2FAylLQqIzM0QuSoN78pnnNvFQH6HR9yBPeI2xZRdnnBdk1KGyGxG",
+	"EuXTn67C1LMOu+oCS8Q44vduXJviYA1Sb/D8G9D42ZVUrS5tEE5+F2/APdOrlS/AHcSJM2UcsiQqBElI",
+	"SlgCMT0rSdJmr7qBGh34m85eUM8XXR3GPqWlcKCcv8tn3V4p5z4edUeFIHeU3HfmlLlc8HubTX4DrduC",
+	"tOVKeDtCLqv7BaS5N7q5yjBnnYMrxdsdzkqsAl8IqsLKBi4/t03r76q2WCWeUduBo3OLqp/Dtgey/owt",
+	"DI62WwgeSORzJJH29g6TTPoECWvJZLgNRh4UEiXUhzXkktwRsWxmXehBRylDH65ODcW0sRFWViKpGfxX",
+	"zshGpO3SbWggbXsMKirzGz3LzN+8CfMADzujToH7dxdfjwb5qjMYqGSq5lyV4weal/no5OXx8XiUU2b/",
+	"GrslUqbInIjYGs9ef//agAzSMKPnlZqxh+AqEWX1pX24Ou1YXAB81foIZAcZnYzelYIX5OgNERllo/En",
+	"4A4O0Afm8HthDhWYNsKvfBKbT8UmdkvIiNwgPfIyvvFNB+L9TJSfQ3bJx8suGaDOHqvzNbH7SCqs1meH",
+	"Nvxarzg4Wi24zTDNfB1ByOpMBUqt9zQ8hyX9VdOvO1MyznDpe8pSfj/2MYc3S0V8HTrKGhKlDR8tZVVA",
+	"bov4KRdKitVAYR5FPEzxUjrlBOPhmwdUIAaESGoAoS6JfXHcIYjpIeNC4hd/+WqNkPgEUpiBpUH2+h1Y",
+	"faTCikpFk08hZwUJSNbS4RXP6XCY9eTwtNZ6IIcHL3BVFzYIXI8RY9rAn/2iuIt3n1Q5VdaieiwPy979",
+	"WCRROoZob44sb+2iz6t9DtTlGVCXyL0Ngs1zFmxWZHF6HFeWrSb0+ZJcjwSzPysoYp7zO5JC6sl7vBzr",
+	"958ZrWS2PcKOKJoY736+LQOBegZl3XoRo6s40H1Kv5aBiv7OXFv2T0X3JD0eeSrYnev3wpDQXYmzI7IS",
+	"4TKlLlOYT/yHkSBYuvS/sWpvS1mR7Ebej0rCdKKnbSdjXi0f3ChDdpZnR8ANLMafrpCkUcMQ2JU90A40",
+	"faDpe00Qshs53DtZh+qXa/UAiuYko8wTlCBZEoywfuljk+jYG2lcjdAxKngKNpqCCEmlviF0x7My110x",
+	"zfs8+d/BNgYi/Aye+eaunpnRdqBh7dd9X8TfP816cPUlojTrnfnslImU0X6kFWFZlZ5QC+x9nqGchTEK",
+	"K96rDEWLYMGSBpHx0SzAX3ORY680hkus23cNgnRm3ctx3RGQMG3S/XFke5nyET+N16/jjCVZmRLnU9HM",
+	"6d+ZLJcF6+5YJYWh6zazdYkCn8gP50mLbgws4uBZRECCn5AvmLzUE5Aw10q09fzx+JawMDONF857KChe",
+	"Owek2pBcVIOYZKCWiSyIICvznEfT57Ul3q9rCfafESN5BgLrmgz2l5+SAlx1gI0nBK6kjIe+e6oWCNeh",
+	"8x5LxMgdEVW4w0HKmLFU809IURYEZ2qxlpZAs17RJpqHuzxb0lRq0N30fe3jGfwtrHcQLJ/BM9je1SDg",
+	"POc3cF/M3ztlyvh8vdZON3JrM+Slp7nF9ZOaQ+AM6fPGlLk44rzMFC0y8uDexJwRJJUgOAdmA67TRl9Y",
+	"CDKjD5XTdMHBduOHNBRo2oO2fad3PFC2vT2ZLyB4rgknFWzoq+IsW7oFNN6jBU9H+52wgokV0/pGoy1d",
+	"xDVYyqoUOGGpW4lbFYBvtRofadixJIVp9p0etbYkq1Uw7uB/+XIUeIof9wknbJ4WI/d6KQvMGqfG/M4k",
+	"SThLZccqJWUJufRN+iz05TYLdfRGB5bxUmZLpIjIKTPxJRUl6YIq223DomF/J6SwMSKMOWNKQRjEfgBp",
+	"0mlNMz4HAOhUBekc/LsqVhR5UEdFhmmDHbVy9w+c/9ly/jgJe3S+X+BSkm53i3PsPNTW8Xi4YC6QTHBG",
+	"ZFwdkWr33PsFzQi6JaSATB/SRUO1ubaZflBzDzWjBmr0ROHbPfB9/zSIKrH27XHOKVMTyiZXNCdIkMzH",
+	"l/YKGwCHnETH6UGtJczmxMXw5UUZlIUn6IYyQ44/O/9/py/GiBeazSeLkt3q3y7fv33zwggC/3r9HZJk",
+	"nhu75WfnXKq5IJf/+O5FEPbZLjva421yTtVA554FnTM3NcQubS327ITW+6dE/J4In0OoZ/ps02mDHEH9",
+	"kmCf61FdTpSBFgwpsA8kBfYW0L5D8aIdMSvCWQe0OngWW7+j4SFRf5HXceKwlRp7JhZrCg/tSCyioXUD",
+	"vTjooIy1pOKqEzIi8PB08RgDifv9xNPtlcht9WoRZGLDH/p6q2VYEami7mrYhVJs5KsWrCHwWHO/JILg",
+	"qOdaH4lNkAsYZvBOexxa1DzgZ+qiVnNCswo466vWhs7DFJciSPTk1MQuYMcUmX6UHjkyL6q2g3h18BpP",
+	"e1tDlsxHzJIZYE8Hcttb2B7Hy5ysirLX35v+O9hkq1z7joLOg9V2sNoOL5GnigKPoOveBQXC5pT1kAvw",
+	"HaaZMbL6Jbiuq4SBd77NpyUUT4FusNeBhe7OQlcCWxPe4dg3A3f4+HGbJKYwwqon7jvX4jnwRr+d58LU",
+	"7OkOGLbPzKIeCjqRq0NPD+r1DXGlrpP/g6PLI+Q0WospUd0LyLdIcS0il+aK0k+SzmjA8G0xvCc2bsVB",
+	"95QX2JwMSZFJktGGQtnCcaPt46UKkwDHuO/3rsMB5s18VL74nLN+HWAyWsjutyLvVggCDpOq37bKPrsX",
+	"pJiifxFt8nGBw8H461IidnDog0epP3RC1wHv95o+dWe8X8E8t7DlWqRuVFmT1qrboeGMEIoqXcnS+MU7",
+	"I9pKFnpw1tmntJRe2pMf0GmvNs8mQO/IRp8cPxos8TBR5BF8sTbAjqvVN/+0vlgDVu/fJ2p3rF7BJH8p",
+	"ucI9ozNM27YbRTh7LBrDY+8/zFyHx9WGKIZdohh6QEWc06yUxGBUl4wtKYUgTKFS4jnZBAJD+epQwW9/",
+	"V1rf6gd9WAPl3V6e2hoGt5Cs1mHR9Jpd+WZUIsJmXCTaLW9BWETkwqLyieHCaZan6IecKv1bRnOqoBnj",
+	"yg83vV6rljggNNq/4NXYZYe4Vbus7vV/fDJUH7B8e/lqS/6lZapC0IRMlLaZr1UtmLbItIVSxYojIhXN",
+	"ne0g4WCKb+FyjKmd69GuzMSPKs77WQ7RkTk8UuvDnHA2o/NSHGjOzJ2AwEGhbtMjhmt/8AYMoAFyj/Ho",
+	"XQVtjQt/4mftdngwENrlviCyAfya+hrCvT7TIjTrFuNwllXEXqIcMzyHpIi2fkDU1a7Of+XoaaX6Td3d",
+	"DlO03vFSuriyIJKXIiHrQSPBBU6oWpp1VO5vfgCzEnRbFdRZERxfld2p3MrtMh4RNlbMOlCqraFzB7hw",
+	"QHn7V2nBUZG8MDGCvaKAWg5CVfce4T9XQeOV7zObGVIn8zTT+lk05vH7Kt9S6/HWyMoYfj8Iv3t3BINH",
+	"8O4ewSuBscMD3p0/SKjRkJhTQbAiCHeP34J16NJx1aPH9ehrztbXtc9txjr3WW1MD8H15afYApxwiioA",
+	"zZYHEZvytyd4TLqbwpkgOF0i8kClkgeFl72QZj1O1jhS4JDfw/yzonhCJ95G83EFeNtbiRjM8EdPZfU0",
+	"+hWHEocZprUxWPbhVpsGpayF/nYGhoME/eNPwW8G5FKHnnJqf5gV1VRekCLDyfa8JZpk6lAQ7ODF0U8Z",
+	"azKQh+dMHjbH235i6R0Rcl2Aiyvpqt3LCEuR7YMom/EWgfgnfDyDb48G1Xaa/lDcIrYrd2WGhesAQlaK",
+	"bHQyOrp7Ofr4kz/bVqVdXShFLXRYgssEbOMcgvrgp5V+3RI7rbb6OO4/2DodbUtLtMngPmVAe51pM9nC",
+	"NsNWYfKNUeHDTmtFQSae+Jptg91mATfL7kneuAxNO8wRKhXjs1SEfIN53jQzuduxwcfx0v68yYjGfmQt",
+	"SkEIwQow0j1GH3/6+P8PAD5qaCpgegIA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
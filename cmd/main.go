@@ -22,6 +22,9 @@ import (
 	"os"
 	"os/signal"
 	"time"
+	// Power schedules can be evaluated in any time zone,
+	// but the container image does not ship the time zone database.
+	_ "time/tzdata"
 
 	"github.com/go-logr/zapr"
 	"go.uber.org/zap"
@@ -76,6 +79,13 @@ func main() {
 		}
 	}
 
+	go server.RunPowerScheduleJob(tCtx)
+	go server.RunBackupRetentionJob(tCtx)
+	go server.RunBackupVerificationJob(tCtx)
+	go server.RunRestoreProgressJob(tCtx)
+	go server.RunBackupReplicationJob(tCtx)
+	go server.RunPreRestoreBackupJob(tCtx)
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt)
 	<-quit
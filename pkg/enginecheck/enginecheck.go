@@ -0,0 +1,113 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package enginecheck checks database engines serve their data. It logs in to the engine
+// and lists the databases, which proves the users and the catalog of the engine are in place.
+package enginecheck
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// Credentials are the credentials of the database user to log in with.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// ListDatabases logs in to the database engine at the address and returns the names of its databases.
+func ListDatabases(ctx context.Context, engineType everestv1alpha1.EngineType, address string, creds Credentials) ([]string, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultTimeout)
+		defer cancel()
+	}
+
+	switch engineType {
+	case everestv1alpha1.DatabaseEnginePXC:
+		return listMySQLDatabases(ctx, address, creds)
+	case everestv1alpha1.DatabaseEnginePSMDB:
+		return listMongoDBDatabases(ctx, address, creds)
+	case everestv1alpha1.DatabaseEnginePostgresql:
+		return listPostgreSQLDatabases(ctx, address, creds)
+	default:
+		return nil, fmt.Errorf("unsupported engine type %s", engineType)
+	}
+}
+
+func listMySQLDatabases(ctx context.Context, address string, creds Credentials) ([]string, error) {
+	cfg := mysql.NewConfig()
+	cfg.User = creds.Username
+	cfg.Passwd = creds.Password
+	cfg.Net = "tcp"
+	cfg.Addr = address
+	cfg.Timeout = defaultTimeout
+	// The certificates of the database clusters are issued by the operators, so they are not verified.
+	cfg.TLSConfig = "preferred"
+	connector, err := mysql.NewConnector(cfg)
+	if err != nil {
+		return nil, err
+	}
+	db := sql.OpenDB(connector)
+	defer db.Close() //nolint:errcheck
+
+	return queryNames(ctx, db, "SHOW DATABASES")
+}
+
+// queryNames runs the query returning a single column of names and returns the names.
+func queryNames(ctx context.Context, db *sql.DB, query string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
+
+func listMongoDBDatabases(ctx context.Context, address string, creds Credentials) ([]string, error) {
+	client, err := mongo.Connect(ctx, options.Client().
+		SetHosts([]string{address}).
+		// The address is either the mongos or the replica set service, so the topology is not discovered.
+		SetDirect(true).
+		SetAuth(options.Credential{Username: creds.Username, Password: creds.Password}).
+		SetConnectTimeout(defaultTimeout).
+		SetServerSelectionTimeout(defaultTimeout))
+	if err != nil {
+		return nil, err
+	}
+	defer client.Disconnect(context.WithoutCancel(ctx)) //nolint:errcheck
+
+	return client.ListDatabaseNames(ctx, bson.D{})
+}
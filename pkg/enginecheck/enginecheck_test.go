@@ -0,0 +1,60 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package enginecheck
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+// serve accepts a single connection and handles it with the handler.
+func serve(t *testing.T, handler func(conn net.Conn)) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close() //nolint:errcheck
+		handler(conn)
+	}()
+	return l.Addr().String()
+}
+
+func TestListDatabasesUnreachable(t *testing.T) {
+	t.Parallel()
+	for _, engine := range []everestv1alpha1.EngineType{
+		everestv1alpha1.DatabaseEnginePXC,
+		everestv1alpha1.DatabaseEnginePSMDB,
+		everestv1alpha1.DatabaseEnginePostgresql,
+	} {
+		// The connection is closed right away, so the engine never answers.
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		_, err := ListDatabases(ctx, engine, serve(t, func(net.Conn) {}), Credentials{})
+		cancel()
+		require.Error(t, err, engine)
+	}
+
+	_, err := ListDatabases(context.Background(), "redis", "127.0.0.1:6379", Credentials{})
+	require.EqualError(t, err, "unsupported engine type redis")
+}
@@ -0,0 +1,49 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginecheck
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+const (
+	pgDatabase         = "postgres"
+	pgListDatabasesSQL = "SELECT datname FROM pg_database WHERE NOT datistemplate"
+)
+
+func listPostgreSQLDatabases(ctx context.Context, address string, creds Credentials) ([]string, error) {
+	dsn := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(creds.Username, creds.Password),
+		Host:   address,
+		Path:   pgDatabase,
+		// The certificates of the database clusters are issued by the operators, so they are not verified.
+		RawQuery: url.Values{"sslmode": {"prefer"}}.Encode(),
+	}
+	cfg, err := pgx.ParseConfig(dsn.String())
+	if err != nil {
+		return nil, err
+	}
+	cfg.ConnectTimeout = defaultTimeout
+	db := stdlib.OpenDB(*cfg)
+	defer db.Close() //nolint:errcheck
+
+	return queryNames(ctx, db, pgListDatabasesSQL)
+}
@@ -44,10 +44,14 @@ type dbClusterBackupClient struct {
 	namespace  string
 }
 
-// DBClusterBackupInterface supports list, get and watch methods.
+// DBClusterBackupInterface supports list, get, create, update, update status, delete and watch methods.
 type DBClusterBackupInterface interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*everestv1alpha1.DatabaseClusterBackupList, error)
 	Get(ctx context.Context, name string, options metav1.GetOptions) (*everestv1alpha1.DatabaseClusterBackup, error)
+	Create(ctx context.Context, backup *everestv1alpha1.DatabaseClusterBackup, opts metav1.CreateOptions) (*everestv1alpha1.DatabaseClusterBackup, error)
+	Update(ctx context.Context, backup *everestv1alpha1.DatabaseClusterBackup, opts metav1.UpdateOptions) (*everestv1alpha1.DatabaseClusterBackup, error)
+	UpdateStatus(ctx context.Context, backup *everestv1alpha1.DatabaseClusterBackup, opts metav1.UpdateOptions) (*everestv1alpha1.DatabaseClusterBackup, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
 	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
 }
 
@@ -82,6 +86,68 @@ func (c *dbClusterBackupClient) Get(
 	return result, err
 }
 
+// Create creates a database cluster backup.
+func (c *dbClusterBackupClient) Create(
+	ctx context.Context,
+	backup *everestv1alpha1.DatabaseClusterBackup,
+	opts metav1.CreateOptions,
+) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	result := &everestv1alpha1.DatabaseClusterBackup{}
+	err := c.restClient.
+		Post().
+		Namespace(c.namespace).
+		Resource(dbClusterBackupsAPIKind).Body(backup).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do(ctx).Into(result)
+	return result, err
+}
+
+// Update updates a database cluster backup.
+func (c *dbClusterBackupClient) Update(
+	ctx context.Context,
+	backup *everestv1alpha1.DatabaseClusterBackup,
+	opts metav1.UpdateOptions,
+) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	result := &everestv1alpha1.DatabaseClusterBackup{}
+	err := c.restClient.
+		Put().Name(backup.Name).
+		Namespace(c.namespace).
+		Resource(dbClusterBackupsAPIKind).Body(backup).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do(ctx).Into(result)
+	return result, err
+}
+
+// UpdateStatus updates the status of a database cluster backup.
+func (c *dbClusterBackupClient) UpdateStatus(
+	ctx context.Context,
+	backup *everestv1alpha1.DatabaseClusterBackup,
+	opts metav1.UpdateOptions,
+) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	result := &everestv1alpha1.DatabaseClusterBackup{}
+	err := c.restClient.
+		Put().Name(backup.Name).
+		Namespace(c.namespace).
+		Resource(dbClusterBackupsAPIKind).SubResource("status").Body(backup).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do(ctx).Into(result)
+	return result, err
+}
+
+// Delete deletes a database cluster backup.
+func (c *dbClusterBackupClient) Delete(
+	ctx context.Context,
+	name string,
+	opts metav1.DeleteOptions,
+) error {
+	return c.restClient.
+		Delete().Name(name).
+		Namespace(c.namespace).
+		Resource(dbClusterBackupsAPIKind).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do(ctx).Error()
+}
+
 // Watch starts a watch based on opts.
 func (c *dbClusterBackupClient) Watch( //nolint:ireturn
 	ctx context.Context,
@@ -44,10 +44,12 @@ type dbClusterRestoreClient struct {
 	namespace  string
 }
 
-// DBClusterRestoreInterface supports list, get and watch methods.
+// DBClusterRestoreInterface supports list, get, create, update and watch methods.
 type DBClusterRestoreInterface interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*everestv1alpha1.DatabaseClusterRestoreList, error)
 	Get(ctx context.Context, name string, options metav1.GetOptions) (*everestv1alpha1.DatabaseClusterRestore, error)
+	Create(ctx context.Context, restore *everestv1alpha1.DatabaseClusterRestore, opts metav1.CreateOptions) (*everestv1alpha1.DatabaseClusterRestore, error)
+	Update(ctx context.Context, restore *everestv1alpha1.DatabaseClusterRestore, opts metav1.UpdateOptions) (*everestv1alpha1.DatabaseClusterRestore, error)
 	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
 }
 
@@ -82,6 +84,38 @@ func (c *dbClusterRestoreClient) Get(
 	return result, err
 }
 
+// Create creates a database cluster restore.
+func (c *dbClusterRestoreClient) Create(
+	ctx context.Context,
+	restore *everestv1alpha1.DatabaseClusterRestore,
+	opts metav1.CreateOptions,
+) (*everestv1alpha1.DatabaseClusterRestore, error) {
+	result := &everestv1alpha1.DatabaseClusterRestore{}
+	err := c.restClient.
+		Post().
+		Namespace(c.namespace).
+		Resource(dbClusterRestoresAPIKind).Body(restore).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do(ctx).Into(result)
+	return result, err
+}
+
+// Update updates a database cluster restore.
+func (c *dbClusterRestoreClient) Update(
+	ctx context.Context,
+	restore *everestv1alpha1.DatabaseClusterRestore,
+	opts metav1.UpdateOptions,
+) (*everestv1alpha1.DatabaseClusterRestore, error) {
+	result := &everestv1alpha1.DatabaseClusterRestore{}
+	err := c.restClient.
+		Put().Name(restore.Name).
+		Namespace(c.namespace).
+		Resource(dbClusterRestoresAPIKind).Body(restore).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do(ctx).Into(result)
+	return result, err
+}
+
 // Watch starts a watch based on opts.
 func (c *dbClusterRestoreClient) Watch( //nolint:ireturn
 	ctx context.Context,
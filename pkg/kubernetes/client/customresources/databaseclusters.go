@@ -44,10 +44,13 @@ type dbClusterClient struct {
 	namespace  string
 }
 
-// DBClusterInterface supports list, get and watch methods.
+// DBClusterInterface supports list, get, create, update and watch methods.
 type DBClusterInterface interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*everestv1alpha1.DatabaseClusterList, error)
 	Get(ctx context.Context, name string, options metav1.GetOptions) (*everestv1alpha1.DatabaseCluster, error)
+	Create(ctx context.Context, cluster *everestv1alpha1.DatabaseCluster, opts metav1.CreateOptions) (*everestv1alpha1.DatabaseCluster, error)
+	Update(ctx context.Context, cluster *everestv1alpha1.DatabaseCluster, opts metav1.UpdateOptions) (*everestv1alpha1.DatabaseCluster, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
 	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
 }
 
@@ -82,6 +85,52 @@ func (c *dbClusterClient) Get(
 	return result, err
 }
 
+// Create creates a database cluster.
+func (c *dbClusterClient) Create(
+	ctx context.Context,
+	cluster *everestv1alpha1.DatabaseCluster,
+	opts metav1.CreateOptions,
+) (*everestv1alpha1.DatabaseCluster, error) {
+	result := &everestv1alpha1.DatabaseCluster{}
+	err := c.restClient.
+		Post().
+		Namespace(c.namespace).
+		Resource(dbClustersAPIKind).Body(cluster).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do(ctx).Into(result)
+	return result, err
+}
+
+// Update updates a database cluster.
+func (c *dbClusterClient) Update(
+	ctx context.Context,
+	cluster *everestv1alpha1.DatabaseCluster,
+	opts metav1.UpdateOptions,
+) (*everestv1alpha1.DatabaseCluster, error) {
+	result := &everestv1alpha1.DatabaseCluster{}
+	err := c.restClient.
+		Put().Name(cluster.Name).
+		Namespace(c.namespace).
+		Resource(dbClustersAPIKind).Body(cluster).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do(ctx).Into(result)
+	return result, err
+}
+
+// Delete deletes a database cluster.
+func (c *dbClusterClient) Delete(
+	ctx context.Context,
+	name string,
+	opts metav1.DeleteOptions,
+) error {
+	return c.restClient.
+		Delete().Name(name).
+		Namespace(c.namespace).
+		Resource(dbClustersAPIKind).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do(ctx).Error()
+}
+
 // Watch starts a watch based on opts.
 func (c *dbClusterClient) Watch( //nolint:ireturn
 	ctx context.Context,
@@ -16,3 +16,18 @@ func (c *Client) ListDatabaseClusters(ctx context.Context, namespace string, opt
 func (c *Client) GetDatabaseCluster(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error) {
 	return c.customClientSet.DBClusters(namespace).Get(ctx, name, metav1.GetOptions{})
 }
+
+// CreateDatabaseCluster creates the provided database cluster.
+func (c *Client) CreateDatabaseCluster(ctx context.Context, cluster *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
+	return c.customClientSet.DBClusters(cluster.Namespace).Create(ctx, cluster, metav1.CreateOptions{})
+}
+
+// UpdateDatabaseCluster updates the provided database cluster.
+func (c *Client) UpdateDatabaseCluster(ctx context.Context, cluster *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
+	return c.customClientSet.DBClusters(cluster.Namespace).Update(ctx, cluster, metav1.UpdateOptions{})
+}
+
+// DeleteDatabaseCluster deletes the database cluster.
+func (c *Client) DeleteDatabaseCluster(ctx context.Context, namespace, name string) error {
+	return c.customClientSet.DBClusters(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+}
@@ -16,3 +16,23 @@ func (c *Client) ListDatabaseClusterBackups(ctx context.Context, namespace strin
 func (c *Client) GetDatabaseClusterBackup(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseClusterBackup, error) {
 	return c.customClientSet.DBClusterBackups(namespace).Get(ctx, name, metav1.GetOptions{})
 }
+
+// CreateDatabaseClusterBackup creates a database cluster backup.
+func (c *Client) CreateDatabaseClusterBackup(ctx context.Context, namespace string, backup *everestv1alpha1.DatabaseClusterBackup) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	return c.customClientSet.DBClusterBackups(namespace).Create(ctx, backup, metav1.CreateOptions{})
+}
+
+// UpdateDatabaseClusterBackup updates the provided database cluster backup.
+func (c *Client) UpdateDatabaseClusterBackup(ctx context.Context, backup *everestv1alpha1.DatabaseClusterBackup) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	return c.customClientSet.DBClusterBackups(backup.Namespace).Update(ctx, backup, metav1.UpdateOptions{})
+}
+
+// UpdateDatabaseClusterBackupStatus updates the status of the provided database cluster backup.
+func (c *Client) UpdateDatabaseClusterBackupStatus(ctx context.Context, backup *everestv1alpha1.DatabaseClusterBackup) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	return c.customClientSet.DBClusterBackups(backup.Namespace).UpdateStatus(ctx, backup, metav1.UpdateOptions{})
+}
+
+// DeleteDatabaseClusterBackup deletes a database cluster backup.
+func (c *Client) DeleteDatabaseClusterBackup(ctx context.Context, namespace, name string) error {
+	return c.customClientSet.DBClusterBackups(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+}
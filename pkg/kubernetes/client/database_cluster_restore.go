@@ -16,3 +16,13 @@ func (c *Client) ListDatabaseClusterRestores(ctx context.Context, namespace stri
 func (c *Client) GetDatabaseClusterRestore(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseClusterRestore, error) {
 	return c.customClientSet.DBClusterRestores(namespace).Get(ctx, name, metav1.GetOptions{})
 }
+
+// CreateDatabaseClusterRestore creates the database cluster restore.
+func (c *Client) CreateDatabaseClusterRestore(ctx context.Context, restore *everestv1alpha1.DatabaseClusterRestore) (*everestv1alpha1.DatabaseClusterRestore, error) {
+	return c.customClientSet.DBClusterRestores(restore.Namespace).Create(ctx, restore, metav1.CreateOptions{})
+}
+
+// UpdateDatabaseClusterRestore updates the database cluster restore.
+func (c *Client) UpdateDatabaseClusterRestore(ctx context.Context, restore *everestv1alpha1.DatabaseClusterRestore) (*everestv1alpha1.DatabaseClusterRestore, error) {
+	return c.customClientSet.DBClusterRestores(restore.Namespace).Update(ctx, restore, metav1.UpdateOptions{})
+}
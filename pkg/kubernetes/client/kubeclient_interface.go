@@ -4,6 +4,7 @@ package client
 
 import (
 	"context"
+	"io"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
@@ -49,22 +50,46 @@ type KubeClientConnector interface {
 	GetConfigMap(ctx context.Context, namespace, name string) (*corev1.ConfigMap, error)
 	// GetDeployment returns deployment by name.
 	GetDeployment(ctx context.Context, name string, namespace string) (*appsv1.Deployment, error)
+	// CreateConfigMap creates the provided config map.
+	CreateConfigMap(ctx context.Context, configMap *corev1.ConfigMap) (*corev1.ConfigMap, error)
+	// UpdateConfigMap updates the provided config map.
+	UpdateConfigMap(ctx context.Context, configMap *corev1.ConfigMap) (*corev1.ConfigMap, error)
 	// ListDatabaseClusters returns list of managed database clusters.
 	ListDatabaseClusters(ctx context.Context, namespace string, options metav1.ListOptions) (*everestv1alpha1.DatabaseClusterList, error)
 	// GetDatabaseCluster returns database clusters by provided name.
 	GetDatabaseCluster(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error)
+	// CreateDatabaseCluster creates the provided database cluster.
+	CreateDatabaseCluster(ctx context.Context, cluster *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error)
+	// UpdateDatabaseCluster updates the provided database cluster.
+	UpdateDatabaseCluster(ctx context.Context, cluster *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error)
+	// DeleteDatabaseCluster deletes the database cluster.
+	DeleteDatabaseCluster(ctx context.Context, namespace, name string) error
 	// ListDatabaseClusterBackups returns list of managed database cluster backups.
 	ListDatabaseClusterBackups(ctx context.Context, namespace string, options metav1.ListOptions) (*everestv1alpha1.DatabaseClusterBackupList, error)
 	// GetDatabaseClusterBackup returns database cluster backups by provided name.
 	GetDatabaseClusterBackup(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseClusterBackup, error)
+	// CreateDatabaseClusterBackup creates a database cluster backup.
+	CreateDatabaseClusterBackup(ctx context.Context, namespace string, backup *everestv1alpha1.DatabaseClusterBackup) (*everestv1alpha1.DatabaseClusterBackup, error)
+	// UpdateDatabaseClusterBackup updates the provided database cluster backup.
+	UpdateDatabaseClusterBackup(ctx context.Context, backup *everestv1alpha1.DatabaseClusterBackup) (*everestv1alpha1.DatabaseClusterBackup, error)
+	// UpdateDatabaseClusterBackupStatus updates the status of the provided database cluster backup.
+	UpdateDatabaseClusterBackupStatus(ctx context.Context, backup *everestv1alpha1.DatabaseClusterBackup) (*everestv1alpha1.DatabaseClusterBackup, error)
+	// DeleteDatabaseClusterBackup deletes a database cluster backup.
+	DeleteDatabaseClusterBackup(ctx context.Context, namespace, name string) error
 	// ListDatabaseClusterRestores returns list of managed database clusters.
 	ListDatabaseClusterRestores(ctx context.Context, namespace string, options metav1.ListOptions) (*everestv1alpha1.DatabaseClusterRestoreList, error)
 	// GetDatabaseClusterRestore returns database clusters by provided name.
 	GetDatabaseClusterRestore(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseClusterRestore, error)
+	// CreateDatabaseClusterRestore creates the database cluster restore.
+	CreateDatabaseClusterRestore(ctx context.Context, restore *everestv1alpha1.DatabaseClusterRestore) (*everestv1alpha1.DatabaseClusterRestore, error)
+	// UpdateDatabaseClusterRestore updates the database cluster restore.
+	UpdateDatabaseClusterRestore(ctx context.Context, restore *everestv1alpha1.DatabaseClusterRestore) (*everestv1alpha1.DatabaseClusterRestore, error)
 	// ListDatabaseEngines returns list of managed database clusters.
 	ListDatabaseEngines(ctx context.Context, namespace string) (*everestv1alpha1.DatabaseEngineList, error)
 	// GetDatabaseEngine returns database clusters by provided name.
 	GetDatabaseEngine(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseEngine, error)
+	// ListEvents returns events in the namespace.
+	ListEvents(ctx context.Context, namespace string, options metav1.ListOptions) (*corev1.EventList, error)
 	// CreateMonitoringConfig creates an monitoringConfig.
 	CreateMonitoringConfig(ctx context.Context, config *everestv1alpha1.MonitoringConfig) error
 	// UpdateMonitoringConfig updates an monitoringConfig.
@@ -81,6 +106,8 @@ type KubeClientConnector interface {
 	GetNodes(ctx context.Context) (*corev1.NodeList, error)
 	// GetPods returns list of pods.
 	GetPods(ctx context.Context, namespace string, labelSelector *metav1.LabelSelector) (*corev1.PodList, error)
+	// GetPodLogs returns a stream of the logs of the pod container.
+	GetPodLogs(ctx context.Context, namespace, name string, options *corev1.PodLogOptions) (io.ReadCloser, error)
 	// GetSecret returns secret by name.
 	GetSecret(ctx context.Context, namespace, name string) (*corev1.Secret, error)
 	// UpdateSecret updates k8s Secret.
@@ -93,4 +120,6 @@ type KubeClientConnector interface {
 	GetStorageClasses(ctx context.Context) (*storagev1.StorageClassList, error)
 	// GetPersistentVolumes returns Persistent Volumes available in the cluster.
 	GetPersistentVolumes(ctx context.Context) (*corev1.PersistentVolumeList, error)
+	// GetPersistentVolumeClaims returns Persistent Volume Claims in the namespace matching the label selector.
+	GetPersistentVolumeClaims(ctx context.Context, namespace string, labelSelector *metav1.LabelSelector) (*corev1.PersistentVolumeClaimList, error)
 }
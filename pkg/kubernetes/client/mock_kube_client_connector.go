@@ -4,6 +4,7 @@ package client
 
 import (
 	context "context"
+	io "io"
 
 	v1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	mock "github.com/stretchr/testify/mock"
@@ -96,6 +97,126 @@ func (_m *MockKubeClientConnector) CreateBackupStorage(ctx context.Context, stor
 	return r0
 }
 
+// CreateConfigMap provides a mock function with given fields: ctx, configMap
+func (_m *MockKubeClientConnector) CreateConfigMap(ctx context.Context, configMap *v1.ConfigMap) (*v1.ConfigMap, error) {
+	ret := _m.Called(ctx, configMap)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConfigMap")
+	}
+
+	var r0 *v1.ConfigMap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ConfigMap) (*v1.ConfigMap, error)); ok {
+		return rf(ctx, configMap)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ConfigMap) *v1.ConfigMap); ok {
+		r0 = rf(ctx, configMap)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ConfigMap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ConfigMap) error); ok {
+		r1 = rf(ctx, configMap)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDatabaseCluster provides a mock function with given fields: ctx, cluster
+func (_m *MockKubeClientConnector) CreateDatabaseCluster(ctx context.Context, cluster *v1alpha1.DatabaseCluster) (*v1alpha1.DatabaseCluster, error) {
+	ret := _m.Called(ctx, cluster)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDatabaseCluster")
+	}
+
+	var r0 *v1alpha1.DatabaseCluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseCluster) (*v1alpha1.DatabaseCluster, error)); ok {
+		return rf(ctx, cluster)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseCluster) *v1alpha1.DatabaseCluster); ok {
+		r0 = rf(ctx, cluster)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DatabaseCluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.DatabaseCluster) error); ok {
+		r1 = rf(ctx, cluster)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDatabaseClusterBackup provides a mock function with given fields: ctx, namespace, backup
+func (_m *MockKubeClientConnector) CreateDatabaseClusterBackup(ctx context.Context, namespace string, backup *v1alpha1.DatabaseClusterBackup) (*v1alpha1.DatabaseClusterBackup, error) {
+	ret := _m.Called(ctx, namespace, backup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDatabaseClusterBackup")
+	}
+
+	var r0 *v1alpha1.DatabaseClusterBackup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *v1alpha1.DatabaseClusterBackup) (*v1alpha1.DatabaseClusterBackup, error)); ok {
+		return rf(ctx, namespace, backup)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *v1alpha1.DatabaseClusterBackup) *v1alpha1.DatabaseClusterBackup); ok {
+		r0 = rf(ctx, namespace, backup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DatabaseClusterBackup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *v1alpha1.DatabaseClusterBackup) error); ok {
+		r1 = rf(ctx, namespace, backup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDatabaseClusterRestore provides a mock function with given fields: ctx, restore
+func (_m *MockKubeClientConnector) CreateDatabaseClusterRestore(ctx context.Context, restore *v1alpha1.DatabaseClusterRestore) (*v1alpha1.DatabaseClusterRestore, error) {
+	ret := _m.Called(ctx, restore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDatabaseClusterRestore")
+	}
+
+	var r0 *v1alpha1.DatabaseClusterRestore
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseClusterRestore) (*v1alpha1.DatabaseClusterRestore, error)); ok {
+		return rf(ctx, restore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseClusterRestore) *v1alpha1.DatabaseClusterRestore); ok {
+		r0 = rf(ctx, restore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DatabaseClusterRestore)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.DatabaseClusterRestore) error); ok {
+		r1 = rf(ctx, restore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateMonitoringConfig provides a mock function with given fields: ctx, config
 func (_m *MockKubeClientConnector) CreateMonitoringConfig(ctx context.Context, config *v1alpha1.MonitoringConfig) error {
 	ret := _m.Called(ctx, config)
@@ -162,6 +283,42 @@ func (_m *MockKubeClientConnector) DeleteBackupStorage(ctx context.Context, name
 	return r0
 }
 
+// DeleteDatabaseCluster provides a mock function with given fields: ctx, namespace, name
+func (_m *MockKubeClientConnector) DeleteDatabaseCluster(ctx context.Context, namespace string, name string) error {
+	ret := _m.Called(ctx, namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatabaseCluster")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, namespace, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteDatabaseClusterBackup provides a mock function with given fields: ctx, namespace, name
+func (_m *MockKubeClientConnector) DeleteDatabaseClusterBackup(ctx context.Context, namespace string, name string) error {
+	ret := _m.Called(ctx, namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatabaseClusterBackup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, namespace, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteMonitoringConfig provides a mock function with given fields: ctx, namespace, name
 func (_m *MockKubeClientConnector) DeleteMonitoringConfig(ctx context.Context, namespace string, name string) error {
 	ret := _m.Called(ctx, namespace, name)
@@ -534,6 +691,36 @@ func (_m *MockKubeClientConnector) GetObject(gvk schema.GroupVersionKind, name s
 	return r0
 }
 
+// GetPersistentVolumeClaims provides a mock function with given fields: ctx, namespace, labelSelector
+func (_m *MockKubeClientConnector) GetPersistentVolumeClaims(ctx context.Context, namespace string, labelSelector *metav1.LabelSelector) (*v1.PersistentVolumeClaimList, error) {
+	ret := _m.Called(ctx, namespace, labelSelector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPersistentVolumeClaims")
+	}
+
+	var r0 *v1.PersistentVolumeClaimList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *metav1.LabelSelector) (*v1.PersistentVolumeClaimList, error)); ok {
+		return rf(ctx, namespace, labelSelector)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *metav1.LabelSelector) *v1.PersistentVolumeClaimList); ok {
+		r0 = rf(ctx, namespace, labelSelector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.PersistentVolumeClaimList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *metav1.LabelSelector) error); ok {
+		r1 = rf(ctx, namespace, labelSelector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPersistentVolumes provides a mock function with given fields: ctx
 func (_m *MockKubeClientConnector) GetPersistentVolumes(ctx context.Context) (*v1.PersistentVolumeList, error) {
 	ret := _m.Called(ctx)
@@ -564,6 +751,36 @@ func (_m *MockKubeClientConnector) GetPersistentVolumes(ctx context.Context) (*v
 	return r0, r1
 }
 
+// GetPodLogs provides a mock function with given fields: ctx, namespace, name, options
+func (_m *MockKubeClientConnector) GetPodLogs(ctx context.Context, namespace string, name string, options *v1.PodLogOptions) (io.ReadCloser, error) {
+	ret := _m.Called(ctx, namespace, name, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPodLogs")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *v1.PodLogOptions) (io.ReadCloser, error)); ok {
+		return rf(ctx, namespace, name, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *v1.PodLogOptions) io.ReadCloser); ok {
+		r0 = rf(ctx, namespace, name, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *v1.PodLogOptions) error); ok {
+		r1 = rf(ctx, namespace, name, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPods provides a mock function with given fields: ctx, namespace, labelSelector
 func (_m *MockKubeClientConnector) GetPods(ctx context.Context, namespace string, labelSelector *metav1.LabelSelector) (*v1.PodList, error) {
 	ret := _m.Called(ctx, namespace, labelSelector)
@@ -834,6 +1051,36 @@ func (_m *MockKubeClientConnector) ListDatabaseEngines(ctx context.Context, name
 	return r0, r1
 }
 
+// ListEvents provides a mock function with given fields: ctx, namespace, options
+func (_m *MockKubeClientConnector) ListEvents(ctx context.Context, namespace string, options metav1.ListOptions) (*v1.EventList, error) {
+	ret := _m.Called(ctx, namespace, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEvents")
+	}
+
+	var r0 *v1.EventList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, metav1.ListOptions) (*v1.EventList, error)); ok {
+		return rf(ctx, namespace, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, metav1.ListOptions) *v1.EventList); ok {
+		r0 = rf(ctx, namespace, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.EventList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, metav1.ListOptions) error); ok {
+		r1 = rf(ctx, namespace, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListMonitoringConfigs provides a mock function with given fields: ctx, namespace
 func (_m *MockKubeClientConnector) ListMonitoringConfigs(ctx context.Context, namespace string) (*v1alpha1.MonitoringConfigList, error) {
 	ret := _m.Called(ctx, namespace)
@@ -918,6 +1165,156 @@ func (_m *MockKubeClientConnector) UpdateBackupStorage(ctx context.Context, stor
 	return r0
 }
 
+// UpdateConfigMap provides a mock function with given fields: ctx, configMap
+func (_m *MockKubeClientConnector) UpdateConfigMap(ctx context.Context, configMap *v1.ConfigMap) (*v1.ConfigMap, error) {
+	ret := _m.Called(ctx, configMap)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfigMap")
+	}
+
+	var r0 *v1.ConfigMap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ConfigMap) (*v1.ConfigMap, error)); ok {
+		return rf(ctx, configMap)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.ConfigMap) *v1.ConfigMap); ok {
+		r0 = rf(ctx, configMap)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ConfigMap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.ConfigMap) error); ok {
+		r1 = rf(ctx, configMap)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateDatabaseCluster provides a mock function with given fields: ctx, cluster
+func (_m *MockKubeClientConnector) UpdateDatabaseCluster(ctx context.Context, cluster *v1alpha1.DatabaseCluster) (*v1alpha1.DatabaseCluster, error) {
+	ret := _m.Called(ctx, cluster)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatabaseCluster")
+	}
+
+	var r0 *v1alpha1.DatabaseCluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseCluster) (*v1alpha1.DatabaseCluster, error)); ok {
+		return rf(ctx, cluster)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseCluster) *v1alpha1.DatabaseCluster); ok {
+		r0 = rf(ctx, cluster)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DatabaseCluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.DatabaseCluster) error); ok {
+		r1 = rf(ctx, cluster)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateDatabaseClusterBackup provides a mock function with given fields: ctx, backup
+func (_m *MockKubeClientConnector) UpdateDatabaseClusterBackup(ctx context.Context, backup *v1alpha1.DatabaseClusterBackup) (*v1alpha1.DatabaseClusterBackup, error) {
+	ret := _m.Called(ctx, backup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatabaseClusterBackup")
+	}
+
+	var r0 *v1alpha1.DatabaseClusterBackup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseClusterBackup) (*v1alpha1.DatabaseClusterBackup, error)); ok {
+		return rf(ctx, backup)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseClusterBackup) *v1alpha1.DatabaseClusterBackup); ok {
+		r0 = rf(ctx, backup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DatabaseClusterBackup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.DatabaseClusterBackup) error); ok {
+		r1 = rf(ctx, backup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateDatabaseClusterBackupStatus provides a mock function with given fields: ctx, backup
+func (_m *MockKubeClientConnector) UpdateDatabaseClusterBackupStatus(ctx context.Context, backup *v1alpha1.DatabaseClusterBackup) (*v1alpha1.DatabaseClusterBackup, error) {
+	ret := _m.Called(ctx, backup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatabaseClusterBackupStatus")
+	}
+
+	var r0 *v1alpha1.DatabaseClusterBackup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseClusterBackup) (*v1alpha1.DatabaseClusterBackup, error)); ok {
+		return rf(ctx, backup)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseClusterBackup) *v1alpha1.DatabaseClusterBackup); ok {
+		r0 = rf(ctx, backup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DatabaseClusterBackup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.DatabaseClusterBackup) error); ok {
+		r1 = rf(ctx, backup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateDatabaseClusterRestore provides a mock function with given fields: ctx, restore
+func (_m *MockKubeClientConnector) UpdateDatabaseClusterRestore(ctx context.Context, restore *v1alpha1.DatabaseClusterRestore) (*v1alpha1.DatabaseClusterRestore, error) {
+	ret := _m.Called(ctx, restore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatabaseClusterRestore")
+	}
+
+	var r0 *v1alpha1.DatabaseClusterRestore
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseClusterRestore) (*v1alpha1.DatabaseClusterRestore, error)); ok {
+		return rf(ctx, restore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseClusterRestore) *v1alpha1.DatabaseClusterRestore); ok {
+		r0 = rf(ctx, restore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DatabaseClusterRestore)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.DatabaseClusterRestore) error); ok {
+		r1 = rf(ctx, restore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateMonitoringConfig provides a mock function with given fields: ctx, config
 func (_m *MockKubeClientConnector) UpdateMonitoringConfig(ctx context.Context, config *v1alpha1.MonitoringConfig) error {
 	ret := _m.Called(ctx, config)
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"io"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -16,3 +17,8 @@ func (c *Client) GetPods(ctx context.Context, namespace string, labelSelector *m
 
 	return c.clientset.CoreV1().Pods(namespace).List(ctx, options)
 }
+
+// GetPodLogs returns a stream of the logs of the pod container.
+func (c *Client) GetPodLogs(ctx context.Context, namespace, name string, options *corev1.PodLogOptions) (io.ReadCloser, error) {
+	return c.clientset.CoreV1().Pods(namespace).GetLogs(name, options).Stream(ctx)
+}
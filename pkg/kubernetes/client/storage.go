@@ -17,3 +17,16 @@ func (c *Client) GetStorageClasses(ctx context.Context) (*storagev1.StorageClass
 func (c *Client) GetPersistentVolumes(ctx context.Context) (*corev1.PersistentVolumeList, error) {
 	return c.clientset.CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
 }
+
+// GetPersistentVolumeClaims returns Persistent Volume Claims in the namespace matching the label selector.
+func (c *Client) GetPersistentVolumeClaims(
+	ctx context.Context,
+	namespace string,
+	labelSelector *metav1.LabelSelector,
+) (*corev1.PersistentVolumeClaimList, error) {
+	options := metav1.ListOptions{}
+	if labelSelector != nil && (labelSelector.MatchLabels != nil || labelSelector.MatchExpressions != nil) {
+		options.LabelSelector = metav1.FormatLabelSelector(labelSelector)
+	}
+	return c.clientset.CoreV1().PersistentVolumeClaims(namespace).List(ctx, options)
+}
@@ -0,0 +1,119 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// GetConfigMap returns a config map by name.
+func (k *Kubernetes) GetConfigMap(ctx context.Context, namespace, name string) (*corev1.ConfigMap, error) {
+	return k.client.GetConfigMap(ctx, namespace, name)
+}
+
+// GetConfigMapData returns the data of the config map with the provided name
+// in the Everest namespace. A missing config map is treated as an empty one.
+func (k *Kubernetes) GetConfigMapData(ctx context.Context, name string) (map[string]string, error) {
+	cm, err := k.client.GetConfigMap(ctx, k.namespace, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+	if cm.Data == nil {
+		return map[string]string{}, nil
+	}
+	return cm.Data, nil
+}
+
+// SetConfigMapEntry sets the key of the config map with the provided name in
+// the Everest namespace. The config map is created if it does not exist.
+func (k *Kubernetes) SetConfigMapEntry(ctx context.Context, name, key, value string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := k.client.GetConfigMap(ctx, k.namespace, name)
+		if k8serrors.IsNotFound(err) {
+			_, err = k.client.CreateConfigMap(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: k.namespace,
+				},
+				Data: map[string]string{key: value},
+			})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[key] = value
+		_, err = k.client.UpdateConfigMap(ctx, cm)
+		return err
+	})
+}
+
+// DeleteConfigMapEntry removes the key from the config map with the provided
+// name in the Everest namespace.
+func (k *Kubernetes) DeleteConfigMapEntry(ctx context.Context, name, key string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := k.client.GetConfigMap(ctx, k.namespace, name)
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, ok := cm.Data[key]; !ok {
+			return nil
+		}
+		delete(cm.Data, key)
+		_, err = k.client.UpdateConfigMap(ctx, cm)
+		return err
+	})
+}
+
+// UpdateConfigMapEntry sets the key of the config map with the provided name in
+// the Everest namespace to the value returned by update for its current value.
+// Nothing is written if the config map or the key does not exist.
+func (k *Kubernetes) UpdateConfigMapEntry(ctx context.Context, name, key string, update func(value string) (string, error)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := k.client.GetConfigMap(ctx, k.namespace, name)
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		value, ok := cm.Data[key]
+		if !ok {
+			return nil
+		}
+		value, err = update(value)
+		if err != nil {
+			return err
+		}
+		cm.Data[key] = value
+		_, err = k.client.UpdateConfigMap(ctx, cm)
+		return err
+	})
+}
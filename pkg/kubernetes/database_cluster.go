@@ -32,3 +32,18 @@ func (k *Kubernetes) ListDatabaseClusters(ctx context.Context, namespace string)
 func (k *Kubernetes) GetDatabaseCluster(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error) {
 	return k.client.GetDatabaseCluster(ctx, namespace, name)
 }
+
+// CreateDatabaseCluster creates the provided database cluster.
+func (k *Kubernetes) CreateDatabaseCluster(ctx context.Context, cluster *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
+	return k.client.CreateDatabaseCluster(ctx, cluster)
+}
+
+// UpdateDatabaseCluster updates the provided database cluster.
+func (k *Kubernetes) UpdateDatabaseCluster(ctx context.Context, cluster *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
+	return k.client.UpdateDatabaseCluster(ctx, cluster)
+}
+
+// DeleteDatabaseCluster deletes the database cluster.
+func (k *Kubernetes) DeleteDatabaseCluster(ctx context.Context, namespace, name string) error {
+	return k.client.DeleteDatabaseCluster(ctx, namespace, name)
+}
@@ -31,3 +31,23 @@ func (k *Kubernetes) GetDatabaseClusterBackup(ctx context.Context, namespace, na
 func (k *Kubernetes) ListDatabaseClusterBackups(ctx context.Context, namespace string, options metav1.ListOptions) (*everestv1alpha1.DatabaseClusterBackupList, error) {
 	return k.client.ListDatabaseClusterBackups(ctx, namespace, options)
 }
+
+// CreateDatabaseClusterBackup creates a database cluster backup.
+func (k *Kubernetes) CreateDatabaseClusterBackup(ctx context.Context, namespace string, backup *everestv1alpha1.DatabaseClusterBackup) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	return k.client.CreateDatabaseClusterBackup(ctx, namespace, backup)
+}
+
+// UpdateDatabaseClusterBackup updates the provided database cluster backup.
+func (k *Kubernetes) UpdateDatabaseClusterBackup(ctx context.Context, backup *everestv1alpha1.DatabaseClusterBackup) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	return k.client.UpdateDatabaseClusterBackup(ctx, backup)
+}
+
+// UpdateDatabaseClusterBackupStatus updates the status of the provided database cluster backup.
+func (k *Kubernetes) UpdateDatabaseClusterBackupStatus(ctx context.Context, backup *everestv1alpha1.DatabaseClusterBackup) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	return k.client.UpdateDatabaseClusterBackupStatus(ctx, backup)
+}
+
+// DeleteDatabaseClusterBackup deletes a database cluster backup.
+func (k *Kubernetes) DeleteDatabaseClusterBackup(ctx context.Context, namespace, name string) error {
+	return k.client.DeleteDatabaseClusterBackup(ctx, namespace, name)
+}
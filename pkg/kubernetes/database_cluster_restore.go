@@ -31,3 +31,13 @@ func (k *Kubernetes) GetDatabaseClusterRestore(ctx context.Context, namespace, n
 func (k *Kubernetes) ListDatabaseClusterRestores(ctx context.Context, namespace string, options metav1.ListOptions) (*everestv1alpha1.DatabaseClusterRestoreList, error) {
 	return k.client.ListDatabaseClusterRestores(ctx, namespace, options)
 }
+
+// CreateDatabaseClusterRestore creates the database cluster restore.
+func (k *Kubernetes) CreateDatabaseClusterRestore(ctx context.Context, restore *everestv1alpha1.DatabaseClusterRestore) (*everestv1alpha1.DatabaseClusterRestore, error) {
+	return k.client.CreateDatabaseClusterRestore(ctx, restore)
+}
+
+// UpdateDatabaseClusterRestore updates the database cluster restore.
+func (k *Kubernetes) UpdateDatabaseClusterRestore(ctx context.Context, restore *everestv1alpha1.DatabaseClusterRestore) (*everestv1alpha1.DatabaseClusterRestore, error) {
+	return k.client.UpdateDatabaseClusterRestore(ctx, restore)
+}
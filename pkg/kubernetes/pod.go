@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"context"
+	"io"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -11,3 +12,8 @@ import (
 func (k *Kubernetes) GetPods(ctx context.Context, namespace string, labelSelector *metav1.LabelSelector) (*corev1.PodList, error) {
 	return k.client.GetPods(ctx, namespace, labelSelector)
 }
+
+// GetPodLogs returns a stream of the logs of the pod container.
+func (k *Kubernetes) GetPodLogs(ctx context.Context, namespace, name string, options *corev1.PodLogOptions) (io.ReadCloser, error) {
+	return k.client.GetPodLogs(ctx, namespace, name, options)
+}
@@ -5,6 +5,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // GetPersistentVolumes returns list of persistent volumes.
@@ -16,3 +17,12 @@ func (k *Kubernetes) GetPersistentVolumes(ctx context.Context) (*corev1.Persiste
 func (k *Kubernetes) GetStorageClasses(ctx context.Context) (*storagev1.StorageClassList, error) {
 	return k.client.GetStorageClasses(ctx)
 }
+
+// GetPersistentVolumeClaims returns list of persistent volume claims matching the label selector.
+func (k *Kubernetes) GetPersistentVolumeClaims(
+	ctx context.Context,
+	namespace string,
+	labelSelector *metav1.LabelSelector,
+) (*corev1.PersistentVolumeClaimList, error) {
+	return k.client.GetPersistentVolumeClaims(ctx, namespace, labelSelector)
+}